@@ -67,6 +67,41 @@ func InvalidFieldLimit(field string, validationName string, validationSize strin
 	}
 }
 
+func InvalidFrontMatter(err error) *echo.HTTPError {
+	return &echo.HTTPError{
+		Code:    http.StatusBadRequest,
+		Message: fmt.Sprintf("Could not read front matter: %v", err),
+	}
+}
+
+func InvalidArchive(err error) *echo.HTTPError {
+	return &echo.HTTPError{
+		Code:    http.StatusBadRequest,
+		Message: fmt.Sprintf("Could not read archive: %v", err),
+	}
+}
+
+func ArchiveTooLarge(maxSize int64) *echo.HTTPError {
+	return &echo.HTTPError{
+		Code:    http.StatusRequestEntityTooLarge,
+		Message: fmt.Sprintf("Archive is larger than %d bytes", maxSize),
+	}
+}
+
+func MediaTooLarge(maxSize int64) *echo.HTTPError {
+	return &echo.HTTPError{
+		Code:    http.StatusRequestEntityTooLarge,
+		Message: fmt.Sprintf("Media is larger than %d bytes", maxSize),
+	}
+}
+
+func UnsupportedMedia(err error) *echo.HTTPError {
+	return &echo.HTTPError{
+		Code:    http.StatusUnsupportedMediaType,
+		Message: fmt.Sprintf("Media should be a JPEG, PNG or GIF image: %v", err),
+	}
+}
+
 func UserNotFound(identifier string) *echo.HTTPError {
 	return &echo.HTTPError{
 		Code:    http.StatusNotFound,
@@ -88,6 +123,41 @@ func ArticleNotFound(identifier string) *echo.HTTPError {
 	}
 }
 
+func ArticleAlreadyPublished(identifier string) *echo.HTTPError {
+	return &echo.HTTPError{
+		Code:    http.StatusConflict,
+		Message: fmt.Sprintf("Article with identifier %q is already published", identifier),
+	}
+}
+
+func RevisionNotFound(slug string, number int64) *echo.HTTPError {
+	return &echo.HTTPError{
+		Code:    http.StatusNotFound,
+		Message: fmt.Sprintf("Revision %d of article %q not found", number, slug),
+	}
+}
+
+func CoAuthorInvitationNotFound(slug, username string) *echo.HTTPError {
+	return &echo.HTTPError{
+		Code:    http.StatusNotFound,
+		Message: fmt.Sprintf("%q was not invited to co-author article %q", username, slug),
+	}
+}
+
+func SeriesNotFound(slug string) *echo.HTTPError {
+	return &echo.HTTPError{
+		Code:    http.StatusNotFound,
+		Message: fmt.Sprintf("Series %q not found", slug),
+	}
+}
+
+func TagAliasNotFound(tag, alias string) *echo.HTTPError {
+	return &echo.HTTPError{
+		Code:    http.StatusNotFound,
+		Message: fmt.Sprintf("%q is not an alias of tag %q", alias, tag),
+	}
+}
+
 func FeedNotFound(identifier string) *echo.HTTPError {
 	return &echo.HTTPError{
 		Code:    http.StatusNotFound,
@@ -102,6 +172,34 @@ func CommentNotFound(identifier string) *echo.HTTPError {
 	}
 }
 
+func FavoriteNotFound(slug, username string) *echo.HTTPError {
+	return &echo.HTTPError{
+		Code:    http.StatusNotFound,
+		Message: fmt.Sprintf("%q has not favorited article %q", username, slug),
+	}
+}
+
+func MediaNotFound(identifier string) *echo.HTTPError {
+	return &echo.HTTPError{
+		Code:    http.StatusNotFound,
+		Message: fmt.Sprintf("Media %q not found", identifier),
+	}
+}
+
+func SitemapNotFound(page int) *echo.HTTPError {
+	return &echo.HTTPError{
+		Code:    http.StatusNotFound,
+		Message: fmt.Sprintf("Sitemap page %d not found", page),
+	}
+}
+
+func PreconditionFailed(identifier string) *echo.HTTPError {
+	return &echo.HTTPError{
+		Code:    http.StatusPreconditionFailed,
+		Message: fmt.Sprintf("%q was modified since it was fetched", identifier),
+	}
+}
+
 func InternalError(internal error) *echo.HTTPError {
 	return &echo.HTTPError{
 		Code:     http.StatusInternalServerError,
@@ -21,7 +21,7 @@ func ToHTTP(err validator.FieldError) *echo.HTTPError {
 		return api.RequiredFieldError(err.Field())
 	case "min", "max":
 		return api.InvalidFieldLimit(err.Field(), tag, err.Param())
-	case "email", "http_url|base64":
+	case "email", "http_url|base64", "oneof":
 		return api.InvalidFieldError(err.Field(), err.Value())
 	case "unique":
 		return api.UniqueFieldError(err.Field())
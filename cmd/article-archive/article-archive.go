@@ -0,0 +1,184 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/ravilock/goduit/api/validators"
+	"github.com/ravilock/goduit/internal/log"
+	"github.com/ravilock/goduit/internal/mongo"
+
+	articleArchives "github.com/ravilock/goduit/internal/articlePublisher/archives"
+	articleAssemblers "github.com/ravilock/goduit/internal/articlePublisher/assemblers"
+	articlePublishers "github.com/ravilock/goduit/internal/articlePublisher/publishers"
+	articleRepositories "github.com/ravilock/goduit/internal/articlePublisher/repositories"
+	articleServices "github.com/ravilock/goduit/internal/articlePublisher/services"
+	_ "github.com/ravilock/goduit/internal/config"
+	profileRepositories "github.com/ravilock/goduit/internal/profileManager/repositories"
+	profileServices "github.com/ravilock/goduit/internal/profileManager/services"
+	"github.com/ravilock/goduit/internal/queue"
+	"github.com/spf13/viper"
+	mongoDriver "go.mongodb.org/mongo-driver/mongo"
+)
+
+const usage = `Usage:
+  article-archive export -user <username> [-format zip|tar] [-out <file>]
+  article-archive import -user <username> -in <file> [-dry-run]
+`
+
+func main() {
+	logger := log.NewLogger(map[string]string{"emitter": "Goduit-Article-Archive"})
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	var err error
+	switch os.Args[1] {
+	case "export":
+		err = exportArticles(ctx, logger, os.Args[2:])
+	case "import":
+		err = importArticles(ctx, logger, os.Args[2:])
+	default:
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+	if err != nil {
+		logger.Error("Failed to "+os.Args[1]+" articles", "error", err)
+		os.Exit(1)
+	}
+}
+
+func exportArticles(ctx context.Context, logger *slog.Logger, args []string) error {
+	flags := flag.NewFlagSet("export", flag.ExitOnError)
+	username := flags.String("user", "", "username of the author whose articles are exported")
+	format := flags.String("format", articleArchives.FormatZip, "archive format, zip or tar")
+	out := flags.String("out", "", "file the archive is written to, <username>-articles.<format> by default")
+	flags.Parse(args)
+	if *username == "" {
+		flags.Usage()
+		os.Exit(2)
+	}
+	if *out == "" {
+		*out = fmt.Sprintf("%s-articles.%s", *username, *format)
+	}
+
+	databaseClient, err := mongo.ConnectDatabase(viper.GetString("db.url"))
+	if err != nil {
+		return err
+	}
+	author, err := getAuthorID(ctx, databaseClient, *username)
+	if err != nil {
+		return err
+	}
+
+	articlePublisherRepository := articleRepositories.NewArticleRepository(databaseClient)
+	exportArticlesService := articleServices.NewExportArticlesService(articlePublisherRepository)
+	exporter := articleArchives.NewArticleExporter(exportArticlesService, viper.GetInt64("export.batch.size"))
+
+	file, err := os.Create(*out)
+	if err != nil {
+		return err
+	}
+	exported, err := exporter.Export(ctx, author, *format, file)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(*out)
+		return err
+	}
+	logger.Info("Exported articles", "user", *username, "exported", exported, "archive", *out)
+	return nil
+}
+
+func importArticles(ctx context.Context, logger *slog.Logger, args []string) error {
+	flags := flag.NewFlagSet("import", flag.ExitOnError)
+	username := flags.String("user", "", "username of the author the articles are imported for")
+	in := flags.String("in", "", "zip or tar archive of Markdown files with YAML front matter")
+	dryRun := flags.Bool("dry-run", false, "only validate the files, without writing any article")
+	flags.Parse(args)
+	if *username == "" || *in == "" {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	if err := validators.InitValidator(); err != nil {
+		return err
+	}
+	archive, err := os.ReadFile(*in)
+	if err != nil {
+		return err
+	}
+
+	databaseClient, err := mongo.ConnectDatabase(viper.GetString("db.url"))
+	if err != nil {
+		return err
+	}
+	author, err := getAuthorID(ctx, databaseClient, *username)
+	if err != nil {
+		return err
+	}
+
+	queueConnection, err := queue.Connect(
+		queue.QueueType(viper.GetString("queue.type")),
+		viper.GetString("queue.url"),
+	)
+	if err != nil {
+		return err
+	}
+	queuePublisher, err := queueConnection.NewPublisher(viper.GetString("article.queue.name"))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := queuePublisher.Close(); err != nil {
+			logger.Error("Failed to close queue publisher", "error", err)
+		}
+	}()
+	articleQueuePublisher := articlePublishers.NewArticlePublisher(queuePublisher)
+
+	articlePublisherRepository := articleRepositories.NewArticleRepository(databaseClient)
+	slugRepository := articleRepositories.NewSlugRepository(databaseClient)
+	revisionRepository := articleRepositories.NewRevisionRepository(databaseClient)
+	tagRepository := articleRepositories.NewTagRepository(databaseClient)
+	writeArticleService := articleServices.NewWriteArticleService(articlePublisherRepository, slugRepository, revisionRepository, tagRepository, articleQueuePublisher)
+	importer := articleArchives.NewArticleImporter(writeArticleService)
+
+	files, err := importer.Import(ctx, author, archive, *dryRun)
+	if err != nil {
+		return err
+	}
+	failed := 0
+	for _, file := range files {
+		report := articleAssemblers.ImportedArticleFile(file.Name, file.Article, file.Err)
+		if report.Error != "" {
+			failed++
+			logger.Warn("Rejected file", "file", report.Name, "error", report.Error)
+			continue
+		}
+		logger.Info("Accepted file", "file", report.Name, "slug", report.Slug)
+	}
+	logger.Info("Imported articles", "user", *username, "dryRun", *dryRun, "imported", len(files)-failed, "failed", failed)
+	if failed > 0 {
+		return fmt.Errorf("%d of %d files were rejected", failed, len(files))
+	}
+	return nil
+}
+
+func getAuthorID(ctx context.Context, databaseClient *mongoDriver.Client, username string) (string, error) {
+	getProfileService := profileServices.NewGetProfileService(profileRepositories.NewUserRepository(databaseClient))
+	author, err := getProfileService.GetProfileByUsername(ctx, username)
+	if err != nil {
+		return "", err
+	}
+	return author.ID.Hex(), nil
+}
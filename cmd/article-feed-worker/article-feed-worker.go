@@ -29,6 +29,7 @@ func main() {
 
 	userRepository := profileRepositories.NewUserRepository(databaseClient)
 	followerRepository := followerRepositories.NewFollowerRepository(databaseClient)
+	tagFollowerRepository := followerRepositories.NewTagFollowerRepository(databaseClient)
 	articlePublisherRepository := articleRepositories.NewArticleRepository(databaseClient)
 	feedRepository := articleRepositories.NewFeedRepository(databaseClient)
 
@@ -41,7 +42,7 @@ func main() {
 		panic(err)
 	}
 
-	handler := articleFeedWorker.NewArticleFeedHandler(articlePublisherRepository, userRepository, followerRepository, feedRepository, logger)
+	handler := articleFeedWorker.NewArticleFeedHandler(articlePublisherRepository, userRepository, followerRepository, tagFollowerRepository, feedRepository, logger)
 
 	articleFeedQueueConsumer, err := queueConnection.NewConsumer(viper.GetString("article.queue.name"), handler)
 	if err != nil {
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/ravilock/goduit/internal/log"
+	"github.com/ravilock/goduit/internal/mongo"
+
+	articleRepositories "github.com/ravilock/goduit/internal/articlePublisher/repositories"
+	articleServices "github.com/ravilock/goduit/internal/articlePublisher/services"
+	articlePurger "github.com/ravilock/goduit/internal/articlePublisher/workers/article-purger"
+	_ "github.com/ravilock/goduit/internal/config"
+	"github.com/spf13/viper"
+)
+
+func main() {
+	logger := log.NewLogger(map[string]string{"emitter": "Goduit-Article-Purger"})
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	databaseClient, err := mongo.ConnectDatabase(viper.GetString("db.url"))
+	if err != nil {
+		panic(err)
+	}
+
+	articlePublisherRepository := articleRepositories.NewArticleRepository(databaseClient)
+	commentRepository := articleRepositories.NewCommentRepository(databaseClient)
+	feedRepository := articleRepositories.NewFeedRepository(databaseClient)
+	favoriteRepository := articleRepositories.NewFavoriteRepository(databaseClient)
+	revisionRepository := articleRepositories.NewRevisionRepository(databaseClient)
+	slugRepository := articleRepositories.NewSlugRepository(databaseClient)
+	coAuthorInvitationRepository := articleRepositories.NewCoAuthorInvitationRepository(databaseClient)
+	seriesRepository := articleRepositories.NewSeriesRepository(databaseClient)
+	viewRepository := articleRepositories.NewViewRepository(databaseClient)
+
+	purgeArticleService := articleServices.NewPurgeArticleService(articlePublisherRepository, commentRepository, feedRepository, favoriteRepository, revisionRepository, slugRepository, coAuthorInvitationRepository, seriesRepository, viewRepository)
+
+	purger := articlePurger.NewArticlePurger(articlePublisherRepository, purgeArticleService, viper.GetDuration("trash.retention"), viper.GetInt64("purger.batch.size"), logger)
+
+	logger.Info(" [*] Purging expired trashed articles. To exit press CTRL+C\n")
+	purger.Run(ctx, viper.GetDuration("purger.interval"))
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/ravilock/goduit/internal/log"
+	"github.com/ravilock/goduit/internal/mongo"
+
+	articlePublishers "github.com/ravilock/goduit/internal/articlePublisher/publishers"
+	articleRepositories "github.com/ravilock/goduit/internal/articlePublisher/repositories"
+	articleServices "github.com/ravilock/goduit/internal/articlePublisher/services"
+	articleScheduler "github.com/ravilock/goduit/internal/articlePublisher/workers/article-scheduler"
+	_ "github.com/ravilock/goduit/internal/config"
+	"github.com/ravilock/goduit/internal/queue"
+	"github.com/spf13/viper"
+)
+
+func main() {
+	logger := log.NewLogger(map[string]string{"emitter": "Goduit-Article-Scheduler"})
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	databaseClient, err := mongo.ConnectDatabase(viper.GetString("db.url"))
+	if err != nil {
+		panic(err)
+	}
+
+	articlePublisherRepository := articleRepositories.NewArticleRepository(databaseClient)
+	tagRepository := articleRepositories.NewTagRepository(databaseClient)
+
+	queueConnection, err := queue.Connect(
+		queue.QueueType(viper.GetString("queue.type")),
+		viper.GetString("queue.url"),
+	)
+	if err != nil {
+		logger.Error("Failed to connect to queue", "error", err)
+		panic(err)
+	}
+
+	queuePublisher, err := queueConnection.NewPublisher(viper.GetString("article.queue.name"))
+	if err != nil {
+		panic(err)
+	}
+	articleQueuePublisher := articlePublishers.NewArticlePublisher(queuePublisher)
+
+	publishArticleService := articleServices.NewPublishArticleService(articlePublisherRepository, tagRepository, articleQueuePublisher)
+
+	scheduler := articleScheduler.NewArticleScheduler(articlePublisherRepository, publishArticleService, viper.GetInt64("scheduler.batch.size"), logger)
+
+	logger.Info(" [*] Publishing scheduled articles. To exit press CTRL+C\n")
+	scheduler.Run(ctx, viper.GetDuration("scheduler.interval"))
+	if err := queuePublisher.Close(); err != nil {
+		logger.Error("Failed to close queue publisher", "error", err)
+	}
+}
@@ -0,0 +1,38 @@
+package main
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/ravilock/goduit/internal/log"
+	"github.com/ravilock/goduit/internal/mongo"
+
+	articleRepositories "github.com/ravilock/goduit/internal/articlePublisher/repositories"
+	articleServices "github.com/ravilock/goduit/internal/articlePublisher/services"
+	_ "github.com/ravilock/goduit/internal/config"
+	"github.com/spf13/viper"
+)
+
+func main() {
+	logger := log.NewLogger(map[string]string{"emitter": "Goduit-Article-Summary-Backfill"})
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	databaseClient, err := mongo.ConnectDatabase(viper.GetString("db.url"))
+	if err != nil {
+		panic(err)
+	}
+
+	articlePublisherRepository := articleRepositories.NewArticleRepository(databaseClient)
+	backfillArticleSummariesService := articleServices.NewBackfillArticleSummariesService(articlePublisherRepository)
+
+	logger.Info("Summarizing articles without word count, reading time and excerpt")
+	summarized, err := backfillArticleSummariesService.BackfillArticleSummaries(ctx, viper.GetInt64("backfill.batch.size"))
+	if err != nil {
+		logger.Error("Failed to backfill article summaries", "summarized", summarized, "error", err)
+		os.Exit(1)
+	}
+	logger.Info("Backfilled article summaries", "summarized", summarized)
+}
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/ravilock/goduit/internal/log"
+	"github.com/ravilock/goduit/internal/mongo"
+
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+	articleRepositories "github.com/ravilock/goduit/internal/articlePublisher/repositories"
+	articleServices "github.com/ravilock/goduit/internal/articlePublisher/services"
+	articleTrending "github.com/ravilock/goduit/internal/articlePublisher/workers/article-trending"
+	_ "github.com/ravilock/goduit/internal/config"
+	"github.com/spf13/viper"
+)
+
+func main() {
+	logger := log.NewLogger(map[string]string{"emitter": "Goduit-Article-Trending"})
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	databaseClient, err := mongo.ConnectDatabase(viper.GetString("db.url"))
+	if err != nil {
+		panic(err)
+	}
+
+	articlePublisherRepository := articleRepositories.NewArticleRepository(databaseClient)
+	viewRepository := articleRepositories.NewViewRepository(databaseClient)
+	favoriteRepository := articleRepositories.NewFavoriteRepository(databaseClient)
+	commentRepository := articleRepositories.NewCommentRepository(databaseClient)
+	trendingRepository := articleRepositories.NewTrendingRepository(databaseClient)
+
+	scoreTrendingArticlesService := articleServices.NewScoreTrendingArticlesService(articlePublisherRepository, viewRepository, favoriteRepository, commentRepository, trendingRepository, viper.GetInt("trending.size"))
+
+	trending := articleTrending.NewArticleTrending(scoreTrendingArticlesService, models.TrendingWindows, logger)
+
+	logger.Info(" [*] Scoring trending articles. To exit press CTRL+C\n")
+	trending.Run(ctx, viper.GetDuration("trending.interval"))
+}
@@ -8,7 +8,6 @@ import (
 	"io"
 	"net/http"
 	"slices"
-	"strings"
 	"testing"
 	"time"
 
@@ -17,14 +16,14 @@ import (
 	articlePublisherRepositories "github.com/ravilock/goduit/internal/articlePublisher/repositories"
 	articlePublisherRequests "github.com/ravilock/goduit/internal/articlePublisher/requests"
 	articlePublisherResponses "github.com/ravilock/goduit/internal/articlePublisher/responses"
+	"github.com/ravilock/goduit/internal/slugger"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
 	"go.mongodb.org/mongo-driver/mongo"
 )
 
 func MakeSlug(title string) string {
-	loweredTitle := strings.ToLower(title)
-	return strings.ReplaceAll(loweredTitle, " ", "-")
+	return slugger.Make(title)
 }
 
 func MustWriteArticle(t *testing.T, writeArticlePayload articlePublisherRequests.WriteArticlePayload, cookie *http.Cookie) *articlePublisherResponses.ArticleResponse {
@@ -127,3 +126,24 @@ func MustWriteComment(t *testing.T, writeCommentPayload articlePublisherRequests
 	require.NoError(t, err)
 	return writeCommentResponse
 }
+
+func MustFavoriteArticle(t *testing.T, articleSlug string, userCookie *http.Cookie) *articlePublisherResponses.ArticleResponse {
+	httpClient := http.Client{}
+	serverUrl := viper.GetString("server.url")
+	favoriteArticleEndpoint := fmt.Sprintf("%s%s/%s%s", serverUrl, "/api/articles", articleSlug, "/favorite")
+	req, err := http.NewRequest(http.MethodPost, favoriteArticleEndpoint, nil)
+	require.NoError(t, err)
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.AddCookie(userCookie)
+	res, err := httpClient.Do(req)
+	require.NoError(t, err)
+	defer res.Body.Close()
+	favoriteArticleResponse := new(articlePublisherResponses.ArticleResponse)
+	resBytes, err := io.ReadAll(res.Body)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	err = json.Unmarshal(resBytes, favoriteArticleResponse)
+	require.NoError(t, err)
+	require.True(t, favoriteArticleResponse.Article.Favorited)
+	return favoriteArticleResponse
+}
@@ -0,0 +1,67 @@
+package articlepublisher
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	integrationtests "github.com/ravilock/goduit/integrationTests"
+	articlePublisherRequests "github.com/ravilock/goduit/internal/articlePublisher/requests"
+	articlePublisherResponses "github.com/ravilock/goduit/internal/articlePublisher/responses"
+	profileManagerRequests "github.com/ravilock/goduit/internal/profileManager/requests"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArticleAnalytics(t *testing.T) {
+	serverUrl := viper.GetString("server.url")
+	articlesEndpoint := fmt.Sprintf("%s%s", serverUrl, "/api/articles")
+	analyticsEndpoint := fmt.Sprintf("%s/analytics?days=7", articlesEndpoint)
+	httpClient := http.Client{}
+
+	t.Run("Authors should see the views, favorites and comments of their articles", func(t *testing.T) {
+		// Arrange
+		_, authorCookie := integrationtests.MustRegisterUser(t, profileManagerRequests.RegisterPayload{})
+		_, readerCookie := integrationtests.MustRegisterUser(t, profileManagerRequests.RegisterPayload{})
+		article := integrationtests.MustWriteArticle(t, articlePublisherRequests.WriteArticlePayload{}, authorCookie)
+		articleEndpoint := fmt.Sprintf("%s/%s", articlesEndpoint, article.Article.Slug)
+		for range 3 {
+			res := mustDoWithCookie(t, httpClient, http.MethodGet, articleEndpoint, readerCookie, http.StatusOK)
+			res.Body.Close()
+		}
+		authorRes := mustDoWithCookie(t, httpClient, http.MethodGet, articleEndpoint, authorCookie, http.StatusOK)
+		authorRes.Body.Close()
+		integrationtests.MustFavoriteArticle(t, article.Article.Slug, readerCookie)
+		integrationtests.MustWriteComment(t, articlePublisherRequests.WriteCommentPayload{}, article.Article.Slug, readerCookie)
+
+		// Act
+		analyticsResponse := new(articlePublisherResponses.ArticleAnalyticsResponse)
+		require.Eventually(t, func() bool {
+			res := mustDoWithCookie(t, httpClient, http.MethodGet, analyticsEndpoint, authorCookie, http.StatusOK)
+			decodeResponse(t, res, analyticsResponse)
+			return len(analyticsResponse.Articles) == 1 && analyticsResponse.Articles[0].Totals.Views > 0
+		}, 20*time.Second, 500*time.Millisecond)
+
+		// Assert
+		articleAnalytics := analyticsResponse.Articles[0]
+		require.Equal(t, article.Article.Slug, articleAnalytics.Slug)
+		require.Equal(t, articlePublisherResponses.ActivityCounts{Views: 1, Favorites: 1, Comments: 1}, articleAnalytics.Totals)
+		require.Len(t, articleAnalytics.Days, 7)
+		require.Equal(t, articleAnalytics.Totals, articleAnalytics.Days[6].ActivityCounts)
+	})
+
+	t.Run("Should require authentication", func(t *testing.T) {
+		// Arrange
+		req, err := http.NewRequest(http.MethodGet, analyticsEndpoint, nil)
+		require.NoError(t, err)
+
+		// Act
+		res, err := httpClient.Do(req)
+		require.NoError(t, err)
+		defer res.Body.Close()
+
+		// Assert
+		require.Equal(t, http.StatusUnauthorized, res.StatusCode)
+	})
+}
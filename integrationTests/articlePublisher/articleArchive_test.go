@@ -0,0 +1,117 @@
+package articlepublisher
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	integrationtests "github.com/ravilock/goduit/integrationTests"
+	articlePublisherRequests "github.com/ravilock/goduit/internal/articlePublisher/requests"
+	articlePublisherResponses "github.com/ravilock/goduit/internal/articlePublisher/responses"
+	profileManagerRequests "github.com/ravilock/goduit/internal/profileManager/requests"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArticleArchive(t *testing.T) {
+	serverUrl := viper.GetString("server.url")
+	articlesEndpoint := fmt.Sprintf("%s%s", serverUrl, "/api/articles")
+	httpClient := http.Client{}
+
+	t.Run("Should import exported articles for another author", func(t *testing.T) {
+		// Arrange
+		_, authorCookie := integrationtests.MustRegisterUser(t, profileManagerRequests.RegisterPayload{})
+		article := integrationtests.MustWriteArticle(t, articlePublisherRequests.WriteArticlePayload{}, authorCookie)
+		res := mustGetWithCookie(t, httpClient, fmt.Sprintf("%s/export?format=zip", articlesEndpoint), authorCookie)
+		require.Equal(t, http.StatusOK, res.StatusCode)
+		require.Equal(t, "application/zip", res.Header.Get("Content-Type"))
+		archive, err := io.ReadAll(res.Body)
+		require.NoError(t, err)
+		res.Body.Close()
+		requireArchiveContains(t, archive, article.Article.Slug+".md")
+		importerIdentity, importerCookie := integrationtests.MustRegisterUser(t, profileManagerRequests.RegisterPayload{})
+
+		// Act
+		dryRunResponse := mustImportArticles(t, httpClient, fmt.Sprintf("%s/import?dryRun=true", articlesEndpoint), archive, importerCookie, http.StatusOK)
+		importResponse := mustImportArticles(t, httpClient, fmt.Sprintf("%s/import", articlesEndpoint), archive, importerCookie, http.StatusOK)
+
+		// Assert
+		require.True(t, dryRunResponse.DryRun)
+		require.Equal(t, 1, dryRunResponse.Imported)
+		require.False(t, importResponse.DryRun)
+		require.Equal(t, 1, importResponse.Imported)
+		require.Equal(t, 0, importResponse.Failed)
+		importedSlug := importResponse.Files[0].Slug
+		require.NotEqual(t, article.Article.Slug, importedSlug)
+		res = mustGetWithCookie(t, httpClient, fmt.Sprintf("%s/%s", articlesEndpoint, importedSlug), importerCookie)
+		require.Equal(t, http.StatusOK, res.StatusCode)
+		importedArticle := new(articlePublisherResponses.ArticleResponse)
+		decodeResponse(t, res, importedArticle)
+		require.Equal(t, article.Article.Title, importedArticle.Article.Title)
+		require.Equal(t, article.Article.Body, importedArticle.Article.Body)
+		require.ElementsMatch(t, article.Article.TagList, importedArticle.Article.TagList)
+		require.Equal(t, importerIdentity.Username, importedArticle.Article.Author.Username)
+	})
+
+	t.Run("Should report files that are not valid articles", func(t *testing.T) {
+		// Arrange
+		_, cookie := integrationtests.MustRegisterUser(t, profileManagerRequests.RegisterPayload{})
+		archive := new(bytes.Buffer)
+		writer := zip.NewWriter(archive)
+		file, err := writer.Create("untitled.md")
+		require.NoError(t, err)
+		_, err = file.Write([]byte("---\ndescription: Article Description\ntags: [technology]\n---\n\nArticle Body\n"))
+		require.NoError(t, err)
+		require.NoError(t, writer.Close())
+
+		// Act
+		importResponse := mustImportArticles(t, httpClient, fmt.Sprintf("%s/import", articlesEndpoint), archive.Bytes(), cookie, http.StatusOK)
+
+		// Assert
+		require.Equal(t, 0, importResponse.Imported)
+		require.Equal(t, 1, importResponse.Failed)
+		require.Equal(t, "untitled.md", importResponse.Files[0].Name)
+		require.Equal(t, "field 'Title' is required", importResponse.Files[0].Error)
+	})
+
+	t.Run("Should return HTTP 400 if body is not an archive", func(t *testing.T) {
+		// Arrange
+		_, cookie := integrationtests.MustRegisterUser(t, profileManagerRequests.RegisterPayload{})
+
+		// Act
+		mustImportArticles(t, httpClient, fmt.Sprintf("%s/import", articlesEndpoint), []byte("PK\x03\x04 not an archive"), cookie, http.StatusBadRequest)
+	})
+}
+
+func mustImportArticles(t *testing.T, httpClient http.Client, endpoint string, archive []byte, cookie *http.Cookie, expectedStatus int) *articlePublisherResponses.ImportArticlesResponse {
+	t.Helper()
+	req, err := http.NewRequest(http.MethodPost, endpoint, bytes.NewBuffer(archive))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/zip")
+	req.AddCookie(cookie)
+	res, err := httpClient.Do(req)
+	require.NoError(t, err)
+	require.Equal(t, expectedStatus, res.StatusCode)
+	importResponse := new(articlePublisherResponses.ImportArticlesResponse)
+	if expectedStatus != http.StatusOK {
+		res.Body.Close()
+		return importResponse
+	}
+	decodeResponse(t, res, importResponse)
+	return importResponse
+}
+
+func requireArchiveContains(t *testing.T, archive []byte, name string) {
+	t.Helper()
+	reader, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	require.NoError(t, err)
+	for _, file := range reader.File {
+		if file.Name == name {
+			return
+		}
+	}
+	require.Failf(t, "file not found in archive", "%q is not in the archive", name)
+}
@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
 	integrationtests "github.com/ravilock/goduit/integrationTests"
 	"github.com/ravilock/goduit/internal/app"
 	articleRepositories "github.com/ravilock/goduit/internal/articlePublisher/repositories"
@@ -26,13 +27,14 @@ func TestArticleFeedHandler(t *testing.T) {
 		log.Fatalln("Error connecting to database", err)
 	}
 	followerCentralRepository := followerCentralRepositories.NewFollowerRepository(client)
+	tagFollowerRepository := followerCentralRepositories.NewTagFollowerRepository(client)
 	userRepository := profileRepositories.NewUserRepository(client)
 	articlePublisherRepository := articleRepositories.NewArticleRepository(client)
 	feedRepository := articleRepositories.NewFeedRepository(client)
 	logger := logger.NewLogger(map[string]string{"emitter": "Goduit-Article-Feed-Worker"})
 	messageMock := app.NewMockMessage(t)
 
-	handler := articleFeedWorker.NewArticleFeedHandler(articlePublisherRepository, userRepository, followerCentralRepository, feedRepository, logger)
+	handler := articleFeedWorker.NewArticleFeedHandler(articlePublisherRepository, userRepository, followerCentralRepository, tagFollowerRepository, feedRepository, logger)
 
 	t.Run("Should write followers feed when followed user posts new article", func(t *testing.T) {
 		// Arrange
@@ -49,13 +51,40 @@ func TestArticleFeedHandler(t *testing.T) {
 		handler.Handle(messageMock)
 
 		// Assert
-		feeds, err := feedRepository.PaginateFeed(context.Background(), followerIdentity.Subject, 1, 0)
+		feeds, err := feedRepository.PaginateFeed(context.Background(), followerIdentity.Subject, 0, 1, 0)
 		require.NoError(t, err)
 		require.Len(t, feeds, 1)
 		feed := feeds[0]
 		require.Equal(t, expectedArticle.ID.Hex(), *feed.ArticleID)
 		require.Equal(t, authorIdentity.Subject, *feed.Author)
 	})
+	t.Run("Should write tag followers feed when an article is posted with a followed tag", func(t *testing.T) {
+		// Arrange
+		tag := "tag-" + uuid.NewString()[:8]
+		authorIdentity, _ := integrationtests.MustRegisterUser(t, profileManagerRequests.RegisterPayload{})
+		followerIdentity, followerToken := integrationtests.MustRegisterUser(t, profileManagerRequests.RegisterPayload{})
+		integrationtests.MustFollowUser(t, authorIdentity.Username, followerToken)
+		integrationtests.MustFollowTag(t, tag, followerToken)
+		tagFollowerIdentity, tagFollowerToken := integrationtests.MustRegisterUser(t, profileManagerRequests.RegisterPayload{})
+		integrationtests.MustFollowTag(t, tag, tagFollowerToken)
+		expectedArticle := integrationtests.GenerateArticleModel(authorIdentity.Subject)
+		expectedArticle.TagList = append(expectedArticle.TagList, tag)
+		integrationtests.MustWriteArticleRegister(t, client, expectedArticle)
+		messageData := []byte(expectedArticle.ID.Hex())
+		messageMock.EXPECT().Data().Return(messageData)
+		messageMock.EXPECT().Success().Return(nil)
+
+		// Act
+		handler.Handle(messageMock)
+
+		// Assert
+		for _, subject := range []string{followerIdentity.Subject, tagFollowerIdentity.Subject} {
+			feeds, err := feedRepository.PaginateFeed(context.Background(), subject, 0, 10, 0)
+			require.NoError(t, err)
+			require.Len(t, feeds, 1)
+			require.Equal(t, expectedArticle.ID.Hex(), *feeds[0].ArticleID)
+		}
+	})
 }
 
 func TestArticleFeedWorkerWithQueue(t *testing.T) {
@@ -65,6 +94,7 @@ func TestArticleFeedWorkerWithQueue(t *testing.T) {
 		log.Fatalln("Error connecting to database", err)
 	}
 	followerCentralRepository := followerCentralRepositories.NewFollowerRepository(client)
+	tagFollowerRepository := followerCentralRepositories.NewTagFollowerRepository(client)
 	userRepository := profileRepositories.NewUserRepository(client)
 	articlePublisherRepository := articleRepositories.NewArticleRepository(client)
 	feedRepository := articleRepositories.NewFeedRepository(client)
@@ -81,7 +111,7 @@ func TestArticleFeedWorkerWithQueue(t *testing.T) {
 		integrationtests.MustWriteArticleRegister(t, client, expectedArticle)
 
 		// Setup worker
-		handler := articleFeedWorker.NewArticleFeedHandler(articlePublisherRepository, userRepository, followerCentralRepository, feedRepository, logger)
+		handler := articleFeedWorker.NewArticleFeedHandler(articlePublisherRepository, userRepository, followerCentralRepository, tagFollowerRepository, feedRepository, logger)
 		consumer, err := queueConnection.NewConsumer(viper.GetString("article.queue.name")+"-integration-test", handler)
 		require.NoError(t, err)
 
@@ -101,7 +131,7 @@ func TestArticleFeedWorkerWithQueue(t *testing.T) {
 		time.Sleep(2 * time.Second)
 
 		// Assert
-		feeds, err := feedRepository.PaginateFeed(context.Background(), followerIdentity.Subject, 1, 0)
+		feeds, err := feedRepository.PaginateFeed(context.Background(), followerIdentity.Subject, 0, 1, 0)
 		require.NoError(t, err)
 		require.Len(t, feeds, 1)
 		feed := feeds[0]
@@ -0,0 +1,80 @@
+package articlepublisher
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"slices"
+	"strings"
+	"testing"
+
+	integrationtests "github.com/ravilock/goduit/integrationTests"
+	articleRepositories "github.com/ravilock/goduit/internal/articlePublisher/repositories"
+	articlePublisherRequests "github.com/ravilock/goduit/internal/articlePublisher/requests"
+	articlePublisherResponses "github.com/ravilock/goduit/internal/articlePublisher/responses"
+	articleServices "github.com/ravilock/goduit/internal/articlePublisher/services"
+	"github.com/ravilock/goduit/internal/mongo"
+	profileManagerRequests "github.com/ravilock/goduit/internal/profileManager/requests"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArticleSummary(t *testing.T) {
+	client, err := mongo.ConnectDatabase(viper.GetString("db.url"))
+	if err != nil {
+		log.Fatalln("Error connecting to database", err)
+	}
+	serverUrl := viper.GetString("server.url")
+	articlesEndpoint := fmt.Sprintf("%s%s", serverUrl, "/api/articles")
+	httpClient := http.Client{}
+
+	t.Run("Should summarize the article body on write", func(t *testing.T) {
+		// Arrange
+		authorIdentity, authorCookie := integrationtests.MustRegisterUser(t, profileManagerRequests.RegisterPayload{})
+		body := "# Heading\n\n" + strings.Repeat("*word* ", 249)
+
+		// Act
+		article := integrationtests.MustWriteArticle(t, articlePublisherRequests.WriteArticlePayload{Body: body}, authorCookie)
+		res := mustGetWithCookie(t, httpClient, fmt.Sprintf("%s?author=%s", articlesEndpoint, authorIdentity.Username), authorCookie)
+
+		// Assert
+		require.Equal(t, int64(250), article.Article.WordCount)
+		require.Equal(t, int64(2), article.Article.ReadingTime)
+		require.True(t, strings.HasPrefix(article.Article.Excerpt, "Heading word word"))
+		require.True(t, strings.HasSuffix(article.Article.Excerpt, "word…"))
+		require.Equal(t, http.StatusOK, res.StatusCode)
+		listArticlesResponse := new(articlePublisherResponses.ArticlesResponse)
+		decodeResponse(t, res, listArticlesResponse)
+		listedIndex := slices.IndexFunc(listArticlesResponse.Articles, func(listed articlePublisherResponses.MultiArticle) bool {
+			return listed.Slug == article.Article.Slug
+		})
+		require.NotEqual(t, -1, listedIndex)
+		listed := listArticlesResponse.Articles[listedIndex]
+		require.Equal(t, article.Article.WordCount, listed.WordCount)
+		require.Equal(t, article.Article.ReadingTime, listed.ReadingTime)
+		require.Equal(t, article.Article.Excerpt, listed.Excerpt)
+	})
+
+	t.Run("Should backfill summaries of articles written before they were stored", func(t *testing.T) {
+		// Arrange
+		authorIdentity, authorCookie := integrationtests.MustRegisterUser(t, profileManagerRequests.RegisterPayload{})
+		legacyArticle := integrationtests.GenerateArticleModel(authorIdentity.Subject)
+		integrationtests.MustWriteArticleRegister(t, client, legacyArticle)
+		service := articleServices.NewBackfillArticleSummariesService(articleRepositories.NewArticleRepository(client))
+
+		// Act
+		summarized, err := service.BackfillArticleSummaries(context.Background(), 100)
+
+		// Assert
+		require.NoError(t, err)
+		require.GreaterOrEqual(t, summarized, 1)
+		res := mustGetWithCookie(t, httpClient, fmt.Sprintf("%s/%s", articlesEndpoint, *legacyArticle.Slug), authorCookie)
+		require.Equal(t, http.StatusOK, res.StatusCode)
+		getArticleResponse := new(articlePublisherResponses.ArticleResponse)
+		decodeResponse(t, res, getArticleResponse)
+		require.Equal(t, int64(2), getArticleResponse.Article.WordCount)
+		require.Equal(t, int64(1), getArticleResponse.Article.ReadingTime)
+		require.Equal(t, *legacyArticle.Body, getArticleResponse.Article.Excerpt)
+	})
+}
@@ -0,0 +1,58 @@
+package articlepublisher
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"testing"
+
+	integrationtests "github.com/ravilock/goduit/integrationTests"
+	articlePublisherRequests "github.com/ravilock/goduit/internal/articlePublisher/requests"
+	articlePublisherResponses "github.com/ravilock/goduit/internal/articlePublisher/responses"
+	"github.com/ravilock/goduit/internal/mongo"
+	profileManagerRequests "github.com/ravilock/goduit/internal/profileManager/requests"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBodyHTML(t *testing.T) {
+	client, err := mongo.ConnectDatabase(viper.GetString("db.url"))
+	if err != nil {
+		log.Fatalln("Error connecting to database", err)
+	}
+	serverUrl := viper.GetString("server.url")
+	articlesEndpoint := fmt.Sprintf("%s%s", serverUrl, "/api/articles")
+	httpClient := http.Client{}
+
+	t.Run("Should render the article body to sanitised HTML", func(t *testing.T) {
+		// Arrange
+		_, authorCookie := integrationtests.MustRegisterUser(t, profileManagerRequests.RegisterPayload{})
+		body := "# Getting Started\n\nHello <script>alert(1)</script> **world**"
+
+		// Act
+		article := integrationtests.MustWriteArticle(t, articlePublisherRequests.WriteArticlePayload{Body: body}, authorCookie)
+
+		// Assert
+		require.Equal(t, body, article.Article.Body)
+		require.Contains(t, article.Article.BodyHTML, `<nav class="toc"><ul><li><a href="#getting-started">Getting Started</a></li></ul></nav>`)
+		require.Contains(t, article.Article.BodyHTML, `<h1 id="getting-started"><a href="#getting-started" class="anchor"></a>Getting Started</h1>`)
+		require.Contains(t, article.Article.BodyHTML, "<strong>world</strong>")
+		require.NotContains(t, article.Article.BodyHTML, "<script")
+	})
+
+	t.Run("Should render the body of articles written before bodies were rendered", func(t *testing.T) {
+		// Arrange
+		authorIdentity, authorCookie := integrationtests.MustRegisterUser(t, profileManagerRequests.RegisterPayload{})
+		legacyArticle := integrationtests.GenerateArticleModel(authorIdentity.Subject)
+		integrationtests.MustWriteArticleRegister(t, client, legacyArticle)
+
+		// Act
+		res := mustGetWithCookie(t, httpClient, fmt.Sprintf("%s/%s", articlesEndpoint, *legacyArticle.Slug), authorCookie)
+
+		// Assert
+		require.Equal(t, http.StatusOK, res.StatusCode)
+		getArticleResponse := new(articlePublisherResponses.ArticleResponse)
+		decodeResponse(t, res, getArticleResponse)
+		require.Equal(t, fmt.Sprintf("<p>%s</p>\n", *legacyArticle.Body), getArticleResponse.Article.BodyHTML)
+	})
+}
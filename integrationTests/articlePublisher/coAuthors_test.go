@@ -0,0 +1,119 @@
+package articlepublisher
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	integrationtests "github.com/ravilock/goduit/integrationTests"
+	articlePublisherRequests "github.com/ravilock/goduit/internal/articlePublisher/requests"
+	articlePublisherResponses "github.com/ravilock/goduit/internal/articlePublisher/responses"
+	profileManagerRequests "github.com/ravilock/goduit/internal/profileManager/requests"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCoAuthors(t *testing.T) {
+	serverUrl := viper.GetString("server.url")
+	articlesEndpoint := fmt.Sprintf("%s%s", serverUrl, "/api/articles")
+	httpClient := http.Client{}
+
+	t.Run("Invited users should become co-authors once they accept", func(t *testing.T) {
+		// Arrange
+		authorIdentity, authorCookie := integrationtests.MustRegisterUser(t, profileManagerRequests.RegisterPayload{})
+		coAuthorIdentity, coAuthorCookie := integrationtests.MustRegisterUser(t, profileManagerRequests.RegisterPayload{})
+		article := integrationtests.MustWriteArticle(t, articlePublisherRequests.WriteArticlePayload{}, authorCookie)
+		articleEndpoint := fmt.Sprintf("%s/%s", articlesEndpoint, article.Article.Slug)
+		inviteRes := mustInviteCoAuthor(t, httpClient, articleEndpoint, coAuthorIdentity.Username, authorCookie, http.StatusCreated)
+		inviteRes.Body.Close()
+
+		// Act
+		invitationsRes := mustGetWithCookie(t, httpClient, fmt.Sprintf("%s/co-author-invitations", articlesEndpoint), coAuthorCookie)
+		acceptRes := mustDoWithCookie(t, httpClient, http.MethodPost, fmt.Sprintf("%s/co-authors/accept", articleEndpoint), coAuthorCookie, http.StatusOK)
+		updateArticleRequest := generateUpdateArticleBody()
+		requestBody, err := json.Marshal(updateArticleRequest)
+		require.NoError(t, err)
+		req, err := http.NewRequest(http.MethodPut, articleEndpoint, bytes.NewBuffer(requestBody))
+		require.NoError(t, err)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.AddCookie(coAuthorCookie)
+		updateRes, err := httpClient.Do(req)
+		require.NoError(t, err)
+		listRes := mustGetWithCookie(t, httpClient, fmt.Sprintf("%s?author=%s", articlesEndpoint, coAuthorIdentity.Username), coAuthorCookie)
+
+		// Assert
+		require.Equal(t, http.StatusOK, invitationsRes.StatusCode)
+		invitationsResponse := new(articlePublisherResponses.CoAuthorInvitationsResponse)
+		decodeResponse(t, invitationsRes, invitationsResponse)
+		require.Len(t, invitationsResponse.Invitations, 1)
+		require.Equal(t, article.Article.Slug, invitationsResponse.Invitations[0].Slug)
+		require.Equal(t, authorIdentity.Username, invitationsResponse.Invitations[0].Inviter.Username)
+		acceptResponse := new(articlePublisherResponses.ArticleResponse)
+		decodeResponse(t, acceptRes, acceptResponse)
+		require.Equal(t, authorIdentity.Username, acceptResponse.Article.Author.Username)
+		require.Len(t, acceptResponse.Article.CoAuthors, 1)
+		require.Equal(t, coAuthorIdentity.Username, acceptResponse.Article.CoAuthors[0].Username)
+		require.Equal(t, http.StatusOK, updateRes.StatusCode)
+		updateResponse := new(articlePublisherResponses.ArticleResponse)
+		decodeResponse(t, updateRes, updateResponse)
+		require.Equal(t, updateArticleRequest.Article.Title, updateResponse.Article.Title)
+		require.Equal(t, authorIdentity.Username, updateResponse.Article.Author.Username)
+		require.Equal(t, http.StatusOK, listRes.StatusCode)
+		listArticlesResponse := new(articlePublisherResponses.ArticlesResponse)
+		decodeResponse(t, listRes, listArticlesResponse)
+		require.True(t, slices.ContainsFunc(listArticlesResponse.Articles, func(listed articlePublisherResponses.MultiArticle) bool {
+			return listed.Slug == updateResponse.Article.Slug
+		}))
+	})
+
+	t.Run("Should only let the primary author unpublish a co-authored article", func(t *testing.T) {
+		// Arrange
+		_, authorCookie := integrationtests.MustRegisterUser(t, profileManagerRequests.RegisterPayload{})
+		coAuthorIdentity, coAuthorCookie := integrationtests.MustRegisterUser(t, profileManagerRequests.RegisterPayload{})
+		article := integrationtests.MustWriteArticle(t, articlePublisherRequests.WriteArticlePayload{}, authorCookie)
+		articleEndpoint := fmt.Sprintf("%s/%s", articlesEndpoint, article.Article.Slug)
+		inviteRes := mustInviteCoAuthor(t, httpClient, articleEndpoint, coAuthorIdentity.Username, authorCookie, http.StatusCreated)
+		inviteRes.Body.Close()
+		acceptRes := mustDoWithCookie(t, httpClient, http.MethodPost, fmt.Sprintf("%s/co-authors/accept", articleEndpoint), coAuthorCookie, http.StatusOK)
+		acceptRes.Body.Close()
+
+		// Act
+		res := mustDoWithCookie(t, httpClient, http.MethodDelete, articleEndpoint, coAuthorCookie, http.StatusForbidden)
+
+		// Assert
+		res.Body.Close()
+	})
+
+	t.Run("Should not let users accept invitations they did not receive", func(t *testing.T) {
+		// Arrange
+		_, authorCookie := integrationtests.MustRegisterUser(t, profileManagerRequests.RegisterPayload{})
+		_, nonInvitedCookie := integrationtests.MustRegisterUser(t, profileManagerRequests.RegisterPayload{})
+		article := integrationtests.MustWriteArticle(t, articlePublisherRequests.WriteArticlePayload{}, authorCookie)
+
+		// Act
+		res := mustDoWithCookie(t, httpClient, http.MethodPost, fmt.Sprintf("%s/%s/co-authors/accept", articlesEndpoint, article.Article.Slug), nonInvitedCookie, http.StatusNotFound)
+
+		// Assert
+		res.Body.Close()
+	})
+}
+
+func mustInviteCoAuthor(t *testing.T, httpClient http.Client, articleEndpoint, username string, cookie *http.Cookie, expectedStatus int) *http.Response {
+	t.Helper()
+	request := new(articlePublisherRequests.InviteCoAuthorRequest)
+	request.CoAuthor.Username = username
+	requestBody, err := json.Marshal(request)
+	require.NoError(t, err)
+	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/co-authors", articleEndpoint), bytes.NewBuffer(requestBody))
+	require.NoError(t, err)
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.AddCookie(cookie)
+	res, err := httpClient.Do(req)
+	require.NoError(t, err)
+	require.Equal(t, expectedStatus, res.StatusCode)
+	return res
+}
@@ -0,0 +1,144 @@
+package articlepublisher
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	integrationtests "github.com/ravilock/goduit/integrationTests"
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+	articlePublisherRequests "github.com/ravilock/goduit/internal/articlePublisher/requests"
+	articlePublisherResponses "github.com/ravilock/goduit/internal/articlePublisher/responses"
+	profileManagerRequests "github.com/ravilock/goduit/internal/profileManager/requests"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDrafts(t *testing.T) {
+	serverUrl := viper.GetString("server.url")
+	articlesEndpoint := fmt.Sprintf("%s%s", serverUrl, "/api/articles")
+	httpClient := http.Client{}
+
+	t.Run("Drafts should only be visible to their author", func(t *testing.T) {
+		// Arrange
+		authorIdentity, authorCookie := integrationtests.MustRegisterUser(t, profileManagerRequests.RegisterPayload{})
+		_, readerCookie := integrationtests.MustRegisterUser(t, profileManagerRequests.RegisterPayload{})
+		draft := integrationtests.MustWriteArticle(t, articlePublisherRequests.WriteArticlePayload{Draft: true}, authorCookie)
+		require.Equal(t, models.ArticleStatusDraft, draft.Article.Status)
+		articleEndpoint := fmt.Sprintf("%s/%s", articlesEndpoint, draft.Article.Slug)
+
+		// Act
+		readerRes := mustGetWithCookie(t, httpClient, articleEndpoint, readerCookie)
+		authorRes := mustGetWithCookie(t, httpClient, articleEndpoint, authorCookie)
+		listRes := mustGetWithCookie(t, httpClient, fmt.Sprintf("%s?author=%s", articlesEndpoint, authorIdentity.Username), readerCookie)
+		draftsRes := mustGetWithCookie(t, httpClient, fmt.Sprintf("%s/drafts", articlesEndpoint), authorCookie)
+
+		// Assert
+		require.Equal(t, http.StatusNotFound, readerRes.StatusCode)
+		require.Equal(t, http.StatusOK, authorRes.StatusCode)
+		require.Equal(t, http.StatusOK, listRes.StatusCode)
+		listArticlesResponse := new(articlePublisherResponses.ArticlesResponse)
+		decodeResponse(t, listRes, listArticlesResponse)
+		require.Empty(t, listArticlesResponse.Articles)
+		require.Equal(t, http.StatusOK, draftsRes.StatusCode)
+		listDraftsResponse := new(articlePublisherResponses.ArticlesResponse)
+		decodeResponse(t, draftsRes, listDraftsResponse)
+		require.True(t, slices.ContainsFunc(listDraftsResponse.Articles, func(article articlePublisherResponses.MultiArticle) bool {
+			return article.Slug == draft.Article.Slug
+		}))
+	})
+
+	t.Run("Drafts should not be visible through spoofed identity headers", func(t *testing.T) {
+		// Arrange
+		authorIdentity, authorCookie := integrationtests.MustRegisterUser(t, profileManagerRequests.RegisterPayload{})
+		draft := integrationtests.MustWriteArticle(t, articlePublisherRequests.WriteArticlePayload{Draft: true}, authorCookie)
+		req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/%s", articlesEndpoint, draft.Article.Slug), nil)
+		require.NoError(t, err)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set("Goduit-Subject", authorIdentity.Subject)
+		req.Header.Set("Goduit-Client-Username", authorIdentity.Username)
+		req.Header.Set("Goduit-Client-Email", authorIdentity.UserEmail)
+
+		// Act
+		res, err := httpClient.Do(req)
+		require.NoError(t, err)
+		defer res.Body.Close()
+
+		// Assert
+		require.Equal(t, http.StatusNotFound, res.StatusCode)
+	})
+
+	t.Run("Should publish a draft", func(t *testing.T) {
+		// Arrange
+		_, authorCookie := integrationtests.MustRegisterUser(t, profileManagerRequests.RegisterPayload{})
+		draft := integrationtests.MustWriteArticle(t, articlePublisherRequests.WriteArticlePayload{Draft: true}, authorCookie)
+		publishEndpoint := fmt.Sprintf("%s/%s/publish", articlesEndpoint, draft.Article.Slug)
+		req, err := http.NewRequest(http.MethodPost, publishEndpoint, nil)
+		require.NoError(t, err)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.AddCookie(authorCookie)
+
+		// Act
+		res, err := httpClient.Do(req)
+		require.NoError(t, err)
+
+		// Assert
+		require.Equal(t, http.StatusOK, res.StatusCode)
+		publishArticleResponse := new(articlePublisherResponses.ArticleResponse)
+		decodeResponse(t, res, publishArticleResponse)
+		require.Equal(t, models.ArticleStatusPublished, publishArticleResponse.Article.Status)
+		req, err = http.NewRequest(http.MethodPost, publishEndpoint, nil)
+		require.NoError(t, err)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.AddCookie(authorCookie)
+		res, err = httpClient.Do(req)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusConflict, res.StatusCode)
+	})
+
+	t.Run("Scheduled articles should be published once due", func(t *testing.T) {
+		// Arrange
+		_, authorCookie := integrationtests.MustRegisterUser(t, profileManagerRequests.RegisterPayload{})
+		_, readerCookie := integrationtests.MustRegisterUser(t, profileManagerRequests.RegisterPayload{})
+		publishAt := time.Now().Add(2 * time.Second)
+		scheduled := integrationtests.MustWriteArticle(t, articlePublisherRequests.WriteArticlePayload{PublishAt: &publishAt}, authorCookie)
+		require.Equal(t, models.ArticleStatusScheduled, scheduled.Article.Status)
+		articleEndpoint := fmt.Sprintf("%s/%s", articlesEndpoint, scheduled.Article.Slug)
+
+		// Act
+		res := mustGetWithCookie(t, httpClient, articleEndpoint, readerCookie)
+
+		// Assert
+		require.Equal(t, http.StatusNotFound, res.StatusCode)
+		require.Eventually(t, func() bool {
+			res := mustGetWithCookie(t, httpClient, articleEndpoint, readerCookie)
+			defer res.Body.Close()
+			return res.StatusCode == http.StatusOK
+		}, time.Minute, time.Second)
+	})
+}
+
+func mustGetWithCookie(t *testing.T, httpClient http.Client, endpoint string, cookie *http.Cookie) *http.Response {
+	t.Helper()
+	req, err := http.NewRequest(http.MethodGet, endpoint, nil)
+	require.NoError(t, err)
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.AddCookie(cookie)
+	res, err := httpClient.Do(req)
+	require.NoError(t, err)
+	return res
+}
+
+func decodeResponse(t *testing.T, res *http.Response, response any) {
+	t.Helper()
+	defer res.Body.Close()
+	resBytes, err := io.ReadAll(res.Body)
+	require.NoError(t, err)
+	err = json.Unmarshal(resBytes, response)
+	require.NoError(t, err)
+}
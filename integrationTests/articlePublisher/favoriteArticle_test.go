@@ -0,0 +1,153 @@
+package articlepublisher
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	integrationtests "github.com/ravilock/goduit/integrationTests"
+	articlePublisherRequests "github.com/ravilock/goduit/internal/articlePublisher/requests"
+	articlePublisherResponses "github.com/ravilock/goduit/internal/articlePublisher/responses"
+	profileManagerRequests "github.com/ravilock/goduit/internal/profileManager/requests"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFavoriteArticle(t *testing.T) {
+	serverUrl := viper.GetString("server.url")
+	articlesEndpoint := fmt.Sprintf("%s%s", serverUrl, "/api/articles")
+	httpClient := http.Client{}
+
+	t.Run("Should favorite an article", func(t *testing.T) {
+		// Arrange
+		_, authorCookie := integrationtests.MustRegisterUser(t, profileManagerRequests.RegisterPayload{})
+		article := integrationtests.MustWriteArticle(t, articlePublisherRequests.WriteArticlePayload{}, authorCookie)
+		_, readerCookie := integrationtests.MustRegisterUser(t, profileManagerRequests.RegisterPayload{})
+		req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/%s/favorite", articlesEndpoint, article.Article.Slug), nil)
+		require.NoError(t, err)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.AddCookie(readerCookie)
+
+		// Act
+		res, err := httpClient.Do(req)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, res.StatusCode)
+		resBytes, err := io.ReadAll(res.Body)
+		require.NoError(t, err)
+		favoriteArticleResponse := new(articlePublisherResponses.ArticleResponse)
+		err = json.Unmarshal(resBytes, favoriteArticleResponse)
+		require.NoError(t, err)
+		require.True(t, favoriteArticleResponse.Article.Favorited)
+		require.Equal(t, int64(1), favoriteArticleResponse.Article.FavoritesCount)
+	})
+
+	t.Run("Should return HTTP 409 if article is already favorited", func(t *testing.T) {
+		// Arrange
+		_, authorCookie := integrationtests.MustRegisterUser(t, profileManagerRequests.RegisterPayload{})
+		article := integrationtests.MustWriteArticle(t, articlePublisherRequests.WriteArticlePayload{}, authorCookie)
+		_, readerCookie := integrationtests.MustRegisterUser(t, profileManagerRequests.RegisterPayload{})
+		integrationtests.MustFavoriteArticle(t, article.Article.Slug, readerCookie)
+		req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/%s/favorite", articlesEndpoint, article.Article.Slug), nil)
+		require.NoError(t, err)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.AddCookie(readerCookie)
+
+		// Act
+		res, err := httpClient.Do(req)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusConflict, res.StatusCode)
+	})
+
+	t.Run("Should return HTTP 404 if targeted article does not exists", func(t *testing.T) {
+		// Arrange
+		_, readerCookie := integrationtests.MustRegisterUser(t, profileManagerRequests.RegisterPayload{})
+		req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/%s/favorite", articlesEndpoint, uuid.NewString()), nil)
+		require.NoError(t, err)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.AddCookie(readerCookie)
+
+		// Act
+		res, err := httpClient.Do(req)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusNotFound, res.StatusCode)
+	})
+
+	t.Run("Should list articles favorited by user", func(t *testing.T) {
+		// Arrange
+		_, authorCookie := integrationtests.MustRegisterUser(t, profileManagerRequests.RegisterPayload{})
+		favoritedArticle := integrationtests.MustWriteArticle(t, articlePublisherRequests.WriteArticlePayload{}, authorCookie)
+		integrationtests.MustWriteArticle(t, articlePublisherRequests.WriteArticlePayload{}, authorCookie)
+		readerIdentity, readerCookie := integrationtests.MustRegisterUser(t, profileManagerRequests.RegisterPayload{})
+		integrationtests.MustFavoriteArticle(t, favoritedArticle.Article.Slug, readerCookie)
+		req, err := http.NewRequest(http.MethodGet, articlesEndpoint, nil)
+		require.NoError(t, err)
+		q := req.URL.Query()
+		q.Add("favorited", readerIdentity.Username)
+		req.URL.RawQuery = q.Encode()
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.AddCookie(readerCookie)
+
+		// Act
+		res, err := httpClient.Do(req)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, res.StatusCode)
+		resBytes, err := io.ReadAll(res.Body)
+		require.NoError(t, err)
+		listArticlesResponse := new(articlePublisherResponses.ArticlesResponse)
+		err = json.Unmarshal(resBytes, listArticlesResponse)
+		require.NoError(t, err)
+		require.Len(t, listArticlesResponse.Articles, 1)
+		require.Equal(t, favoritedArticle.Article.Slug, listArticlesResponse.Articles[0].Slug)
+		require.True(t, listArticlesResponse.Articles[0].Favorited)
+	})
+}
+
+func TestUnfavoriteArticle(t *testing.T) {
+	serverUrl := viper.GetString("server.url")
+	articlesEndpoint := fmt.Sprintf("%s%s", serverUrl, "/api/articles")
+	httpClient := http.Client{}
+
+	t.Run("Should unfavorite an article", func(t *testing.T) {
+		// Arrange
+		_, authorCookie := integrationtests.MustRegisterUser(t, profileManagerRequests.RegisterPayload{})
+		article := integrationtests.MustWriteArticle(t, articlePublisherRequests.WriteArticlePayload{}, authorCookie)
+		_, readerCookie := integrationtests.MustRegisterUser(t, profileManagerRequests.RegisterPayload{})
+		integrationtests.MustFavoriteArticle(t, article.Article.Slug, readerCookie)
+		req, err := http.NewRequest(http.MethodDelete, fmt.Sprintf("%s/%s/favorite", articlesEndpoint, article.Article.Slug), nil)
+		require.NoError(t, err)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.AddCookie(readerCookie)
+
+		// Act
+		res, err := httpClient.Do(req)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, res.StatusCode)
+		resBytes, err := io.ReadAll(res.Body)
+		require.NoError(t, err)
+		unfavoriteArticleResponse := new(articlePublisherResponses.ArticleResponse)
+		err = json.Unmarshal(resBytes, unfavoriteArticleResponse)
+		require.NoError(t, err)
+		require.False(t, unfavoriteArticleResponse.Article.Favorited)
+		require.Equal(t, int64(0), unfavoriteArticleResponse.Article.FavoritesCount)
+	})
+
+	t.Run("Should return HTTP 404 if article is not favorited", func(t *testing.T) {
+		// Arrange
+		_, authorCookie := integrationtests.MustRegisterUser(t, profileManagerRequests.RegisterPayload{})
+		article := integrationtests.MustWriteArticle(t, articlePublisherRequests.WriteArticlePayload{}, authorCookie)
+		_, readerCookie := integrationtests.MustRegisterUser(t, profileManagerRequests.RegisterPayload{})
+		req, err := http.NewRequest(http.MethodDelete, fmt.Sprintf("%s/%s/favorite", articlesEndpoint, article.Article.Slug), nil)
+		require.NoError(t, err)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.AddCookie(readerCookie)
+
+		// Act
+		res, err := httpClient.Do(req)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusNotFound, res.StatusCode)
+	})
+}
@@ -12,6 +12,7 @@ import (
 	integrationtests "github.com/ravilock/goduit/integrationTests"
 	articlePublisherRequests "github.com/ravilock/goduit/internal/articlePublisher/requests"
 	articlePublisherResponses "github.com/ravilock/goduit/internal/articlePublisher/responses"
+	"github.com/ravilock/goduit/internal/etag"
 	profileManagerRequests "github.com/ravilock/goduit/internal/profileManager/requests"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
@@ -43,6 +44,27 @@ func TestGetArticle(t *testing.T) {
 		checkGetArticleResponse(t, article.Article.Title, article.Article.Slug, authorIdentity.Username, article.Article.TagList, getArticleResponse)
 	})
 
+	t.Run("Should return HTTP 304 if the article did not change since it was fetched", func(t *testing.T) {
+		// Arrange
+		_, authorCookie := integrationtests.MustRegisterUser(t, profileManagerRequests.RegisterPayload{})
+		article := integrationtests.MustWriteArticle(t, articlePublisherRequests.WriteArticlePayload{}, authorCookie)
+		articleEndpoint := fmt.Sprintf("%s/%s", publicArticleEndpoint, article.Article.Slug)
+		res := mustGetWithCookie(t, httpClient, articleEndpoint, authorCookie)
+		res.Body.Close()
+		req, err := http.NewRequest(http.MethodGet, articleEndpoint, nil)
+		require.NoError(t, err)
+		req.Header.Set(etag.HeaderIfNoneMatch, res.Header.Get(etag.HeaderETag))
+		req.AddCookie(authorCookie)
+
+		// Act
+		res, err = httpClient.Do(req)
+		require.NoError(t, err)
+		defer res.Body.Close()
+
+		// Assert
+		require.Equal(t, http.StatusNotModified, res.StatusCode)
+	})
+
 	t.Run("Should return HTTP 404 if no article is found", func(t *testing.T) {
 		// Arrange
 		_, authorCookie := integrationtests.MustRegisterUser(t, profileManagerRequests.RegisterPayload{})
@@ -11,11 +11,14 @@ import (
 
 	"github.com/labstack/echo/v4"
 	integrationtests "github.com/ravilock/goduit/integrationTests"
+	articlePublisherRequests "github.com/ravilock/goduit/internal/articlePublisher/requests"
 	articlePublisherResponses "github.com/ravilock/goduit/internal/articlePublisher/responses"
 	"github.com/ravilock/goduit/internal/mongo"
+	"github.com/ravilock/goduit/internal/pagination"
 	profileManagerRequests "github.com/ravilock/goduit/internal/profileManager/requests"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
 func TestListArticles(t *testing.T) {
@@ -175,6 +178,142 @@ func TestListArticles(t *testing.T) {
 			require.True(t, checkArticlesAreTheSame(article1, article2))
 		}
 	})
+
+	t.Run("Should continue listing from next cursor", func(t *testing.T) {
+		// Arrange
+		limit := 10
+		req, err := http.NewRequest(http.MethodGet, listArticlesEndpoint, nil)
+		require.NoError(t, err)
+		q := req.URL.Query()
+		q.Add("limit", strconv.Itoa(limit*2))
+		req.URL.RawQuery = q.Encode()
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		res, err := httpClient.Do(req)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, res.StatusCode)
+		resBytes, err := io.ReadAll(res.Body)
+		require.NoError(t, err)
+		expectedResponse := new(articlePublisherResponses.ArticlesResponse)
+		err = json.Unmarshal(resBytes, expectedResponse)
+		require.NoError(t, err)
+
+		// Act
+		req, err = http.NewRequest(http.MethodGet, listArticlesEndpoint, nil)
+		require.NoError(t, err)
+		q = req.URL.Query()
+		q.Add("limit", strconv.Itoa(limit))
+		req.URL.RawQuery = q.Encode()
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		res, err = httpClient.Do(req)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, res.StatusCode)
+		resBytes, err = io.ReadAll(res.Body)
+		require.NoError(t, err)
+		firstPage := new(articlePublisherResponses.ArticlesResponse)
+		err = json.Unmarshal(resBytes, firstPage)
+		require.NoError(t, err)
+		require.NotEmpty(t, firstPage.NextCursor)
+		req, err = http.NewRequest(http.MethodGet, listArticlesEndpoint, nil)
+		require.NoError(t, err)
+		q = req.URL.Query()
+		q.Add("limit", strconv.Itoa(limit))
+		q.Add("cursor", firstPage.NextCursor)
+		req.URL.RawQuery = q.Encode()
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		res, err = httpClient.Do(req)
+		require.NoError(t, err)
+
+		// Assert
+		require.Equal(t, http.StatusOK, res.StatusCode)
+		resBytes, err = io.ReadAll(res.Body)
+		require.NoError(t, err)
+		secondPage := new(articlePublisherResponses.ArticlesResponse)
+		err = json.Unmarshal(resBytes, secondPage)
+		require.NoError(t, err)
+		pages := append(firstPage.Articles, secondPage.Articles...)
+		require.Len(t, pages, len(expectedResponse.Articles))
+		for i := range pages {
+			require.True(t, checkArticlesAreTheSame(&expectedResponse.Articles[i], &pages[i]))
+		}
+	})
+
+	t.Run("Should not accept invalid cursor", func(t *testing.T) {
+		// Arrange
+		req, err := http.NewRequest(http.MethodGet, listArticlesEndpoint, nil)
+		require.NoError(t, err)
+		q := req.URL.Query()
+		q.Add("cursor", "not-a-cursor")
+		req.URL.RawQuery = q.Encode()
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+		// Act
+		res, err := httpClient.Do(req)
+		require.NoError(t, err)
+
+		// Assert
+		require.Equal(t, http.StatusBadRequest, res.StatusCode)
+	})
+
+	t.Run("Should not accept cursor of an unknown article", func(t *testing.T) {
+		// Arrange
+		req, err := http.NewRequest(http.MethodGet, listArticlesEndpoint, nil)
+		require.NoError(t, err)
+		q := req.URL.Query()
+		q.Add("cursor", pagination.EncodeCursor(primitive.NewObjectID().Hex()))
+		req.URL.RawQuery = q.Encode()
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+		// Act
+		res, err := httpClient.Do(req)
+		require.NoError(t, err)
+
+		// Assert
+		require.Equal(t, http.StatusBadRequest, res.StatusCode)
+	})
+
+	t.Run("Should list articles by publication date", func(t *testing.T) {
+		// Arrange
+		draft := integrationtests.MustWriteArticle(t, articlePublisherRequests.WriteArticlePayload{Draft: true}, authorCookie1)
+		published := integrationtests.MustWriteArticle(t, articlePublisherRequests.WriteArticlePayload{}, authorCookie1)
+		req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/%s/publish", listArticlesEndpoint, draft.Article.Slug), nil)
+		require.NoError(t, err)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.AddCookie(authorCookie1)
+		res, err := httpClient.Do(req)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, res.StatusCode)
+		req, err = http.NewRequest(http.MethodGet, listArticlesEndpoint, nil)
+		require.NoError(t, err)
+		q := req.URL.Query()
+		q.Add("limit", "1")
+		req.URL.RawQuery = q.Encode()
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+		// Act
+		res, err = httpClient.Do(req)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, res.StatusCode)
+		firstPage := new(articlePublisherResponses.ArticlesResponse)
+		decodeResponse(t, res, firstPage)
+		req, err = http.NewRequest(http.MethodGet, listArticlesEndpoint, nil)
+		require.NoError(t, err)
+		q = req.URL.Query()
+		q.Add("limit", "1")
+		q.Add("cursor", firstPage.NextCursor)
+		req.URL.RawQuery = q.Encode()
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		res, err = httpClient.Do(req)
+		require.NoError(t, err)
+
+		// Assert
+		require.Equal(t, http.StatusOK, res.StatusCode)
+		secondPage := new(articlePublisherResponses.ArticlesResponse)
+		decodeResponse(t, res, secondPage)
+		require.Len(t, firstPage.Articles, 1)
+		require.Equal(t, draft.Article.Slug, firstPage.Articles[0].Slug)
+		require.Len(t, secondPage.Articles, 1)
+		require.Equal(t, published.Article.Slug, secondPage.Articles[0].Slug)
+	})
 }
 
 func checkListArticlesResponse(t *testing.T, tags []string, authorsUsername []string, limit int, response *articlePublisherResponses.ArticlesResponse) {
@@ -0,0 +1,79 @@
+package articlepublisher
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	integrationtests "github.com/ravilock/goduit/integrationTests"
+	articlePublisherRequests "github.com/ravilock/goduit/internal/articlePublisher/requests"
+	articlePublisherResponses "github.com/ravilock/goduit/internal/articlePublisher/responses"
+	profileManagerRequests "github.com/ravilock/goduit/internal/profileManager/requests"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListTags(t *testing.T) {
+	serverUrl := viper.GetString("server.url")
+	listTagsEndpoint := fmt.Sprintf("%s%s", serverUrl, "/api/tags")
+	articlesEndpoint := fmt.Sprintf("%s%s", serverUrl, "/api/articles")
+	httpClient := http.Client{}
+
+	t.Run("Should count tags of written articles", func(t *testing.T) {
+		// Arrange
+		tag := strings.ReplaceAll(uuid.NewString(), "-", "")[:20]
+		_, authorCookie := integrationtests.MustRegisterUser(t, profileManagerRequests.RegisterPayload{})
+		integrationtests.MustWriteArticle(t, articlePublisherRequests.WriteArticlePayload{TagList: []string{tag}}, authorCookie)
+		integrationtests.MustWriteArticle(t, articlePublisherRequests.WriteArticlePayload{TagList: []string{tag}}, authorCookie)
+
+		// Act
+		listTagsResponse := mustListTags(t, httpClient, listTagsEndpoint, tag)
+
+		// Assert
+		require.Equal(t, []string{tag}, listTagsResponse.Tags)
+		require.Equal(t, int64(2), listTagsResponse.TagCounts[0].Count)
+	})
+
+	t.Run("Should remove tags of unpublished articles", func(t *testing.T) {
+		// Arrange
+		tag := strings.ReplaceAll(uuid.NewString(), "-", "")[:20]
+		_, authorCookie := integrationtests.MustRegisterUser(t, profileManagerRequests.RegisterPayload{})
+		article := integrationtests.MustWriteArticle(t, articlePublisherRequests.WriteArticlePayload{TagList: []string{tag}}, authorCookie)
+		req, err := http.NewRequest(http.MethodDelete, fmt.Sprintf("%s/%s", articlesEndpoint, article.Article.Slug), nil)
+		require.NoError(t, err)
+		req.AddCookie(authorCookie)
+		res, err := httpClient.Do(req)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusNoContent, res.StatusCode)
+
+		// Act
+		listTagsResponse := mustListTags(t, httpClient, listTagsEndpoint, tag)
+
+		// Assert
+		require.Empty(t, listTagsResponse.Tags)
+	})
+}
+
+func mustListTags(t *testing.T, httpClient http.Client, listTagsEndpoint, prefix string) *articlePublisherResponses.TagsResponse {
+	t.Helper()
+	req, err := http.NewRequest(http.MethodGet, listTagsEndpoint, nil)
+	require.NoError(t, err)
+	q := req.URL.Query()
+	q.Add("prefix", prefix)
+	req.URL.RawQuery = q.Encode()
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	res, err := httpClient.Do(req)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	resBytes, err := io.ReadAll(res.Body)
+	require.NoError(t, err)
+	listTagsResponse := new(articlePublisherResponses.TagsResponse)
+	err = json.Unmarshal(resBytes, listTagsResponse)
+	require.NoError(t, err)
+	return listTagsResponse
+}
@@ -0,0 +1,84 @@
+package articlepublisher
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	integrationtests "github.com/ravilock/goduit/integrationTests"
+	articlePublisherRequests "github.com/ravilock/goduit/internal/articlePublisher/requests"
+	articlePublisherResponses "github.com/ravilock/goduit/internal/articlePublisher/responses"
+	profileManagerRequests "github.com/ravilock/goduit/internal/profileManager/requests"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRelatedArticles(t *testing.T) {
+	serverUrl := viper.GetString("server.url")
+	articlesEndpoint := fmt.Sprintf("%s%s", serverUrl, "/api/articles")
+	httpClient := http.Client{}
+
+	t.Run("Should rank related articles by shared tags, then author", func(t *testing.T) {
+		// Arrange
+		firstTag := "related-" + uuid.NewString()[:8]
+		secondTag := "related-" + uuid.NewString()[:8]
+		_, authorCookie := integrationtests.MustRegisterUser(t, profileManagerRequests.RegisterPayload{})
+		_, otherCookie := integrationtests.MustRegisterUser(t, profileManagerRequests.RegisterPayload{})
+		article := integrationtests.MustWriteArticle(t, articlePublisherRequests.WriteArticlePayload{TagList: []string{firstTag, secondTag}}, authorCookie)
+		sameAuthorArticle := integrationtests.MustWriteArticle(t, articlePublisherRequests.WriteArticlePayload{}, authorCookie)
+		oneTagArticle := integrationtests.MustWriteArticle(t, articlePublisherRequests.WriteArticlePayload{TagList: []string{firstTag}}, otherCookie)
+		bothTagsArticle := integrationtests.MustWriteArticle(t, articlePublisherRequests.WriteArticlePayload{TagList: []string{firstTag, secondTag}}, otherCookie)
+		draft := integrationtests.MustWriteArticle(t, articlePublisherRequests.WriteArticlePayload{TagList: []string{firstTag, secondTag}, Draft: true}, otherCookie)
+
+		// Act
+		res := mustGetWithCookie(t, httpClient, fmt.Sprintf("%s/%s/related?limit=3", articlesEndpoint, article.Article.Slug), otherCookie)
+
+		// Assert
+		require.Equal(t, http.StatusOK, res.StatusCode)
+		relatedArticlesResponse := new(articlePublisherResponses.ArticlesResponse)
+		decodeResponse(t, res, relatedArticlesResponse)
+		require.Len(t, relatedArticlesResponse.Articles, 3)
+		require.Equal(t, bothTagsArticle.Article.Slug, relatedArticlesResponse.Articles[0].Slug)
+		require.Equal(t, oneTagArticle.Article.Slug, relatedArticlesResponse.Articles[1].Slug)
+		require.Equal(t, sameAuthorArticle.Article.Slug, relatedArticlesResponse.Articles[2].Slug)
+		for _, relatedArticle := range relatedArticlesResponse.Articles {
+			require.NotEqual(t, draft.Article.Slug, relatedArticle.Slug)
+			require.NotEqual(t, article.Article.Slug, relatedArticle.Slug)
+		}
+	})
+
+	t.Run("Should relate articles written by the article's co-authors", func(t *testing.T) {
+		// Arrange
+		_, authorCookie := integrationtests.MustRegisterUser(t, profileManagerRequests.RegisterPayload{})
+		coAuthorIdentity, coAuthorCookie := integrationtests.MustRegisterUser(t, profileManagerRequests.RegisterPayload{})
+		article := integrationtests.MustWriteArticle(t, articlePublisherRequests.WriteArticlePayload{}, authorCookie)
+		articleEndpoint := fmt.Sprintf("%s/%s", articlesEndpoint, article.Article.Slug)
+		inviteRes := mustInviteCoAuthor(t, httpClient, articleEndpoint, coAuthorIdentity.Username, authorCookie, http.StatusCreated)
+		inviteRes.Body.Close()
+		acceptRes := mustDoWithCookie(t, httpClient, http.MethodPost, fmt.Sprintf("%s/co-authors/accept", articleEndpoint), coAuthorCookie, http.StatusOK)
+		acceptRes.Body.Close()
+		coAuthorArticle := integrationtests.MustWriteArticle(t, articlePublisherRequests.WriteArticlePayload{}, coAuthorCookie)
+
+		// Act
+		res := mustGetWithCookie(t, httpClient, fmt.Sprintf("%s/related", articleEndpoint), authorCookie)
+
+		// Assert
+		require.Equal(t, http.StatusOK, res.StatusCode)
+		relatedArticlesResponse := new(articlePublisherResponses.ArticlesResponse)
+		decodeResponse(t, res, relatedArticlesResponse)
+		require.Len(t, relatedArticlesResponse.Articles, 1)
+		require.Equal(t, coAuthorArticle.Article.Slug, relatedArticlesResponse.Articles[0].Slug)
+	})
+
+	t.Run("Should return HTTP 404 if no article is found", func(t *testing.T) {
+		// Arrange
+		_, cookie := integrationtests.MustRegisterUser(t, profileManagerRequests.RegisterPayload{})
+
+		// Act
+		res := mustDoWithCookie(t, httpClient, http.MethodGet, fmt.Sprintf("%s/inexistent-article/related", articlesEndpoint), cookie, http.StatusNotFound)
+
+		// Assert
+		res.Body.Close()
+	})
+}
@@ -0,0 +1,94 @@
+package articlepublisher
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	integrationtests "github.com/ravilock/goduit/integrationTests"
+	articlePublisherRequests "github.com/ravilock/goduit/internal/articlePublisher/requests"
+	articlePublisherResponses "github.com/ravilock/goduit/internal/articlePublisher/responses"
+	profileManagerRequests "github.com/ravilock/goduit/internal/profileManager/requests"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRevisions(t *testing.T) {
+	serverUrl := viper.GetString("server.url")
+	articlesEndpoint := fmt.Sprintf("%s%s", serverUrl, "/api/articles")
+	httpClient := http.Client{}
+
+	t.Run("Should record a revision for each update and restore an older one", func(t *testing.T) {
+		// Arrange
+		_, authorCookie := integrationtests.MustRegisterUser(t, profileManagerRequests.RegisterPayload{})
+		article := integrationtests.MustWriteArticle(t, articlePublisherRequests.WriteArticlePayload{}, authorCookie)
+		updateArticleRequest := generateUpdateArticleBody()
+		updatedArticle := mustUpdateArticle(t, httpClient, articlesEndpoint, article.Article.Slug, updateArticleRequest, authorCookie)
+
+		// Act
+		res := mustGetWithCookie(t, httpClient, fmt.Sprintf("%s/%s/revisions", articlesEndpoint, updatedArticle.Article.Slug), authorCookie)
+		require.Equal(t, http.StatusOK, res.StatusCode)
+		listRevisionsResponse := new(articlePublisherResponses.RevisionsResponse)
+		decodeResponse(t, res, listRevisionsResponse)
+		res = mustGetWithCookie(t, httpClient, fmt.Sprintf("%s/%s/revisions/diff?from=1&to=2", articlesEndpoint, updatedArticle.Article.Slug), authorCookie)
+		require.Equal(t, http.StatusOK, res.StatusCode)
+		diffRevisionsResponse := new(articlePublisherResponses.RevisionDiffResponse)
+		decodeResponse(t, res, diffRevisionsResponse)
+		req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/%s/revisions/1/restore", articlesEndpoint, updatedArticle.Article.Slug), nil)
+		require.NoError(t, err)
+		req.AddCookie(authorCookie)
+		res, err = httpClient.Do(req)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, res.StatusCode)
+		restoreRevisionResponse := new(articlePublisherResponses.ArticleResponse)
+		decodeResponse(t, res, restoreRevisionResponse)
+
+		// Assert
+		require.Len(t, listRevisionsResponse.Revisions, 2)
+		require.Equal(t, int64(2), listRevisionsResponse.Revisions[0].Number)
+		require.Equal(t, updateArticleRequest.Article.Title, listRevisionsResponse.Revisions[0].Title)
+		require.Equal(t, int64(1), listRevisionsResponse.Revisions[1].Number)
+		require.Equal(t, article.Article.Title, listRevisionsResponse.Revisions[1].Title)
+		require.NotEmpty(t, diffRevisionsResponse.Diff.Body)
+		require.Equal(t, article.Article.Title, restoreRevisionResponse.Article.Title)
+		require.Equal(t, article.Article.Body, restoreRevisionResponse.Article.Body)
+		res = mustGetWithCookie(t, httpClient, fmt.Sprintf("%s/%s/revisions/3", articlesEndpoint, restoreRevisionResponse.Article.Slug), authorCookie)
+		require.Equal(t, http.StatusOK, res.StatusCode)
+		getRevisionResponse := new(articlePublisherResponses.RevisionResponse)
+		decodeResponse(t, res, getRevisionResponse)
+		require.NotNil(t, getRevisionResponse.Revision.RestoredFrom)
+		require.Equal(t, int64(1), *getRevisionResponse.Revision.RestoredFrom)
+	})
+
+	t.Run("Should not show revisions to other users", func(t *testing.T) {
+		// Arrange
+		_, authorCookie := integrationtests.MustRegisterUser(t, profileManagerRequests.RegisterPayload{})
+		_, otherCookie := integrationtests.MustRegisterUser(t, profileManagerRequests.RegisterPayload{})
+		article := integrationtests.MustWriteArticle(t, articlePublisherRequests.WriteArticlePayload{}, authorCookie)
+
+		// Act
+		res := mustGetWithCookie(t, httpClient, fmt.Sprintf("%s/%s/revisions", articlesEndpoint, article.Article.Slug), otherCookie)
+
+		// Assert
+		require.Equal(t, http.StatusForbidden, res.StatusCode)
+	})
+}
+
+func mustUpdateArticle(t *testing.T, httpClient http.Client, articlesEndpoint, slug string, updateArticleRequest *articlePublisherRequests.UpdateArticleRequest, cookie *http.Cookie) *articlePublisherResponses.ArticleResponse {
+	t.Helper()
+	requestBody, err := json.Marshal(updateArticleRequest)
+	require.NoError(t, err)
+	req, err := http.NewRequest(http.MethodPut, fmt.Sprintf("%s/%s", articlesEndpoint, slug), bytes.NewBuffer(requestBody))
+	require.NoError(t, err)
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.AddCookie(cookie)
+	res, err := httpClient.Do(req)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	updateArticleResponse := new(articlePublisherResponses.ArticleResponse)
+	decodeResponse(t, res, updateArticleResponse)
+	return updateArticleResponse
+}
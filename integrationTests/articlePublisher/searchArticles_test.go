@@ -0,0 +1,94 @@
+package articlepublisher
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	integrationtests "github.com/ravilock/goduit/integrationTests"
+	articlePublisherRequests "github.com/ravilock/goduit/internal/articlePublisher/requests"
+	articlePublisherResponses "github.com/ravilock/goduit/internal/articlePublisher/responses"
+	profileManagerRequests "github.com/ravilock/goduit/internal/profileManager/requests"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearchArticles(t *testing.T) {
+	serverUrl := viper.GetString("server.url")
+	searchArticlesEndpoint := fmt.Sprintf("%s%s", serverUrl, "/api/articles/search")
+	httpClient := http.Client{}
+
+	t.Run("Should rank title matches before body matches", func(t *testing.T) {
+		// Arrange
+		term := strings.ReplaceAll(uuid.NewString(), "-", "")
+		_, authorCookie := integrationtests.MustRegisterUser(t, profileManagerRequests.RegisterPayload{})
+		bodyMatch := integrationtests.MustWriteArticle(t, articlePublisherRequests.WriteArticlePayload{Body: fmt.Sprintf("An article about %s", term)}, authorCookie)
+		titleMatch := integrationtests.MustWriteArticle(t, articlePublisherRequests.WriteArticlePayload{Title: fmt.Sprintf("%s %s", integrationtests.UniqueTitle(), term)}, authorCookie)
+
+		// Act
+		searchArticlesResponse := mustSearchArticles(t, httpClient, searchArticlesEndpoint, term, "")
+
+		// Assert
+		require.Len(t, searchArticlesResponse.Articles, 2)
+		require.Equal(t, titleMatch.Article.Slug, searchArticlesResponse.Articles[0].Slug)
+		require.Equal(t, bodyMatch.Article.Slug, searchArticlesResponse.Articles[1].Slug)
+		require.Contains(t, searchArticlesResponse.Articles[1].Snippet, fmt.Sprintf("<em>%s</em>", term))
+	})
+
+	t.Run("Should combine query with tag filter", func(t *testing.T) {
+		// Arrange
+		term := strings.ReplaceAll(uuid.NewString(), "-", "")
+		tag := term[:20]
+		_, authorCookie := integrationtests.MustRegisterUser(t, profileManagerRequests.RegisterPayload{})
+		taggedArticle := integrationtests.MustWriteArticle(t, articlePublisherRequests.WriteArticlePayload{Body: term, TagList: []string{tag}}, authorCookie)
+		integrationtests.MustWriteArticle(t, articlePublisherRequests.WriteArticlePayload{Body: term}, authorCookie)
+
+		// Act
+		searchArticlesResponse := mustSearchArticles(t, httpClient, searchArticlesEndpoint, term, tag)
+
+		// Assert
+		require.Len(t, searchArticlesResponse.Articles, 1)
+		require.Equal(t, taggedArticle.Article.Slug, searchArticlesResponse.Articles[0].Slug)
+	})
+
+	t.Run("Should require a query", func(t *testing.T) {
+		// Arrange
+		req, err := http.NewRequest(http.MethodGet, searchArticlesEndpoint, nil)
+		require.NoError(t, err)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+		// Act
+		res, err := httpClient.Do(req)
+		require.NoError(t, err)
+
+		// Assert
+		require.Equal(t, http.StatusBadRequest, res.StatusCode)
+	})
+}
+
+func mustSearchArticles(t *testing.T, httpClient http.Client, endpoint, query, tag string) *articlePublisherResponses.SearchArticlesResponse {
+	t.Helper()
+	req, err := http.NewRequest(http.MethodGet, endpoint, nil)
+	require.NoError(t, err)
+	q := req.URL.Query()
+	q.Add("q", query)
+	if tag != "" {
+		q.Add("tag", tag)
+	}
+	req.URL.RawQuery = q.Encode()
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	res, err := httpClient.Do(req)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	resBytes, err := io.ReadAll(res.Body)
+	require.NoError(t, err)
+	searchArticlesResponse := new(articlePublisherResponses.SearchArticlesResponse)
+	err = json.Unmarshal(resBytes, searchArticlesResponse)
+	require.NoError(t, err)
+	return searchArticlesResponse
+}
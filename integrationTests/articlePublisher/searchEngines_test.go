@@ -0,0 +1,83 @@
+package articlepublisher
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	integrationtests "github.com/ravilock/goduit/integrationTests"
+	articlePublisherRequests "github.com/ravilock/goduit/internal/articlePublisher/requests"
+	articlePublisherResponses "github.com/ravilock/goduit/internal/articlePublisher/responses"
+	profileManagerRequests "github.com/ravilock/goduit/internal/profileManager/requests"
+	"github.com/ravilock/goduit/internal/sitemap"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSitemap(t *testing.T) {
+	serverUrl := viper.GetString("server.url")
+	sitemapEndpoint := fmt.Sprintf("%s%s", serverUrl, "/api/sitemap.xml")
+	httpClient := http.Client{}
+
+	t.Run("Should list published articles and profiles", func(t *testing.T) {
+		// Arrange
+		authorIdentity, authorCookie := integrationtests.MustRegisterUser(t, profileManagerRequests.RegisterPayload{})
+		article := integrationtests.MustWriteArticle(t, articlePublisherRequests.WriteArticlePayload{}, authorCookie)
+
+		// Act
+		res, err := httpClient.Get(sitemapEndpoint)
+
+		// Assert
+		require.NoError(t, err)
+		defer res.Body.Close()
+		require.Equal(t, http.StatusOK, res.StatusCode)
+		require.Equal(t, sitemap.ContentType, res.Header.Get(echo.HeaderContentType))
+		resBytes, err := io.ReadAll(res.Body)
+		require.NoError(t, err)
+		require.Contains(t, string(resBytes), fmt.Sprintf("/article/%s</loc>", article.Article.Slug))
+		require.Contains(t, string(resBytes), fmt.Sprintf("/profile/%s</loc>", authorIdentity.Username))
+	})
+}
+
+func TestGetArticleMetadata(t *testing.T) {
+	serverUrl := viper.GetString("server.url")
+	httpClient := http.Client{}
+
+	t.Run("Should describe a published article", func(t *testing.T) {
+		// Arrange
+		authorIdentity, authorCookie := integrationtests.MustRegisterUser(t, profileManagerRequests.RegisterPayload{})
+		article := integrationtests.MustWriteArticle(t, articlePublisherRequests.WriteArticlePayload{}, authorCookie)
+		metadataEndpoint := fmt.Sprintf("%s/api/articles/%s/metadata", serverUrl, article.Article.Slug)
+
+		// Act
+		res, err := httpClient.Get(metadataEndpoint)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, res.StatusCode)
+		metadataResponse := new(articlePublisherResponses.ArticleMetadataResponse)
+		decodeResponse(t, res, metadataResponse)
+		require.Equal(t, article.Article.Title, metadataResponse.Metadata.OpenGraph.Title)
+		require.Equal(t, article.Article.Description, metadataResponse.Metadata.Twitter.Description)
+		require.Equal(t, "Article", metadataResponse.Metadata.StructuredData.Type)
+		require.Equal(t, authorIdentity.Username, metadataResponse.Metadata.StructuredData.Author.Name)
+		require.ElementsMatch(t, article.Article.TagList, metadataResponse.Metadata.StructuredData.Keywords)
+	})
+
+	t.Run("Should not describe drafts", func(t *testing.T) {
+		// Arrange
+		_, authorCookie := integrationtests.MustRegisterUser(t, profileManagerRequests.RegisterPayload{})
+		draft := integrationtests.MustWriteArticle(t, articlePublisherRequests.WriteArticlePayload{Draft: true}, authorCookie)
+		metadataEndpoint := fmt.Sprintf("%s/api/articles/%s/metadata", serverUrl, draft.Article.Slug)
+
+		// Act
+		res, err := httpClient.Get(metadataEndpoint)
+
+		// Assert
+		require.NoError(t, err)
+		defer res.Body.Close()
+		require.Equal(t, http.StatusNotFound, res.StatusCode)
+	})
+}
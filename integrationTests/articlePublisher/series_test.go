@@ -0,0 +1,111 @@
+package articlepublisher
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	integrationtests "github.com/ravilock/goduit/integrationTests"
+	articlePublisherRequests "github.com/ravilock/goduit/internal/articlePublisher/requests"
+	articlePublisherResponses "github.com/ravilock/goduit/internal/articlePublisher/responses"
+	profileManagerRequests "github.com/ravilock/goduit/internal/profileManager/requests"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSeries(t *testing.T) {
+	serverUrl := viper.GetString("server.url")
+	articlesEndpoint := fmt.Sprintf("%s%s", serverUrl, "/api/articles")
+	seriesEndpoint := fmt.Sprintf("%s%s", serverUrl, "/api/series")
+	httpClient := http.Client{}
+
+	t.Run("Articles should link to their neighbours in the series", func(t *testing.T) {
+		// Arrange
+		authorIdentity, authorCookie := integrationtests.MustRegisterUser(t, profileManagerRequests.RegisterPayload{})
+		firstArticle := integrationtests.MustWriteArticle(t, articlePublisherRequests.WriteArticlePayload{}, authorCookie)
+		secondArticle := integrationtests.MustWriteArticle(t, articlePublisherRequests.WriteArticlePayload{}, authorCookie)
+		seriesRes := mustWriteSeries(t, httpClient, seriesEndpoint, authorCookie, http.StatusCreated, firstArticle.Article.Slug, secondArticle.Article.Slug)
+		seriesResponse := new(articlePublisherResponses.SeriesResponse)
+		decodeResponse(t, seriesRes, seriesResponse)
+
+		// Act
+		firstRes := mustGetWithCookie(t, httpClient, fmt.Sprintf("%s/%s", articlesEndpoint, firstArticle.Article.Slug), authorCookie)
+		secondRes := mustGetWithCookie(t, httpClient, fmt.Sprintf("%s/%s", articlesEndpoint, secondArticle.Article.Slug), authorCookie)
+
+		// Assert
+		require.Equal(t, authorIdentity.Username, seriesResponse.Series.Owner.Username)
+		require.Len(t, seriesResponse.Series.Articles, 2)
+		require.Equal(t, http.StatusOK, firstRes.StatusCode)
+		firstResponse := new(articlePublisherResponses.ArticleResponse)
+		decodeResponse(t, firstRes, firstResponse)
+		require.NotNil(t, firstResponse.Article.Series)
+		require.Equal(t, seriesResponse.Series.Slug, firstResponse.Article.Series.Slug)
+		require.Equal(t, 1, firstResponse.Article.Series.Position)
+		require.Equal(t, 2, firstResponse.Article.Series.Total)
+		require.Nil(t, firstResponse.Article.Series.Previous)
+		require.NotNil(t, firstResponse.Article.Series.Next)
+		require.Equal(t, secondArticle.Article.Slug, firstResponse.Article.Series.Next.Slug)
+		require.Equal(t, http.StatusOK, secondRes.StatusCode)
+		secondResponse := new(articlePublisherResponses.ArticleResponse)
+		decodeResponse(t, secondRes, secondResponse)
+		require.NotNil(t, secondResponse.Article.Series)
+		require.Equal(t, 2, secondResponse.Article.Series.Position)
+		require.NotNil(t, secondResponse.Article.Series.Previous)
+		require.Equal(t, firstArticle.Article.Slug, secondResponse.Article.Series.Previous.Slug)
+		require.Nil(t, secondResponse.Article.Series.Next)
+	})
+
+	t.Run("Should not add articles of other authors to a series", func(t *testing.T) {
+		// Arrange
+		_, authorCookie := integrationtests.MustRegisterUser(t, profileManagerRequests.RegisterPayload{})
+		_, otherCookie := integrationtests.MustRegisterUser(t, profileManagerRequests.RegisterPayload{})
+		otherArticle := integrationtests.MustWriteArticle(t, articlePublisherRequests.WriteArticlePayload{}, otherCookie)
+
+		// Act
+		res := mustWriteSeries(t, httpClient, seriesEndpoint, authorCookie, http.StatusForbidden, otherArticle.Article.Slug)
+
+		// Assert
+		res.Body.Close()
+	})
+
+	t.Run("Should only let the owner delete a series", func(t *testing.T) {
+		// Arrange
+		_, authorCookie := integrationtests.MustRegisterUser(t, profileManagerRequests.RegisterPayload{})
+		_, otherCookie := integrationtests.MustRegisterUser(t, profileManagerRequests.RegisterPayload{})
+		article := integrationtests.MustWriteArticle(t, articlePublisherRequests.WriteArticlePayload{}, authorCookie)
+		seriesRes := mustWriteSeries(t, httpClient, seriesEndpoint, authorCookie, http.StatusCreated, article.Article.Slug)
+		seriesResponse := new(articlePublisherResponses.SeriesResponse)
+		decodeResponse(t, seriesRes, seriesResponse)
+		endpoint := fmt.Sprintf("%s/%s", seriesEndpoint, seriesResponse.Series.Slug)
+
+		// Act
+		forbiddenRes := mustDoWithCookie(t, httpClient, http.MethodDelete, endpoint, otherCookie, http.StatusForbidden)
+		deleteRes := mustDoWithCookie(t, httpClient, http.MethodDelete, endpoint, authorCookie, http.StatusNoContent)
+		getRes := mustDoWithCookie(t, httpClient, http.MethodGet, endpoint, authorCookie, http.StatusNotFound)
+
+		// Assert
+		forbiddenRes.Body.Close()
+		deleteRes.Body.Close()
+		getRes.Body.Close()
+	})
+}
+
+func mustWriteSeries(t *testing.T, httpClient http.Client, seriesEndpoint string, cookie *http.Cookie, expectedStatus int, articles ...string) *http.Response {
+	t.Helper()
+	request := new(articlePublisherRequests.WriteSeriesRequest)
+	request.Series.Title = "Integration Test Series"
+	request.Series.Articles = articles
+	requestBody, err := json.Marshal(request)
+	require.NoError(t, err)
+	req, err := http.NewRequest(http.MethodPost, seriesEndpoint, bytes.NewBuffer(requestBody))
+	require.NoError(t, err)
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.AddCookie(cookie)
+	res, err := httpClient.Do(req)
+	require.NoError(t, err)
+	require.Equal(t, expectedStatus, res.StatusCode)
+	return res
+}
@@ -0,0 +1,71 @@
+package articlepublisher
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	integrationtests "github.com/ravilock/goduit/integrationTests"
+	articlePublisherRequests "github.com/ravilock/goduit/internal/articlePublisher/requests"
+	articlePublisherResponses "github.com/ravilock/goduit/internal/articlePublisher/responses"
+	profileManagerRequests "github.com/ravilock/goduit/internal/profileManager/requests"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSlugHistory(t *testing.T) {
+	serverUrl := viper.GetString("server.url")
+	articlesEndpoint := fmt.Sprintf("%s%s", serverUrl, "/api/articles")
+	httpClient := http.Client{
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	t.Run("Should redirect an old slug to the current one", func(t *testing.T) {
+		// Arrange
+		_, authorCookie := integrationtests.MustRegisterUser(t, profileManagerRequests.RegisterPayload{})
+		article := integrationtests.MustWriteArticle(t, articlePublisherRequests.WriteArticlePayload{}, authorCookie)
+		updatedArticle := mustUpdateArticle(t, httpClient, articlesEndpoint, article.Article.Slug, generateUpdateArticleBody(), authorCookie)
+
+		// Act
+		res := mustGetWithCookie(t, httpClient, fmt.Sprintf("%s/%s", articlesEndpoint, article.Article.Slug), authorCookie)
+
+		// Assert
+		require.Equal(t, http.StatusMovedPermanently, res.StatusCode)
+		require.Equal(t, fmt.Sprintf("/api/articles/%s", updatedArticle.Article.Slug), res.Header.Get(echo.HeaderLocation))
+	})
+
+	t.Run("Should serve comments of an article through an old slug", func(t *testing.T) {
+		// Arrange
+		_, authorCookie := integrationtests.MustRegisterUser(t, profileManagerRequests.RegisterPayload{})
+		article := integrationtests.MustWriteArticle(t, articlePublisherRequests.WriteArticlePayload{}, authorCookie)
+		integrationtests.MustWriteComment(t, articlePublisherRequests.WriteCommentPayload{}, article.Article.Slug, authorCookie)
+		mustUpdateArticle(t, httpClient, articlesEndpoint, article.Article.Slug, generateUpdateArticleBody(), authorCookie)
+
+		// Act
+		res := mustGetWithCookie(t, httpClient, fmt.Sprintf("%s/%s/comments", articlesEndpoint, article.Article.Slug), authorCookie)
+
+		// Assert
+		require.Equal(t, http.StatusOK, res.StatusCode)
+		listCommentsResponse := new(articlePublisherResponses.CommentsResponse)
+		decodeResponse(t, res, listCommentsResponse)
+		require.Len(t, listCommentsResponse.Comment, 1)
+	})
+
+	t.Run("Should keep old slugs reserved", func(t *testing.T) {
+		// Arrange
+		_, authorCookie := integrationtests.MustRegisterUser(t, profileManagerRequests.RegisterPayload{})
+		article := integrationtests.MustWriteArticle(t, articlePublisherRequests.WriteArticlePayload{}, authorCookie)
+		mustUpdateArticle(t, httpClient, articlesEndpoint, article.Article.Slug, generateUpdateArticleBody(), authorCookie)
+
+		// Act
+		otherArticle := integrationtests.MustWriteArticle(t, articlePublisherRequests.WriteArticlePayload{Title: article.Article.Title}, authorCookie)
+
+		// Assert
+		require.NotEqual(t, article.Article.Slug, otherArticle.Article.Slug)
+		require.True(t, strings.HasPrefix(otherArticle.Article.Slug, article.Article.Slug+"-"))
+	})
+}
@@ -0,0 +1,95 @@
+package articlepublisher
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	integrationtests "github.com/ravilock/goduit/integrationTests"
+	articlePublisherRequests "github.com/ravilock/goduit/internal/articlePublisher/requests"
+	"github.com/ravilock/goduit/internal/articlePublisher/syndication"
+	"github.com/ravilock/goduit/internal/etag"
+	profileManagerRequests "github.com/ravilock/goduit/internal/profileManager/requests"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSyndicateArticles(t *testing.T) {
+	serverUrl := viper.GetString("server.url")
+	httpClient := http.Client{}
+
+	t.Run("Should list an author's articles in their Atom feed", func(t *testing.T) {
+		// Arrange
+		authorIdentity, authorCookie := integrationtests.MustRegisterUser(t, profileManagerRequests.RegisterPayload{})
+		article := integrationtests.MustWriteArticle(t, articlePublisherRequests.WriteArticlePayload{}, authorCookie)
+		feedEndpoint := fmt.Sprintf("%s/api/profiles/%s/feed.atom", serverUrl, authorIdentity.Username)
+
+		// Act
+		res, err := httpClient.Get(feedEndpoint)
+
+		// Assert
+		require.NoError(t, err)
+		defer res.Body.Close()
+		require.Equal(t, http.StatusOK, res.StatusCode)
+		require.Equal(t, syndication.ContentType(syndication.FormatAtom), res.Header.Get(echo.HeaderContentType))
+		require.NotEmpty(t, res.Header.Get(echo.HeaderLastModified))
+		resBytes, err := io.ReadAll(res.Body)
+		require.NoError(t, err)
+		require.Contains(t, string(resBytes), fmt.Sprintf("<title>%s</title>", article.Article.Title))
+		require.Contains(t, string(resBytes), fmt.Sprintf("<name>%s</name>", authorIdentity.Username))
+	})
+
+	t.Run("Should list a tag's articles in its RSS feed", func(t *testing.T) {
+		// Arrange
+		_, authorCookie := integrationtests.MustRegisterUser(t, profileManagerRequests.RegisterPayload{})
+		tag := "feed-" + uuid.NewString()[:8]
+		article := integrationtests.MustWriteArticle(t, articlePublisherRequests.WriteArticlePayload{TagList: []string{tag}}, authorCookie)
+		feedEndpoint := fmt.Sprintf("%s/api/tags/%s/feed.rss", serverUrl, tag)
+
+		// Act
+		res, err := httpClient.Get(feedEndpoint)
+
+		// Assert
+		require.NoError(t, err)
+		defer res.Body.Close()
+		require.Equal(t, http.StatusOK, res.StatusCode)
+		require.Equal(t, syndication.ContentType(syndication.FormatRSS), res.Header.Get(echo.HeaderContentType))
+		resBytes, err := io.ReadAll(res.Body)
+		require.NoError(t, err)
+		require.Contains(t, string(resBytes), fmt.Sprintf("<title>%s</title>", article.Article.Title))
+		require.Contains(t, string(resBytes), fmt.Sprintf("<category>%s</category>", tag))
+	})
+
+	t.Run("Should return HTTP 304 if the global feed did not change since it was fetched", func(t *testing.T) {
+		// Arrange
+		feedEndpoint := fmt.Sprintf("%s/api/articles/feed.atom", serverUrl)
+		res, err := httpClient.Get(feedEndpoint)
+		require.NoError(t, err)
+		res.Body.Close()
+		require.Equal(t, http.StatusOK, res.StatusCode)
+		req, err := http.NewRequest(http.MethodGet, feedEndpoint, nil)
+		require.NoError(t, err)
+		req.Header.Set(etag.HeaderIfNoneMatch, res.Header.Get(etag.HeaderETag))
+
+		// Act
+		res, err = httpClient.Do(req)
+
+		// Assert
+		require.NoError(t, err)
+		defer res.Body.Close()
+		require.Equal(t, http.StatusNotModified, res.StatusCode)
+	})
+
+	t.Run("Should return HTTP 404 if author is not found", func(t *testing.T) {
+		// Act
+		res, err := httpClient.Get(fmt.Sprintf("%s/api/profiles/%s/feed.rss", serverUrl, integrationtests.UniqueUsername()))
+
+		// Assert
+		require.NoError(t, err)
+		defer res.Body.Close()
+		require.Equal(t, http.StatusNotFound, res.StatusCode)
+	})
+}
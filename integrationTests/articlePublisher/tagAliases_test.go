@@ -0,0 +1,44 @@
+package articlepublisher
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	integrationtests "github.com/ravilock/goduit/integrationTests"
+	articlePublisherResponses "github.com/ravilock/goduit/internal/articlePublisher/responses"
+	profileManagerRequests "github.com/ravilock/goduit/internal/profileManager/requests"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTagAliases(t *testing.T) {
+	serverUrl := viper.GetString("server.url")
+	tagsEndpoint := fmt.Sprintf("%s%s", serverUrl, "/api/tags")
+	httpClient := http.Client{}
+
+	t.Run("Should list the aliases of a tag", func(t *testing.T) {
+		// Arrange
+		_, cookie := integrationtests.MustRegisterUser(t, profileManagerRequests.RegisterPayload{})
+
+		// Act
+		res := mustGetWithCookie(t, httpClient, fmt.Sprintf("%s/Technology/aliases", tagsEndpoint), cookie)
+
+		// Assert
+		require.Equal(t, http.StatusOK, res.StatusCode)
+		tagAliasesResponse := new(articlePublisherResponses.TagAliasesResponse)
+		decodeResponse(t, res, tagAliasesResponse)
+		require.Equal(t, "technology", tagAliasesResponse.Tag.Name)
+	})
+
+	t.Run("Should return HTTP 403 if user is not an admin", func(t *testing.T) {
+		// Arrange
+		_, cookie := integrationtests.MustRegisterUser(t, profileManagerRequests.RegisterPayload{})
+
+		// Act
+		res := mustDoWithCookie(t, httpClient, http.MethodPost, fmt.Sprintf("%s/go-lang/merge", tagsEndpoint), cookie, http.StatusForbidden)
+
+		// Assert
+		res.Body.Close()
+	})
+}
@@ -0,0 +1,92 @@
+package articlepublisher
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	integrationtests "github.com/ravilock/goduit/integrationTests"
+	articlePublisherRequests "github.com/ravilock/goduit/internal/articlePublisher/requests"
+	articlePublisherResponses "github.com/ravilock/goduit/internal/articlePublisher/responses"
+	profileManagerRequests "github.com/ravilock/goduit/internal/profileManager/requests"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrash(t *testing.T) {
+	serverUrl := viper.GetString("server.url")
+	articlesEndpoint := fmt.Sprintf("%s%s", serverUrl, "/api/articles")
+	httpClient := http.Client{}
+
+	t.Run("Unpublished articles should be moved to the trash and restorable by their author", func(t *testing.T) {
+		// Arrange
+		_, authorCookie := integrationtests.MustRegisterUser(t, profileManagerRequests.RegisterPayload{})
+		article := integrationtests.MustWriteArticle(t, articlePublisherRequests.WriteArticlePayload{}, authorCookie)
+		articleEndpoint := fmt.Sprintf("%s/%s", articlesEndpoint, article.Article.Slug)
+		mustDoWithCookie(t, httpClient, http.MethodDelete, articleEndpoint, authorCookie, http.StatusNoContent)
+
+		// Act
+		getRes := mustGetWithCookie(t, httpClient, articleEndpoint, authorCookie)
+		trashRes := mustGetWithCookie(t, httpClient, fmt.Sprintf("%s/trash", articlesEndpoint), authorCookie)
+		restoreRes := mustDoWithCookie(t, httpClient, http.MethodPost, fmt.Sprintf("%s/restore", articleEndpoint), authorCookie, http.StatusOK)
+		restoredRes := mustGetWithCookie(t, httpClient, articleEndpoint, authorCookie)
+
+		// Assert
+		require.Equal(t, http.StatusNotFound, getRes.StatusCode)
+		require.Equal(t, http.StatusOK, trashRes.StatusCode)
+		listTrashResponse := new(articlePublisherResponses.ArticlesResponse)
+		decodeResponse(t, trashRes, listTrashResponse)
+		require.True(t, slices.ContainsFunc(listTrashResponse.Articles, func(trashed articlePublisherResponses.MultiArticle) bool {
+			return trashed.Slug == article.Article.Slug && trashed.TrashedAt != nil
+		}))
+		restoreArticleResponse := new(articlePublisherResponses.ArticleResponse)
+		decodeResponse(t, restoreRes, restoreArticleResponse)
+		require.Equal(t, article.Article.Slug, restoreArticleResponse.Article.Slug)
+		require.Equal(t, http.StatusOK, restoredRes.StatusCode)
+	})
+
+	t.Run("Should not allow user to restore other author's articles", func(t *testing.T) {
+		// Arrange
+		_, authorCookie := integrationtests.MustRegisterUser(t, profileManagerRequests.RegisterPayload{})
+		_, nonAuthorCookie := integrationtests.MustRegisterUser(t, profileManagerRequests.RegisterPayload{})
+		article := integrationtests.MustWriteArticle(t, articlePublisherRequests.WriteArticlePayload{}, authorCookie)
+		articleEndpoint := fmt.Sprintf("%s/%s", articlesEndpoint, article.Article.Slug)
+		mustDoWithCookie(t, httpClient, http.MethodDelete, articleEndpoint, authorCookie, http.StatusNoContent)
+
+		// Act
+		res := mustDoWithCookie(t, httpClient, http.MethodPost, fmt.Sprintf("%s/restore", articleEndpoint), nonAuthorCookie, http.StatusForbidden)
+
+		// Assert
+		res.Body.Close()
+		trashRes := mustGetWithCookie(t, httpClient, fmt.Sprintf("%s/trash", articlesEndpoint), nonAuthorCookie)
+		listTrashResponse := new(articlePublisherResponses.ArticlesResponse)
+		decodeResponse(t, trashRes, listTrashResponse)
+		require.Empty(t, listTrashResponse.Articles)
+	})
+
+	t.Run("Should return HTTP 404 when restoring an article that is not in the trash", func(t *testing.T) {
+		// Arrange
+		_, authorCookie := integrationtests.MustRegisterUser(t, profileManagerRequests.RegisterPayload{})
+		article := integrationtests.MustWriteArticle(t, articlePublisherRequests.WriteArticlePayload{}, authorCookie)
+
+		// Act
+		res := mustDoWithCookie(t, httpClient, http.MethodPost, fmt.Sprintf("%s/%s/restore", articlesEndpoint, article.Article.Slug), authorCookie, http.StatusNotFound)
+
+		// Assert
+		res.Body.Close()
+	})
+}
+
+func mustDoWithCookie(t *testing.T, httpClient http.Client, method, endpoint string, cookie *http.Cookie, expectedStatus int) *http.Response {
+	t.Helper()
+	req, err := http.NewRequest(method, endpoint, nil)
+	require.NoError(t, err)
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.AddCookie(cookie)
+	res, err := httpClient.Do(req)
+	require.NoError(t, err)
+	require.Equal(t, expectedStatus, res.StatusCode)
+	return res
+}
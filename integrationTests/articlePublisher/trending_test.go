@@ -0,0 +1,50 @@
+package articlepublisher
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	integrationtests "github.com/ravilock/goduit/integrationTests"
+	articlePublisherResponses "github.com/ravilock/goduit/internal/articlePublisher/responses"
+	profileManagerRequests "github.com/ravilock/goduit/internal/profileManager/requests"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrendingArticles(t *testing.T) {
+	serverUrl := viper.GetString("server.url")
+	trendingEndpoint := fmt.Sprintf("%s%s", serverUrl, "/api/articles/trending")
+	httpClient := http.Client{}
+
+	t.Run("Should list trending articles of every window", func(t *testing.T) {
+		// Arrange
+		_, cookie := integrationtests.MustRegisterUser(t, profileManagerRequests.RegisterPayload{})
+
+		for _, window := range []string{"day", "week", "month"} {
+			// Act
+			res := mustGetWithCookie(t, httpClient, fmt.Sprintf("%s?window=%s&tag=technology&limit=5", trendingEndpoint, window), cookie)
+
+			// Assert
+			require.Equal(t, http.StatusOK, res.StatusCode)
+			trendingArticlesResponse := new(articlePublisherResponses.ArticlesResponse)
+			decodeResponse(t, res, trendingArticlesResponse)
+			require.LessOrEqual(t, len(trendingArticlesResponse.Articles), 5)
+			for _, article := range trendingArticlesResponse.Articles {
+				require.Contains(t, article.TagList, "technology")
+				require.Equal(t, "published", article.Status)
+			}
+		}
+	})
+
+	t.Run("Should return HTTP 400 if window is not supported", func(t *testing.T) {
+		// Arrange
+		_, cookie := integrationtests.MustRegisterUser(t, profileManagerRequests.RegisterPayload{})
+
+		// Act
+		res := mustDoWithCookie(t, httpClient, http.MethodGet, fmt.Sprintf("%s?window=year", trendingEndpoint), cookie, http.StatusBadRequest)
+
+		// Assert
+		res.Body.Close()
+	})
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/google/uuid"
@@ -13,6 +14,7 @@ import (
 	integrationtests "github.com/ravilock/goduit/integrationTests"
 	articlePublisherRequests "github.com/ravilock/goduit/internal/articlePublisher/requests"
 	articlePublisherResponses "github.com/ravilock/goduit/internal/articlePublisher/responses"
+	"github.com/ravilock/goduit/internal/etag"
 	profileManagerRequests "github.com/ravilock/goduit/internal/profileManager/requests"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
@@ -72,24 +74,99 @@ func TestUpdateArticle(t *testing.T) {
 		checkUpdateArticleResponse(t, updateArticleRequest, authorIdentity.Username, updateArticleResponse, article.Article.TagList)
 	})
 
-	t.Run("Should not allow to update article's slug to another that already exists", func(t *testing.T) {
+	t.Run("Should suffix the slug when updating to a title that is already taken", func(t *testing.T) {
 		// Arrange
 		_, authorCookie := integrationtests.MustRegisterUser(t, profileManagerRequests.RegisterPayload{})
 		article := integrationtests.MustWriteArticle(t, articlePublisherRequests.WriteArticlePayload{}, authorCookie)
 		conflictedArticle := integrationtests.MustWriteArticle(t, articlePublisherRequests.WriteArticlePayload{}, authorCookie)
 		updateArticleRequest := generateUpdateArticleBody()
 		updateArticleRequest.Article.Title = conflictedArticle.Article.Title
-		requestBody, err := json.Marshal(updateArticleRequest)
+
+		// Act
+		updatedArticle := mustUpdateArticle(t, httpClient, updateArticleEndpoint, article.Article.Slug, updateArticleRequest, authorCookie)
+
+		// Assert
+		require.Equal(t, conflictedArticle.Article.Title, updatedArticle.Article.Title)
+		require.True(t, strings.HasPrefix(updatedArticle.Article.Slug, conflictedArticle.Article.Slug+"-"))
+		keptArticle := mustUpdateArticle(t, httpClient, updateArticleEndpoint, updatedArticle.Article.Slug, updateArticleRequest, authorCookie)
+		require.Equal(t, updatedArticle.Article.Slug, keptArticle.Article.Slug)
+	})
+
+	t.Run("Should return HTTP 412 if the article changed since it was fetched", func(t *testing.T) {
+		// Arrange
+		_, authorCookie := integrationtests.MustRegisterUser(t, profileManagerRequests.RegisterPayload{})
+		article := integrationtests.MustWriteArticle(t, articlePublisherRequests.WriteArticlePayload{}, authorCookie)
+		res := mustGetWithCookie(t, httpClient, fmt.Sprintf("%s/%s", updateArticleEndpoint, article.Article.Slug), authorCookie)
+		res.Body.Close()
+		fetchedETag := res.Header.Get(etag.HeaderETag)
+		require.NotEmpty(t, fetchedETag)
+		updatedArticle := mustUpdateArticle(t, httpClient, updateArticleEndpoint, article.Article.Slug, generateUpdateArticleBody(), authorCookie)
+		requestBody, err := json.Marshal(generateUpdateArticleBody())
+		require.NoError(t, err)
+		req, err := http.NewRequest(http.MethodPut, fmt.Sprintf("%s/%s", updateArticleEndpoint, updatedArticle.Article.Slug), bytes.NewBuffer(requestBody))
+		require.NoError(t, err)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set(etag.HeaderIfMatch, fetchedETag)
+		req.AddCookie(authorCookie)
+
+		// Act
+		res, err = httpClient.Do(req)
+		require.NoError(t, err)
+		defer res.Body.Close()
+
+		// Assert
+		require.Equal(t, http.StatusPreconditionFailed, res.StatusCode)
+	})
+
+	t.Run("Should not return HTTP 412 if the article was only favorited since it was fetched", func(t *testing.T) {
+		// Arrange
+		_, authorCookie := integrationtests.MustRegisterUser(t, profileManagerRequests.RegisterPayload{})
+		_, readerCookie := integrationtests.MustRegisterUser(t, profileManagerRequests.RegisterPayload{})
+		article := integrationtests.MustWriteArticle(t, articlePublisherRequests.WriteArticlePayload{}, authorCookie)
+		res := mustGetWithCookie(t, httpClient, fmt.Sprintf("%s/%s", updateArticleEndpoint, article.Article.Slug), authorCookie)
+		res.Body.Close()
+		fetchedETag := res.Header.Get(etag.HeaderETag)
+		integrationtests.MustFavoriteArticle(t, article.Article.Slug, readerCookie)
+		requestBody, err := json.Marshal(generateUpdateArticleBody())
 		require.NoError(t, err)
 		req, err := http.NewRequest(http.MethodPut, fmt.Sprintf("%s/%s", updateArticleEndpoint, article.Article.Slug), bytes.NewBuffer(requestBody))
 		require.NoError(t, err)
 		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set(etag.HeaderIfMatch, fetchedETag)
+		req.AddCookie(authorCookie)
+
+		// Act
+		res, err = httpClient.Do(req)
+		require.NoError(t, err)
+		defer res.Body.Close()
+
+		// Assert
+		require.Equal(t, http.StatusOK, res.StatusCode)
+	})
+
+	t.Run("Should patch only the given fields of an article, including its tags", func(t *testing.T) {
+		// Arrange
+		_, authorCookie := integrationtests.MustRegisterUser(t, profileManagerRequests.RegisterPayload{})
+		article := integrationtests.MustWriteArticle(t, articlePublisherRequests.WriteArticlePayload{}, authorCookie)
+		patch := `{"article": {"description": "A patched description", "tagList": ["Patched Tag"]}}`
+		req, err := http.NewRequest(http.MethodPatch, fmt.Sprintf("%s/%s", updateArticleEndpoint, article.Article.Slug), strings.NewReader(patch))
+		require.NoError(t, err)
+		req.Header.Set(echo.HeaderContentType, articlePublisherRequests.MIMEApplicationMergePatchJSON)
 		req.AddCookie(authorCookie)
 
 		// Act
 		res, err := httpClient.Do(req)
 		require.NoError(t, err)
-		require.Equal(t, http.StatusConflict, res.StatusCode)
+		require.Equal(t, http.StatusOK, res.StatusCode)
+		patchArticleResponse := new(articlePublisherResponses.ArticleResponse)
+		decodeResponse(t, res, patchArticleResponse)
+
+		// Assert
+		require.Equal(t, article.Article.Slug, patchArticleResponse.Article.Slug)
+		require.Equal(t, article.Article.Title, patchArticleResponse.Article.Title)
+		require.Equal(t, article.Article.Body, patchArticleResponse.Article.Body)
+		require.Equal(t, "A patched description", patchArticleResponse.Article.Description)
+		require.Equal(t, []string{"patched-tag"}, patchArticleResponse.Article.TagList)
 	})
 
 	t.Run("Should return HTTP 404 if targeted article does not exists", func(t *testing.T) {
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/labstack/echo/v4"
@@ -45,32 +46,19 @@ func TestWriteArticle(t *testing.T) {
 		checkWriteArticleResponse(t, createArticleRequest, authorIdentity.Username, createArticleResponse)
 	})
 
-	t.Run("Should not allow to write article with slug that already exists", func(t *testing.T) {
+	t.Run("Should suffix the slug of an article whose title is already taken", func(t *testing.T) {
 		// Arrange
-		authorIdentity, authorCookie := integrationtests.MustRegisterUser(t, profileManagerRequests.RegisterPayload{})
-		createArticleRequest := generateWriteArticleBody()
-		requestBody, err := json.Marshal(createArticleRequest)
-		require.NoError(t, err)
-		req, err := http.NewRequest(http.MethodPost, writeArticleEndpoint, bytes.NewBuffer(requestBody))
-		require.NoError(t, err)
-		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
-		req.AddCookie(authorCookie)
-		res, err := httpClient.Do(req)
-		require.NoError(t, err)
-		require.Equal(t, http.StatusCreated, res.StatusCode)
-		resBytes, err := io.ReadAll(res.Body)
-		require.NoError(t, err)
-		createArticleResponse := new(articlePublisherResponses.ArticleResponse)
-		err = json.Unmarshal(resBytes, createArticleResponse)
-		require.NoError(t, err)
-		checkWriteArticleResponse(t, createArticleRequest, authorIdentity.Username, createArticleResponse)
+		_, authorCookie := integrationtests.MustRegisterUser(t, profileManagerRequests.RegisterPayload{})
+		title := integrationtests.UniqueTitle()
+		firstArticle := integrationtests.MustWriteArticle(t, articlePublisherRequests.WriteArticlePayload{Title: title}, authorCookie)
 
 		// Act
-		res, err = httpClient.Do(req)
+		secondArticle := integrationtests.MustWriteArticle(t, articlePublisherRequests.WriteArticlePayload{Title: title}, authorCookie)
 
-		// Arrange
-		require.NoError(t, err)
-		require.Equal(t, http.StatusConflict, res.StatusCode)
+		// Assert
+		require.Equal(t, integrationtests.MakeSlug(title), firstArticle.Article.Slug)
+		require.NotEqual(t, firstArticle.Article.Slug, secondArticle.Article.Slug)
+		require.True(t, strings.HasPrefix(secondArticle.Article.Slug, firstArticle.Article.Slug+"-"))
 	})
 }
 
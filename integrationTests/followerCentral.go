@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/labstack/echo/v4"
+	followerCentralResponses "github.com/ravilock/goduit/internal/followerCentral/responses"
 	profileManagerResponses "github.com/ravilock/goduit/internal/profileManager/responses"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
@@ -31,3 +32,22 @@ func MustFollowUser(t *testing.T, followedUsername string, followerCookie *http.
 	require.NoError(t, err)
 	require.True(t, folllowUserResponse.Profile.Following)
 }
+
+func MustFollowTag(t *testing.T, tag string, followerCookie *http.Cookie) {
+	httpClient := http.Client{}
+	serverUrl := viper.GetString("server.url")
+	followTagEndpoint := fmt.Sprintf("%s%s%s%s", serverUrl, "/api/tags/", tag, "/followers")
+	req, err := http.NewRequest(http.MethodPost, followTagEndpoint, nil)
+	require.NoError(t, err)
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.AddCookie(followerCookie)
+	res, err := httpClient.Do(req)
+	require.NoError(t, err)
+	followTagResponse := new(followerCentralResponses.TagResponse)
+	resBytes, err := io.ReadAll(res.Body)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	err = json.Unmarshal(resBytes, followTagResponse)
+	require.NoError(t, err)
+	require.True(t, followTagResponse.Tag.Following)
+}
@@ -0,0 +1,90 @@
+package followercentral
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	integrationtests "github.com/ravilock/goduit/integrationTests"
+	followerCentralResponses "github.com/ravilock/goduit/internal/followerCentral/responses"
+	profileManagerRequests "github.com/ravilock/goduit/internal/profileManager/requests"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFollowTag(t *testing.T) {
+	serverUrl := viper.GetString("server.url")
+	tagsEndpoint := fmt.Sprintf("%s%s", serverUrl, "/api/tags/")
+	httpClient := http.Client{}
+
+	t.Run("Should follow, list and unfollow a tag", func(t *testing.T) {
+		// Arrange
+		tag := "tag-" + uuid.NewString()[:8]
+		_, followerCookie := integrationtests.MustRegisterUser(t, profileManagerRequests.RegisterPayload{})
+		req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s%s%s", tagsEndpoint, tag, "/followers"), nil)
+		require.NoError(t, err)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.AddCookie(followerCookie)
+
+		// Act
+		res, err := httpClient.Do(req)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, res.StatusCode)
+		tagResponse := new(followerCentralResponses.TagResponse)
+		resBytes, err := io.ReadAll(res.Body)
+		require.NoError(t, err)
+		err = json.Unmarshal(resBytes, tagResponse)
+		require.NoError(t, err)
+		require.Equal(t, tag, tagResponse.Tag.Name)
+		require.True(t, tagResponse.Tag.Following)
+		require.Equal(t, []string{tag}, mustListFollowedTags(t, httpClient, tagsEndpoint, followerCookie))
+
+		req, err = http.NewRequest(http.MethodDelete, fmt.Sprintf("%s%s%s", tagsEndpoint, tag, "/followers"), nil)
+		require.NoError(t, err)
+		req.AddCookie(followerCookie)
+		res, err = httpClient.Do(req)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, res.StatusCode)
+		require.Empty(t, mustListFollowedTags(t, httpClient, tagsEndpoint, followerCookie))
+	})
+
+	t.Run("Should return HTTP 409 if user already follows tag", func(t *testing.T) {
+		// Arrange
+		tag := "tag-" + uuid.NewString()[:8]
+		_, followerCookie := integrationtests.MustRegisterUser(t, profileManagerRequests.RegisterPayload{})
+		integrationtests.MustFollowTag(t, tag, followerCookie)
+		req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s%s%s", tagsEndpoint, tag, "/followers"), nil)
+		require.NoError(t, err)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.AddCookie(followerCookie)
+
+		// Act
+		res, err := httpClient.Do(req)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, http.StatusConflict, res.StatusCode)
+	})
+}
+
+func mustListFollowedTags(t *testing.T, httpClient http.Client, tagsEndpoint string, cookie *http.Cookie) []string {
+	t.Helper()
+	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s%s", tagsEndpoint, "followed"), nil)
+	require.NoError(t, err)
+	req.AddCookie(cookie)
+	res, err := httpClient.Do(req)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	followedTagsResponse := new(followerCentralResponses.FollowedTagsResponse)
+	resBytes, err := io.ReadAll(res.Body)
+	require.NoError(t, err)
+	err = json.Unmarshal(resBytes, followedTagsResponse)
+	require.NoError(t, err)
+	return followedTagsResponse.Tags
+}
@@ -0,0 +1,138 @@
+package medialibrary
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	integrationtests "github.com/ravilock/goduit/integrationTests"
+	"github.com/ravilock/goduit/internal/imaging"
+	"github.com/ravilock/goduit/internal/mediaLibrary/handlers"
+	mediaLibraryResponses "github.com/ravilock/goduit/internal/mediaLibrary/responses"
+	profileManagerRequests "github.com/ravilock/goduit/internal/profileManager/requests"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUploadMedia(t *testing.T) {
+	serverUrl := viper.GetString("server.url")
+	uploadMediaEndpoint := fmt.Sprintf("%s%s", serverUrl, "/api/media")
+	httpClient := http.Client{}
+
+	t.Run("Should upload an image and serve it with its thumbnail", func(t *testing.T) {
+		// Arrange
+		_, cookie := integrationtests.MustRegisterUser(t, profileManagerRequests.RegisterPayload{})
+		req := newUploadRequest(t, uploadMediaEndpoint, mustEncodePNG(t, 640, 480))
+		req.AddCookie(cookie)
+
+		// Act
+		res, err := httpClient.Do(req)
+
+		// Assert
+		require.NoError(t, err)
+		defer res.Body.Close()
+		require.Equal(t, http.StatusCreated, res.StatusCode)
+		mediaResponse := new(mediaLibraryResponses.MediaResponse)
+		err = json.NewDecoder(res.Body).Decode(mediaResponse)
+		require.NoError(t, err)
+		require.Equal(t, imaging.MIMEImagePNG, mediaResponse.Media.ContentType)
+		require.Equal(t, 640, mediaResponse.Media.Width)
+		require.Equal(t, 480, mediaResponse.Media.Height)
+		content := mustGetMedia(t, serverUrl, mediaResponse.Media.URL)
+		config, err := png.DecodeConfig(bytes.NewReader(content))
+		require.NoError(t, err)
+		require.Equal(t, 640, config.Width)
+		thumbnail := mustGetMedia(t, serverUrl, mediaResponse.Media.ThumbnailURL)
+		config, err = png.DecodeConfig(bytes.NewReader(thumbnail))
+		require.NoError(t, err)
+		require.Equal(t, 320, config.Width)
+		require.Equal(t, 240, config.Height)
+	})
+
+	t.Run("Should not upload files that are not images", func(t *testing.T) {
+		// Arrange
+		_, cookie := integrationtests.MustRegisterUser(t, profileManagerRequests.RegisterPayload{})
+		req := newUploadRequest(t, uploadMediaEndpoint, []byte("<svg xmlns=\"http://www.w3.org/2000/svg\"></svg>"))
+		req.AddCookie(cookie)
+
+		// Act
+		res, err := httpClient.Do(req)
+
+		// Assert
+		require.NoError(t, err)
+		defer res.Body.Close()
+		require.Equal(t, http.StatusUnsupportedMediaType, res.StatusCode)
+	})
+
+	t.Run("Should require authentication", func(t *testing.T) {
+		// Arrange
+		req := newUploadRequest(t, uploadMediaEndpoint, mustEncodePNG(t, 10, 10))
+
+		// Act
+		res, err := httpClient.Do(req)
+
+		// Assert
+		require.NoError(t, err)
+		defer res.Body.Close()
+		require.Equal(t, http.StatusUnauthorized, res.StatusCode)
+	})
+
+	t.Run("Should return HTTP 404 if media is not found", func(t *testing.T) {
+		// Act
+		res, err := httpClient.Get(fmt.Sprintf("%s/api/media/%s", serverUrl, "missing.png"))
+
+		// Assert
+		require.NoError(t, err)
+		defer res.Body.Close()
+		require.Equal(t, http.StatusNotFound, res.StatusCode)
+	})
+}
+
+func newUploadRequest(t *testing.T, endpoint string, content []byte) *http.Request {
+	body := new(bytes.Buffer)
+	writer := multipart.NewWriter(body)
+	part, err := writer.CreateFormFile(handlers.UploadFormField, "image")
+	require.NoError(t, err)
+	_, err = part.Write(content)
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+	req, err := http.NewRequest(http.MethodPost, endpoint, body)
+	require.NoError(t, err)
+	req.Header.Set(echo.HeaderContentType, writer.FormDataContentType())
+	return req
+}
+
+func mustEncodePNG(t *testing.T, width, height int) []byte {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for x := range width {
+		for y := range height {
+			img.Set(x, y, color.RGBA{uint8(x), uint8(y), 128, 255})
+		}
+	}
+	content := new(bytes.Buffer)
+	require.NoError(t, png.Encode(content, img))
+	return content.Bytes()
+}
+
+// mustGetMedia fetches media from the server under test, whatever public URL the media URL was built from.
+func mustGetMedia(t *testing.T, serverUrl, mediaURL string) []byte {
+	parsedURL, err := url.Parse(mediaURL)
+	require.NoError(t, err)
+	res, err := http.Get(serverUrl + parsedURL.Path)
+	require.NoError(t, err)
+	defer res.Body.Close()
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	require.Equal(t, "nosniff", res.Header.Get(echo.HeaderXContentTypeOptions))
+	content, err := io.ReadAll(res.Body)
+	require.NoError(t, err)
+	return content
+}
@@ -1,28 +1,43 @@
 package api
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
 
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 	"github.com/ravilock/goduit/api/validators"
+	articleArchives "github.com/ravilock/goduit/internal/articlePublisher/archives"
 	articleHandlers "github.com/ravilock/goduit/internal/articlePublisher/handlers"
 	articlePublishers "github.com/ravilock/goduit/internal/articlePublisher/publishers"
 	articleRepositories "github.com/ravilock/goduit/internal/articlePublisher/repositories"
 	articleServices "github.com/ravilock/goduit/internal/articlePublisher/services"
+	"github.com/ravilock/goduit/internal/articlePublisher/syndication"
+	articleViews "github.com/ravilock/goduit/internal/articlePublisher/workers/article-views"
+	"github.com/ravilock/goduit/internal/blobstore"
 	"github.com/ravilock/goduit/internal/cookie"
+	"github.com/ravilock/goduit/internal/etag"
 	followerHandlers "github.com/ravilock/goduit/internal/followerCentral/handlers"
 	followerRepositories "github.com/ravilock/goduit/internal/followerCentral/repositories"
 	followerServices "github.com/ravilock/goduit/internal/followerCentral/services"
 	"github.com/ravilock/goduit/internal/identity"
 	"github.com/ravilock/goduit/internal/log"
+	mediaHandlers "github.com/ravilock/goduit/internal/mediaLibrary/handlers"
+	mediaRepositories "github.com/ravilock/goduit/internal/mediaLibrary/repositories"
+	mediaServices "github.com/ravilock/goduit/internal/mediaLibrary/services"
 	"github.com/ravilock/goduit/internal/mongo"
 	profileHandlers "github.com/ravilock/goduit/internal/profileManager/handlers"
 	profileRepositories "github.com/ravilock/goduit/internal/profileManager/repositories"
 	profileServices "github.com/ravilock/goduit/internal/profileManager/services"
 	"github.com/ravilock/goduit/internal/queue"
+	"github.com/ravilock/goduit/internal/sitemap"
 	"github.com/spf13/viper"
 	mongoDriver "go.mongodb.org/mongo-driver/mongo"
 )
@@ -36,11 +51,35 @@ type server struct {
 	*echo.Echo
 	db    *mongoDriver.Client
 	queue queue.Connection
+	views *articleViews.ViewCounter
 }
 
+// Start serves requests until the process is interrupted, then flushes the article views still buffered.
 func (s *server) Start() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	viewsCtx, stopViews := context.WithCancel(context.Background())
+	viewsDone := make(chan struct{})
+	go func() {
+		s.views.Run(viewsCtx, viper.GetDuration("views.flush.interval"))
+		close(viewsDone)
+	}()
+
+	go func() {
+		<-ctx.Done()
+		if err := s.Shutdown(context.Background()); err != nil {
+			s.Logger.Error(err)
+		}
+	}()
+
 	addr := fmt.Sprintf(":%d", viper.GetInt("port"))
-	s.Logger.Fatal(s.Echo.Start(addr))
+	err := s.Echo.Start(addr)
+	stopViews()
+	<-viewsDone
+	if !errors.Is(err, http.ErrServerClosed) {
+		s.Logger.Fatal(err)
+	}
 }
 
 func NewServer() (Server, error) {
@@ -58,10 +97,18 @@ func NewServer() (Server, error) {
 		return nil, err
 	}
 
-	return createNewServer(databaseClient, queueConnection, serverLogger)
+	mediaStore, err := blobstore.Open(
+		blobstore.StoreType(viper.GetString("media.store.type")),
+		viper.GetString("media.store.url"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return createNewServer(databaseClient, queueConnection, mediaStore, serverLogger)
 }
 
-func createNewServer(databaseClient *mongoDriver.Client, queueConnection queue.Connection, _ *slog.Logger) (Server, error) {
+func createNewServer(databaseClient *mongoDriver.Client, queueConnection queue.Connection, mediaStore blobstore.Store, logger *slog.Logger) (Server, error) {
 	// TODO: Add logger to each controller
 	// Echo instance
 	e := echo.New()
@@ -80,20 +127,42 @@ func createNewServer(databaseClient *mongoDriver.Client, queueConnection queue.C
 	// repositories
 	userRepository := profileRepositories.NewUserRepository(databaseClient)
 	followerRepository := followerRepositories.NewFollowerRepository(databaseClient)
+	tagFollowerRepository := followerRepositories.NewTagFollowerRepository(databaseClient)
 	commentRepository := articleRepositories.NewCommentRepository(databaseClient)
 	articlePublisherRepository := articleRepositories.NewArticleRepository(databaseClient)
 	feedRepository := articleRepositories.NewFeedRepository(databaseClient)
+	favoriteRepository := articleRepositories.NewFavoriteRepository(databaseClient)
+	tagRepository := articleRepositories.NewTagRepository(databaseClient)
+	revisionRepository := articleRepositories.NewRevisionRepository(databaseClient)
+	slugRepository := articleRepositories.NewSlugRepository(databaseClient)
+	coAuthorInvitationRepository := articleRepositories.NewCoAuthorInvitationRepository(databaseClient)
+	seriesRepository := articleRepositories.NewSeriesRepository(databaseClient)
+	viewRepository := articleRepositories.NewViewRepository(databaseClient)
+	trendingRepository := articleRepositories.NewTrendingRepository(databaseClient)
+	mediaRepository := mediaRepositories.NewMediaRepository(databaseClient)
+
+	// view counter
+	viewCounter := articleViews.NewViewCounter(viewRepository, viper.GetDuration("views.dedup.window"), viper.GetInt("views.batch.size"), logger)
+	server.views = viewCounter
 
 	// profile services
 	registerProfileService := profileServices.NewRegisterProfileService(userRepository)
 	logUserService := profileServices.NewLogUserService(userRepository)
 	getProfileService := profileServices.NewGetProfileService(userRepository)
 	updateUserService := profileServices.NewUpdateUserService(userRepository)
+	sitemapProfilesService := profileServices.NewSitemapProfilesService(userRepository)
 
 	// follower services
 	followService := followerServices.NewFollowUserService(followerRepository)
 	isFollowedByService := followerServices.NewIsFollowedByService(followerRepository)
 	unfollowService := followerServices.NewUnfollowUserService(followerRepository)
+	followTagService := followerServices.NewFollowTagService(tagFollowerRepository, tagRepository)
+	unfollowTagService := followerServices.NewUnfollowTagService(tagFollowerRepository, tagRepository)
+	listFollowedTagsService := followerServices.NewListFollowedTagsService(tagFollowerRepository)
+
+	// media services
+	uploadMediaService := mediaServices.NewUploadMediaService(mediaRepository, mediaStore, viper.GetInt("media.max.dimension"), viper.GetInt("media.max.pixels"), viper.GetInt("media.thumbnail.size"))
+	getMediaService := mediaServices.NewGetMediaService(mediaStore)
 
 	// comment services
 	writeCommentService := articleServices.NewWriteCommentService(commentRepository)
@@ -102,12 +171,51 @@ func createNewServer(databaseClient *mongoDriver.Client, queueConnection queue.C
 	deleteCommentService := articleServices.NewDeleteCommentService(commentRepository)
 
 	// article services
-	writeArticleService := articleServices.NewWriteArticleService(articlePublisherRepository, articleQueuePublisher)
-	getArticleService := articleServices.NewGetArticleService(articlePublisherRepository)
-	listArticlesService := articleServices.NewListArticlesService(articlePublisherRepository)
+	writeArticleService := articleServices.NewWriteArticleService(articlePublisherRepository, slugRepository, revisionRepository, tagRepository, articleQueuePublisher)
+	getArticleService := articleServices.NewGetArticleService(articlePublisherRepository, slugRepository)
+	listArticlesService := articleServices.NewListArticlesService(articlePublisherRepository, tagRepository)
+	sitemapArticlesService := articleServices.NewSitemapArticlesService(articlePublisherRepository)
 	feedArticlesService := articleServices.NewFeedArticlesService(articlePublisherRepository, feedRepository)
-	updateArticleService := articleServices.NewUpdateArticleService(articlePublisherRepository)
-	unpublishArticlesService := articleServices.NewUnpublishArticleService(articlePublisherRepository)
+	searchArticlesService := articleServices.NewSearchArticlesService(articlePublisherRepository, tagRepository)
+	listRelatedArticlesService := articleServices.NewListRelatedArticlesService(articlePublisherRepository)
+	listTrendingArticlesService := articleServices.NewListTrendingArticlesService(articlePublisherRepository, trendingRepository, tagRepository)
+	listDraftsService := articleServices.NewListDraftsService(articlePublisherRepository)
+	publishArticleService := articleServices.NewPublishArticleService(articlePublisherRepository, tagRepository, articleQueuePublisher)
+	updateArticleService := articleServices.NewUpdateArticleService(articlePublisherRepository, slugRepository, revisionRepository, tagRepository)
+	unpublishArticlesService := articleServices.NewUnpublishArticleService(articlePublisherRepository, tagRepository)
+	listTrashService := articleServices.NewListTrashService(articlePublisherRepository)
+	getTrashedArticleService := articleServices.NewGetTrashedArticleService(articlePublisherRepository)
+	restoreArticleService := articleServices.NewRestoreArticleService(articlePublisherRepository, tagRepository)
+	exportArticlesService := articleServices.NewExportArticlesService(articlePublisherRepository)
+	// archive services
+	articleExporter := articleArchives.NewArticleExporter(exportArticlesService, viper.GetInt64("export.batch.size"))
+	articleImporter := articleArchives.NewArticleImporter(writeArticleService)
+	// co-author services
+	inviteCoAuthorService := articleServices.NewInviteCoAuthorService(coAuthorInvitationRepository)
+	acceptCoAuthorInvitationService := articleServices.NewAcceptCoAuthorInvitationService(coAuthorInvitationRepository, articlePublisherRepository, articleQueuePublisher)
+	declineCoAuthorInvitationService := articleServices.NewDeclineCoAuthorInvitationService(coAuthorInvitationRepository)
+	listCoAuthorInvitationsService := articleServices.NewListCoAuthorInvitationsService(coAuthorInvitationRepository)
+	// series services
+	writeSeriesService := articleServices.NewWriteSeriesService(seriesRepository)
+	getSeriesService := articleServices.NewGetSeriesService(seriesRepository)
+	listSeriesService := articleServices.NewListSeriesService(seriesRepository)
+	updateSeriesService := articleServices.NewUpdateSeriesService(seriesRepository)
+	deleteSeriesService := articleServices.NewDeleteSeriesService(seriesRepository)
+	getSeriesNavigationService := articleServices.NewGetSeriesNavigationService(seriesRepository, articlePublisherRepository)
+	// analytics services
+	getArticleAnalyticsService := articleServices.NewGetArticleAnalyticsService(viewRepository, favoriteRepository, commentRepository)
+	// revision services
+	listRevisionsService := articleServices.NewListRevisionsService(revisionRepository)
+	getRevisionService := articleServices.NewGetRevisionService(revisionRepository)
+	// favorite services
+	favoriteArticleService := articleServices.NewFavoriteArticleService(favoriteRepository, articlePublisherRepository)
+	unfavoriteArticleService := articleServices.NewUnfavoriteArticleService(favoriteRepository, articlePublisherRepository)
+	isFavoritedByService := articleServices.NewIsFavoritedByService(favoriteRepository)
+	// tag services
+	listTagsService := articleServices.NewListTagsService(tagRepository)
+	listTagAliasesService := articleServices.NewListTagAliasesService(tagRepository)
+	removeTagAliasService := articleServices.NewRemoveTagAliasService(tagRepository)
+	mergeTagsService := articleServices.NewMergeTagsService(articlePublisherRepository, tagRepository, tagFollowerRepository)
 
 	// cookie manager
 	cookieManager := cookie.NewCookieManager()
@@ -118,24 +226,76 @@ func createNewServer(databaseClient *mongoDriver.Client, queueConnection queue.C
 	getProfileHandler := profileHandlers.NewGetProfileHandler(getProfileService, isFollowedByService)
 	loginHandler := profileHandlers.NewLoginHandler(logUserService, updateUserService, cookieManager)
 	logoutHandler := profileHandlers.NewLogoutHandler(cookieManager)
-	updateProfileHandler := profileHandlers.NewUpdateProfileHandler(updateUserService, cookieManager)
+	updateProfileHandler := profileHandlers.NewUpdateProfileHandler(updateUserService, getProfileService, cookieManager)
 
 	// follower handlers
 	followUserHandler := followerHandlers.NewFollowUserHandler(followService, getProfileService)
 	unfollowUserHandler := followerHandlers.NewUnfollowUserHandler(unfollowService, getProfileService)
+	followTagHandler := followerHandlers.NewFollowTagHandler(followTagService)
+	unfollowTagHandler := followerHandlers.NewUnfollowTagHandler(unfollowTagService)
+	listFollowedTagsHandler := followerHandlers.NewListFollowedTagsHandler(listFollowedTagsService)
 
 	// article handlers
 	writeArticleHandler := articleHandlers.NewWriteArticleHandler(writeArticleService, getProfileService)
-	getArticleHandler := articleHandlers.NewGetArticleHandler(getArticleService, getProfileService, isFollowedByService)
-	listArticlesHandler := articleHandlers.NewListArticlesHandler(listArticlesService, getProfileService, isFollowedByService)
-	feedArticlesHandler := articleHandlers.NewFeedArticlesHandler(feedArticlesService, getProfileService)
+	getArticleHandler := articleHandlers.NewGetArticleHandler(getArticleService, getProfileService, isFollowedByService, isFavoritedByService, getSeriesNavigationService, viewCounter)
+	listArticlesHandler := articleHandlers.NewListArticlesHandler(listArticlesService, getProfileService, isFollowedByService, isFavoritedByService)
+	feedArticlesHandler := articleHandlers.NewFeedArticlesHandler(feedArticlesService, getProfileService, isFavoritedByService)
+	searchArticlesHandler := articleHandlers.NewSearchArticlesHandler(searchArticlesService, getProfileService, isFollowedByService, isFavoritedByService)
+	relatedArticlesHandler := articleHandlers.NewRelatedArticlesHandler(listRelatedArticlesService, getArticleService, getProfileService, isFollowedByService, isFavoritedByService)
+	trendingArticlesHandler := articleHandlers.NewTrendingArticlesHandler(listTrendingArticlesService, getProfileService, isFollowedByService, isFavoritedByService)
+	listDraftsHandler := articleHandlers.NewListDraftsHandler(listDraftsService, getProfileService)
+	publishArticleHandler := articleHandlers.NewPublishArticleHandler(publishArticleService, getArticleService, getProfileService)
 	updateArticleHandler := articleHandlers.NewUpdateArticleHandler(updateArticleService, getArticleService, getProfileService)
 	unpublishArticlesHandler := articleHandlers.NewUnpublishArticleHandler(unpublishArticlesService, getArticleService)
+	listTrashHandler := articleHandlers.NewListTrashHandler(listTrashService, getProfileService)
+	restoreArticleHandler := articleHandlers.NewRestoreArticleHandler(restoreArticleService, getTrashedArticleService, getProfileService)
+	exportArticlesHandler := articleHandlers.NewExportArticlesHandler(articleExporter)
+	importArticlesHandler := articleHandlers.NewImportArticlesHandler(articleImporter, viper.GetInt64("import.max.size"))
+
+	// co-author handlers
+	inviteCoAuthorHandler := articleHandlers.NewInviteCoAuthorHandler(inviteCoAuthorService, getArticleService, getProfileService)
+	acceptCoAuthorInvitationHandler := articleHandlers.NewAcceptCoAuthorInvitationHandler(acceptCoAuthorInvitationService, getArticleService, getProfileService)
+	declineCoAuthorInvitationHandler := articleHandlers.NewDeclineCoAuthorInvitationHandler(declineCoAuthorInvitationService, getArticleService)
+	listCoAuthorInvitationsHandler := articleHandlers.NewListCoAuthorInvitationsHandler(listCoAuthorInvitationsService, getArticleService, getProfileService)
+
+	// series handlers
+	writeSeriesHandler := articleHandlers.NewWriteSeriesHandler(writeSeriesService, getArticleService, getProfileService)
+	getSeriesHandler := articleHandlers.NewGetSeriesHandler(getSeriesService, getArticleService, getProfileService, isFollowedByService)
+	listSeriesHandler := articleHandlers.NewListSeriesHandler(listSeriesService, getProfileService, isFollowedByService)
+	updateSeriesHandler := articleHandlers.NewUpdateSeriesHandler(updateSeriesService, getSeriesService, getArticleService, getProfileService)
+	deleteSeriesHandler := articleHandlers.NewDeleteSeriesHandler(deleteSeriesService, getSeriesService)
+
+	// analytics handlers
+	articleAnalyticsHandler := articleHandlers.NewArticleAnalyticsHandler(getArticleAnalyticsService, listArticlesService)
+
+	// revision handlers
+	listRevisionsHandler := articleHandlers.NewListRevisionsHandler(listRevisionsService, getArticleService, getProfileService)
+	getRevisionHandler := articleHandlers.NewGetRevisionHandler(getRevisionService, getArticleService, getProfileService)
+	diffRevisionsHandler := articleHandlers.NewDiffRevisionsHandler(getRevisionService, getArticleService)
+	restoreRevisionHandler := articleHandlers.NewRestoreRevisionHandler(updateArticleService, getRevisionService, getArticleService, getProfileService)
 
 	// comment handlers
 	writeCommentHandler := articleHandlers.NewWriteCommentHandler(writeCommentService, getArticleService, getProfileService)
 	listCommentsHandler := articleHandlers.NewListCommentsHandler(listCommentsService, getArticleService, getProfileService, isFollowedByService)
 	deleteCommentHandler := articleHandlers.NewDeleteCommentHandler(deleteCommentService, getCommentService, getArticleService)
+	// favorite handlers
+	favoriteArticleHandler := articleHandlers.NewFavoriteArticleHandler(favoriteArticleService, getArticleService, getProfileService, isFollowedByService)
+	unfavoriteArticleHandler := articleHandlers.NewUnfavoriteArticleHandler(unfavoriteArticleService, getArticleService, getProfileService, isFollowedByService)
+	// tag handlers
+	listTagsHandler := articleHandlers.NewListTagsHandler(listTagsService)
+	listTagAliasesHandler := articleHandlers.NewListTagAliasesHandler(listTagAliasesService)
+	addTagAliasHandler := articleHandlers.NewAddTagAliasHandler(mergeTagsService, listTagAliasesService)
+	removeTagAliasHandler := articleHandlers.NewRemoveTagAliasHandler(removeTagAliasService)
+	mergeTagsHandler := articleHandlers.NewMergeTagsHandler(mergeTagsService, listTagAliasesService)
+	// syndication handlers
+	atomFeedHandler := articleHandlers.NewSyndicateArticlesHandler(listArticlesService, getProfileService, syndication.FormatAtom, viper.GetString("site.url"), viper.GetString("media.public.url"), viper.GetInt64("syndication.max.articles"))
+	rssFeedHandler := articleHandlers.NewSyndicateArticlesHandler(listArticlesService, getProfileService, syndication.FormatRSS, viper.GetString("site.url"), viper.GetString("media.public.url"), viper.GetInt64("syndication.max.articles"))
+	// search engine handlers
+	sitemapHandler := articleHandlers.NewSitemapHandler(sitemapArticlesService, sitemapProfilesService, viper.GetString("site.url"), viper.GetString("media.public.url"), sitemap.MaxURLs)
+	getArticleMetadataHandler := articleHandlers.NewGetArticleMetadataHandler(getArticleService, getProfileService, viper.GetString("site.url"))
+	// media handlers
+	uploadMediaHandler := mediaHandlers.NewUploadMediaHandler(uploadMediaService, viper.GetInt64("media.max.size"), viper.GetString("media.public.url"))
+	getMediaHandler := mediaHandlers.NewGetMediaHandler(getMediaService)
 
 	// Middleware
 	e.Use(middleware.RequestLogger())
@@ -143,7 +303,8 @@ func createNewServer(databaseClient *mongoDriver.Client, queueConnection queue.C
 	// TODO: make origins be loaded as configurations
 	e.Use(middleware.CORSWithConfig(middleware.CORSConfig{
 		AllowOrigins:     []string{"http://localhost:3000", "http://localhost:5173"},
-		AllowHeaders:     []string{echo.HeaderOrigin, echo.HeaderContentType, echo.HeaderAccept},
+		AllowHeaders:     []string{echo.HeaderOrigin, echo.HeaderContentType, echo.HeaderAccept, etag.HeaderIfMatch, etag.HeaderIfNoneMatch},
+		ExposeHeaders:    []string{etag.HeaderETag},
 		AllowCredentials: true,
 	}))
 
@@ -154,10 +315,12 @@ func createNewServer(databaseClient *mongoDriver.Client, queueConnection queue.C
 
 	optionalAuthMiddleware := identity.CreateAuthMiddleware(false)
 	requiredAuthMiddleware := identity.CreateAuthMiddleware(true)
+	adminMiddleware := identity.CreateAdminMiddleware(strings.Split(viper.GetString("admin.usernames"), ","))
 
 	// Routes
 	apiGroup := e.Group("/api")
 	apiGroup.GET("/healthcheck", healthcheck)
+	apiGroup.GET("/sitemap.xml", sitemapHandler.Sitemap)
 	// User Routes
 	usersGroup := apiGroup.Group("/users")
 	usersGroup.POST("", registerProfileHandler.Register)
@@ -171,17 +334,66 @@ func createNewServer(databaseClient *mongoDriver.Client, queueConnection queue.C
 	profileGroup.GET("/:username", getProfileHandler.GetProfile, optionalAuthMiddleware)
 	profileGroup.POST("/:username/followers", followUserHandler.Follow, requiredAuthMiddleware)
 	profileGroup.DELETE("/:username/followers", unfollowUserHandler.Unfollow, requiredAuthMiddleware)
+	profileGroup.GET("/:username/feed.atom", atomFeedHandler.AuthorFeed)
+	profileGroup.GET("/:username/feed.rss", rssFeedHandler.AuthorFeed)
 	// Article Routes
 	articlesGroup := apiGroup.Group("/articles")
 	articlesGroup.POST("", writeArticleHandler.WriteArticle, requiredAuthMiddleware)
 	articlesGroup.GET("", listArticlesHandler.ListArticles, optionalAuthMiddleware)
 	articlesGroup.GET("/feed", feedArticlesHandler.FeedArticles, requiredAuthMiddleware)
+	articlesGroup.GET("/feed.atom", atomFeedHandler.GlobalFeed)
+	articlesGroup.GET("/feed.rss", rssFeedHandler.GlobalFeed)
+	articlesGroup.GET("/search", searchArticlesHandler.SearchArticles, optionalAuthMiddleware)
+	articlesGroup.GET("/trending", trendingArticlesHandler.ListTrendingArticles, optionalAuthMiddleware)
+	articlesGroup.GET("/drafts", listDraftsHandler.ListDrafts, requiredAuthMiddleware)
+	articlesGroup.GET("/trash", listTrashHandler.ListTrash, requiredAuthMiddleware)
+	articlesGroup.GET("/co-author-invitations", listCoAuthorInvitationsHandler.ListInvitations, requiredAuthMiddleware)
+	articlesGroup.GET("/analytics", articleAnalyticsHandler.GetAnalytics, requiredAuthMiddleware)
+	articlesGroup.GET("/export", exportArticlesHandler.ExportArticles, requiredAuthMiddleware)
+	articlesGroup.POST("/import", importArticlesHandler.ImportArticles, requiredAuthMiddleware)
 	articlesGroup.GET("/:slug", getArticleHandler.GetArticle, optionalAuthMiddleware)
 	articlesGroup.DELETE("/:slug", unpublishArticlesHandler.UnpublishArticle, requiredAuthMiddleware)
 	articlesGroup.PUT("/:slug", updateArticleHandler.UpdateArticle, requiredAuthMiddleware)
+	articlesGroup.PATCH("/:slug", updateArticleHandler.PatchArticle, requiredAuthMiddleware)
+	articlesGroup.GET("/:slug/related", relatedArticlesHandler.ListRelatedArticles, optionalAuthMiddleware)
+	articlesGroup.GET("/:slug/metadata", getArticleMetadataHandler.GetArticleMetadata)
+	articlesGroup.POST("/:slug/publish", publishArticleHandler.PublishArticle, requiredAuthMiddleware)
+	articlesGroup.POST("/:slug/restore", restoreArticleHandler.RestoreArticle, requiredAuthMiddleware)
+	articlesGroup.POST("/:slug/co-authors", inviteCoAuthorHandler.InviteCoAuthor, requiredAuthMiddleware)
+	articlesGroup.POST("/:slug/co-authors/accept", acceptCoAuthorInvitationHandler.AcceptInvitation, requiredAuthMiddleware)
+	articlesGroup.POST("/:slug/co-authors/decline", declineCoAuthorInvitationHandler.DeclineInvitation, requiredAuthMiddleware)
+	articlesGroup.GET("/:slug/revisions", listRevisionsHandler.ListRevisions, requiredAuthMiddleware)
+	articlesGroup.GET("/:slug/revisions/diff", diffRevisionsHandler.DiffRevisions, requiredAuthMiddleware)
+	articlesGroup.GET("/:slug/revisions/:revision", getRevisionHandler.GetRevision, requiredAuthMiddleware)
+	articlesGroup.POST("/:slug/revisions/:revision/restore", restoreRevisionHandler.RestoreRevision, requiredAuthMiddleware)
 	articlesGroup.POST("/:slug/comments", writeCommentHandler.WriteComment, requiredAuthMiddleware)
 	articlesGroup.GET("/:slug/comments", listCommentsHandler.ListComments, optionalAuthMiddleware)
 	articlesGroup.DELETE("/:slug/comments/:id", deleteCommentHandler.DeleteComment, requiredAuthMiddleware)
+	articlesGroup.POST("/:slug/favorite", favoriteArticleHandler.FavoriteArticle, requiredAuthMiddleware)
+	articlesGroup.DELETE("/:slug/favorite", unfavoriteArticleHandler.UnfavoriteArticle, requiredAuthMiddleware)
+	// Series Routes
+	seriesGroup := apiGroup.Group("/series")
+	seriesGroup.POST("", writeSeriesHandler.WriteSeries, requiredAuthMiddleware)
+	seriesGroup.GET("", listSeriesHandler.ListSeries, optionalAuthMiddleware)
+	seriesGroup.GET("/:slug", getSeriesHandler.GetSeries, optionalAuthMiddleware)
+	seriesGroup.PUT("/:slug", updateSeriesHandler.UpdateSeries, requiredAuthMiddleware)
+	seriesGroup.DELETE("/:slug", deleteSeriesHandler.DeleteSeries, requiredAuthMiddleware)
+	// Tag Routes
+	tagsGroup := apiGroup.Group("/tags")
+	tagsGroup.GET("", listTagsHandler.ListTags)
+	tagsGroup.GET("/followed", listFollowedTagsHandler.ListFollowedTags, requiredAuthMiddleware)
+	tagsGroup.GET("/:tag/feed.atom", atomFeedHandler.TagFeed)
+	tagsGroup.GET("/:tag/feed.rss", rssFeedHandler.TagFeed)
+	tagsGroup.POST("/:tag/followers", followTagHandler.FollowTag, requiredAuthMiddleware)
+	tagsGroup.DELETE("/:tag/followers", unfollowTagHandler.UnfollowTag, requiredAuthMiddleware)
+	tagsGroup.GET("/:tag/aliases", listTagAliasesHandler.ListTagAliases)
+	tagsGroup.POST("/:tag/aliases", addTagAliasHandler.AddTagAlias, requiredAuthMiddleware, adminMiddleware)
+	tagsGroup.DELETE("/:tag/aliases/:alias", removeTagAliasHandler.RemoveTagAlias, requiredAuthMiddleware, adminMiddleware)
+	tagsGroup.POST("/:tag/merge", mergeTagsHandler.MergeTags, requiredAuthMiddleware, adminMiddleware)
+
+	mediaGroup := apiGroup.Group("/media")
+	mediaGroup.POST("", uploadMediaHandler.UploadMedia, requiredAuthMiddleware)
+	mediaGroup.GET("/:name", getMediaHandler.GetMedia)
 	return server, nil
 }
 
@@ -11,8 +11,17 @@ const (
 	ArticleNotFoundErrorCode
 	CommentNotFoundErrorCode
 	FeedNotFoundErrorCode
+	FavoriteNotFoundErrorCode
+	ArticleAlreadyPublishedErrorCode
+	RevisionNotFoundErrorCode
+	CoAuthorInvitationNotFoundErrorCode
+	SeriesNotFoundErrorCode
 	WrongPasswordErrorCode
 	ConflictErrorCode
+	TagAliasNotFoundErrorCode
+	VersionMismatchErrorCode
+	MediaNotFoundErrorCode
+	UnsupportedMediaErrorCode
 )
 
 type AppError struct {
@@ -62,6 +71,78 @@ func FeedNotFoundError(identifier string, originalError error) *AppError {
 	}
 }
 
+func FavoriteNotFoundError(article, user string, originalError error) *AppError {
+	return &AppError{
+		ErrorCode:     FavoriteNotFoundErrorCode,
+		CustomMessage: fmt.Sprintf("User %q has not favorited article %q", user, article),
+		OriginalError: originalError,
+	}
+}
+
+func ArticleAlreadyPublishedError(identifier string) *AppError {
+	return &AppError{
+		ErrorCode:     ArticleAlreadyPublishedErrorCode,
+		CustomMessage: fmt.Sprintf("Article with identifier %q is already published", identifier),
+		OriginalError: nil,
+	}
+}
+
+func RevisionNotFoundError(article, number string, originalError error) *AppError {
+	return &AppError{
+		ErrorCode:     RevisionNotFoundErrorCode,
+		CustomMessage: fmt.Sprintf("Revision %s of article %q was not found", number, article),
+		OriginalError: originalError,
+	}
+}
+
+func CoAuthorInvitationNotFoundError(article, invitee string, originalError error) *AppError {
+	return &AppError{
+		ErrorCode:     CoAuthorInvitationNotFoundErrorCode,
+		CustomMessage: fmt.Sprintf("User %q was not invited to co-author article %q", invitee, article),
+		OriginalError: originalError,
+	}
+}
+
+func SeriesNotFoundError(identifier string, originalError error) *AppError {
+	return &AppError{
+		ErrorCode:     SeriesNotFoundErrorCode,
+		CustomMessage: fmt.Sprintf("Series with identifier %q was not found", identifier),
+		OriginalError: originalError,
+	}
+}
+
+func TagAliasNotFoundError(identifier string, originalError error) *AppError {
+	return &AppError{
+		ErrorCode:     TagAliasNotFoundErrorCode,
+		CustomMessage: fmt.Sprintf("Tag alias with identifier %q was not found", identifier),
+		OriginalError: originalError,
+	}
+}
+
+func VersionMismatchError(identifier string, version int64) *AppError {
+	return &AppError{
+		ErrorCode:     VersionMismatchErrorCode,
+		CustomMessage: fmt.Sprintf("Resource with identifier %q is no longer at version %d", identifier, version),
+		OriginalError: nil,
+	}
+}
+
+func MediaNotFoundError(identifier string, originalError error) *AppError {
+	return &AppError{
+		ErrorCode:     MediaNotFoundErrorCode,
+		CustomMessage: fmt.Sprintf("Media with identifier %q was not found", identifier),
+		OriginalError: originalError,
+	}
+}
+
+func UnsupportedMediaError(originalError error) *AppError {
+	return &AppError{
+		ErrorCode:     UnsupportedMediaErrorCode,
+		CustomMessage: "Media is not an image in a supported format",
+		OriginalError: originalError,
+	}
+}
+
 func ConflictError(resource string) *AppError {
 	return &AppError{
 		ErrorCode:     ConflictErrorCode,
@@ -0,0 +1,194 @@
+package archives
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"time"
+)
+
+const (
+	FormatZip = "zip"
+	FormatTar = "tar"
+)
+
+// MaxFileSize bounds the uncompressed size of each file read from an archive, so a small archive cannot expand into gigabytes.
+const MaxFileSize = 4 << 20
+
+// MaxContentSize bounds the uncompressed size of all the files read from an archive together.
+const MaxContentSize = 64 << 20
+
+// MaxEntries bounds the number of entries of an archive, files and directories alike.
+const MaxEntries = 1000
+
+// ErrInvalidArchive is returned when an archive cannot be read, whether it is corrupt or neither a zip nor a tar archive.
+var ErrInvalidArchive = errors.New("invalid archive")
+
+var zipSignature = []byte("PK\x03\x04")
+
+// ContentType is the media type archives of a format are served with.
+func ContentType(format string) string {
+	if format == FormatTar {
+		return "application/x-tar"
+	}
+	return "application/zip"
+}
+
+// archiveWriter adds files to an archive being written.
+type archiveWriter interface {
+	WriteFile(name string, modTime time.Time, data []byte) error
+	Close() error
+}
+
+func newArchiveWriter(w io.Writer, format string) (archiveWriter, error) {
+	switch format {
+	case FormatZip:
+		return &zipWriter{zip.NewWriter(w)}, nil
+	case FormatTar:
+		return &tarWriter{tar.NewWriter(w)}, nil
+	default:
+		return nil, fmt.Errorf("unsupported archive format %q", format)
+	}
+}
+
+type zipWriter struct {
+	writer *zip.Writer
+}
+
+func (w *zipWriter) WriteFile(name string, modTime time.Time, data []byte) error {
+	file, err := w.writer.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Deflate, Modified: modTime})
+	if err != nil {
+		return err
+	}
+	_, err = file.Write(data)
+	return err
+}
+
+func (w *zipWriter) Close() error {
+	return w.writer.Close()
+}
+
+type tarWriter struct {
+	writer *tar.Writer
+}
+
+func (w *tarWriter) WriteFile(name string, modTime time.Time, data []byte) error {
+	header := &tar.Header{Name: name, Mode: 0o644, Size: int64(len(data)), ModTime: modTime, Typeflag: tar.TypeReg}
+	if err := w.writer.WriteHeader(header); err != nil {
+		return err
+	}
+	_, err := w.writer.Write(data)
+	return err
+}
+
+func (w *tarWriter) Close() error {
+	return w.writer.Close()
+}
+
+// readMarkdownFiles calls read with the name and content of every Markdown file of a zip or tar archive, in archive order.
+// Directories, other files and hidden files, such as the metadata archivers leave behind, are skipped.
+//
+// A file that cannot be read, such as one larger than MaxFileSize, is passed to read with the error instead of its content.
+// Errors reading the archive itself, or archives with more than MaxEntries entries or MaxContentSize bytes of Markdown,
+// wrap ErrInvalidArchive, while errors returned by read are returned as is.
+func readMarkdownFiles(archive []byte, read func(name string, data []byte, err error) error) error {
+	if bytes.HasPrefix(archive, zipSignature) {
+		return readZipFiles(archive, read)
+	}
+	return readTarFiles(archive, read)
+}
+
+func readZipFiles(archive []byte, read func(name string, data []byte, err error) error) error {
+	reader, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	if err != nil {
+		return invalidArchive(err)
+	}
+	if len(reader.File) > MaxEntries {
+		return invalidArchive(fmt.Errorf("archive has more than %d entries", MaxEntries))
+	}
+	contentSize := 0
+	for _, file := range reader.File {
+		if file.FileInfo().IsDir() || !isMarkdownFile(file.Name) {
+			continue
+		}
+		data, err := readZipFile(file)
+		contentSize += len(data)
+		if contentSize > MaxContentSize {
+			return invalidArchive(fmt.Errorf("archive content is larger than %d bytes", MaxContentSize))
+		}
+		if err := read(file.Name, data, err); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func readZipFile(file *zip.File) ([]byte, error) {
+	content, err := file.Open()
+	if err != nil {
+		return nil, fmt.Errorf("could not open file %q: %w", file.Name, err)
+	}
+	defer content.Close()
+	return readFile(file.Name, content)
+}
+
+func readTarFiles(archive []byte, read func(name string, data []byte, err error) error) error {
+	reader := tar.NewReader(bytes.NewReader(archive))
+	entries := 0
+	contentSize := 0
+	for {
+		header, err := reader.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return invalidArchive(err)
+		}
+		entries++
+		if entries > MaxEntries {
+			return invalidArchive(fmt.Errorf("archive has more than %d entries", MaxEntries))
+		}
+		if header.Typeflag != tar.TypeReg || !isMarkdownFile(header.Name) {
+			continue
+		}
+		data, err := readFile(header.Name, reader)
+		contentSize += len(data)
+		if contentSize > MaxContentSize {
+			return invalidArchive(fmt.Errorf("archive content is larger than %d bytes", MaxContentSize))
+		}
+		if err := read(header.Name, data, err); err != nil {
+			return err
+		}
+	}
+}
+
+// readFile reads a file of an archive, failing with a nil content if it is larger than MaxFileSize.
+func readFile(name string, content io.Reader) ([]byte, error) {
+	data, err := io.ReadAll(io.LimitReader(content, MaxFileSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("could not read file %q: %w", name, err)
+	}
+	if len(data) > MaxFileSize {
+		return nil, fmt.Errorf("file %q is larger than %d bytes", name, MaxFileSize)
+	}
+	return data, nil
+}
+
+func invalidArchive(err error) error {
+	return fmt.Errorf("%w: %w", ErrInvalidArchive, err)
+}
+
+func isMarkdownFile(name string) bool {
+	for _, segment := range strings.Split(path.Clean(name), "/") {
+		if strings.HasPrefix(segment, ".") || strings.HasPrefix(segment, "__") {
+			return false
+		}
+	}
+	extension := strings.ToLower(path.Ext(name))
+	return extension == ".md" || extension == ".markdown"
+}
@@ -0,0 +1,100 @@
+package archives
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestArchive(t *testing.T) {
+	for _, format := range []string{FormatZip, FormatTar} {
+		t.Run("Should read back the Markdown files of a "+format+" archive", func(t *testing.T) {
+			// Arrange
+			archive := new(bytes.Buffer)
+			writer, err := newArchiveWriter(archive, format)
+			require.NoError(t, err)
+			modTime := time.Date(2024, time.March, 1, 10, 30, 0, 0, time.UTC)
+			require.NoError(t, writer.WriteFile("first.md", modTime, []byte("first")))
+			require.NoError(t, writer.WriteFile("posts/second.markdown", modTime, []byte("second")))
+			require.NoError(t, writer.WriteFile("notes.txt", modTime, []byte("notes")))
+			require.NoError(t, writer.WriteFile(".hidden.md", modTime, []byte("hidden")))
+			require.NoError(t, writer.WriteFile("__MACOSX/._first.md", modTime, []byte("metadata")))
+			require.NoError(t, writer.Close())
+
+			// Act
+			files := map[string]string{}
+			err = readMarkdownFiles(archive.Bytes(), func(name string, data []byte, err error) error {
+				require.NoError(t, err)
+				files[name] = string(data)
+				return nil
+			})
+
+			// Assert
+			require.NoError(t, err)
+			require.Equal(t, map[string]string{"first.md": "first", "posts/second.markdown": "second"}, files)
+		})
+	}
+
+	t.Run("Should not write archives of unsupported formats", func(t *testing.T) {
+		_, err := newArchiveWriter(new(bytes.Buffer), "rar")
+		require.ErrorContains(t, err, "unsupported archive format")
+	})
+
+	t.Run("Should fail to read something that is not an archive", func(t *testing.T) {
+		err := readMarkdownFiles([]byte("definitely not an archive, neither zip nor tar, just some text that is long enough"), func(name string, data []byte, err error) error {
+			return nil
+		})
+		require.ErrorIs(t, err, ErrInvalidArchive)
+	})
+
+	t.Run("Should report files larger than the limit and keep reading the others", func(t *testing.T) {
+		// Arrange
+		archive := new(bytes.Buffer)
+		writer, err := newArchiveWriter(archive, FormatZip)
+		require.NoError(t, err)
+		require.NoError(t, writer.WriteFile("large.md", time.Now(), bytes.Repeat([]byte("a"), MaxFileSize+1)))
+		require.NoError(t, writer.WriteFile("small.md", time.Now(), []byte("small")))
+		require.NoError(t, writer.Close())
+
+		// Act
+		files := map[string]string{}
+		fileErrors := map[string]error{}
+		err = readMarkdownFiles(archive.Bytes(), func(name string, data []byte, err error) error {
+			files[name] = string(data)
+			fileErrors[name] = err
+			return nil
+		})
+
+		// Assert
+		require.NoError(t, err)
+		require.ErrorContains(t, fileErrors["large.md"], "large.md")
+		require.Empty(t, files["large.md"])
+		require.NoError(t, fileErrors["small.md"])
+		require.Equal(t, "small", files["small.md"])
+	})
+
+	for _, format := range []string{FormatZip, FormatTar} {
+		t.Run("Should fail to read "+format+" archives with too many entries", func(t *testing.T) {
+			// Arrange
+			archive := new(bytes.Buffer)
+			writer, err := newArchiveWriter(archive, format)
+			require.NoError(t, err)
+			for i := range MaxEntries + 1 {
+				require.NoError(t, writer.WriteFile(fmt.Sprintf("%d.txt", i), time.Now(), nil))
+			}
+			require.NoError(t, writer.Close())
+
+			// Act
+			err = readMarkdownFiles(archive.Bytes(), func(name string, data []byte, err error) error {
+				return nil
+			})
+
+			// Assert
+			require.ErrorIs(t, err, ErrInvalidArchive)
+			require.ErrorContains(t, err, "entries")
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package archives
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/ravilock/goduit/internal/articlePublisher/assemblers"
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+)
+
+type articlesExporter interface {
+	ExportArticles(ctx context.Context, author string, batchSize int64, export func(article *models.Article) error) (int, error)
+}
+
+// ArticleExporter writes an author's articles to a zip or tar archive, one Markdown file with YAML front matter per article.
+type ArticleExporter struct {
+	service   articlesExporter
+	batchSize int64
+}
+
+func NewArticleExporter(service articlesExporter, batchSize int64) *ArticleExporter {
+	return &ArticleExporter{
+		service:   service,
+		batchSize: batchSize,
+	}
+}
+
+// Export writes the archive of an author's articles to w. Returns how many articles were exported.
+func (e *ArticleExporter) Export(ctx context.Context, author, format string, w io.Writer) (int, error) {
+	archive, err := newArchiveWriter(w, format)
+	if err != nil {
+		return 0, err
+	}
+	exported, err := e.service.ExportArticles(ctx, author, e.batchSize, func(article *models.Article) error {
+		name, data, err := assemblers.ArticleFile(article)
+		if err != nil {
+			return err
+		}
+		return archive.WriteFile(name, articleModTime(article), data)
+	})
+	if err != nil {
+		return exported, err
+	}
+	return exported, archive.Close()
+}
+
+func articleModTime(article *models.Article) time.Time {
+	if article.UpdatedAt != nil {
+		return *article.UpdatedAt
+	}
+	if article.CreatedAt != nil {
+		return *article.CreatedAt
+	}
+	return time.Now()
+}
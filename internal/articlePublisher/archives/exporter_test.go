@@ -0,0 +1,91 @@
+package archives
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+	"github.com/ravilock/goduit/internal/articlePublisher/requests"
+	mock "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArticleExporter(t *testing.T) {
+	articlesExporterMock := newMockArticlesExporter(t)
+	exporter := NewArticleExporter(articlesExporterMock, 100)
+	ctx := context.Background()
+	author := "author-id"
+
+	for _, format := range []string{FormatZip, FormatTar} {
+		t.Run("Should write a Markdown file per article to a "+format+" archive", func(t *testing.T) {
+			// Arrange
+			articles := []*models.Article{assembleExportedArticle("first-article", models.ArticleStatusPublished), assembleExportedArticle("second-article", models.ArticleStatusDraft)}
+			articlesExporterMock.EXPECT().ExportArticles(ctx, author, int64(100), mock.Anything).RunAndReturn(func(_ context.Context, _ string, _ int64, export func(*models.Article) error) (int, error) {
+				for _, article := range articles {
+					if err := export(article); err != nil {
+						return 0, err
+					}
+				}
+				return len(articles), nil
+			}).Once()
+			archive := new(bytes.Buffer)
+
+			// Act
+			exported, err := exporter.Export(ctx, author, format, archive)
+
+			// Assert
+			require.NoError(t, err)
+			require.Equal(t, 2, exported)
+			files := map[string]*requests.ImportArticleRequest{}
+			err = readMarkdownFiles(archive.Bytes(), func(name string, data []byte, err error) error {
+				if err != nil {
+					return err
+				}
+				request, err := requests.ParseImportArticleRequest(data)
+				files[name] = request
+				return err
+			})
+			require.NoError(t, err)
+			require.Len(t, files, 2)
+			first := files["first-article.md"]
+			require.Equal(t, "first-article title", first.Title)
+			require.Equal(t, "first-article description", first.Description)
+			require.Equal(t, "first-article body", first.Body)
+			require.Equal(t, []string{"golang", "testing"}, first.TagList)
+			require.Equal(t, models.ArticleStatusPublished, first.Status)
+			require.Equal(t, *articles[0].CreatedAt, first.CreatedAt.UTC())
+			require.Equal(t, models.ArticleStatusDraft, files["second-article.md"].Status)
+		})
+	}
+
+	t.Run("Should fail if articles cannot be listed", func(t *testing.T) {
+		// Arrange
+		exportError := errors.New("database is down")
+		articlesExporterMock.EXPECT().ExportArticles(ctx, author, int64(100), mock.Anything).Return(0, exportError).Once()
+
+		// Act
+		_, err := exporter.Export(ctx, author, FormatZip, new(bytes.Buffer))
+
+		// Assert
+		require.ErrorIs(t, err, exportError)
+	})
+}
+
+func assembleExportedArticle(slug, status string) *models.Article {
+	title := slug + " title"
+	description := slug + " description"
+	body := slug + " body"
+	createdAt := time.Date(2024, time.March, 1, 10, 30, 0, 0, time.UTC)
+	return &models.Article{
+		Slug:        &slug,
+		Title:       &title,
+		Description: &description,
+		Body:        &body,
+		TagList:     []string{"golang", "testing"},
+		Status:      &status,
+		CreatedAt:   &createdAt,
+	}
+}
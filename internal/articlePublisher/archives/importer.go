@@ -0,0 +1,87 @@
+package archives
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ravilock/goduit/api"
+	"github.com/ravilock/goduit/internal/app"
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+	"github.com/ravilock/goduit/internal/articlePublisher/requests"
+)
+
+type articleWriter interface {
+	WriteArticle(ctx context.Context, article *models.Article) error
+}
+
+// ImportedFile is the outcome of importing a file of an archive.
+// Err holds why the file was rejected; otherwise Article is the article it became, which is only written outside of dry runs.
+type ImportedFile struct {
+	Name    string
+	Article *models.Article
+	Err     error
+}
+
+// ArticleImporter writes the articles of a zip or tar archive of Markdown files with YAML front matter, as exports produce them.
+type ArticleImporter struct {
+	service articleWriter
+}
+
+func NewArticleImporter(service articleWriter) *ArticleImporter {
+	return &ArticleImporter{
+		service: service,
+	}
+}
+
+// Import writes every valid article of an archive on behalf of author, reporting each Markdown file it found.
+// Files are validated like written articles; a rejected file, including one that cannot be read, does not keep the
+// others from being imported. On dry runs files are only validated, nothing is written.
+//
+// The whole archive is read and validated before any article is written, so archives that cannot be read fail with
+// an error wrapping ErrInvalidArchive without importing anything.
+func (i *ArticleImporter) Import(ctx context.Context, author string, archive []byte, dryRun bool) ([]*ImportedFile, error) {
+	files := []*ImportedFile{}
+	err := readMarkdownFiles(archive, func(name string, data []byte, err error) error {
+		file := &ImportedFile{Name: name}
+		files = append(files, file)
+		if err != nil {
+			file.Err = err
+			return nil
+		}
+		request, err := requests.ParseImportArticleRequest(data)
+		if err != nil {
+			file.Err = err
+			return nil
+		}
+		if err := request.Validate(); err != nil {
+			file.Err = err
+			return nil
+		}
+		file.Article = request.Model(author)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if dryRun {
+		return files, nil
+	}
+
+	for _, file := range files {
+		if file.Err != nil {
+			continue
+		}
+		if err := i.service.WriteArticle(ctx, file.Article); err != nil {
+			if appError := new(app.AppError); errors.As(err, &appError) {
+				switch appError.ErrorCode {
+				case app.ConflictErrorCode:
+					file.Article = nil
+					file.Err = api.ConfictError
+					continue
+				}
+			}
+			return files, err
+		}
+	}
+	return files, nil
+}
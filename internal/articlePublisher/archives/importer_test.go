@@ -0,0 +1,166 @@
+package archives
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ravilock/goduit/api"
+	"github.com/ravilock/goduit/api/validators"
+	"github.com/ravilock/goduit/internal/app"
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+	mock "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const validArticleFile = "---\ntitle: Imported Title\ndescription: Imported Description\ntags: [Golang]\ncreatedAt: 2024-03-01T10:30:00Z\n---\n\nImported Body\n"
+
+func TestArticleImporter(t *testing.T) {
+	err := validators.InitValidator()
+	require.NoError(t, err)
+	articleWriterMock := newMockArticleWriter(t)
+	importer := NewArticleImporter(articleWriterMock)
+	ctx := context.Background()
+	author := "author-id"
+
+	t.Run("Should write every valid article and report the rejected files", func(t *testing.T) {
+		// Arrange
+		archive := mustWriteArchive(t, map[string]string{
+			"valid.md":     validArticleFile,
+			"untitled.md":  "---\ndescription: Imported Description\ntags: [golang]\n---\n\nImported Body\n",
+			"plain.md":     "Imported Body",
+			"notes.txt":    "Not an article",
+			"conflict.md":  validArticleFile,
+			"scheduled.md": "---\ntitle: Imported Title\ndescription: Imported Description\ntags: [golang]\nstatus: scheduled\n---\n\nImported Body\n",
+		})
+		articleWriterMock.EXPECT().WriteArticle(ctx, mock.AnythingOfType("*models.Article")).RunAndReturn(func(_ context.Context, article *models.Article) error {
+			slug := "imported-title"
+			article.Slug = &slug
+			return nil
+		}).Once()
+		articleWriterMock.EXPECT().WriteArticle(ctx, mock.AnythingOfType("*models.Article")).Return(app.ConflictError("articles")).Once()
+
+		// Act
+		files, err := importer.Import(ctx, author, archive, false)
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, files, 5)
+		filesByName := importedFilesByName(files)
+		written := filesByName["valid.md"]
+		if written.Err != nil {
+			written = filesByName["conflict.md"]
+		}
+		require.NoError(t, written.Err)
+		require.Equal(t, author, *written.Article.Author)
+		require.Equal(t, "Imported Title", *written.Article.Title)
+		require.Equal(t, []string{"golang"}, written.Article.TagList)
+		require.Equal(t, time.Date(2024, time.March, 1, 10, 30, 0, 0, time.UTC), *written.Article.CreatedAt)
+		require.ErrorContains(t, filesByName["untitled.md"].Err, api.RequiredFieldError("Title").Error())
+		require.ErrorContains(t, filesByName["plain.md"].Err, "Could not read front matter")
+		require.ErrorContains(t, filesByName["scheduled.md"].Err, api.RequiredFieldError("PublishAt").Error())
+		require.NotContains(t, filesByName, "notes.txt")
+		conflicts := 0
+		for _, file := range files {
+			if errors.Is(file.Err, api.ConfictError) {
+				conflicts++
+			}
+		}
+		require.Equal(t, 1, conflicts)
+	})
+
+	t.Run("Should only validate files on dry runs", func(t *testing.T) {
+		// Arrange
+		archive := mustWriteArchive(t, map[string]string{"valid.md": validArticleFile})
+
+		// Act
+		files, err := importer.Import(ctx, author, archive, true)
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, files, 1)
+		require.NoError(t, files[0].Err)
+		require.Equal(t, "imported-title", *files[0].Article.Slug)
+	})
+
+	t.Run("Should stop importing if an article cannot be written", func(t *testing.T) {
+		// Arrange
+		archive := mustWriteArchive(t, map[string]string{"valid.md": validArticleFile})
+		writeError := errors.New("database is down")
+		articleWriterMock.EXPECT().WriteArticle(ctx, mock.AnythingOfType("*models.Article")).Return(writeError).Once()
+
+		// Act
+		_, err := importer.Import(ctx, author, archive, false)
+
+		// Assert
+		require.ErrorIs(t, err, writeError)
+	})
+
+	t.Run("Should report files that cannot be read and import the others", func(t *testing.T) {
+		// Arrange
+		archive := mustWriteArchive(t, map[string]string{
+			"valid.md": validArticleFile,
+			"large.md": strings.Repeat("a", MaxFileSize+1),
+		})
+		articleWriterMock.EXPECT().WriteArticle(ctx, mock.AnythingOfType("*models.Article")).Return(nil).Once()
+
+		// Act
+		files, err := importer.Import(ctx, author, archive, false)
+
+		// Assert
+		require.NoError(t, err)
+		filesByName := importedFilesByName(files)
+		require.NoError(t, filesByName["valid.md"].Err)
+		require.ErrorContains(t, filesByName["large.md"].Err, "larger than")
+	})
+
+	t.Run("Should not write anything if the archive is corrupt past its first files", func(t *testing.T) {
+		// Arrange
+		archive := new(bytes.Buffer)
+		writer, err := newArchiveWriter(archive, FormatTar)
+		require.NoError(t, err)
+		require.NoError(t, writer.WriteFile("valid.md", time.Now(), []byte(validArticleFile)))
+		require.NoError(t, writer.WriteFile("other.md", time.Now(), []byte(validArticleFile)))
+		require.NoError(t, writer.Close())
+		validFileSize := 512 + (len(validArticleFile)+511)/512*512
+		truncated := archive.Bytes()[:validFileSize+100]
+
+		// Act
+		files, err := importer.Import(ctx, author, truncated, false)
+
+		// Assert
+		require.ErrorIs(t, err, ErrInvalidArchive)
+		require.Empty(t, files)
+	})
+
+	t.Run("Should fail if the archive cannot be read", func(t *testing.T) {
+		// Act
+		_, err := importer.Import(ctx, author, []byte("PK\x03\x04 but not really a zip archive"), false)
+
+		// Assert
+		require.ErrorIs(t, err, ErrInvalidArchive)
+	})
+}
+
+func mustWriteArchive(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+	archive := new(bytes.Buffer)
+	writer, err := newArchiveWriter(archive, FormatZip)
+	require.NoError(t, err)
+	for name, content := range files {
+		require.NoError(t, writer.WriteFile(name, time.Now(), []byte(content)))
+	}
+	require.NoError(t, writer.Close())
+	return archive.Bytes()
+}
+
+func importedFilesByName(files []*ImportedFile) map[string]*ImportedFile {
+	filesByName := make(map[string]*ImportedFile, len(files))
+	for _, file := range files {
+		filesByName[file.Name] = file
+	}
+	return filesByName
+}
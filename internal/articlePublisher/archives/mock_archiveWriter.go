@@ -0,0 +1,129 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package archives
+
+import (
+	time "time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockArchiveWriter is an autogenerated mock type for the archiveWriter type
+type mockArchiveWriter struct {
+	mock.Mock
+}
+
+type mockArchiveWriter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockArchiveWriter) EXPECT() *mockArchiveWriter_Expecter {
+	return &mockArchiveWriter_Expecter{mock: &_m.Mock}
+}
+
+// Close provides a mock function with no fields
+func (_m *mockArchiveWriter) Close() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Close")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// mockArchiveWriter_Close_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Close'
+type mockArchiveWriter_Close_Call struct {
+	*mock.Call
+}
+
+// Close is a helper method to define mock.On call
+func (_e *mockArchiveWriter_Expecter) Close() *mockArchiveWriter_Close_Call {
+	return &mockArchiveWriter_Close_Call{Call: _e.mock.On("Close")}
+}
+
+func (_c *mockArchiveWriter_Close_Call) Run(run func()) *mockArchiveWriter_Close_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *mockArchiveWriter_Close_Call) Return(_a0 error) *mockArchiveWriter_Close_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *mockArchiveWriter_Close_Call) RunAndReturn(run func() error) *mockArchiveWriter_Close_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WriteFile provides a mock function with given fields: name, modTime, data
+func (_m *mockArchiveWriter) WriteFile(name string, modTime time.Time, data []byte) error {
+	ret := _m.Called(name, modTime, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WriteFile")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, time.Time, []byte) error); ok {
+		r0 = rf(name, modTime, data)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// mockArchiveWriter_WriteFile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WriteFile'
+type mockArchiveWriter_WriteFile_Call struct {
+	*mock.Call
+}
+
+// WriteFile is a helper method to define mock.On call
+//   - name string
+//   - modTime time.Time
+//   - data []byte
+func (_e *mockArchiveWriter_Expecter) WriteFile(name interface{}, modTime interface{}, data interface{}) *mockArchiveWriter_WriteFile_Call {
+	return &mockArchiveWriter_WriteFile_Call{Call: _e.mock.On("WriteFile", name, modTime, data)}
+}
+
+func (_c *mockArchiveWriter_WriteFile_Call) Run(run func(name string, modTime time.Time, data []byte)) *mockArchiveWriter_WriteFile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(time.Time), args[2].([]byte))
+	})
+	return _c
+}
+
+func (_c *mockArchiveWriter_WriteFile_Call) Return(_a0 error) *mockArchiveWriter_WriteFile_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *mockArchiveWriter_WriteFile_Call) RunAndReturn(run func(string, time.Time, []byte) error) *mockArchiveWriter_WriteFile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockArchiveWriter creates a new instance of mockArchiveWriter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockArchiveWriter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockArchiveWriter {
+	mock := &mockArchiveWriter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,96 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package archives
+
+import (
+	context "context"
+
+	models "github.com/ravilock/goduit/internal/articlePublisher/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockArticlesExporter is an autogenerated mock type for the articlesExporter type
+type mockArticlesExporter struct {
+	mock.Mock
+}
+
+type mockArticlesExporter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockArticlesExporter) EXPECT() *mockArticlesExporter_Expecter {
+	return &mockArticlesExporter_Expecter{mock: &_m.Mock}
+}
+
+// ExportArticles provides a mock function with given fields: ctx, author, batchSize, export
+func (_m *mockArticlesExporter) ExportArticles(ctx context.Context, author string, batchSize int64, export func(*models.Article) error) (int, error) {
+	ret := _m.Called(ctx, author, batchSize, export)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportArticles")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, func(*models.Article) error) (int, error)); ok {
+		return rf(ctx, author, batchSize, export)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, func(*models.Article) error) int); ok {
+		r0 = rf(ctx, author, batchSize, export)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64, func(*models.Article) error) error); ok {
+		r1 = rf(ctx, author, batchSize, export)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockArticlesExporter_ExportArticles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportArticles'
+type mockArticlesExporter_ExportArticles_Call struct {
+	*mock.Call
+}
+
+// ExportArticles is a helper method to define mock.On call
+//   - ctx context.Context
+//   - author string
+//   - batchSize int64
+//   - export func(*models.Article) error
+func (_e *mockArticlesExporter_Expecter) ExportArticles(ctx interface{}, author interface{}, batchSize interface{}, export interface{}) *mockArticlesExporter_ExportArticles_Call {
+	return &mockArticlesExporter_ExportArticles_Call{Call: _e.mock.On("ExportArticles", ctx, author, batchSize, export)}
+}
+
+func (_c *mockArticlesExporter_ExportArticles_Call) Run(run func(ctx context.Context, author string, batchSize int64, export func(*models.Article) error)) *mockArticlesExporter_ExportArticles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64), args[3].(func(*models.Article) error))
+	})
+	return _c
+}
+
+func (_c *mockArticlesExporter_ExportArticles_Call) Return(_a0 int, _a1 error) *mockArticlesExporter_ExportArticles_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *mockArticlesExporter_ExportArticles_Call) RunAndReturn(run func(context.Context, string, int64, func(*models.Article) error) (int, error)) *mockArticlesExporter_ExportArticles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockArticlesExporter creates a new instance of mockArticlesExporter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockArticlesExporter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockArticlesExporter {
+	mock := &mockArticlesExporter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
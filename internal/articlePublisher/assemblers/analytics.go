@@ -0,0 +1,23 @@
+package assemblers
+
+import (
+	"time"
+
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+	"github.com/ravilock/goduit/internal/articlePublisher/responses"
+)
+
+func ArticleAnalyticsResponse(article *models.Article, activity []*models.DailyActivity) *responses.ArticleAnalytics {
+	response := new(responses.ArticleAnalytics)
+	response.Slug = *article.Slug
+	response.Title = *article.Title
+	response.Days = make([]responses.DailyActivity, 0, len(activity))
+	for _, day := range activity {
+		counts := responses.ActivityCounts{Views: day.Views, Favorites: day.Favorites, Comments: day.Comments}
+		response.Days = append(response.Days, responses.DailyActivity{Date: day.Day.Format(time.DateOnly), ActivityCounts: counts})
+		response.Totals.Views += day.Views
+		response.Totals.Favorites += day.Favorites
+		response.Totals.Comments += day.Comments
+	}
+	return response
+}
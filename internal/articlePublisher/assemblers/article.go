@@ -6,31 +6,69 @@ import (
 	profileManagerResponses "github.com/ravilock/goduit/internal/profileManager/responses"
 )
 
-func ArticleResponse(article *models.Article, author *profileManagerResponses.ProfileResponse) *responses.ArticleResponse {
+func ArticleResponse(article *models.Article, author *profileManagerResponses.ProfileResponse, favorited bool) *responses.ArticleResponse {
 	response := new(responses.ArticleResponse)
 	response.Article.Slug = *article.Slug
 	response.Article.Title = *article.Title
 	response.Article.Description = *article.Description
 	response.Article.Body = *article.Body
+	if article.BodyHTML != nil {
+		response.Article.BodyHTML = *article.BodyHTML
+	}
+	response.Article.WordCount, response.Article.ReadingTime, response.Article.Excerpt = articleSummary(article)
 	response.Article.TagList = article.TagList
 	response.Article.CreatedAt = article.CreatedAt
 	response.Article.UpdatedAt = article.UpdatedAt
-	response.Article.Favorited = false
+	response.Article.Favorited = favorited
 	response.Article.FavoritesCount = *article.FavoritesCount
 	response.Article.Author = author.Profile
+	response.Article.CoAuthors = []profileManagerResponses.Profile{}
+	response.Article.Status = articleStatus(article)
+	response.Article.PublishAt = article.PublishAt
 	return response
 }
 
-func MultiArticleResponse(article *models.Article, author *profileManagerResponses.ProfileResponse) *responses.MultiArticle {
+func MultiArticleResponse(article *models.Article, author *profileManagerResponses.ProfileResponse, favorited bool) *responses.MultiArticle {
 	response := new(responses.MultiArticle)
 	response.Slug = *article.Slug
 	response.Title = *article.Title
 	response.Description = *article.Description
+	response.WordCount, response.ReadingTime, response.Excerpt = articleSummary(article)
 	response.TagList = article.TagList
 	response.CreatedAt = article.CreatedAt
 	response.UpdatedAt = article.UpdatedAt
-	response.Favorited = false
+	response.Favorited = favorited
 	response.FavoritesCount = *article.FavoritesCount
 	response.Author = author.Profile
+	response.Status = articleStatus(article)
+	response.PublishAt = article.PublishAt
+	response.TrashedAt = article.TrashedAt
 	return response
 }
+
+func SearchArticleResponse(article *models.Article, author *profileManagerResponses.ProfileResponse, favorited bool, snippet string) *responses.SearchArticle {
+	response := new(responses.SearchArticle)
+	response.MultiArticle = *MultiArticleResponse(article, author, favorited)
+	response.Snippet = snippet
+	return response
+}
+
+func articleStatus(article *models.Article) string {
+	if article.Status == nil {
+		return models.ArticleStatusPublished
+	}
+	return *article.Status
+}
+
+func articleSummary(article *models.Article) (wordCount, readingTime int64, excerpt string) {
+	if article.WordCount != nil {
+		wordCount = *article.WordCount
+	}
+	if article.ReadingTime != nil {
+		readingTime = *article.ReadingTime
+	}
+	if article.Excerpt != nil {
+		excerpt = *article.Excerpt
+	}
+	return wordCount, readingTime, excerpt
+}
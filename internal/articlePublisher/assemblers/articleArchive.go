@@ -0,0 +1,50 @@
+package assemblers
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+	"github.com/ravilock/goduit/internal/articlePublisher/responses"
+	"github.com/ravilock/goduit/internal/frontmatter"
+)
+
+// ArticleFile writes an article as a Markdown document with YAML front matter, named after its slug.
+func ArticleFile(article *models.Article) (string, []byte, error) {
+	frontMatter := &responses.ArticleFrontMatter{
+		Title:       *article.Title,
+		Description: *article.Description,
+		Tags:        article.TagList,
+		Status:      articleStatus(article),
+		PublishAt:   article.PublishAt,
+		CreatedAt:   article.CreatedAt,
+		UpdatedAt:   article.UpdatedAt,
+		PublishedAt: article.PublishedAt,
+	}
+	body := ""
+	if article.Body != nil {
+		body = *article.Body
+	}
+	document, err := frontmatter.Marshal(frontMatter, body)
+	if err != nil {
+		return "", nil, err
+	}
+	return *article.Slug + ".md", document, nil
+}
+
+// ImportedArticleFile reports the outcome of importing a file: the slug of the article it became, or why it was rejected.
+func ImportedArticleFile(name string, article *models.Article, importError error) responses.ImportedArticleFile {
+	response := responses.ImportedArticleFile{Name: name}
+	if importError != nil {
+		response.Error = importError.Error()
+		if httpError := new(echo.HTTPError); errors.As(importError, &httpError) {
+			response.Error = fmt.Sprint(httpError.Message)
+		}
+		return response
+	}
+	if article != nil && article.Slug != nil {
+		response.Slug = *article.Slug
+	}
+	return response
+}
@@ -0,0 +1,83 @@
+package assemblers
+
+import (
+	"time"
+
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+	"github.com/ravilock/goduit/internal/articlePublisher/responses"
+	profileManagerModels "github.com/ravilock/goduit/internal/profileManager/models"
+)
+
+// SiteName is the name articles are published under in link previews and structured data.
+const SiteName = "goduit"
+
+// ArticleMetadataResponse builds the metadata of an article's page on the site. Articles have no images of their own, so
+// previews show the author's image when they have one.
+func ArticleMetadataResponse(article *models.Article, author *profileManagerModels.User, siteURL string) *responses.ArticleMetadataResponse {
+	articleURL := ArticleURL(siteURL, *article.Slug)
+	authorURL := ProfileURL(siteURL, *author.Username)
+	description := *article.Description
+	if description == "" && article.Excerpt != nil {
+		description = *article.Excerpt
+	}
+	var image string
+	if author.Image != nil {
+		image = *author.Image
+	}
+	publishedAt := ArticlePublishedAt(article).UTC().Format(time.RFC3339)
+	updatedAt := ArticleUpdatedAt(article).UTC().Format(time.RFC3339)
+	tags := article.TagList
+	if tags == nil {
+		tags = []string{}
+	}
+
+	response := new(responses.ArticleMetadataResponse)
+	response.Metadata.Title = *article.Title
+	response.Metadata.Description = description
+	response.Metadata.CanonicalURL = articleURL
+	response.Metadata.OpenGraph = responses.OpenGraph{
+		Type:          "article",
+		Title:         *article.Title,
+		Description:   description,
+		URL:           articleURL,
+		SiteName:      SiteName,
+		Image:         image,
+		PublishedTime: publishedAt,
+		ModifiedTime:  updatedAt,
+		Author:        authorURL,
+		Tags:          tags,
+	}
+	response.Metadata.Twitter = responses.TwitterCard{
+		Card:        "summary",
+		Title:       *article.Title,
+		Description: description,
+		Image:       image,
+	}
+	response.Metadata.StructuredData = responses.ArticleStructuredData{
+		Context:          "https://schema.org",
+		Type:             "Article",
+		Headline:         *article.Title,
+		Description:      description,
+		URL:              articleURL,
+		MainEntityOfPage: articleURL,
+		Image:            image,
+		DatePublished:    publishedAt,
+		DateModified:     updatedAt,
+		Author: responses.StructuredDataPerson{
+			Type:  "Person",
+			Name:  *author.Username,
+			URL:   authorURL,
+			Image: image,
+		},
+		Publisher: responses.StructuredDataPublisher{
+			Type: "Organization",
+			Name: SiteName,
+			URL:  siteURL,
+		},
+		Keywords: tags,
+	}
+	if article.WordCount != nil {
+		response.Metadata.StructuredData.WordCount = *article.WordCount
+	}
+	return response
+}
@@ -0,0 +1,23 @@
+package assemblers
+
+import (
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+	"github.com/ravilock/goduit/internal/articlePublisher/responses"
+	profileManagerResponses "github.com/ravilock/goduit/internal/profileManager/responses"
+)
+
+func CoAuthorInvitationResponse(invitation *models.CoAuthorInvitation, article *models.Article, inviter, invitee *profileManagerResponses.ProfileResponse) *responses.CoAuthorInvitationResponse {
+	response := new(responses.CoAuthorInvitationResponse)
+	response.Invitation = *MultiCoAuthorInvitationResponse(invitation, article, inviter, invitee)
+	return response
+}
+
+func MultiCoAuthorInvitationResponse(invitation *models.CoAuthorInvitation, article *models.Article, inviter, invitee *profileManagerResponses.ProfileResponse) *responses.CoAuthorInvitation {
+	response := new(responses.CoAuthorInvitation)
+	response.Slug = *article.Slug
+	response.Title = *article.Title
+	response.Inviter = inviter.Profile
+	response.Invitee = invitee.Profile
+	response.CreatedAt = invitation.CreatedAt
+	return response
+}
@@ -0,0 +1,106 @@
+package assemblers
+
+import (
+	"html"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+)
+
+const (
+	snippetLength  = 160
+	snippetContext = 60
+)
+
+var searchPhrasePattern = regexp.MustCompile(`"[^"]*"|\S+`)
+
+// Highlighter builds HTML snippets of an article around the terms of a search query.
+// Matched terms are wrapped in <em> tags and the remaining text is HTML escaped.
+type Highlighter struct {
+	pattern *regexp.Regexp
+}
+
+// NewHighlighter creates a Highlighter for the terms of a full-text search query.
+// Quoted phrases are highlighted as a whole and negated terms are ignored.
+func NewHighlighter(query string) *Highlighter {
+	terms := []string{}
+	for _, term := range searchPhrasePattern.FindAllString(query, -1) {
+		if strings.HasPrefix(term, "-") {
+			continue
+		}
+		term = strings.Trim(term, `"`)
+		if strings.TrimSpace(term) == "" {
+			continue
+		}
+		terms = append(terms, regexp.QuoteMeta(term))
+	}
+	if len(terms) == 0 {
+		return &Highlighter{}
+	}
+	// Longer terms first, so that a phrase is preferred over the words it contains
+	slices.SortFunc(terms, func(a, b string) int {
+		return len(b) - len(a)
+	})
+	return &Highlighter{pattern: regexp.MustCompile(`(?i)(` + strings.Join(terms, "|") + `)`)}
+}
+
+// Snippet returns an excerpt of the article centered on the first matched term.
+// Body, description and title are tried in that order, falling back to the start of the body.
+func (h *Highlighter) Snippet(article *models.Article) string {
+	candidates := []*string{article.Body, article.Description, article.Title}
+	for _, candidate := range candidates {
+		if candidate == nil {
+			continue
+		}
+		text := strings.Join(strings.Fields(*candidate), " ")
+		if h.pattern == nil {
+			break
+		}
+		if loc := h.pattern.FindStringIndex(text); loc != nil {
+			return h.excerpt(text, loc[0])
+		}
+	}
+	if article.Body == nil {
+		return ""
+	}
+	return h.excerpt(strings.Join(strings.Fields(*article.Body), " "), 0)
+}
+
+func (h *Highlighter) excerpt(text string, match int) string {
+	start := max(0, match-snippetContext)
+	for start > 0 && !utf8.RuneStart(text[start]) {
+		start--
+	}
+	end := min(len(text), start+snippetLength)
+	for end < len(text) && !utf8.RuneStart(text[end]) {
+		end++
+	}
+	snippet := h.highlight(text[start:end])
+	if start > 0 {
+		snippet = "…" + snippet
+	}
+	if end < len(text) {
+		snippet += "…"
+	}
+	return snippet
+}
+
+func (h *Highlighter) highlight(text string) string {
+	if h.pattern == nil {
+		return html.EscapeString(text)
+	}
+	var builder strings.Builder
+	last := 0
+	for _, loc := range h.pattern.FindAllStringIndex(text, -1) {
+		builder.WriteString(html.EscapeString(text[last:loc[0]]))
+		builder.WriteString("<em>")
+		builder.WriteString(html.EscapeString(text[loc[0]:loc[1]]))
+		builder.WriteString("</em>")
+		last = loc[1]
+	}
+	builder.WriteString(html.EscapeString(text[last:]))
+	return builder.String()
+}
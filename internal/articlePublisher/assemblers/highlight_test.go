@@ -0,0 +1,53 @@
+package assemblers
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHighlighter(t *testing.T) {
+	t.Run("Should highlight matched terms ignoring case", func(t *testing.T) {
+		article := assembleSearchedArticle("Title", "Description", "Learning Go is fun, go learn it")
+		snippet := NewHighlighter("go").Snippet(article)
+		require.Equal(t, "Learning <em>Go</em> is fun, <em>go</em> learn it", snippet)
+	})
+	t.Run("Should highlight quoted phrases as a whole and ignore negated terms", func(t *testing.T) {
+		article := assembleSearchedArticle("Title", "Description", "channels and goroutines, not mutexes")
+		snippet := NewHighlighter(`"channels and goroutines" -mutexes`).Snippet(article)
+		require.Equal(t, "<em>channels and goroutines</em>, not mutexes", snippet)
+	})
+	t.Run("Should fall back to description and title when body does not match", func(t *testing.T) {
+		article := assembleSearchedArticle("Title", "About generics", "Nothing here")
+		snippet := NewHighlighter("generics").Snippet(article)
+		require.Equal(t, "About <em>generics</em>", snippet)
+	})
+	t.Run("Should start from the body when nothing matches", func(t *testing.T) {
+		article := assembleSearchedArticle("Title", "Description", "Nothing here")
+		snippet := NewHighlighter("generics").Snippet(article)
+		require.Equal(t, "Nothing here", snippet)
+	})
+	t.Run("Should escape HTML from the article", func(t *testing.T) {
+		article := assembleSearchedArticle("Title", "Description", "<script>go</script>")
+		snippet := NewHighlighter("go").Snippet(article)
+		require.Equal(t, "&lt;script&gt;<em>go</em>&lt;/script&gt;", snippet)
+	})
+	t.Run("Should trim long bodies around the first match", func(t *testing.T) {
+		body := strings.Repeat("lorem ", 100) + "needle " + strings.Repeat("ipsum ", 100)
+		article := assembleSearchedArticle("Title", "Description", body)
+		snippet := NewHighlighter("needle").Snippet(article)
+		require.True(t, strings.HasPrefix(snippet, "…"))
+		require.True(t, strings.HasSuffix(snippet, "…"))
+		require.Contains(t, snippet, "<em>needle</em>")
+	})
+}
+
+func assembleSearchedArticle(title, description, body string) *models.Article {
+	return &models.Article{
+		Title:       &title,
+		Description: &description,
+		Body:        &body,
+	}
+}
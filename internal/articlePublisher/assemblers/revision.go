@@ -0,0 +1,68 @@
+package assemblers
+
+import (
+	"slices"
+
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+	"github.com/ravilock/goduit/internal/articlePublisher/responses"
+	profileManagerResponses "github.com/ravilock/goduit/internal/profileManager/responses"
+	"github.com/ravilock/goduit/internal/textdiff"
+)
+
+func RevisionResponse(revision *models.Revision, author *profileManagerResponses.ProfileResponse) *responses.RevisionResponse {
+	response := new(responses.RevisionResponse)
+	response.Revision.Number = *revision.Number
+	response.Revision.CreatedAt = revision.CreatedAt
+	response.Revision.Author = author.Profile
+	response.Revision.Slug = *revision.Slug
+	response.Revision.Title = *revision.Title
+	response.Revision.Description = *revision.Description
+	response.Revision.Body = *revision.Body
+	response.Revision.TagList = revision.TagList
+	response.Revision.RestoredFrom = revision.RestoredFrom
+	return response
+}
+
+func MultiRevisionResponse(revision *models.Revision, author *profileManagerResponses.ProfileResponse) *responses.MultiRevision {
+	response := new(responses.MultiRevision)
+	response.Number = *revision.Number
+	response.CreatedAt = revision.CreatedAt
+	response.Author = author.Profile
+	response.Slug = *revision.Slug
+	response.Title = *revision.Title
+	response.Description = *revision.Description
+	response.TagList = revision.TagList
+	response.RestoredFrom = revision.RestoredFrom
+	return response
+}
+
+func RevisionDiffResponse(from, to *models.Revision) *responses.RevisionDiffResponse {
+	response := new(responses.RevisionDiffResponse)
+	response.Diff.From = *from.Number
+	response.Diff.To = *to.Number
+	response.Diff.Title = textChanges(*from.Title, *to.Title)
+	response.Diff.Description = textChanges(*from.Description, *to.Description)
+	response.Diff.Body = textChanges(*from.Body, *to.Body)
+	response.Diff.AddedTags = []string{}
+	response.Diff.RemovedTags = []string{}
+	for _, tag := range to.TagList {
+		if !slices.Contains(from.TagList, tag) {
+			response.Diff.AddedTags = append(response.Diff.AddedTags, tag)
+		}
+	}
+	for _, tag := range from.TagList {
+		if !slices.Contains(to.TagList, tag) {
+			response.Diff.RemovedTags = append(response.Diff.RemovedTags, tag)
+		}
+	}
+	return response
+}
+
+func textChanges(from, to string) []responses.TextChange {
+	changes := textdiff.Lines(from, to)
+	result := make([]responses.TextChange, 0, len(changes))
+	for _, change := range changes {
+		result = append(result, responses.TextChange{Operation: string(change.Operation), Text: change.Text})
+	}
+	return result
+}
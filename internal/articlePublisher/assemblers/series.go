@@ -0,0 +1,61 @@
+package assemblers
+
+import (
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+	"github.com/ravilock/goduit/internal/articlePublisher/responses"
+	profileManagerResponses "github.com/ravilock/goduit/internal/profileManager/responses"
+)
+
+func SeriesResponse(series *models.Series, owner *profileManagerResponses.ProfileResponse, articles []*models.Article) *responses.SeriesResponse {
+	response := new(responses.SeriesResponse)
+	response.Series.Slug = *series.Slug
+	response.Series.Title = *series.Title
+	if series.Description != nil {
+		response.Series.Description = *series.Description
+	}
+	response.Series.Owner = owner.Profile
+	response.Series.Articles = make([]responses.SeriesArticle, 0, len(articles))
+	for _, article := range articles {
+		response.Series.Articles = append(response.Series.Articles, *seriesArticle(article))
+	}
+	response.Series.CreatedAt = series.CreatedAt
+	response.Series.UpdatedAt = series.UpdatedAt
+	return response
+}
+
+func MultiSeriesResponse(series *models.Series, owner *profileManagerResponses.ProfileResponse) *responses.MultiSeries {
+	response := new(responses.MultiSeries)
+	response.Slug = *series.Slug
+	response.Title = *series.Title
+	if series.Description != nil {
+		response.Description = *series.Description
+	}
+	response.Owner = owner.Profile
+	response.ArticlesCount = len(series.Articles)
+	response.CreatedAt = series.CreatedAt
+	response.UpdatedAt = series.UpdatedAt
+	return response
+}
+
+func SeriesNavigationResponse(navigation *models.SeriesNavigation) *responses.SeriesNavigation {
+	response := new(responses.SeriesNavigation)
+	response.Slug = *navigation.Series.Slug
+	response.Title = *navigation.Series.Title
+	response.Position = navigation.Position
+	response.Total = navigation.Total
+	if navigation.Previous != nil {
+		response.Previous = seriesArticle(navigation.Previous)
+	}
+	if navigation.Next != nil {
+		response.Next = seriesArticle(navigation.Next)
+	}
+	return response
+}
+
+func seriesArticle(article *models.Article) *responses.SeriesArticle {
+	return &responses.SeriesArticle{
+		Slug:   *article.Slug,
+		Title:  *article.Title,
+		Status: articleStatus(article),
+	}
+}
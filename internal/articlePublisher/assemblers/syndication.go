@@ -0,0 +1,69 @@
+package assemblers
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+	"github.com/ravilock/goduit/internal/articlePublisher/syndication"
+	"github.com/ravilock/goduit/internal/markdown"
+)
+
+// ArticleURL is the page of an article on the site, which is where feeds link entries to.
+func ArticleURL(siteURL, slug string) string {
+	return fmt.Sprintf("%s/article/%s", strings.TrimSuffix(siteURL, "/"), url.PathEscape(slug))
+}
+
+// ProfileURL is the page of a profile on the site.
+func ProfileURL(siteURL, username string) string {
+	return fmt.Sprintf("%s/profile/%s", strings.TrimSuffix(siteURL, "/"), url.PathEscape(username))
+}
+
+// FeedEntry builds the feed entry of an article. Entries are identified by the article's ID rather than its link, so
+// readers do not show an article again when its slug changes.
+func FeedEntry(article *models.Article, author, siteURL string) syndication.Entry {
+	entry := syndication.Entry{
+		ID:         fmt.Sprintf("urn:goduit:article:%s", article.ID.Hex()),
+		Title:      *article.Title,
+		Link:       ArticleURL(siteURL, *article.Slug),
+		Author:     author,
+		Categories: article.TagList,
+		Published:  ArticlePublishedAt(article),
+		Updated:    ArticleUpdatedAt(article),
+	}
+	if article.Description != nil {
+		entry.Summary = *article.Description
+	}
+	if article.BodyHTML != nil {
+		entry.ContentHTML = *article.BodyHTML
+	} else if article.Body != nil {
+		// Articles written before bodies were rendered are rendered on the fly
+		if bodyHTML, err := markdown.Render(*article.Body); err == nil {
+			entry.ContentHTML = bodyHTML
+		}
+	}
+	return entry
+}
+
+// ArticlePublishedAt is when an article was published. Articles published before publication dates were recorded were
+// published when they were written.
+func ArticlePublishedAt(article *models.Article) time.Time {
+	if article.PublishedAt != nil {
+		return *article.PublishedAt
+	}
+	if article.CreatedAt != nil {
+		return *article.CreatedAt
+	}
+	return time.Time{}
+}
+
+// ArticleUpdatedAt is when an article last changed, which is when it was published if it never changed.
+func ArticleUpdatedAt(article *models.Article) time.Time {
+	publishedAt := ArticlePublishedAt(article)
+	if article.UpdatedAt != nil && article.UpdatedAt.After(publishedAt) {
+		return *article.UpdatedAt
+	}
+	return publishedAt
+}
@@ -0,0 +1,31 @@
+package assemblers
+
+import (
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+	"github.com/ravilock/goduit/internal/articlePublisher/responses"
+)
+
+func TagsResponse(tags []*models.Tag) *responses.TagsResponse {
+	response := &responses.TagsResponse{
+		Tags:      make([]string, 0, len(tags)),
+		TagCounts: make([]responses.Tag, 0, len(tags)),
+	}
+	for _, tag := range tags {
+		response.Tags = append(response.Tags, *tag.Name)
+		response.TagCounts = append(response.TagCounts, responses.Tag{Name: *tag.Name, Count: *tag.Count})
+	}
+	return response
+}
+
+func TagAliasesResponse(tag string, aliases []*models.TagAlias) *responses.TagAliasesResponse {
+	response := &responses.TagAliasesResponse{
+		Tag: responses.TagAliases{
+			Name:    tag,
+			Aliases: make([]string, 0, len(aliases)),
+		},
+	}
+	for _, alias := range aliases {
+		response.Tag.Aliases = append(response.Tag.Aliases, *alias.Name)
+	}
+	return response
+}
@@ -0,0 +1,98 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ravilock/goduit/api"
+	"github.com/ravilock/goduit/internal/app"
+	"github.com/ravilock/goduit/internal/articlePublisher/assemblers"
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+	"github.com/ravilock/goduit/internal/articlePublisher/requests"
+	"github.com/ravilock/goduit/internal/identity"
+	profileManagerAssembler "github.com/ravilock/goduit/internal/profileManager/assemblers"
+)
+
+type coAuthorInvitationAccepter interface {
+	AcceptInvitation(ctx context.Context, article, invitee string) (*models.Article, error)
+}
+
+type AcceptCoAuthorInvitationHandler struct {
+	service        coAuthorInvitationAccepter
+	articleGetter  articleGetter
+	profileManager profileGetter
+}
+
+func NewAcceptCoAuthorInvitationHandler(service coAuthorInvitationAccepter, articleGetter articleGetter, profileManager profileGetter) *AcceptCoAuthorInvitationHandler {
+	return &AcceptCoAuthorInvitationHandler{
+		service:        service,
+		articleGetter:  articleGetter,
+		profileManager: profileManager,
+	}
+}
+
+func (h *AcceptCoAuthorInvitationHandler) AcceptInvitation(c echo.Context) error {
+	request := new(requests.ArticleSlugRequest)
+	identity := new(identity.IdentityHeaders)
+	binder := &echo.DefaultBinder{}
+	if err := binder.BindPathParams(c, request); err != nil {
+		return err
+	}
+	if err := binder.BindHeaders(c, identity); err != nil {
+		return err
+	}
+
+	if err := request.Validate(); err != nil {
+		return err
+	}
+
+	ctx := c.Request().Context()
+
+	article, err := h.articleGetter.GetArticleBySlug(ctx, request.Slug)
+	if err != nil {
+		if appError := new(app.AppError); errors.As(err, &appError) {
+			switch appError.ErrorCode {
+			case app.ArticleNotFoundErrorCode:
+				return api.ArticleNotFound(request.Slug)
+			}
+		}
+		return err
+	}
+
+	article, err = h.service.AcceptInvitation(ctx, article.ID.Hex(), identity.Subject)
+	if err != nil {
+		if appError := new(app.AppError); errors.As(err, &appError) {
+			switch appError.ErrorCode {
+			case app.CoAuthorInvitationNotFoundErrorCode:
+				return api.CoAuthorInvitationNotFound(request.Slug, identity.ClientUsername)
+			case app.ArticleNotFoundErrorCode:
+				return api.ArticleNotFound(request.Slug)
+			}
+		}
+		return err
+	}
+
+	authorProfile, err := h.profileManager.GetProfileByID(ctx, *article.Author)
+	if err != nil {
+		if appError := new(app.AppError); errors.As(err, &appError) {
+			switch appError.ErrorCode {
+			case app.UserNotFoundErrorCode:
+				return api.UserNotFound(*article.Author)
+			}
+		}
+		return err
+	}
+
+	profileResponse, err := profileManagerAssembler.ProfileResponse(authorProfile, false)
+	if err != nil {
+		return err
+	}
+
+	response := assemblers.ArticleResponse(article, profileResponse, false)
+	if err := withCoAuthors(ctx, h.profileManager, article, response); err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, response)
+}
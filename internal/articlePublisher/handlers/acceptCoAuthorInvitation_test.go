@@ -0,0 +1,89 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ravilock/goduit/api"
+	"github.com/ravilock/goduit/api/validators"
+	"github.com/ravilock/goduit/internal/app"
+	articlePublisherResponses "github.com/ravilock/goduit/internal/articlePublisher/responses"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestAcceptCoAuthorInvitation(t *testing.T) {
+	err := validators.InitValidator()
+	require.NoError(t, err)
+	coAuthorInvitationAccepterMock := newMockCoAuthorInvitationAccepter(t)
+	articleGetterMock := newMockArticleGetter(t)
+	profileGetterMock := newMockProfileGetter(t)
+	handler := &AcceptCoAuthorInvitationHandler{coAuthorInvitationAccepterMock, articleGetterMock, profileGetterMock}
+	e := echo.New()
+
+	t.Run("Should make the invited user a co-author of the article", func(t *testing.T) {
+		// Arrange
+		articleAuthorID := primitive.NewObjectID()
+		expectedAuthor := assembleArticleAuthor(articleAuthorID.Hex())
+		expectedInvitee := assembleArticleAuthor(primitive.NewObjectID().Hex())
+		expectedArticle := assembleArticleModel(articleAuthorID)
+		coAuthoredArticle := *expectedArticle
+		coAuthoredArticle.CoAuthors = []string{expectedInvitee.ID.Hex()}
+		req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/api/articles/%s/co-authors/accept", *expectedArticle.Slug), nil)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set("Goduit-Subject", expectedInvitee.ID.Hex())
+		req.Header.Set("Goduit-Client-Username", *expectedInvitee.Username)
+		req.Header.Set("Goduit-Client-Email", *expectedInvitee.Email)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("slug")
+		c.SetParamValues(*expectedArticle.Slug)
+		ctx := c.Request().Context()
+		articleGetterMock.EXPECT().GetArticleBySlug(ctx, *expectedArticle.Slug).Return(expectedArticle, nil).Once()
+		coAuthorInvitationAccepterMock.EXPECT().AcceptInvitation(ctx, expectedArticle.ID.Hex(), expectedInvitee.ID.Hex()).Return(&coAuthoredArticle, nil).Once()
+		profileGetterMock.EXPECT().GetProfileByID(ctx, expectedAuthor.ID.Hex()).Return(expectedAuthor, nil).Once()
+		profileGetterMock.EXPECT().GetProfileByID(ctx, expectedInvitee.ID.Hex()).Return(expectedInvitee, nil).Once()
+
+		// Act
+		err := handler.AcceptInvitation(c)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Code)
+		articleResponse := new(articlePublisherResponses.ArticleResponse)
+		err = json.Unmarshal(rec.Body.Bytes(), articleResponse)
+		require.NoError(t, err)
+		require.Equal(t, *expectedAuthor.Username, articleResponse.Article.Author.Username)
+		require.Len(t, articleResponse.Article.CoAuthors, 1)
+		require.Equal(t, *expectedInvitee.Username, articleResponse.Article.CoAuthors[0].Username)
+	})
+
+	t.Run("Should return HTTP 404 if the user was not invited", func(t *testing.T) {
+		// Arrange
+		articleAuthorID := primitive.NewObjectID()
+		expectedInvitee := assembleArticleAuthor(primitive.NewObjectID().Hex())
+		expectedArticle := assembleArticleModel(articleAuthorID)
+		req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/api/articles/%s/co-authors/accept", *expectedArticle.Slug), nil)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set("Goduit-Subject", expectedInvitee.ID.Hex())
+		req.Header.Set("Goduit-Client-Username", *expectedInvitee.Username)
+		req.Header.Set("Goduit-Client-Email", *expectedInvitee.Email)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("slug")
+		c.SetParamValues(*expectedArticle.Slug)
+		ctx := c.Request().Context()
+		articleGetterMock.EXPECT().GetArticleBySlug(ctx, *expectedArticle.Slug).Return(expectedArticle, nil).Once()
+		coAuthorInvitationAccepterMock.EXPECT().AcceptInvitation(ctx, expectedArticle.ID.Hex(), expectedInvitee.ID.Hex()).Return(nil, app.CoAuthorInvitationNotFoundError(expectedArticle.ID.Hex(), expectedInvitee.ID.Hex(), nil)).Once()
+
+		// Act
+		err := handler.AcceptInvitation(c)
+
+		// Assert
+		require.ErrorContains(t, err, api.CoAuthorInvitationNotFound(*expectedArticle.Slug, *expectedInvitee.Username).Error())
+	})
+}
@@ -0,0 +1,66 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ravilock/goduit/api"
+	"github.com/ravilock/goduit/internal/app"
+	"github.com/ravilock/goduit/internal/articlePublisher/assemblers"
+	"github.com/ravilock/goduit/internal/articlePublisher/requests"
+)
+
+type tagsMerger interface {
+	MergeTags(ctx context.Context, from, into string) (string, error)
+}
+
+type AddTagAliasHandler struct {
+	service       tagsMerger
+	aliasesLister tagAliasesLister
+}
+
+func NewAddTagAliasHandler(service tagsMerger, aliasesLister tagAliasesLister) *AddTagAliasHandler {
+	return &AddTagAliasHandler{
+		service:       service,
+		aliasesLister: aliasesLister,
+	}
+}
+
+// AddTagAlias makes a variant resolve to a tag. Articles already using the variant are merged into the tag.
+func (h *AddTagAliasHandler) AddTagAlias(c echo.Context) error {
+	request := new(requests.AddTagAliasRequest)
+	binder := &echo.DefaultBinder{}
+	if err := binder.BindBody(c, request); err != nil {
+		return api.CouldNotUnmarshalBodyError
+	}
+	if err := binder.BindPathParams(c, request); err != nil {
+		return err
+	}
+
+	if err := request.Validate(); err != nil {
+		return err
+	}
+
+	ctx := c.Request().Context()
+
+	tag, err := h.service.MergeTags(ctx, request.Alias.Name, request.Tag)
+	if err != nil {
+		if appError := new(app.AppError); errors.As(err, &appError) {
+			switch appError.ErrorCode {
+			case app.ConflictErrorCode:
+				return api.ConfictError
+			}
+		}
+		return err
+	}
+
+	tag, aliases, err := h.aliasesLister.ListTagAliases(ctx, tag)
+	if err != nil {
+		return err
+	}
+
+	response := assemblers.TagAliasesResponse(tag, aliases)
+	return c.JSON(http.StatusCreated, response)
+}
@@ -0,0 +1,84 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ravilock/goduit/api"
+	"github.com/ravilock/goduit/api/validators"
+	"github.com/ravilock/goduit/internal/app"
+	articlePublisherResponses "github.com/ravilock/goduit/internal/articlePublisher/responses"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddTagAlias(t *testing.T) {
+	err := validators.InitValidator()
+	require.NoError(t, err)
+	tagsMergerMock := newMockTagsMerger(t)
+	tagAliasesListerMock := newMockTagAliasesLister(t)
+	handler := &AddTagAliasHandler{tagsMergerMock, tagAliasesListerMock}
+	e := echo.New()
+
+	t.Run("Should fold the alias into the tag", func(t *testing.T) {
+		// Arrange
+		req := httptest.NewRequest(http.MethodPost, "/api/tags/golang/aliases", bytes.NewBufferString(`{"alias":{"name":"Go Lang"}}`))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("tag")
+		c.SetParamValues("golang")
+		ctx := c.Request().Context()
+		tagsMergerMock.EXPECT().MergeTags(ctx, "Go Lang", "golang").Return("golang", nil).Once()
+		tagAliasesListerMock.EXPECT().ListTagAliases(ctx, "golang").Return("golang", assembleTagAliases("golang", "go-lang"), nil).Once()
+
+		// Act
+		err := handler.AddTagAlias(c)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, http.StatusCreated, rec.Code)
+		tagAliasesResponse := new(articlePublisherResponses.TagAliasesResponse)
+		err = json.Unmarshal(rec.Body.Bytes(), tagAliasesResponse)
+		require.NoError(t, err)
+		require.Equal(t, "golang", tagAliasesResponse.Tag.Name)
+		require.Equal(t, []string{"go-lang"}, tagAliasesResponse.Tag.Aliases)
+	})
+
+	t.Run("Should return HTTP 409 if alias resolves to the tag itself", func(t *testing.T) {
+		// Arrange
+		req := httptest.NewRequest(http.MethodPost, "/api/tags/golang/aliases", bytes.NewBufferString(`{"alias":{"name":"GoLang"}}`))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("tag")
+		c.SetParamValues("golang")
+		ctx := c.Request().Context()
+		tagsMergerMock.EXPECT().MergeTags(ctx, "GoLang", "golang").Return("", app.ConflictError("tags")).Once()
+
+		// Act
+		err := handler.AddTagAlias(c)
+
+		// Assert
+		require.ErrorContains(t, err, api.ConfictError.Error())
+	})
+
+	t.Run("Should return HTTP 400 if alias is missing", func(t *testing.T) {
+		// Arrange
+		req := httptest.NewRequest(http.MethodPost, "/api/tags/golang/aliases", bytes.NewBufferString(`{"alias":{}}`))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("tag")
+		c.SetParamValues("golang")
+
+		// Act
+		err := handler.AddTagAlias(c)
+
+		// Assert
+		require.ErrorContains(t, err, api.RequiredFieldError("Name").Error())
+	})
+}
@@ -0,0 +1,71 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ravilock/goduit/internal/articlePublisher/assemblers"
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+	"github.com/ravilock/goduit/internal/articlePublisher/requests"
+	"github.com/ravilock/goduit/internal/articlePublisher/responses"
+	"github.com/ravilock/goduit/internal/identity"
+)
+
+type articleAnalyticsGetter interface {
+	GetArticleAnalytics(ctx context.Context, articles []string, since time.Time) (map[string][]*models.DailyActivity, error)
+}
+
+type ArticleAnalyticsHandler struct {
+	service       articleAnalyticsGetter
+	articleLister articleLister
+}
+
+func NewArticleAnalyticsHandler(service articleAnalyticsGetter, articleLister articleLister) *ArticleAnalyticsHandler {
+	return &ArticleAnalyticsHandler{
+		service:       service,
+		articleLister: articleLister,
+	}
+}
+
+func (h *ArticleAnalyticsHandler) GetAnalytics(c echo.Context) error {
+	request := requests.NewArticleAnalyticsRequest()
+	identity := new(identity.IdentityHeaders)
+	binder := &echo.DefaultBinder{}
+	if err := binder.BindQueryParams(c, request); err != nil {
+		return err
+	}
+	if err := binder.BindHeaders(c, identity); err != nil {
+		return err
+	}
+
+	if err := request.Validate(); err != nil {
+		return err
+	}
+
+	ctx := c.Request().Context()
+
+	articles, err := h.articleLister.ListArticles(ctx, identity.Subject, "", "", "", int64(request.Pagination.Limit), int64(request.Pagination.Offset))
+	if err != nil {
+		return err
+	}
+
+	articleIDs := make([]string, 0, len(articles))
+	for _, article := range articles {
+		articleIDs = append(articleIDs, article.ID.Hex())
+	}
+
+	since := models.Day(time.Now()).AddDate(0, 0, 1-request.Days)
+	analytics, err := h.service.GetArticleAnalytics(ctx, articleIDs, since)
+	if err != nil {
+		return err
+	}
+
+	response := responses.ArticleAnalyticsResponse{Since: since, Articles: make([]responses.ArticleAnalytics, 0, len(articles))}
+	for _, article := range articles {
+		response.Articles = append(response.Articles, *assemblers.ArticleAnalyticsResponse(article, analytics[article.ID.Hex()]))
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,84 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ravilock/goduit/api"
+	"github.com/ravilock/goduit/api/validators"
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+	articlePublisherResponses "github.com/ravilock/goduit/internal/articlePublisher/responses"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestArticleAnalytics(t *testing.T) {
+	err := validators.InitValidator()
+	require.NoError(t, err)
+	articleAnalyticsGetterMock := newMockArticleAnalyticsGetter(t)
+	articleListerMock := newMockArticleLister(t)
+	handler := &ArticleAnalyticsHandler{articleAnalyticsGetterMock, articleListerMock}
+	e := echo.New()
+
+	t.Run("Should list the daily activity of the user's articles", func(t *testing.T) {
+		// Arrange
+		authorID := primitive.NewObjectID()
+		expectedAuthor := assembleArticleAuthor(authorID.Hex())
+		article := assembleArticleModel(authorID)
+		today := models.Day(time.Now())
+		yesterday := today.AddDate(0, 0, -1)
+		activity := []*models.DailyActivity{
+			{Day: yesterday, Views: 10, Favorites: 2, Comments: 1},
+			{Day: today, Views: 5, Comments: 3},
+		}
+		req := httptest.NewRequest(http.MethodGet, "/api/articles/analytics?days=2", nil)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set("Goduit-Subject", expectedAuthor.ID.Hex())
+		req.Header.Set("Goduit-Client-Username", *expectedAuthor.Username)
+		req.Header.Set("Goduit-Client-Email", *expectedAuthor.Email)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		ctx := c.Request().Context()
+		articleListerMock.EXPECT().ListArticles(ctx, authorID.Hex(), "", "", "", int64(20), int64(0)).Return([]*models.Article{article}, nil).Once()
+		articleAnalyticsGetterMock.EXPECT().GetArticleAnalytics(ctx, []string{article.ID.Hex()}, yesterday).Return(map[string][]*models.DailyActivity{article.ID.Hex(): activity}, nil).Once()
+
+		// Act
+		err := handler.GetAnalytics(c)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Code)
+		analyticsResponse := new(articlePublisherResponses.ArticleAnalyticsResponse)
+		err = json.Unmarshal(rec.Body.Bytes(), analyticsResponse)
+		require.NoError(t, err)
+		require.True(t, yesterday.Equal(analyticsResponse.Since))
+		require.Len(t, analyticsResponse.Articles, 1)
+		articleAnalytics := analyticsResponse.Articles[0]
+		require.Equal(t, *article.Slug, articleAnalytics.Slug)
+		require.Equal(t, articlePublisherResponses.ActivityCounts{Views: 15, Favorites: 2, Comments: 4}, articleAnalytics.Totals)
+		require.Len(t, articleAnalytics.Days, 2)
+		require.Equal(t, yesterday.Format(time.DateOnly), articleAnalytics.Days[0].Date)
+		require.Equal(t, int64(10), articleAnalytics.Days[0].Views)
+		require.Equal(t, today.Format(time.DateOnly), articleAnalytics.Days[1].Date)
+		require.Equal(t, int64(3), articleAnalytics.Days[1].Comments)
+	})
+
+	t.Run("Should not look back more than 90 days", func(t *testing.T) {
+		// Arrange
+		req := httptest.NewRequest(http.MethodGet, "/api/articles/analytics?days=91", nil)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set("Goduit-Subject", primitive.NewObjectID().Hex())
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		// Act
+		err := handler.GetAnalytics(c)
+
+		// Assert
+		require.ErrorContains(t, err, api.InvalidFieldLimit("Days", "max", "90").Error())
+	})
+}
@@ -0,0 +1,30 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ravilock/goduit/internal/app"
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+	"github.com/ravilock/goduit/internal/articlePublisher/responses"
+	profileManagerAssembler "github.com/ravilock/goduit/internal/profileManager/assemblers"
+)
+
+// withCoAuthors adds the profiles of the article's co-authors to its response, skipping users that no longer exist.
+func withCoAuthors(ctx context.Context, profileManager profileGetter, article *models.Article, response *responses.ArticleResponse) error {
+	for _, coAuthorID := range article.CoAuthors {
+		coAuthor, err := profileManager.GetProfileByID(ctx, coAuthorID)
+		if err != nil {
+			if appError := new(app.AppError); errors.As(err, &appError) && appError.ErrorCode == app.UserNotFoundErrorCode {
+				continue
+			}
+			return err
+		}
+		coAuthorProfile, err := profileManagerAssembler.ProfileResponse(coAuthor, false)
+		if err != nil {
+			return err
+		}
+		response.Article.CoAuthors = append(response.Article.CoAuthors, coAuthorProfile.Profile)
+	}
+	return nil
+}
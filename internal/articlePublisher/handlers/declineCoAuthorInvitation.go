@@ -0,0 +1,70 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ravilock/goduit/api"
+	"github.com/ravilock/goduit/internal/app"
+	"github.com/ravilock/goduit/internal/articlePublisher/requests"
+	"github.com/ravilock/goduit/internal/identity"
+)
+
+type coAuthorInvitationDecliner interface {
+	DeclineInvitation(ctx context.Context, article, invitee string) error
+}
+
+type DeclineCoAuthorInvitationHandler struct {
+	service       coAuthorInvitationDecliner
+	articleGetter articleGetter
+}
+
+func NewDeclineCoAuthorInvitationHandler(service coAuthorInvitationDecliner, articleGetter articleGetter) *DeclineCoAuthorInvitationHandler {
+	return &DeclineCoAuthorInvitationHandler{
+		service:       service,
+		articleGetter: articleGetter,
+	}
+}
+
+func (h *DeclineCoAuthorInvitationHandler) DeclineInvitation(c echo.Context) error {
+	request := new(requests.ArticleSlugRequest)
+	identity := new(identity.IdentityHeaders)
+	binder := &echo.DefaultBinder{}
+	if err := binder.BindPathParams(c, request); err != nil {
+		return err
+	}
+	if err := binder.BindHeaders(c, identity); err != nil {
+		return err
+	}
+
+	if err := request.Validate(); err != nil {
+		return err
+	}
+
+	ctx := c.Request().Context()
+
+	article, err := h.articleGetter.GetArticleBySlug(ctx, request.Slug)
+	if err != nil {
+		if appError := new(app.AppError); errors.As(err, &appError) {
+			switch appError.ErrorCode {
+			case app.ArticleNotFoundErrorCode:
+				return api.ArticleNotFound(request.Slug)
+			}
+		}
+		return err
+	}
+
+	if err := h.service.DeclineInvitation(ctx, article.ID.Hex(), identity.Subject); err != nil {
+		if appError := new(app.AppError); errors.As(err, &appError) {
+			switch appError.ErrorCode {
+			case app.CoAuthorInvitationNotFoundErrorCode:
+				return api.CoAuthorInvitationNotFound(request.Slug, identity.ClientUsername)
+			}
+		}
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
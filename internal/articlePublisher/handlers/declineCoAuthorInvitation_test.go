@@ -0,0 +1,73 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ravilock/goduit/api"
+	"github.com/ravilock/goduit/api/validators"
+	"github.com/ravilock/goduit/internal/app"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestDeclineCoAuthorInvitation(t *testing.T) {
+	err := validators.InitValidator()
+	require.NoError(t, err)
+	coAuthorInvitationDeclinerMock := newMockCoAuthorInvitationDecliner(t)
+	articleGetterMock := newMockArticleGetter(t)
+	handler := &DeclineCoAuthorInvitationHandler{coAuthorInvitationDeclinerMock, articleGetterMock}
+	e := echo.New()
+
+	t.Run("Should decline an invitation to co-author an article", func(t *testing.T) {
+		// Arrange
+		expectedInvitee := assembleArticleAuthor(primitive.NewObjectID().Hex())
+		expectedArticle := assembleArticleModel(primitive.NewObjectID())
+		req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/api/articles/%s/co-authors/decline", *expectedArticle.Slug), nil)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set("Goduit-Subject", expectedInvitee.ID.Hex())
+		req.Header.Set("Goduit-Client-Username", *expectedInvitee.Username)
+		req.Header.Set("Goduit-Client-Email", *expectedInvitee.Email)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("slug")
+		c.SetParamValues(*expectedArticle.Slug)
+		ctx := c.Request().Context()
+		articleGetterMock.EXPECT().GetArticleBySlug(ctx, *expectedArticle.Slug).Return(expectedArticle, nil).Once()
+		coAuthorInvitationDeclinerMock.EXPECT().DeclineInvitation(ctx, expectedArticle.ID.Hex(), expectedInvitee.ID.Hex()).Return(nil).Once()
+
+		// Act
+		err := handler.DeclineInvitation(c)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, http.StatusNoContent, rec.Code)
+	})
+
+	t.Run("Should return HTTP 404 if the user was not invited", func(t *testing.T) {
+		// Arrange
+		expectedInvitee := assembleArticleAuthor(primitive.NewObjectID().Hex())
+		expectedArticle := assembleArticleModel(primitive.NewObjectID())
+		req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/api/articles/%s/co-authors/decline", *expectedArticle.Slug), nil)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set("Goduit-Subject", expectedInvitee.ID.Hex())
+		req.Header.Set("Goduit-Client-Username", *expectedInvitee.Username)
+		req.Header.Set("Goduit-Client-Email", *expectedInvitee.Email)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("slug")
+		c.SetParamValues(*expectedArticle.Slug)
+		ctx := c.Request().Context()
+		articleGetterMock.EXPECT().GetArticleBySlug(ctx, *expectedArticle.Slug).Return(expectedArticle, nil).Once()
+		coAuthorInvitationDeclinerMock.EXPECT().DeclineInvitation(ctx, expectedArticle.ID.Hex(), expectedInvitee.ID.Hex()).Return(app.CoAuthorInvitationNotFoundError(expectedArticle.ID.Hex(), expectedInvitee.ID.Hex(), nil)).Once()
+
+		// Act
+		err := handler.DeclineInvitation(c)
+
+		// Assert
+		require.ErrorContains(t, err, api.CoAuthorInvitationNotFound(*expectedArticle.Slug, *expectedInvitee.Username).Error())
+	})
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/ravilock/goduit/internal/app"
 	"github.com/ravilock/goduit/internal/articlePublisher/models"
 	"github.com/ravilock/goduit/internal/articlePublisher/requests"
+	"github.com/ravilock/goduit/internal/etag"
 	"github.com/ravilock/goduit/internal/identity"
 )
 
@@ -82,6 +83,10 @@ func (h *DeleteCommentHandler) DeleteComment(c echo.Context) error {
 		return api.Forbidden
 	}
 
+	if ifMatch := c.Request().Header.Get(etag.HeaderIfMatch); ifMatch != "" && !etag.MatchesStrongly(ifMatch, etag.FromVersion(comment.CurrentVersion())) {
+		return api.PreconditionFailed(request.ID)
+	}
+
 	if err := h.commentDeleter.DeleteComment(ctx, request.ID); err != nil {
 		if appError := new(app.AppError); errors.As(err, &appError) {
 			switch appError.ErrorCode {
@@ -12,6 +12,7 @@ import (
 	"github.com/ravilock/goduit/api"
 	"github.com/ravilock/goduit/api/validators"
 	"github.com/ravilock/goduit/internal/app"
+	"github.com/ravilock/goduit/internal/etag"
 
 	"github.com/stretchr/testify/require"
 )
@@ -78,6 +79,33 @@ func TestDeleteComment(t *testing.T) {
 		require.ErrorContains(t, err, api.Forbidden.Error())
 	})
 
+	t.Run("Should return HTTP 412 if the comment changed since the client fetched it", func(t *testing.T) {
+		// Arrange
+		articleAuthorID := primitive.NewObjectID()
+		expectedAuthor := assembleArticleAuthor(articleAuthorID.Hex())
+		expectedArticle := assembleArticleModel(articleAuthorID)
+		expectedComment := assembleCommentModel(articleAuthorID.Hex(), expectedArticle.ID.Hex())
+		req := httptest.NewRequest(http.MethodDelete, fmt.Sprintf("/api/article/%s/comments/%s", *expectedArticle.Slug, expectedComment.ID.Hex()), nil)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set(etag.HeaderIfMatch, `"1"`)
+		req.Header.Set("Goduit-Subject", expectedAuthor.ID.Hex())
+		req.Header.Set("Goduit-Client-Username", *expectedAuthor.Username)
+		req.Header.Set("Goduit-Client-Email", *expectedAuthor.Email)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("slug", "id")
+		c.SetParamValues(*expectedArticle.Slug, expectedComment.ID.Hex())
+		ctx := c.Request().Context()
+		articleGetterMock.EXPECT().GetArticleBySlug(ctx, *expectedArticle.Slug).Return(expectedArticle, nil).Once()
+		commentGetterMock.EXPECT().GetCommentByID(ctx, expectedComment.ID.Hex()).Return(expectedComment, nil).Once()
+
+		// Act
+		err := handler.DeleteComment(c)
+
+		// Assert
+		require.ErrorContains(t, err, api.PreconditionFailed(expectedComment.ID.Hex()).Error())
+	})
+
 	t.Run("Should return HTTP 404 if no article is found", func(t *testing.T) {
 		// Arrange
 		articleAuthorID := primitive.NewObjectID()
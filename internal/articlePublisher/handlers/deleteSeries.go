@@ -0,0 +1,74 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ravilock/goduit/api"
+	"github.com/ravilock/goduit/internal/app"
+	"github.com/ravilock/goduit/internal/articlePublisher/requests"
+	"github.com/ravilock/goduit/internal/identity"
+)
+
+type seriesDeleter interface {
+	DeleteSeries(ctx context.Context, ID string) error
+}
+
+type DeleteSeriesHandler struct {
+	service      seriesDeleter
+	seriesGetter seriesGetter
+}
+
+func NewDeleteSeriesHandler(service seriesDeleter, seriesGetter seriesGetter) *DeleteSeriesHandler {
+	return &DeleteSeriesHandler{
+		service:      service,
+		seriesGetter: seriesGetter,
+	}
+}
+
+func (h *DeleteSeriesHandler) DeleteSeries(c echo.Context) error {
+	request := new(requests.SeriesSlugRequest)
+	identity := new(identity.IdentityHeaders)
+	binder := &echo.DefaultBinder{}
+	if err := binder.BindPathParams(c, request); err != nil {
+		return err
+	}
+	if err := binder.BindHeaders(c, identity); err != nil {
+		return err
+	}
+
+	if err := request.Validate(); err != nil {
+		return err
+	}
+
+	ctx := c.Request().Context()
+
+	series, err := h.seriesGetter.GetSeriesBySlug(ctx, request.Slug)
+	if err != nil {
+		if appError := new(app.AppError); errors.As(err, &appError) {
+			switch appError.ErrorCode {
+			case app.SeriesNotFoundErrorCode:
+				return api.SeriesNotFound(request.Slug)
+			}
+		}
+		return err
+	}
+
+	if identity.Subject != *series.Owner {
+		return api.Forbidden
+	}
+
+	if err := h.service.DeleteSeries(ctx, series.ID.Hex()); err != nil {
+		if appError := new(app.AppError); errors.As(err, &appError) {
+			switch appError.ErrorCode {
+			case app.SeriesNotFoundErrorCode:
+				return api.SeriesNotFound(request.Slug)
+			}
+		}
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
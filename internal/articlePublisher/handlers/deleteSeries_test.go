@@ -0,0 +1,72 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/ravilock/goduit/api"
+	"github.com/ravilock/goduit/api/validators"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestDeleteSeries(t *testing.T) {
+	err := validators.InitValidator()
+	require.NoError(t, err)
+	seriesDeleterMock := newMockSeriesDeleter(t)
+	seriesGetterMock := newMockSeriesGetter(t)
+	handler := &DeleteSeriesHandler{seriesDeleterMock, seriesGetterMock}
+	e := echo.New()
+
+	t.Run("Should delete a series", func(t *testing.T) {
+		// Arrange
+		ownerID := primitive.NewObjectID()
+		expectedOwner := assembleArticleAuthor(ownerID.Hex())
+		series := assembleSeriesModel(ownerID.Hex())
+		req := httptest.NewRequest(http.MethodDelete, fmt.Sprintf("/api/series/%s", *series.Slug), nil)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set("Goduit-Subject", expectedOwner.ID.Hex())
+		req.Header.Set("Goduit-Client-Username", *expectedOwner.Username)
+		req.Header.Set("Goduit-Client-Email", *expectedOwner.Email)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("slug")
+		c.SetParamValues(*series.Slug)
+		ctx := c.Request().Context()
+		seriesGetterMock.EXPECT().GetSeriesBySlug(ctx, *series.Slug).Return(series, nil).Once()
+		seriesDeleterMock.EXPECT().DeleteSeries(ctx, series.ID.Hex()).Return(nil).Once()
+
+		// Act
+		err := handler.DeleteSeries(c)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, http.StatusNoContent, rec.Code)
+	})
+
+	t.Run("Should only let the owner delete a series", func(t *testing.T) {
+		// Arrange
+		series := assembleSeriesModel(primitive.NewObjectID().Hex())
+		req := httptest.NewRequest(http.MethodDelete, fmt.Sprintf("/api/series/%s", *series.Slug), nil)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set("Goduit-Subject", uuid.NewString())
+		req.Header.Set("Goduit-Client-Username", "not-the-owner")
+		req.Header.Set("Goduit-Client-Email", "not.the.owner.email@test.test")
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("slug")
+		c.SetParamValues(*series.Slug)
+		ctx := c.Request().Context()
+		seriesGetterMock.EXPECT().GetSeriesBySlug(ctx, *series.Slug).Return(series, nil).Once()
+
+		// Act
+		err := handler.DeleteSeries(c)
+
+		// Assert
+		require.ErrorContains(t, err, api.Forbidden.Error())
+	})
+}
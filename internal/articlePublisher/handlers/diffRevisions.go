@@ -0,0 +1,86 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ravilock/goduit/api"
+	"github.com/ravilock/goduit/internal/app"
+	"github.com/ravilock/goduit/internal/articlePublisher/assemblers"
+	"github.com/ravilock/goduit/internal/articlePublisher/requests"
+	"github.com/ravilock/goduit/internal/identity"
+)
+
+type DiffRevisionsHandler struct {
+	service       revisionGetter
+	articleGetter articleGetter
+}
+
+func NewDiffRevisionsHandler(service revisionGetter, articleGetter articleGetter) *DiffRevisionsHandler {
+	return &DiffRevisionsHandler{
+		service:       service,
+		articleGetter: articleGetter,
+	}
+}
+
+func (h *DiffRevisionsHandler) DiffRevisions(c echo.Context) error {
+	request := new(requests.DiffRevisionsRequest)
+	identity := new(identity.IdentityHeaders)
+	binder := &echo.DefaultBinder{}
+	if err := binder.BindPathParams(c, request); err != nil {
+		return err
+	}
+	if err := binder.BindQueryParams(c, request); err != nil {
+		return err
+	}
+	if err := binder.BindHeaders(c, identity); err != nil {
+		return err
+	}
+
+	if err := request.Validate(); err != nil {
+		return err
+	}
+
+	ctx := c.Request().Context()
+
+	article, err := h.articleGetter.GetArticleBySlug(ctx, request.Slug)
+	if err != nil {
+		if appError := new(app.AppError); errors.As(err, &appError) {
+			switch appError.ErrorCode {
+			case app.ArticleNotFoundErrorCode:
+				return api.ArticleNotFound(request.Slug)
+			}
+		}
+		return err
+	}
+
+	if !article.HasAuthor(identity.Subject) {
+		return api.Forbidden
+	}
+
+	from, err := h.service.GetRevision(ctx, article.ID.Hex(), request.From)
+	if err != nil {
+		if appError := new(app.AppError); errors.As(err, &appError) {
+			switch appError.ErrorCode {
+			case app.RevisionNotFoundErrorCode:
+				return api.RevisionNotFound(request.Slug, request.From)
+			}
+		}
+		return err
+	}
+
+	to, err := h.service.GetRevision(ctx, article.ID.Hex(), request.To)
+	if err != nil {
+		if appError := new(app.AppError); errors.As(err, &appError) {
+			switch appError.ErrorCode {
+			case app.RevisionNotFoundErrorCode:
+				return api.RevisionNotFound(request.Slug, request.To)
+			}
+		}
+		return err
+	}
+
+	response := assemblers.RevisionDiffResponse(from, to)
+	return c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,96 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ravilock/goduit/api"
+	"github.com/ravilock/goduit/api/validators"
+	"github.com/ravilock/goduit/internal/app"
+	articlePublisherResponses "github.com/ravilock/goduit/internal/articlePublisher/responses"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestDiffRevisions(t *testing.T) {
+	err := validators.InitValidator()
+	require.NoError(t, err)
+	revisionGetterMock := newMockRevisionGetter(t)
+	articleGetterMock := newMockArticleGetter(t)
+	handler := &DiffRevisionsHandler{revisionGetterMock, articleGetterMock}
+	e := echo.New()
+
+	t.Run("Should diff two revisions of an article", func(t *testing.T) {
+		// Arrange
+		articleAuthorID := primitive.NewObjectID()
+		expectedAuthor := assembleArticleAuthor(articleAuthorID.Hex())
+		expectedArticle := assembleArticleModel(articleAuthorID)
+		fromRevision := assembleRevisionModel(expectedArticle, 1)
+		toRevision := assembleRevisionModel(expectedArticle, 2)
+		toBody := *fromRevision.Body + "\nNew Paragraph"
+		toRevision.Body = &toBody
+		toRevision.TagList = []string{"new-tag"}
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/articles/%s/revisions/diff?from=1&to=2", *expectedArticle.Slug), nil)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set("Goduit-Subject", articleAuthorID.Hex())
+		req.Header.Set("Goduit-Client-Username", *expectedAuthor.Username)
+		req.Header.Set("Goduit-Client-Email", *expectedAuthor.Email)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("slug")
+		c.SetParamValues(*expectedArticle.Slug)
+		ctx := c.Request().Context()
+		articleGetterMock.EXPECT().GetArticleBySlug(ctx, *expectedArticle.Slug).Return(expectedArticle, nil).Once()
+		revisionGetterMock.EXPECT().GetRevision(ctx, expectedArticle.ID.Hex(), int64(1)).Return(fromRevision, nil).Once()
+		revisionGetterMock.EXPECT().GetRevision(ctx, expectedArticle.ID.Hex(), int64(2)).Return(toRevision, nil).Once()
+
+		// Act
+		err := handler.DiffRevisions(c)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Code)
+		diffRevisionsResponse := new(articlePublisherResponses.RevisionDiffResponse)
+		err = json.Unmarshal(rec.Body.Bytes(), diffRevisionsResponse)
+		require.NoError(t, err)
+		require.Equal(t, int64(1), diffRevisionsResponse.Diff.From)
+		require.Equal(t, int64(2), diffRevisionsResponse.Diff.To)
+		require.Equal(t, []articlePublisherResponses.TextChange{
+			{Operation: "equal", Text: *fromRevision.Body},
+			{Operation: "insert", Text: "New Paragraph"},
+		}, diffRevisionsResponse.Diff.Body)
+		require.Equal(t, []string{"new-tag"}, diffRevisionsResponse.Diff.AddedTags)
+		require.Equal(t, fromRevision.TagList, diffRevisionsResponse.Diff.RemovedTags)
+	})
+
+	t.Run("Should return HTTP 404 if a revision is not found", func(t *testing.T) {
+		// Arrange
+		articleAuthorID := primitive.NewObjectID()
+		expectedAuthor := assembleArticleAuthor(articleAuthorID.Hex())
+		expectedArticle := assembleArticleModel(articleAuthorID)
+		fromRevision := assembleRevisionModel(expectedArticle, 1)
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/articles/%s/revisions/diff?from=1&to=9", *expectedArticle.Slug), nil)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set("Goduit-Subject", articleAuthorID.Hex())
+		req.Header.Set("Goduit-Client-Username", *expectedAuthor.Username)
+		req.Header.Set("Goduit-Client-Email", *expectedAuthor.Email)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("slug")
+		c.SetParamValues(*expectedArticle.Slug)
+		ctx := c.Request().Context()
+		articleGetterMock.EXPECT().GetArticleBySlug(ctx, *expectedArticle.Slug).Return(expectedArticle, nil).Once()
+		revisionGetterMock.EXPECT().GetRevision(ctx, expectedArticle.ID.Hex(), int64(1)).Return(fromRevision, nil).Once()
+		revisionGetterMock.EXPECT().GetRevision(ctx, expectedArticle.ID.Hex(), int64(9)).Return(nil, app.RevisionNotFoundError(expectedArticle.ID.Hex(), "9", nil)).Once()
+
+		// Act
+		err := handler.DiffRevisions(c)
+
+		// Assert
+		require.ErrorContains(t, err, api.RevisionNotFound(*expectedArticle.Slug, 9).Error())
+	})
+}
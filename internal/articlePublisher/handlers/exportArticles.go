@@ -0,0 +1,55 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ravilock/goduit/internal/articlePublisher/archives"
+	"github.com/ravilock/goduit/internal/articlePublisher/requests"
+	"github.com/ravilock/goduit/internal/identity"
+)
+
+type articlesArchiveExporter interface {
+	Export(ctx context.Context, author, format string, w io.Writer) (int, error)
+}
+
+type ExportArticlesHandler struct {
+	exporter articlesArchiveExporter
+}
+
+func NewExportArticlesHandler(exporter articlesArchiveExporter) *ExportArticlesHandler {
+	return &ExportArticlesHandler{
+		exporter: exporter,
+	}
+}
+
+func (h *ExportArticlesHandler) ExportArticles(c echo.Context) error {
+	request := requests.NewExportArticlesRequest()
+	identity := new(identity.IdentityHeaders)
+	binder := &echo.DefaultBinder{}
+	if err := binder.BindQueryParams(c, request); err != nil {
+		return err
+	}
+	if err := binder.BindHeaders(c, identity); err != nil {
+		return err
+	}
+
+	if err := request.Validate(); err != nil {
+		return err
+	}
+
+	ctx := c.Request().Context()
+
+	// The archive is built in memory so a failure halfway through is still answered with an error status
+	archive := new(bytes.Buffer)
+	if _, err := h.exporter.Export(ctx, identity.Subject, request.Format, archive); err != nil {
+		return err
+	}
+
+	c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", fmt.Sprintf("%s-articles.%s", identity.ClientUsername, request.Format)))
+	return c.Blob(http.StatusOK, archives.ContentType(request.Format), archive.Bytes())
+}
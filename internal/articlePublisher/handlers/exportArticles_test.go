@@ -0,0 +1,89 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ravilock/goduit/api"
+	"github.com/ravilock/goduit/api/validators"
+	mock "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestExportArticles(t *testing.T) {
+	err := validators.InitValidator()
+	require.NoError(t, err)
+	articlesArchiveExporterMock := newMockArticlesArchiveExporter(t)
+	handler := &ExportArticlesHandler{articlesArchiveExporterMock}
+	e := echo.New()
+
+	t.Run("Should send the archive of own articles", func(t *testing.T) {
+		// Arrange
+		authorID := primitive.NewObjectID()
+		expectedAuthor := assembleArticleAuthor(authorID.Hex())
+		req := httptest.NewRequest(http.MethodGet, "/api/articles/export?format=tar", nil)
+		req.Header.Set("Goduit-Subject", authorID.Hex())
+		req.Header.Set("Goduit-Client-Username", *expectedAuthor.Username)
+		req.Header.Set("Goduit-Client-Email", *expectedAuthor.Email)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		ctx := c.Request().Context()
+		articlesArchiveExporterMock.EXPECT().Export(ctx, authorID.Hex(), "tar", mock.Anything).RunAndReturn(func(_ context.Context, _, _ string, w io.Writer) (int, error) {
+			_, err := w.Write([]byte("archive"))
+			return 1, err
+		}).Once()
+
+		// Act
+		err := handler.ExportArticles(c)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Equal(t, "application/x-tar", rec.Header().Get(echo.HeaderContentType))
+		require.Equal(t, `attachment; filename="`+*expectedAuthor.Username+`-articles.tar"`, rec.Header().Get(echo.HeaderContentDisposition))
+		require.Equal(t, "archive", rec.Body.String())
+	})
+
+	t.Run("Should return HTTP 400 if format is not supported", func(t *testing.T) {
+		// Arrange
+		req := httptest.NewRequest(http.MethodGet, "/api/articles/export?format=rar", nil)
+		req.Header.Set("Goduit-Subject", primitive.NewObjectID().Hex())
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		// Act
+		err := handler.ExportArticles(c)
+
+		// Assert
+		require.ErrorContains(t, err, api.InvalidFieldError("Format", "rar").Error())
+	})
+
+	t.Run("Should not send a partial archive if the export fails", func(t *testing.T) {
+		// Arrange
+		authorID := primitive.NewObjectID()
+		req := httptest.NewRequest(http.MethodGet, "/api/articles/export", nil)
+		req.Header.Set("Goduit-Subject", authorID.Hex())
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		ctx := c.Request().Context()
+		exportError := errors.New("export error")
+		articlesArchiveExporterMock.EXPECT().Export(ctx, authorID.Hex(), "zip", mock.Anything).RunAndReturn(func(_ context.Context, _, _ string, w io.Writer) (int, error) {
+			_, err := w.Write([]byte("partial archive"))
+			require.NoError(t, err)
+			return 0, exportError
+		}).Once()
+
+		// Act
+		err := handler.ExportArticles(c)
+
+		// Assert
+		require.ErrorIs(t, err, exportError)
+		require.Empty(t, rec.Body.String())
+	})
+}
@@ -0,0 +1,107 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ravilock/goduit/api"
+	"github.com/ravilock/goduit/api/validators"
+	"github.com/ravilock/goduit/internal/app"
+	articlePublisherResponses "github.com/ravilock/goduit/internal/articlePublisher/responses"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestFavoriteArticle(t *testing.T) {
+	err := validators.InitValidator()
+	require.NoError(t, err)
+	articleFavoriterMock := newMockArticleFavoriter(t)
+	articleGetterMock := newMockArticleGetter(t)
+	profileGetterMock := newMockProfileGetter(t)
+	isFollowedCheckerMock := newMockIsFollowedChecker(t)
+	handler := &FavoriteArticleHandler{articleFavoriterMock, articleGetterMock, profileGetterMock, isFollowedCheckerMock}
+	e := echo.New()
+
+	t.Run("Should favorite an article", func(t *testing.T) {
+		// Arrange
+		articleAuthorID := primitive.NewObjectID()
+		expectedAuthor := assembleArticleAuthor(articleAuthorID.Hex())
+		expectedArticle := assembleArticleModel(articleAuthorID)
+		favoritedArticle := *expectedArticle
+		favoritesCount := int64(1)
+		favoritedArticle.FavoritesCount = &favoritesCount
+		user := assembleArticleAuthor(primitive.NewObjectID().Hex())
+		req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/api/articles/%s/favorite", *expectedArticle.Slug), nil)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set("Goduit-Subject", user.ID.Hex())
+		req.Header.Set("Goduit-Client-Username", *user.Username)
+		req.Header.Set("Goduit-Client-Email", *user.Email)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("slug")
+		c.SetParamValues(*expectedArticle.Slug)
+		ctx := c.Request().Context()
+		articleGetterMock.EXPECT().GetArticleBySlug(ctx, *expectedArticle.Slug).Return(expectedArticle, nil).Once()
+		articleFavoriterMock.EXPECT().FavoriteArticle(ctx, expectedArticle.ID.Hex(), user.ID.Hex()).Return(&favoritedArticle, nil).Once()
+		profileGetterMock.EXPECT().GetProfileByID(ctx, articleAuthorID.Hex()).Return(expectedAuthor, nil).Once()
+		isFollowedCheckerMock.EXPECT().IsFollowedBy(ctx, articleAuthorID.Hex(), user.ID.Hex()).Return(false).Once()
+
+		// Act
+		err := handler.FavoriteArticle(c)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Code)
+		favoriteArticleResponse := new(articlePublisherResponses.ArticleResponse)
+		err = json.Unmarshal(rec.Body.Bytes(), favoriteArticleResponse)
+		require.NoError(t, err)
+		require.True(t, favoriteArticleResponse.Article.Favorited)
+		require.Equal(t, favoritesCount, favoriteArticleResponse.Article.FavoritesCount)
+	})
+
+	t.Run("Should return HTTP 404 if no article is found", func(t *testing.T) {
+		// Arrange
+		inexistentSlug := "inexistent-slug"
+		user := assembleArticleAuthor(primitive.NewObjectID().Hex())
+		req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/api/articles/%s/favorite", inexistentSlug), nil)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set("Goduit-Subject", user.ID.Hex())
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("slug")
+		c.SetParamValues(inexistentSlug)
+		articleGetterMock.EXPECT().GetArticleBySlug(c.Request().Context(), inexistentSlug).Return(nil, app.ArticleNotFoundError(inexistentSlug, nil)).Once()
+
+		// Act
+		err := handler.FavoriteArticle(c)
+
+		// Assert
+		require.ErrorContains(t, err, api.ArticleNotFound(inexistentSlug).Error())
+	})
+
+	t.Run("Should return HTTP 409 if article is already favorited", func(t *testing.T) {
+		// Arrange
+		expectedArticle := assembleArticleModel(primitive.NewObjectID())
+		user := assembleArticleAuthor(primitive.NewObjectID().Hex())
+		req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/api/articles/%s/favorite", *expectedArticle.Slug), nil)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set("Goduit-Subject", user.ID.Hex())
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("slug")
+		c.SetParamValues(*expectedArticle.Slug)
+		ctx := c.Request().Context()
+		articleGetterMock.EXPECT().GetArticleBySlug(ctx, *expectedArticle.Slug).Return(expectedArticle, nil).Once()
+		articleFavoriterMock.EXPECT().FavoriteArticle(ctx, expectedArticle.ID.Hex(), user.ID.Hex()).Return(nil, app.ConflictError("favorites")).Once()
+
+		// Act
+		err := handler.FavoriteArticle(c)
+
+		// Assert
+		require.ErrorContains(t, err, api.ConfictError.Error())
+	})
+}
@@ -13,22 +13,25 @@ import (
 	"github.com/ravilock/goduit/internal/articlePublisher/requests"
 	"github.com/ravilock/goduit/internal/articlePublisher/responses"
 	"github.com/ravilock/goduit/internal/identity"
+	"github.com/ravilock/goduit/internal/pagination"
 	profileManagerAssembler "github.com/ravilock/goduit/internal/profileManager/assemblers"
 )
 
 type articleFeeder interface {
-	FeedArticles(ctx context.Context, user string, limit, offset int64) ([]*models.Article, error)
+	FeedArticles(ctx context.Context, user string, after, limit, offset int64) ([]*models.Article, int64, error)
 }
 
 type FeedArticlesHandler struct {
 	service        articleFeeder
 	profileManager profileGetter
+	favorites      isFavoritedChecker
 }
 
-func NewFeedArticlesHandler(service articleFeeder, profileManager profileGetter) *FeedArticlesHandler {
+func NewFeedArticlesHandler(service articleFeeder, profileManager profileGetter, favorites isFavoritedChecker) *FeedArticlesHandler {
 	return &FeedArticlesHandler{
 		service:        service,
 		profileManager: profileManager,
+		favorites:      favorites,
 	}
 }
 
@@ -49,7 +52,7 @@ func (h *FeedArticlesHandler) FeedArticles(c echo.Context) error {
 
 	ctx := c.Request().Context()
 
-	articles, err := h.service.FeedArticles(ctx, identity.Subject, int64(request.Pagination.Limit), int64(request.Pagination.Offset))
+	articles, next, err := h.service.FeedArticles(ctx, identity.Subject, request.Pagination.After(), int64(request.Pagination.Limit), int64(request.Pagination.Offset))
 	if err != nil {
 		if appError := new(app.AppError); errors.As(err, &appError) {
 			switch appError.ErrorCode {
@@ -73,8 +76,12 @@ func (h *FeedArticlesHandler) FeedArticles(c echo.Context) error {
 			continue
 		}
 
-		response.Articles = append(response.Articles, *assemblers.MultiArticleResponse(article, authorProfile))
+		isFavorited := h.favorites.IsFavoritedBy(ctx, article.ID.Hex(), identity.Subject)
+
+		response.Articles = append(response.Articles, *assemblers.MultiArticleResponse(article, authorProfile, isFavorited))
 	}
 
+	response.NextCursor = pagination.EncodePositionCursor(next)
+
 	return c.JSON(http.StatusOK, response)
 }
@@ -10,6 +10,8 @@ import (
 	"github.com/labstack/echo/v4"
 	"github.com/ravilock/goduit/api/validators"
 	articlePublisherResponses "github.com/ravilock/goduit/internal/articlePublisher/responses"
+	"github.com/ravilock/goduit/internal/pagination"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
@@ -19,7 +21,8 @@ func TestFeedArticles(t *testing.T) {
 	require.NoError(t, err)
 	articleFeederMock := newMockArticleFeeder(t)
 	profileGetterMock := newMockProfileGetter(t)
-	handler := &FeedArticlesHandler{articleFeederMock, profileGetterMock}
+	isFavoritedCheckerMock := newMockIsFavoritedChecker(t)
+	handler := &FeedArticlesHandler{articleFeederMock, profileGetterMock, isFavoritedCheckerMock}
 	e := echo.New()
 
 	t.Run("Should feed all articles", func(t *testing.T) {
@@ -41,8 +44,9 @@ func TestFeedArticles(t *testing.T) {
 		urlValues.Add("limit", strconv.Itoa(limit))
 		c.Request().URL.RawQuery = urlValues.Encode()
 		ctx := c.Request().Context()
-		articleFeederMock.EXPECT().FeedArticles(ctx, user.ID.Hex(), int64(limit), int64(0)).Return(expectedArticles, nil).Once()
+		articleFeederMock.EXPECT().FeedArticles(ctx, user.ID.Hex(), int64(0), int64(limit), int64(0)).Return(expectedArticles, int64(1), nil).Once()
 		profileGetterMock.EXPECT().GetProfileByID(ctx, articleAuthorID.Hex()).Return(expectedAuthor, nil).Times(limit)
+		isFavoritedCheckerMock.EXPECT().IsFavoritedBy(ctx, mock.Anything, user.ID.Hex()).Return(false).Times(limit)
 
 		// Act
 		err := handler.FeedArticles(c)
@@ -54,6 +58,7 @@ func TestFeedArticles(t *testing.T) {
 		err = json.Unmarshal(rec.Body.Bytes(), feedArticlesResponse)
 		require.NoError(t, err)
 		checkFeedArticlesResponse(t, limit, feedArticlesResponse)
+		require.Equal(t, pagination.EncodePositionCursor(1), feedArticlesResponse.NextCursor)
 	})
 }
 
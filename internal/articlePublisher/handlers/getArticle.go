@@ -2,8 +2,10 @@ package handlers
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"net/http"
+	"path"
 
 	"github.com/labstack/echo/v4"
 	"github.com/ravilock/goduit/api"
@@ -11,6 +13,7 @@ import (
 	"github.com/ravilock/goduit/internal/articlePublisher/assemblers"
 	"github.com/ravilock/goduit/internal/articlePublisher/models"
 	"github.com/ravilock/goduit/internal/articlePublisher/requests"
+	"github.com/ravilock/goduit/internal/etag"
 	"github.com/ravilock/goduit/internal/identity"
 	profileManagerAssembler "github.com/ravilock/goduit/internal/profileManager/assemblers"
 	profileManagerModels "github.com/ravilock/goduit/internal/profileManager/models"
@@ -29,21 +32,42 @@ type isFollowedChecker interface {
 	IsFollowedBy(ctx context.Context, followed, following string) bool
 }
 
+type isFavoritedChecker interface {
+	IsFavoritedBy(ctx context.Context, article, user string) bool
+}
+
+type seriesNavigator interface {
+	GetSeriesNavigation(ctx context.Context, article *models.Article) (*models.SeriesNavigation, error)
+}
+
+type viewCounter interface {
+	CountView(article, viewer string)
+}
+
 type GetArticleHandler struct {
 	service         articleGetter
 	profileManager  profileGetter
 	followerCentral isFollowedChecker
+	favorites       isFavoritedChecker
+	series          seriesNavigator
+	views           viewCounter
 }
 
 func NewGetArticleHandler(
 	service articleGetter,
 	profileManager profileGetter,
 	followerCentral isFollowedChecker,
+	favorites isFavoritedChecker,
+	series seriesNavigator,
+	views viewCounter,
 ) *GetArticleHandler {
 	return &GetArticleHandler{
 		service:         service,
 		profileManager:  profileManager,
 		followerCentral: followerCentral,
+		favorites:       favorites,
+		series:          series,
+		views:           views,
 	}
 }
 
@@ -75,6 +99,19 @@ func (h *GetArticleHandler) GetArticle(c echo.Context) error {
 		return err
 	}
 
+	if !article.IsPublished() && !article.HasAuthor(identity.Subject) {
+		return api.ArticleNotFound(request.Slug)
+	}
+
+	if *article.Slug != request.Slug {
+		location := path.Join(path.Dir(c.Request().URL.Path), *article.Slug)
+		return c.Redirect(http.StatusMovedPermanently, location)
+	}
+
+	if article.IsPublished() && !article.HasAuthor(identity.Subject) {
+		h.views.CountView(article.ID.Hex(), viewerOf(c, identity))
+	}
+
 	author, err := h.profileManager.GetProfileByID(ctx, *article.Author)
 	if err != nil {
 		return err
@@ -87,7 +124,40 @@ func (h *GetArticleHandler) GetArticle(c echo.Context) error {
 		return err
 	}
 
-	response := assemblers.ArticleResponse(article, authorProfile)
+	isFavorited := h.favorites.IsFavoritedBy(ctx, article.ID.Hex(), identity.Subject)
+
+	response := assemblers.ArticleResponse(article, authorProfile, isFavorited)
+	if err := withCoAuthors(ctx, h.profileManager, article, response); err != nil {
+		return err
+	}
+
+	navigation, err := h.series.GetSeriesNavigation(ctx, article)
+	if err != nil {
+		return err
+	}
+	if navigation != nil {
+		response.Article.Series = assemblers.SeriesNavigationResponse(navigation)
+	}
+
+	// The response depends on the viewer and on the authors' profiles as much as on the article, so it is tagged
+	// after the exact body rather than the article's version alone
+	body, err := json.Marshal(response)
+	if err != nil {
+		return err
+	}
+	tag := etag.FromRepresentation(article.CurrentVersion(), body)
+	c.Response().Header().Set(etag.HeaderETag, tag)
+	if etag.Matches(c.Request().Header.Get(etag.HeaderIfNoneMatch), tag) {
+		return c.NoContent(http.StatusNotModified)
+	}
+
+	return c.JSONBlob(http.StatusOK, body)
+}
 
-	return c.JSON(http.StatusOK, response)
+// viewerOf identifies who is viewing an article: the authenticated user, or the client address of anonymous viewers.
+func viewerOf(c echo.Context, identity *identity.IdentityHeaders) string {
+	if identity.Subject != "" {
+		return identity.Subject
+	}
+	return "client:" + c.RealIP()
 }
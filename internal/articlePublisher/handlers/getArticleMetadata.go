@@ -0,0 +1,83 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ravilock/goduit/api"
+	"github.com/ravilock/goduit/internal/app"
+	"github.com/ravilock/goduit/internal/articlePublisher/assemblers"
+	"github.com/ravilock/goduit/internal/articlePublisher/requests"
+	"github.com/ravilock/goduit/internal/etag"
+)
+
+type GetArticleMetadataHandler struct {
+	service        articleGetter
+	profileManager profileGetter
+	siteURL        string
+}
+
+func NewGetArticleMetadataHandler(service articleGetter, profileManager profileGetter, siteURL string) *GetArticleMetadataHandler {
+	return &GetArticleMetadataHandler{
+		service:        service,
+		profileManager: profileManager,
+		siteURL:        siteURL,
+	}
+}
+
+// GetArticleMetadata describes a published article for search engines and link previews. Only published articles are
+// described, since drafts and scheduled articles are not meant to be indexed.
+func (h *GetArticleMetadataHandler) GetArticleMetadata(c echo.Context) error {
+	request := new(requests.ArticleSlugRequest)
+	binder := &echo.DefaultBinder{}
+	if err := binder.BindPathParams(c, request); err != nil {
+		return err
+	}
+
+	if err := request.Validate(); err != nil {
+		return err
+	}
+
+	ctx := c.Request().Context()
+
+	article, err := h.service.GetArticleBySlug(ctx, request.Slug)
+	if err != nil {
+		if appError := new(app.AppError); errors.As(err, &appError) {
+			switch appError.ErrorCode {
+			case app.ArticleNotFoundErrorCode:
+				return api.ArticleNotFound(request.Slug)
+			}
+		}
+		return err
+	}
+
+	if !article.IsPublished() {
+		return api.ArticleNotFound(request.Slug)
+	}
+
+	if *article.Slug != request.Slug {
+		location := path.Join(path.Dir(path.Dir(c.Request().URL.Path)), *article.Slug, path.Base(c.Request().URL.Path))
+		return c.Redirect(http.StatusMovedPermanently, location)
+	}
+
+	author, err := h.profileManager.GetProfileByID(ctx, *article.Author)
+	if err != nil {
+		return err
+	}
+
+	// Metadata changes along with the article and with the author's profile
+	tag := etag.FromDigest(
+		fmt.Sprintf("%s:%d", article.ID.Hex(), article.CurrentVersion()),
+		fmt.Sprintf("%s:%d", author.ID.Hex(), author.CurrentVersion()),
+	)
+	c.Response().Header().Set(etag.HeaderETag, tag)
+	if etag.Matches(c.Request().Header.Get(etag.HeaderIfNoneMatch), tag) {
+		return c.NoContent(http.StatusNotModified)
+	}
+
+	response := assemblers.ArticleMetadataResponse(article, author, h.siteURL)
+	return c.JSON(http.StatusOK, response)
+}
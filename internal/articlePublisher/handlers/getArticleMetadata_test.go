@@ -0,0 +1,205 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ravilock/goduit/api"
+	"github.com/ravilock/goduit/api/validators"
+	"github.com/ravilock/goduit/internal/app"
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+	articlePublisherResponses "github.com/ravilock/goduit/internal/articlePublisher/responses"
+	"github.com/ravilock/goduit/internal/etag"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestGetArticleMetadata(t *testing.T) {
+	err := validators.InitValidator()
+	require.NoError(t, err)
+	articleGetterMock := newMockArticleGetter(t)
+	profileGetterMock := newMockProfileGetter(t)
+	handler := NewGetArticleMetadataHandler(articleGetterMock, profileGetterMock, "http://localhost:5173")
+	e := echo.New()
+
+	t.Run("Should describe a published article", func(t *testing.T) {
+		// Arrange
+		authorID := primitive.NewObjectID()
+		article := assembleArticleModel(authorID)
+		publishedAt := time.Date(2024, time.January, 1, 8, 0, 0, 0, time.UTC)
+		updatedAt := time.Date(2024, time.January, 2, 10, 0, 0, 0, time.UTC)
+		wordCount := int64(120)
+		article.PublishedAt = &publishedAt
+		article.UpdatedAt = &updatedAt
+		article.WordCount = &wordCount
+		author := assembleArticleAuthor(authorID.Hex())
+		image := "http://localhost:3000/api/media/avatar.png"
+		author.Image = &image
+		req := httptest.NewRequest(http.MethodGet, "/api/articles/article-title/metadata", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("slug")
+		c.SetParamValues(*article.Slug)
+		ctx := c.Request().Context()
+		articleGetterMock.EXPECT().GetArticleBySlug(ctx, *article.Slug).Return(article, nil).Once()
+		profileGetterMock.EXPECT().GetProfileByID(ctx, authorID.Hex()).Return(author, nil).Once()
+
+		// Act
+		err := handler.GetArticleMetadata(c)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.NotEmpty(t, rec.Header().Get(etag.HeaderETag))
+		metadataResponse := new(articlePublisherResponses.ArticleMetadataResponse)
+		err = json.Unmarshal(rec.Body.Bytes(), metadataResponse)
+		require.NoError(t, err)
+		metadata := metadataResponse.Metadata
+		articleURL := "http://localhost:5173/article/article-title"
+		authorURL := "http://localhost:5173/profile/" + authorID.Hex()
+		require.Equal(t, *article.Title, metadata.Title)
+		require.Equal(t, *article.Description, metadata.Description)
+		require.Equal(t, articleURL, metadata.CanonicalURL)
+		require.Equal(t, articlePublisherResponses.OpenGraph{
+			Type:          "article",
+			Title:         *article.Title,
+			Description:   *article.Description,
+			URL:           articleURL,
+			SiteName:      "goduit",
+			Image:         image,
+			PublishedTime: "2024-01-01T08:00:00Z",
+			ModifiedTime:  "2024-01-02T10:00:00Z",
+			Author:        authorURL,
+			Tags:          article.TagList,
+		}, metadata.OpenGraph)
+		require.Equal(t, articlePublisherResponses.TwitterCard{
+			Card:        "summary",
+			Title:       *article.Title,
+			Description: *article.Description,
+			Image:       image,
+		}, metadata.Twitter)
+		require.Equal(t, "https://schema.org", metadata.StructuredData.Context)
+		require.Equal(t, "Article", metadata.StructuredData.Type)
+		require.Equal(t, *article.Title, metadata.StructuredData.Headline)
+		require.Equal(t, "2024-01-01T08:00:00Z", metadata.StructuredData.DatePublished)
+		require.Equal(t, "2024-01-02T10:00:00Z", metadata.StructuredData.DateModified)
+		require.Equal(t, articlePublisherResponses.StructuredDataPerson{Type: "Person", Name: authorID.Hex(), URL: authorURL, Image: image}, metadata.StructuredData.Author)
+		require.Equal(t, article.TagList, metadata.StructuredData.Keywords)
+		require.Equal(t, wordCount, metadata.StructuredData.WordCount)
+	})
+
+	t.Run("Should return HTTP 304 if metadata did not change since it was fetched", func(t *testing.T) {
+		// Arrange
+		authorID := primitive.NewObjectID()
+		article := assembleArticleModel(authorID)
+		author := assembleArticleAuthor(authorID.Hex())
+		req := httptest.NewRequest(http.MethodGet, "/api/articles/article-title/metadata", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("slug")
+		c.SetParamValues(*article.Slug)
+		ctx := c.Request().Context()
+		articleGetterMock.EXPECT().GetArticleBySlug(ctx, *article.Slug).Return(article, nil).Twice()
+		profileGetterMock.EXPECT().GetProfileByID(ctx, authorID.Hex()).Return(author, nil).Twice()
+		err := handler.GetArticleMetadata(c)
+		require.NoError(t, err)
+		req.Header.Set(etag.HeaderIfNoneMatch, rec.Header().Get(etag.HeaderETag))
+		rec = httptest.NewRecorder()
+		c = e.NewContext(req, rec)
+		c.SetParamNames("slug")
+		c.SetParamValues(*article.Slug)
+
+		// Act
+		err = handler.GetArticleMetadata(c)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, http.StatusNotModified, rec.Code)
+	})
+
+	t.Run("Should redirect to the current slug of an article", func(t *testing.T) {
+		// Arrange
+		authorID := primitive.NewObjectID()
+		article := assembleArticleModel(authorID)
+		req := httptest.NewRequest(http.MethodGet, "/api/articles/old-article-title/metadata", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("slug")
+		c.SetParamValues("old-article-title")
+		ctx := c.Request().Context()
+		articleGetterMock.EXPECT().GetArticleBySlug(ctx, "old-article-title").Return(article, nil).Once()
+
+		// Act
+		err := handler.GetArticleMetadata(c)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, http.StatusMovedPermanently, rec.Code)
+		require.Equal(t, "/api/articles/article-title/metadata", rec.Header().Get(echo.HeaderLocation))
+	})
+
+	t.Run("Should return HTTP 404 if article is not published", func(t *testing.T) {
+		// Arrange
+		authorID := primitive.NewObjectID()
+		article := assembleArticleModel(authorID)
+		draft := models.ArticleStatusDraft
+		article.Status = &draft
+		req := httptest.NewRequest(http.MethodGet, "/api/articles/article-title/metadata", nil)
+		req.Header.Set("Goduit-Subject", authorID.Hex())
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("slug")
+		c.SetParamValues(*article.Slug)
+		ctx := c.Request().Context()
+		articleGetterMock.EXPECT().GetArticleBySlug(ctx, *article.Slug).Return(article, nil).Once()
+
+		// Act
+		err := handler.GetArticleMetadata(c)
+
+		// Assert
+		require.ErrorContains(t, err, api.ArticleNotFound(*article.Slug).Error())
+	})
+
+	t.Run("Should return HTTP 404 if article is not found", func(t *testing.T) {
+		// Arrange
+		req := httptest.NewRequest(http.MethodGet, "/api/articles/missing-article/metadata", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("slug")
+		c.SetParamValues("missing-article")
+		ctx := c.Request().Context()
+		articleGetterMock.EXPECT().GetArticleBySlug(ctx, "missing-article").Return(nil, app.ArticleNotFoundError("missing-article", nil)).Once()
+
+		// Act
+		err := handler.GetArticleMetadata(c)
+
+		// Assert
+		require.ErrorContains(t, err, api.ArticleNotFound("missing-article").Error())
+	})
+
+	t.Run("Should return unexpected errors", func(t *testing.T) {
+		// Arrange
+		authorID := primitive.NewObjectID()
+		article := assembleArticleModel(authorID)
+		req := httptest.NewRequest(http.MethodGet, "/api/articles/article-title/metadata", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("slug")
+		c.SetParamValues(*article.Slug)
+		ctx := c.Request().Context()
+		unexpectedError := errors.New("unexpected error")
+		articleGetterMock.EXPECT().GetArticleBySlug(ctx, *article.Slug).Return(article, nil).Once()
+		profileGetterMock.EXPECT().GetProfileByID(ctx, authorID.Hex()).Return(nil, unexpectedError).Once()
+
+		// Act
+		err := handler.GetArticleMetadata(c)
+
+		// Assert
+		require.ErrorIs(t, err, unexpectedError)
+	})
+}
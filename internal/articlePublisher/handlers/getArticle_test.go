@@ -15,7 +15,9 @@ import (
 	"github.com/ravilock/goduit/internal/app"
 	"github.com/ravilock/goduit/internal/articlePublisher/models"
 	articlePublisherResponses "github.com/ravilock/goduit/internal/articlePublisher/responses"
+	"github.com/ravilock/goduit/internal/etag"
 	profileManagerModels "github.com/ravilock/goduit/internal/profileManager/models"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
@@ -26,7 +28,10 @@ func TestGetArticle(t *testing.T) {
 	articleGetterMock := newMockArticleGetter(t)
 	profileGetterMock := newMockProfileGetter(t)
 	isFollowedCheckerMock := newMockIsFollowedChecker(t)
-	handler := &GetArticleHandler{service: articleGetterMock, profileManager: profileGetterMock, followerCentral: isFollowedCheckerMock}
+	isFavoritedCheckerMock := newMockIsFavoritedChecker(t)
+	seriesNavigatorMock := newMockSeriesNavigator(t)
+	viewCounterMock := newMockViewCounter(t)
+	handler := &GetArticleHandler{service: articleGetterMock, profileManager: profileGetterMock, followerCentral: isFollowedCheckerMock, favorites: isFavoritedCheckerMock, series: seriesNavigatorMock, views: viewCounterMock}
 
 	e := echo.New()
 
@@ -44,6 +49,9 @@ func TestGetArticle(t *testing.T) {
 		articleGetterMock.EXPECT().GetArticleBySlug(ctx, *expectedArticle.Slug).Return(expectedArticle, nil).Once()
 		profileGetterMock.EXPECT().GetProfileByID(ctx, *expectedArticle.Author).Return(expectedAuthor, nil).Once()
 		isFollowedCheckerMock.EXPECT().IsFollowedBy(ctx, *expectedArticle.Author, "").Return(false).Once()
+		isFavoritedCheckerMock.EXPECT().IsFavoritedBy(ctx, expectedArticle.ID.Hex(), "").Return(false).Once()
+		seriesNavigatorMock.EXPECT().GetSeriesNavigation(ctx, expectedArticle).Return(nil, nil).Once()
+		viewCounterMock.EXPECT().CountView(expectedArticle.ID.Hex(), "client:192.0.2.1").Once()
 
 		// Act
 		err := handler.GetArticle(c)
@@ -55,6 +63,204 @@ func TestGetArticle(t *testing.T) {
 		err = json.Unmarshal(rec.Body.Bytes(), getArticleResponse)
 		require.NoError(t, err)
 		checkGetArticleResponse(t, expectedArticle, expectedAuthor, getArticleResponse)
+		require.True(t, etag.MatchesVersion(rec.Header().Get(etag.HeaderETag), 0))
+	})
+
+	t.Run("Should not count views of the article's authors", func(t *testing.T) {
+		// Arrange
+		expectedArticle := assembleArticleModel(primitive.NewObjectID())
+		expectedAuthor := assembleArticleAuthor(*expectedArticle.Author)
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/article/%s", *expectedArticle.Slug), nil)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set("Goduit-Subject", *expectedArticle.Author)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("slug")
+		c.SetParamValues(*expectedArticle.Slug)
+		ctx := c.Request().Context()
+		articleGetterMock.EXPECT().GetArticleBySlug(ctx, *expectedArticle.Slug).Return(expectedArticle, nil).Once()
+		profileGetterMock.EXPECT().GetProfileByID(ctx, *expectedArticle.Author).Return(expectedAuthor, nil).Once()
+		isFollowedCheckerMock.EXPECT().IsFollowedBy(ctx, *expectedArticle.Author, *expectedArticle.Author).Return(false).Once()
+		isFavoritedCheckerMock.EXPECT().IsFavoritedBy(ctx, expectedArticle.ID.Hex(), *expectedArticle.Author).Return(false).Once()
+		seriesNavigatorMock.EXPECT().GetSeriesNavigation(ctx, expectedArticle).Return(nil, nil).Once()
+
+		// Act
+		err := handler.GetArticle(c)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Code)
+		viewCounterMock.AssertNotCalled(t, "CountView", expectedArticle.ID.Hex(), *expectedArticle.Author)
+	})
+
+	t.Run("Should include the article's series navigation", func(t *testing.T) {
+		// Arrange
+		authorID := primitive.NewObjectID()
+		expectedArticle := assembleArticleModel(authorID)
+		expectedAuthor := assembleArticleAuthor(*expectedArticle.Author)
+		previousArticle := assembleArticleModel(authorID)
+		previousSlug := "previous-part"
+		previousArticle.Slug = &previousSlug
+		series := assembleSeriesModel(authorID.Hex(), previousArticle, expectedArticle)
+		navigation := &models.SeriesNavigation{Series: series, Position: 2, Total: 2, Previous: previousArticle}
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/article/%s", *expectedArticle.Slug), nil)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("slug")
+		c.SetParamValues(*expectedArticle.Slug)
+		ctx := c.Request().Context()
+		articleGetterMock.EXPECT().GetArticleBySlug(ctx, *expectedArticle.Slug).Return(expectedArticle, nil).Once()
+		profileGetterMock.EXPECT().GetProfileByID(ctx, *expectedArticle.Author).Return(expectedAuthor, nil).Once()
+		isFollowedCheckerMock.EXPECT().IsFollowedBy(ctx, *expectedArticle.Author, "").Return(false).Once()
+		isFavoritedCheckerMock.EXPECT().IsFavoritedBy(ctx, expectedArticle.ID.Hex(), "").Return(false).Once()
+		seriesNavigatorMock.EXPECT().GetSeriesNavigation(ctx, expectedArticle).Return(navigation, nil).Once()
+		viewCounterMock.EXPECT().CountView(expectedArticle.ID.Hex(), "client:192.0.2.1").Once()
+
+		// Act
+		err := handler.GetArticle(c)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Code)
+		getArticleResponse := new(articlePublisherResponses.ArticleResponse)
+		err = json.Unmarshal(rec.Body.Bytes(), getArticleResponse)
+		require.NoError(t, err)
+		require.NotNil(t, getArticleResponse.Article.Series)
+		require.Equal(t, *series.Slug, getArticleResponse.Article.Series.Slug)
+		require.Equal(t, 2, getArticleResponse.Article.Series.Position)
+		require.Equal(t, 2, getArticleResponse.Article.Series.Total)
+		require.NotNil(t, getArticleResponse.Article.Series.Previous)
+		require.Equal(t, previousSlug, getArticleResponse.Article.Series.Previous.Slug)
+		require.Nil(t, getArticleResponse.Article.Series.Next)
+	})
+
+	t.Run("Should redirect old slugs to the article's current slug", func(t *testing.T) {
+		// Arrange
+		expectedArticle := assembleArticleModel(primitive.NewObjectID())
+		oldSlug := "old-article-title"
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/articles/%s", oldSlug), nil)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("slug")
+		c.SetParamValues(oldSlug)
+		ctx := c.Request().Context()
+		articleGetterMock.EXPECT().GetArticleBySlug(ctx, oldSlug).Return(expectedArticle, nil).Once()
+
+		// Act
+		err := handler.GetArticle(c)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, http.StatusMovedPermanently, rec.Code)
+		require.Equal(t, fmt.Sprintf("/api/articles/%s", *expectedArticle.Slug), rec.Header().Get(echo.HeaderLocation))
+	})
+
+	t.Run("Should return HTTP 304 if the article did not change since it was fetched", func(t *testing.T) {
+		// Arrange
+		expectedArticle := assembleArticleModel(primitive.NewObjectID())
+		version := int64(3)
+		expectedArticle.Version = &version
+		expectedAuthor := assembleArticleAuthor(*expectedArticle.Author)
+		articleGetterMock.EXPECT().GetArticleBySlug(mock.Anything, *expectedArticle.Slug).Return(expectedArticle, nil).Twice()
+		profileGetterMock.EXPECT().GetProfileByID(mock.Anything, *expectedArticle.Author).Return(expectedAuthor, nil).Twice()
+		isFollowedCheckerMock.EXPECT().IsFollowedBy(mock.Anything, *expectedArticle.Author, "").Return(false).Twice()
+		isFavoritedCheckerMock.EXPECT().IsFavoritedBy(mock.Anything, expectedArticle.ID.Hex(), "").Return(false).Twice()
+		seriesNavigatorMock.EXPECT().GetSeriesNavigation(mock.Anything, expectedArticle).Return(nil, nil).Twice()
+		viewCounterMock.EXPECT().CountView(expectedArticle.ID.Hex(), "client:192.0.2.1").Twice()
+		fetchRec := httptest.NewRecorder()
+		fetchReq := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/article/%s", *expectedArticle.Slug), nil)
+		fetchContext := e.NewContext(fetchReq, fetchRec)
+		fetchContext.SetParamNames("slug")
+		fetchContext.SetParamValues(*expectedArticle.Slug)
+		require.NoError(t, handler.GetArticle(fetchContext))
+		fetchedETag := fetchRec.Header().Get(etag.HeaderETag)
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/article/%s", *expectedArticle.Slug), nil)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set(etag.HeaderIfNoneMatch, fetchedETag)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("slug")
+		c.SetParamValues(*expectedArticle.Slug)
+
+		// Act
+		err := handler.GetArticle(c)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, http.StatusNotModified, rec.Code)
+		require.Equal(t, fetchedETag, rec.Header().Get(etag.HeaderETag))
+		require.True(t, etag.MatchesVersion(fetchedETag, version))
+		require.Empty(t, rec.Body.Bytes())
+	})
+
+	t.Run("Should not return HTTP 304 if the viewer favorited the article since it was fetched", func(t *testing.T) {
+		// Arrange
+		expectedArticle := assembleArticleModel(primitive.NewObjectID())
+		expectedAuthor := assembleArticleAuthor(*expectedArticle.Author)
+		articleGetterMock.EXPECT().GetArticleBySlug(mock.Anything, *expectedArticle.Slug).Return(expectedArticle, nil).Twice()
+		profileGetterMock.EXPECT().GetProfileByID(mock.Anything, *expectedArticle.Author).Return(expectedAuthor, nil).Twice()
+		isFollowedCheckerMock.EXPECT().IsFollowedBy(mock.Anything, *expectedArticle.Author, "").Return(false).Twice()
+		isFavoritedCheckerMock.EXPECT().IsFavoritedBy(mock.Anything, expectedArticle.ID.Hex(), "").Return(false).Once()
+		isFavoritedCheckerMock.EXPECT().IsFavoritedBy(mock.Anything, expectedArticle.ID.Hex(), "").Return(true).Once()
+		seriesNavigatorMock.EXPECT().GetSeriesNavigation(mock.Anything, expectedArticle).Return(nil, nil).Twice()
+		viewCounterMock.EXPECT().CountView(expectedArticle.ID.Hex(), "client:192.0.2.1").Twice()
+		fetchRec := httptest.NewRecorder()
+		fetchReq := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/article/%s", *expectedArticle.Slug), nil)
+		fetchContext := e.NewContext(fetchReq, fetchRec)
+		fetchContext.SetParamNames("slug")
+		fetchContext.SetParamValues(*expectedArticle.Slug)
+		require.NoError(t, handler.GetArticle(fetchContext))
+		fetchedETag := fetchRec.Header().Get(etag.HeaderETag)
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/article/%s", *expectedArticle.Slug), nil)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set(etag.HeaderIfNoneMatch, fetchedETag)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("slug")
+		c.SetParamValues(*expectedArticle.Slug)
+
+		// Act
+		err := handler.GetArticle(c)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.NotEqual(t, fetchedETag, rec.Header().Get(etag.HeaderETag))
+	})
+
+	t.Run("Should inform if the article is favorited by the user", func(t *testing.T) {
+		// Arrange
+		expectedArticle := assembleArticleModel(primitive.NewObjectID())
+		expectedAuthor := assembleArticleAuthor(*expectedArticle.Author)
+		userID := primitive.NewObjectID().Hex()
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/article/%s", *expectedArticle.Slug), nil)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set("Goduit-Subject", userID)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("slug")
+		c.SetParamValues(*expectedArticle.Slug)
+		ctx := c.Request().Context()
+		articleGetterMock.EXPECT().GetArticleBySlug(ctx, *expectedArticle.Slug).Return(expectedArticle, nil).Once()
+		profileGetterMock.EXPECT().GetProfileByID(ctx, *expectedArticle.Author).Return(expectedAuthor, nil).Once()
+		isFollowedCheckerMock.EXPECT().IsFollowedBy(ctx, *expectedArticle.Author, userID).Return(false).Once()
+		isFavoritedCheckerMock.EXPECT().IsFavoritedBy(ctx, expectedArticle.ID.Hex(), userID).Return(true).Once()
+		seriesNavigatorMock.EXPECT().GetSeriesNavigation(ctx, expectedArticle).Return(nil, nil).Once()
+		viewCounterMock.EXPECT().CountView(expectedArticle.ID.Hex(), userID).Once()
+
+		// Act
+		err := handler.GetArticle(c)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Code)
+		getArticleResponse := new(articlePublisherResponses.ArticleResponse)
+		err = json.Unmarshal(rec.Body.Bytes(), getArticleResponse)
+		require.NoError(t, err)
+		checkGetArticleResponse(t, expectedArticle, expectedAuthor, getArticleResponse)
+		require.True(t, getArticleResponse.Article.Favorited)
 	})
 
 	t.Run("Should return HTTP 404 if no article is found", func(t *testing.T) {
@@ -75,6 +281,61 @@ func TestGetArticle(t *testing.T) {
 		// Assert
 		require.ErrorContains(t, err, api.ArticleNotFound(inexistentSlug).Error())
 	})
+
+	t.Run("Should return HTTP 404 if article is a draft of another user", func(t *testing.T) {
+		// Arrange
+		expectedArticle := assembleArticleModel(primitive.NewObjectID())
+		draftStatus := models.ArticleStatusDraft
+		expectedArticle.Status = &draftStatus
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/article/%s", *expectedArticle.Slug), nil)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set("Goduit-Subject", primitive.NewObjectID().Hex())
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("slug")
+		c.SetParamValues(*expectedArticle.Slug)
+		ctx := c.Request().Context()
+		articleGetterMock.EXPECT().GetArticleBySlug(ctx, *expectedArticle.Slug).Return(expectedArticle, nil).Once()
+
+		// Act
+		err := handler.GetArticle(c)
+
+		// Assert
+		require.ErrorContains(t, err, api.ArticleNotFound(*expectedArticle.Slug).Error())
+	})
+
+	t.Run("Should get own draft", func(t *testing.T) {
+		// Arrange
+		expectedArticle := assembleArticleModel(primitive.NewObjectID())
+		draftStatus := models.ArticleStatusDraft
+		expectedArticle.Status = &draftStatus
+		expectedAuthor := assembleArticleAuthor(*expectedArticle.Author)
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/article/%s", *expectedArticle.Slug), nil)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set("Goduit-Subject", *expectedArticle.Author)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("slug")
+		c.SetParamValues(*expectedArticle.Slug)
+		ctx := c.Request().Context()
+		articleGetterMock.EXPECT().GetArticleBySlug(ctx, *expectedArticle.Slug).Return(expectedArticle, nil).Once()
+		profileGetterMock.EXPECT().GetProfileByID(ctx, *expectedArticle.Author).Return(expectedAuthor, nil).Once()
+		isFollowedCheckerMock.EXPECT().IsFollowedBy(ctx, *expectedArticle.Author, *expectedArticle.Author).Return(false).Once()
+		isFavoritedCheckerMock.EXPECT().IsFavoritedBy(ctx, expectedArticle.ID.Hex(), *expectedArticle.Author).Return(false).Once()
+		seriesNavigatorMock.EXPECT().GetSeriesNavigation(ctx, expectedArticle).Return(nil, nil).Once()
+
+		// Act
+		err := handler.GetArticle(c)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Code)
+		getArticleResponse := new(articlePublisherResponses.ArticleResponse)
+		err = json.Unmarshal(rec.Body.Bytes(), getArticleResponse)
+		require.NoError(t, err)
+		checkGetArticleResponse(t, expectedArticle, expectedAuthor, getArticleResponse)
+		require.Equal(t, models.ArticleStatusDraft, getArticleResponse.Article.Status)
+	})
 }
 
 func assembleArticleModel(authorID primitive.ObjectID) *models.Article {
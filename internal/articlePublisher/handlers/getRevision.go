@@ -0,0 +1,91 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ravilock/goduit/api"
+	"github.com/ravilock/goduit/internal/app"
+	"github.com/ravilock/goduit/internal/articlePublisher/assemblers"
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+	"github.com/ravilock/goduit/internal/articlePublisher/requests"
+	"github.com/ravilock/goduit/internal/identity"
+	profileManagerAssembler "github.com/ravilock/goduit/internal/profileManager/assemblers"
+)
+
+type revisionGetter interface {
+	GetRevision(ctx context.Context, article string, number int64) (*models.Revision, error)
+}
+
+type GetRevisionHandler struct {
+	service        revisionGetter
+	articleGetter  articleGetter
+	profileManager profileGetter
+}
+
+func NewGetRevisionHandler(service revisionGetter, articleGetter articleGetter, profileManager profileGetter) *GetRevisionHandler {
+	return &GetRevisionHandler{
+		service:        service,
+		articleGetter:  articleGetter,
+		profileManager: profileManager,
+	}
+}
+
+func (h *GetRevisionHandler) GetRevision(c echo.Context) error {
+	request := new(requests.RevisionRequest)
+	identity := new(identity.IdentityHeaders)
+	binder := &echo.DefaultBinder{}
+	if err := binder.BindPathParams(c, request); err != nil {
+		return err
+	}
+	if err := binder.BindHeaders(c, identity); err != nil {
+		return err
+	}
+
+	if err := request.Validate(); err != nil {
+		return err
+	}
+
+	ctx := c.Request().Context()
+
+	article, err := h.articleGetter.GetArticleBySlug(ctx, request.Slug)
+	if err != nil {
+		if appError := new(app.AppError); errors.As(err, &appError) {
+			switch appError.ErrorCode {
+			case app.ArticleNotFoundErrorCode:
+				return api.ArticleNotFound(request.Slug)
+			}
+		}
+		return err
+	}
+
+	if !article.HasAuthor(identity.Subject) {
+		return api.Forbidden
+	}
+
+	revision, err := h.service.GetRevision(ctx, article.ID.Hex(), request.Revision)
+	if err != nil {
+		if appError := new(app.AppError); errors.As(err, &appError) {
+			switch appError.ErrorCode {
+			case app.RevisionNotFoundErrorCode:
+				return api.RevisionNotFound(request.Slug, request.Revision)
+			}
+		}
+		return err
+	}
+
+	editor, err := h.profileManager.GetProfileByID(ctx, *revision.Author)
+	if err != nil {
+		return err
+	}
+
+	editorProfile, err := profileManagerAssembler.ProfileResponse(editor, false)
+	if err != nil {
+		return err
+	}
+
+	response := assemblers.RevisionResponse(revision, editorProfile)
+	return c.JSON(http.StatusOK, response)
+}
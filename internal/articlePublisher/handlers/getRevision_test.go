@@ -0,0 +1,126 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/ravilock/goduit/api"
+	"github.com/ravilock/goduit/api/validators"
+	"github.com/ravilock/goduit/internal/app"
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+	articlePublisherResponses "github.com/ravilock/goduit/internal/articlePublisher/responses"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestGetRevision(t *testing.T) {
+	err := validators.InitValidator()
+	require.NoError(t, err)
+	revisionGetterMock := newMockRevisionGetter(t)
+	articleGetterMock := newMockArticleGetter(t)
+	profileGetterMock := newMockProfileGetter(t)
+	handler := &GetRevisionHandler{revisionGetterMock, articleGetterMock, profileGetterMock}
+	e := echo.New()
+
+	t.Run("Should get a revision of an article", func(t *testing.T) {
+		// Arrange
+		articleAuthorID := primitive.NewObjectID()
+		expectedAuthor := assembleArticleAuthor(articleAuthorID.Hex())
+		expectedArticle := assembleArticleModel(articleAuthorID)
+		expectedRevision := assembleRevisionModel(expectedArticle, 1)
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/articles/%s/revisions/1", *expectedArticle.Slug), nil)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set("Goduit-Subject", articleAuthorID.Hex())
+		req.Header.Set("Goduit-Client-Username", *expectedAuthor.Username)
+		req.Header.Set("Goduit-Client-Email", *expectedAuthor.Email)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("slug", "revision")
+		c.SetParamValues(*expectedArticle.Slug, "1")
+		ctx := c.Request().Context()
+		articleGetterMock.EXPECT().GetArticleBySlug(ctx, *expectedArticle.Slug).Return(expectedArticle, nil).Once()
+		revisionGetterMock.EXPECT().GetRevision(ctx, expectedArticle.ID.Hex(), int64(1)).Return(expectedRevision, nil).Once()
+		profileGetterMock.EXPECT().GetProfileByID(ctx, articleAuthorID.Hex()).Return(expectedAuthor, nil).Once()
+
+		// Act
+		err := handler.GetRevision(c)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Code)
+		getRevisionResponse := new(articlePublisherResponses.RevisionResponse)
+		err = json.Unmarshal(rec.Body.Bytes(), getRevisionResponse)
+		require.NoError(t, err)
+		require.Equal(t, int64(1), getRevisionResponse.Revision.Number)
+		require.Equal(t, *expectedRevision.Title, getRevisionResponse.Revision.Title)
+		require.Equal(t, *expectedRevision.Body, getRevisionResponse.Revision.Body)
+		require.Equal(t, *expectedAuthor.Username, getRevisionResponse.Revision.Author.Username)
+	})
+
+	t.Run("Should return HTTP 404 if no revision is found", func(t *testing.T) {
+		// Arrange
+		articleAuthorID := primitive.NewObjectID()
+		expectedAuthor := assembleArticleAuthor(articleAuthorID.Hex())
+		expectedArticle := assembleArticleModel(articleAuthorID)
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/articles/%s/revisions/7", *expectedArticle.Slug), nil)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set("Goduit-Subject", articleAuthorID.Hex())
+		req.Header.Set("Goduit-Client-Username", *expectedAuthor.Username)
+		req.Header.Set("Goduit-Client-Email", *expectedAuthor.Email)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("slug", "revision")
+		c.SetParamValues(*expectedArticle.Slug, "7")
+		ctx := c.Request().Context()
+		articleGetterMock.EXPECT().GetArticleBySlug(ctx, *expectedArticle.Slug).Return(expectedArticle, nil).Once()
+		revisionGetterMock.EXPECT().GetRevision(ctx, expectedArticle.ID.Hex(), int64(7)).Return(nil, app.RevisionNotFoundError(expectedArticle.ID.Hex(), "7", nil)).Once()
+
+		// Act
+		err := handler.GetRevision(c)
+
+		// Assert
+		require.ErrorContains(t, err, api.RevisionNotFound(*expectedArticle.Slug, 7).Error())
+	})
+
+	t.Run("Should only show revisions to the article's author", func(t *testing.T) {
+		// Arrange
+		articleAuthorID := primitive.NewObjectID()
+		expectedArticle := assembleArticleModel(articleAuthorID)
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/articles/%s/revisions/1", *expectedArticle.Slug), nil)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set("Goduit-Subject", uuid.NewString())
+		req.Header.Set("Goduit-Client-Username", "not-the-author")
+		req.Header.Set("Goduit-Client-Email", "not.the.author.email@test.test")
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("slug", "revision")
+		c.SetParamValues(*expectedArticle.Slug, "1")
+		ctx := c.Request().Context()
+		articleGetterMock.EXPECT().GetArticleBySlug(ctx, *expectedArticle.Slug).Return(expectedArticle, nil).Once()
+
+		// Act
+		err := handler.GetRevision(c)
+
+		// Assert
+		require.ErrorContains(t, err, api.Forbidden.Error())
+	})
+}
+
+func assembleRevisionModel(article *models.Article, number int64) *models.Revision {
+	revision := models.NewRevision(article, *article.Author)
+	revisionID := primitive.NewObjectID()
+	now := time.Now().UTC().Truncate(time.Millisecond)
+	title := fmt.Sprintf("%s %s", *article.Title, strconv.FormatInt(number, 10))
+	revision.ID = &revisionID
+	revision.Number = &number
+	revision.Title = &title
+	revision.CreatedAt = &now
+	return revision
+}
@@ -0,0 +1,85 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ravilock/goduit/api"
+	"github.com/ravilock/goduit/internal/app"
+	"github.com/ravilock/goduit/internal/articlePublisher/assemblers"
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+	"github.com/ravilock/goduit/internal/articlePublisher/requests"
+	"github.com/ravilock/goduit/internal/identity"
+	profileManagerAssembler "github.com/ravilock/goduit/internal/profileManager/assemblers"
+)
+
+type seriesGetter interface {
+	GetSeriesBySlug(ctx context.Context, slug string) (*models.Series, error)
+}
+
+type GetSeriesHandler struct {
+	service         seriesGetter
+	articleGetter   articleByIDGetter
+	profileManager  profileGetter
+	followerCentral isFollowedChecker
+}
+
+func NewGetSeriesHandler(service seriesGetter, articleGetter articleByIDGetter, profileManager profileGetter, followerCentral isFollowedChecker) *GetSeriesHandler {
+	return &GetSeriesHandler{
+		service:         service,
+		articleGetter:   articleGetter,
+		profileManager:  profileManager,
+		followerCentral: followerCentral,
+	}
+}
+
+func (h *GetSeriesHandler) GetSeries(c echo.Context) error {
+	request := new(requests.SeriesSlugRequest)
+	identity := new(identity.IdentityHeaders)
+	binder := &echo.DefaultBinder{}
+	if err := binder.BindPathParams(c, request); err != nil {
+		return err
+	}
+	if err := binder.BindHeaders(c, identity); err != nil {
+		return err
+	}
+
+	if err := request.Validate(); err != nil {
+		return err
+	}
+
+	ctx := c.Request().Context()
+
+	series, err := h.service.GetSeriesBySlug(ctx, request.Slug)
+	if err != nil {
+		if appError := new(app.AppError); errors.As(err, &appError) {
+			switch appError.ErrorCode {
+			case app.SeriesNotFoundErrorCode:
+				return api.SeriesNotFound(request.Slug)
+			}
+		}
+		return err
+	}
+
+	owner, err := h.profileManager.GetProfileByID(ctx, *series.Owner)
+	if err != nil {
+		return err
+	}
+
+	isFollowing := h.followerCentral.IsFollowedBy(ctx, *series.Owner, identity.Subject)
+
+	ownerProfile, err := profileManagerAssembler.ProfileResponse(owner, isFollowing)
+	if err != nil {
+		return err
+	}
+
+	articles, err := listSeriesArticles(ctx, h.articleGetter, series, identity.Subject)
+	if err != nil {
+		return err
+	}
+
+	response := assemblers.SeriesResponse(series, ownerProfile, articles)
+	return c.JSON(http.StatusOK, response)
+}
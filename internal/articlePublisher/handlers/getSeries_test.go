@@ -0,0 +1,107 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ravilock/goduit/api"
+	"github.com/ravilock/goduit/api/validators"
+	"github.com/ravilock/goduit/internal/app"
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+	articlePublisherResponses "github.com/ravilock/goduit/internal/articlePublisher/responses"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestGetSeries(t *testing.T) {
+	err := validators.InitValidator()
+	require.NoError(t, err)
+	seriesGetterMock := newMockSeriesGetter(t)
+	articleGetterMock := newMockArticleByIDGetter(t)
+	profileGetterMock := newMockProfileGetter(t)
+	isFollowedCheckerMock := newMockIsFollowedChecker(t)
+	handler := &GetSeriesHandler{seriesGetterMock, articleGetterMock, profileGetterMock, isFollowedCheckerMock}
+	e := echo.New()
+
+	t.Run("Should get a series, leaving out unpublished articles of other users", func(t *testing.T) {
+		// Arrange
+		ownerID := primitive.NewObjectID()
+		expectedOwner := assembleArticleAuthor(ownerID.Hex())
+		publishedArticle, draftArticle := assembleSeriesArticles(ownerID)
+		draft := models.ArticleStatusDraft
+		draftArticle.Status = &draft
+		series := assembleSeriesModel(ownerID.Hex(), publishedArticle, draftArticle)
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/series/%s", *series.Slug), nil)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("slug")
+		c.SetParamValues(*series.Slug)
+		ctx := c.Request().Context()
+		seriesGetterMock.EXPECT().GetSeriesBySlug(ctx, *series.Slug).Return(series, nil).Once()
+		profileGetterMock.EXPECT().GetProfileByID(ctx, ownerID.Hex()).Return(expectedOwner, nil).Once()
+		isFollowedCheckerMock.EXPECT().IsFollowedBy(ctx, ownerID.Hex(), "").Return(false).Once()
+		articleGetterMock.EXPECT().GetArticleByID(ctx, publishedArticle.ID.Hex()).Return(publishedArticle, nil).Once()
+		articleGetterMock.EXPECT().GetArticleByID(ctx, draftArticle.ID.Hex()).Return(draftArticle, nil).Once()
+
+		// Act
+		err := handler.GetSeries(c)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Code)
+		seriesResponse := new(articlePublisherResponses.SeriesResponse)
+		err = json.Unmarshal(rec.Body.Bytes(), seriesResponse)
+		require.NoError(t, err)
+		require.Equal(t, *series.Slug, seriesResponse.Series.Slug)
+		require.Equal(t, *expectedOwner.Username, seriesResponse.Series.Owner.Username)
+		require.Len(t, seriesResponse.Series.Articles, 1)
+		require.Equal(t, *publishedArticle.Slug, seriesResponse.Series.Articles[0].Slug)
+	})
+
+	t.Run("Should return HTTP 404 if no series is found", func(t *testing.T) {
+		// Arrange
+		slug := "missing-series"
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/series/%s", slug), nil)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("slug")
+		c.SetParamValues(slug)
+		ctx := c.Request().Context()
+		seriesGetterMock.EXPECT().GetSeriesBySlug(ctx, slug).Return(nil, app.SeriesNotFoundError(slug, nil)).Once()
+
+		// Act
+		err := handler.GetSeries(c)
+
+		// Assert
+		require.ErrorContains(t, err, api.SeriesNotFound(slug).Error())
+	})
+}
+
+func assembleSeriesModel(ownerID string, articles ...*models.Article) *models.Series {
+	seriesID := primitive.NewObjectID()
+	slug := "series-title"
+	title := "Series Title"
+	description := "Series Description"
+	articleIDs := make([]string, 0, len(articles))
+	for _, article := range articles {
+		articleIDs = append(articleIDs, article.ID.Hex())
+	}
+	now := time.Now().UTC().Truncate(time.Millisecond)
+	return &models.Series{
+		ID:          &seriesID,
+		Owner:       &ownerID,
+		Slug:        &slug,
+		Title:       &title,
+		Description: &description,
+		Articles:    articleIDs,
+		CreatedAt:   &now,
+		UpdatedAt:   &now,
+	}
+}
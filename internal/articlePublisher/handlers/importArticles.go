@@ -0,0 +1,79 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ravilock/goduit/api"
+	"github.com/ravilock/goduit/internal/articlePublisher/archives"
+	"github.com/ravilock/goduit/internal/articlePublisher/assemblers"
+	"github.com/ravilock/goduit/internal/articlePublisher/requests"
+	"github.com/ravilock/goduit/internal/articlePublisher/responses"
+	"github.com/ravilock/goduit/internal/identity"
+)
+
+type articlesArchiveImporter interface {
+	Import(ctx context.Context, author string, archive []byte, dryRun bool) ([]*archives.ImportedFile, error)
+}
+
+type ImportArticlesHandler struct {
+	importer       articlesArchiveImporter
+	maxArchiveSize int64
+}
+
+func NewImportArticlesHandler(importer articlesArchiveImporter, maxArchiveSize int64) *ImportArticlesHandler {
+	return &ImportArticlesHandler{
+		importer:       importer,
+		maxArchiveSize: maxArchiveSize,
+	}
+}
+
+// ImportArticles imports the zip or tar archive sent as the request body.
+func (h *ImportArticlesHandler) ImportArticles(c echo.Context) error {
+	request := new(requests.ImportArticlesRequest)
+	identity := new(identity.IdentityHeaders)
+	binder := &echo.DefaultBinder{}
+	if err := binder.BindQueryParams(c, request); err != nil {
+		return err
+	}
+	if err := binder.BindHeaders(c, identity); err != nil {
+		return err
+	}
+
+	if err := request.Validate(); err != nil {
+		return err
+	}
+
+	archive, err := io.ReadAll(io.LimitReader(c.Request().Body, h.maxArchiveSize+1))
+	if err != nil {
+		return err
+	}
+	if int64(len(archive)) > h.maxArchiveSize {
+		return api.ArchiveTooLarge(h.maxArchiveSize)
+	}
+
+	ctx := c.Request().Context()
+
+	files, err := h.importer.Import(ctx, identity.Subject, archive, request.DryRun)
+	if err != nil {
+		if errors.Is(err, archives.ErrInvalidArchive) {
+			return api.InvalidArchive(err)
+		}
+		return err
+	}
+
+	response := responses.ImportArticlesResponse{DryRun: request.DryRun, Files: make([]responses.ImportedArticleFile, 0, len(files))}
+	for _, file := range files {
+		if file.Err != nil {
+			response.Failed++
+		} else {
+			response.Imported++
+		}
+		response.Files = append(response.Files, assemblers.ImportedArticleFile(file.Name, file.Article, file.Err))
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,91 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ravilock/goduit/api"
+	"github.com/ravilock/goduit/api/validators"
+	"github.com/ravilock/goduit/internal/articlePublisher/archives"
+	articlePublisherResponses "github.com/ravilock/goduit/internal/articlePublisher/responses"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestImportArticles(t *testing.T) {
+	err := validators.InitValidator()
+	require.NoError(t, err)
+	articlesArchiveImporterMock := newMockArticlesArchiveImporter(t)
+	handler := &ImportArticlesHandler{articlesArchiveImporterMock, 16}
+	e := echo.New()
+
+	t.Run("Should report the outcome of every file", func(t *testing.T) {
+		// Arrange
+		authorID := primitive.NewObjectID()
+		importedArticle := assembleArticleModel(authorID)
+		req := httptest.NewRequest(http.MethodPost, "/api/articles/import?dryRun=true", bytes.NewBufferString("archive"))
+		req.Header.Set(echo.HeaderContentType, "application/zip")
+		req.Header.Set("Goduit-Subject", authorID.Hex())
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		ctx := c.Request().Context()
+		articlesArchiveImporterMock.EXPECT().Import(ctx, authorID.Hex(), []byte("archive"), true).Return([]*archives.ImportedFile{
+			{Name: "imported.md", Article: importedArticle},
+			{Name: "untitled.md", Err: api.RequiredFieldError("Title")},
+		}, nil).Once()
+
+		// Act
+		err := handler.ImportArticles(c)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Code)
+		importArticlesResponse := new(articlePublisherResponses.ImportArticlesResponse)
+		err = json.Unmarshal(rec.Body.Bytes(), importArticlesResponse)
+		require.NoError(t, err)
+		require.True(t, importArticlesResponse.DryRun)
+		require.Equal(t, 1, importArticlesResponse.Imported)
+		require.Equal(t, 1, importArticlesResponse.Failed)
+		require.Equal(t, []articlePublisherResponses.ImportedArticleFile{
+			{Name: "imported.md", Slug: *importedArticle.Slug},
+			{Name: "untitled.md", Error: "field 'Title' is required"},
+		}, importArticlesResponse.Files)
+	})
+
+	t.Run("Should return HTTP 400 if archive cannot be read", func(t *testing.T) {
+		// Arrange
+		authorID := primitive.NewObjectID()
+		req := httptest.NewRequest(http.MethodPost, "/api/articles/import", bytes.NewBufferString("archive"))
+		req.Header.Set("Goduit-Subject", authorID.Hex())
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		ctx := c.Request().Context()
+		importError := fmt.Errorf("%w: corrupt", archives.ErrInvalidArchive)
+		articlesArchiveImporterMock.EXPECT().Import(ctx, authorID.Hex(), []byte("archive"), false).Return(nil, importError).Once()
+
+		// Act
+		err := handler.ImportArticles(c)
+
+		// Assert
+		require.ErrorContains(t, err, api.InvalidArchive(importError).Error())
+	})
+
+	t.Run("Should return HTTP 413 if archive is too large", func(t *testing.T) {
+		// Arrange
+		req := httptest.NewRequest(http.MethodPost, "/api/articles/import", bytes.NewBufferString("an archive larger than 16 bytes"))
+		req.Header.Set("Goduit-Subject", primitive.NewObjectID().Hex())
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		// Act
+		err := handler.ImportArticles(c)
+
+		// Assert
+		require.ErrorContains(t, err, api.ArchiveTooLarge(16).Error())
+	})
+}
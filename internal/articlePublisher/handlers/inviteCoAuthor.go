@@ -0,0 +1,116 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ravilock/goduit/api"
+	"github.com/ravilock/goduit/internal/app"
+	"github.com/ravilock/goduit/internal/articlePublisher/assemblers"
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+	"github.com/ravilock/goduit/internal/articlePublisher/requests"
+	"github.com/ravilock/goduit/internal/identity"
+	profileManagerAssembler "github.com/ravilock/goduit/internal/profileManager/assemblers"
+)
+
+type coAuthorInviter interface {
+	InviteCoAuthor(ctx context.Context, article *models.Article, invitee string) (*models.CoAuthorInvitation, error)
+}
+
+type InviteCoAuthorHandler struct {
+	service        coAuthorInviter
+	articleGetter  articleGetter
+	profileManager profileGetter
+}
+
+func NewInviteCoAuthorHandler(service coAuthorInviter, articleGetter articleGetter, profileManager profileGetter) *InviteCoAuthorHandler {
+	return &InviteCoAuthorHandler{
+		service:        service,
+		articleGetter:  articleGetter,
+		profileManager: profileManager,
+	}
+}
+
+func (h *InviteCoAuthorHandler) InviteCoAuthor(c echo.Context) error {
+	request := new(requests.InviteCoAuthorRequest)
+	identity := new(identity.IdentityHeaders)
+	binder := &echo.DefaultBinder{}
+	if err := binder.BindBody(c, request); err != nil {
+		return api.CouldNotUnmarshalBodyError
+	}
+	if err := binder.BindPathParams(c, request); err != nil {
+		return err
+	}
+	if err := binder.BindHeaders(c, identity); err != nil {
+		return err
+	}
+
+	if err := request.Validate(); err != nil {
+		return err
+	}
+
+	ctx := c.Request().Context()
+
+	article, err := h.articleGetter.GetArticleBySlug(ctx, request.Slug)
+	if err != nil {
+		if appError := new(app.AppError); errors.As(err, &appError) {
+			switch appError.ErrorCode {
+			case app.ArticleNotFoundErrorCode:
+				return api.ArticleNotFound(request.Slug)
+			}
+		}
+		return err
+	}
+
+	if identity.Subject != *article.Author {
+		return api.Forbidden
+	}
+
+	invitee, err := h.profileManager.GetProfileByUsername(ctx, request.CoAuthor.Username)
+	if err != nil {
+		if appError := new(app.AppError); errors.As(err, &appError) {
+			switch appError.ErrorCode {
+			case app.UserNotFoundErrorCode:
+				return api.UserNotFound(request.CoAuthor.Username)
+			}
+		}
+		return err
+	}
+
+	invitation, err := h.service.InviteCoAuthor(ctx, article, invitee.ID.Hex())
+	if err != nil {
+		if appError := new(app.AppError); errors.As(err, &appError) {
+			switch appError.ErrorCode {
+			case app.ConflictErrorCode:
+				return api.ConfictError
+			}
+		}
+		return err
+	}
+
+	inviter, err := h.profileManager.GetProfileByID(ctx, identity.Subject)
+	if err != nil {
+		if appError := new(app.AppError); errors.As(err, &appError) {
+			switch appError.ErrorCode {
+			case app.UserNotFoundErrorCode:
+				return api.UserNotFound(identity.ClientUsername)
+			}
+		}
+		return err
+	}
+
+	inviterProfile, err := profileManagerAssembler.ProfileResponse(inviter, false)
+	if err != nil {
+		return err
+	}
+
+	inviteeProfile, err := profileManagerAssembler.ProfileResponse(invitee, false)
+	if err != nil {
+		return err
+	}
+
+	response := assemblers.CoAuthorInvitationResponse(invitation, article, inviterProfile, inviteeProfile)
+	return c.JSON(http.StatusCreated, response)
+}
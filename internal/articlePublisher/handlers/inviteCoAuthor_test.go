@@ -0,0 +1,161 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ravilock/goduit/api"
+	"github.com/ravilock/goduit/api/validators"
+	"github.com/ravilock/goduit/internal/app"
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+	articlePublisherRequests "github.com/ravilock/goduit/internal/articlePublisher/requests"
+	articlePublisherResponses "github.com/ravilock/goduit/internal/articlePublisher/responses"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestInviteCoAuthor(t *testing.T) {
+	err := validators.InitValidator()
+	require.NoError(t, err)
+	coAuthorInviterMock := newMockCoAuthorInviter(t)
+	articleGetterMock := newMockArticleGetter(t)
+	profileGetterMock := newMockProfileGetter(t)
+	handler := &InviteCoAuthorHandler{coAuthorInviterMock, articleGetterMock, profileGetterMock}
+	e := echo.New()
+
+	t.Run("Should invite a user to co-author an article", func(t *testing.T) {
+		// Arrange
+		articleAuthorID := primitive.NewObjectID()
+		expectedAuthor := assembleArticleAuthor(articleAuthorID.Hex())
+		expectedInvitee := assembleArticleAuthor(primitive.NewObjectID().Hex())
+		expectedArticle := assembleArticleModel(articleAuthorID)
+		articleID := expectedArticle.ID.Hex()
+		inviteeID := expectedInvitee.ID.Hex()
+		now := time.Now().UTC().Truncate(time.Millisecond)
+		expectedInvitation := &models.CoAuthorInvitation{Article: &articleID, Invitee: &inviteeID, Inviter: expectedArticle.Author, CreatedAt: &now}
+		requestBody, err := json.Marshal(generateInviteCoAuthorBody(*expectedInvitee.Username))
+		require.NoError(t, err)
+		req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/api/articles/%s/co-authors", *expectedArticle.Slug), bytes.NewBuffer(requestBody))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set("Goduit-Subject", expectedAuthor.ID.Hex())
+		req.Header.Set("Goduit-Client-Username", *expectedAuthor.Username)
+		req.Header.Set("Goduit-Client-Email", *expectedAuthor.Email)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("slug")
+		c.SetParamValues(*expectedArticle.Slug)
+		ctx := c.Request().Context()
+		articleGetterMock.EXPECT().GetArticleBySlug(ctx, *expectedArticle.Slug).Return(expectedArticle, nil).Once()
+		profileGetterMock.EXPECT().GetProfileByUsername(ctx, *expectedInvitee.Username).Return(expectedInvitee, nil).Once()
+		coAuthorInviterMock.EXPECT().InviteCoAuthor(ctx, expectedArticle, inviteeID).Return(expectedInvitation, nil).Once()
+		profileGetterMock.EXPECT().GetProfileByID(ctx, expectedAuthor.ID.Hex()).Return(expectedAuthor, nil).Once()
+
+		// Act
+		err = handler.InviteCoAuthor(c)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, http.StatusCreated, rec.Code)
+		invitationResponse := new(articlePublisherResponses.CoAuthorInvitationResponse)
+		err = json.Unmarshal(rec.Body.Bytes(), invitationResponse)
+		require.NoError(t, err)
+		require.Equal(t, *expectedArticle.Slug, invitationResponse.Invitation.Slug)
+		require.Equal(t, *expectedAuthor.Username, invitationResponse.Invitation.Inviter.Username)
+		require.Equal(t, *expectedInvitee.Username, invitationResponse.Invitation.Invitee.Username)
+	})
+
+	t.Run("Should only let the primary author invite co-authors", func(t *testing.T) {
+		// Arrange
+		articleAuthorID := primitive.NewObjectID()
+		coAuthor := assembleArticleAuthor(primitive.NewObjectID().Hex())
+		expectedArticle := assembleArticleModel(articleAuthorID)
+		expectedArticle.CoAuthors = []string{coAuthor.ID.Hex()}
+		requestBody, err := json.Marshal(generateInviteCoAuthorBody("another-user"))
+		require.NoError(t, err)
+		req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/api/articles/%s/co-authors", *expectedArticle.Slug), bytes.NewBuffer(requestBody))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set("Goduit-Subject", coAuthor.ID.Hex())
+		req.Header.Set("Goduit-Client-Username", *coAuthor.Username)
+		req.Header.Set("Goduit-Client-Email", *coAuthor.Email)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("slug")
+		c.SetParamValues(*expectedArticle.Slug)
+		ctx := c.Request().Context()
+		articleGetterMock.EXPECT().GetArticleBySlug(ctx, *expectedArticle.Slug).Return(expectedArticle, nil).Once()
+
+		// Act
+		err = handler.InviteCoAuthor(c)
+
+		// Assert
+		require.ErrorContains(t, err, api.Forbidden.Error())
+	})
+
+	t.Run("Should return HTTP 404 if the invited user does not exist", func(t *testing.T) {
+		// Arrange
+		articleAuthorID := primitive.NewObjectID()
+		expectedAuthor := assembleArticleAuthor(articleAuthorID.Hex())
+		expectedArticle := assembleArticleModel(articleAuthorID)
+		inviteeUsername := "ghost-user"
+		requestBody, err := json.Marshal(generateInviteCoAuthorBody(inviteeUsername))
+		require.NoError(t, err)
+		req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/api/articles/%s/co-authors", *expectedArticle.Slug), bytes.NewBuffer(requestBody))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set("Goduit-Subject", expectedAuthor.ID.Hex())
+		req.Header.Set("Goduit-Client-Username", *expectedAuthor.Username)
+		req.Header.Set("Goduit-Client-Email", *expectedAuthor.Email)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("slug")
+		c.SetParamValues(*expectedArticle.Slug)
+		ctx := c.Request().Context()
+		articleGetterMock.EXPECT().GetArticleBySlug(ctx, *expectedArticle.Slug).Return(expectedArticle, nil).Once()
+		profileGetterMock.EXPECT().GetProfileByUsername(ctx, inviteeUsername).Return(nil, app.UserNotFoundError(inviteeUsername, nil)).Once()
+
+		// Act
+		err = handler.InviteCoAuthor(c)
+
+		// Assert
+		require.ErrorContains(t, err, api.UserNotFound(inviteeUsername).Error())
+	})
+
+	t.Run("Should return HTTP 409 if the invited user is already an author", func(t *testing.T) {
+		// Arrange
+		articleAuthorID := primitive.NewObjectID()
+		expectedAuthor := assembleArticleAuthor(articleAuthorID.Hex())
+		expectedArticle := assembleArticleModel(articleAuthorID)
+		requestBody, err := json.Marshal(generateInviteCoAuthorBody(*expectedAuthor.Username))
+		require.NoError(t, err)
+		req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/api/articles/%s/co-authors", *expectedArticle.Slug), bytes.NewBuffer(requestBody))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set("Goduit-Subject", expectedAuthor.ID.Hex())
+		req.Header.Set("Goduit-Client-Username", *expectedAuthor.Username)
+		req.Header.Set("Goduit-Client-Email", *expectedAuthor.Email)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("slug")
+		c.SetParamValues(*expectedArticle.Slug)
+		ctx := c.Request().Context()
+		articleGetterMock.EXPECT().GetArticleBySlug(ctx, *expectedArticle.Slug).Return(expectedArticle, nil).Once()
+		profileGetterMock.EXPECT().GetProfileByUsername(ctx, *expectedAuthor.Username).Return(expectedAuthor, nil).Once()
+		coAuthorInviterMock.EXPECT().InviteCoAuthor(ctx, expectedArticle, expectedAuthor.ID.Hex()).Return(nil, app.ConflictError("coAuthors")).Once()
+
+		// Act
+		err = handler.InviteCoAuthor(c)
+
+		// Assert
+		require.ErrorContains(t, err, api.ConfictError.Error())
+	})
+}
+
+func generateInviteCoAuthorBody(username string) *articlePublisherRequests.InviteCoAuthorRequest {
+	request := new(articlePublisherRequests.InviteCoAuthorRequest)
+	request.CoAuthor.Username = username
+	return request
+}
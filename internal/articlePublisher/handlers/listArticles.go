@@ -6,30 +6,34 @@ import (
 	"net/http"
 
 	"github.com/labstack/echo/v4"
+	"github.com/ravilock/goduit/api"
 	"github.com/ravilock/goduit/internal/app"
 	"github.com/ravilock/goduit/internal/articlePublisher/assemblers"
 	"github.com/ravilock/goduit/internal/articlePublisher/models"
 	"github.com/ravilock/goduit/internal/articlePublisher/requests"
 	"github.com/ravilock/goduit/internal/articlePublisher/responses"
 	"github.com/ravilock/goduit/internal/identity"
+	"github.com/ravilock/goduit/internal/pagination"
 	profileManagerAssembler "github.com/ravilock/goduit/internal/profileManager/assemblers"
 )
 
 type articleLister interface {
-	ListArticles(ctx context.Context, author, tag string, limit, offset int64) ([]*models.Article, error)
+	ListArticles(ctx context.Context, author, tag, favorited, after string, limit, offset int64) ([]*models.Article, error)
 }
 
 type ListArticlesHandler struct {
 	service         articleLister
 	profileManager  profileGetter
 	followerCentral isFollowedChecker
+	favorites       isFavoritedChecker
 }
 
-func NewListArticlesHandler(service articleLister, profileManager profileGetter, followerCentral isFollowedChecker) *ListArticlesHandler {
+func NewListArticlesHandler(service articleLister, profileManager profileGetter, followerCentral isFollowedChecker, favorites isFavoritedChecker) *ListArticlesHandler {
 	return &ListArticlesHandler{
 		service:         service,
 		profileManager:  profileManager,
 		followerCentral: followerCentral,
+		favorites:       favorites,
 	}
 }
 
@@ -61,8 +65,25 @@ func (h *ListArticlesHandler) ListArticles(c echo.Context) error {
 		}
 	}
 
-	articles, err := h.service.ListArticles(ctx, request.Filters.Author, request.Filters.Tag, int64(request.Pagination.Limit), int64(request.Pagination.Offset))
+	if request.Filters.Favorited != "" {
+		favoritingUser, err := h.profileManager.GetProfileByUsername(ctx, request.Filters.Favorited)
+		if err != nil {
+			if appError := new(app.AppError); !errors.As(err, &appError) {
+				return err
+			}
+		} else {
+			request.Filters.Favorited = favoritingUser.ID.Hex()
+		}
+	}
+
+	articles, err := h.service.ListArticles(ctx, request.Filters.Author, request.Filters.Tag, request.Filters.Favorited, request.Pagination.After(), int64(request.Pagination.Limit), int64(request.Pagination.Offset))
 	if err != nil {
+		if appError := new(app.AppError); errors.As(err, &appError) {
+			switch appError.ErrorCode {
+			case app.ArticleNotFoundErrorCode:
+				return api.InvalidFieldError("Cursor", request.Pagination.Cursor)
+			}
+		}
 		return err
 	}
 
@@ -81,7 +102,13 @@ func (h *ListArticlesHandler) ListArticles(c echo.Context) error {
 			continue
 		}
 
-		response.Articles = append(response.Articles, *assemblers.MultiArticleResponse(article, authorProfile))
+		isFavorited := h.favorites.IsFavoritedBy(ctx, article.ID.Hex(), identity.Subject)
+
+		response.Articles = append(response.Articles, *assemblers.MultiArticleResponse(article, authorProfile, isFavorited))
+	}
+
+	if len(articles) == request.Pagination.Limit {
+		response.NextCursor = pagination.EncodeCursor(articles[len(articles)-1].ID.Hex())
 	}
 
 	return c.JSON(http.StatusOK, response)
@@ -9,9 +9,13 @@ import (
 	"testing"
 
 	"github.com/labstack/echo/v4"
+	"github.com/ravilock/goduit/api"
 	"github.com/ravilock/goduit/api/validators"
+	"github.com/ravilock/goduit/internal/app"
 	"github.com/ravilock/goduit/internal/articlePublisher/models"
 	articlePublisherResponses "github.com/ravilock/goduit/internal/articlePublisher/responses"
+	"github.com/ravilock/goduit/internal/pagination"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
@@ -22,7 +26,8 @@ func TestListArticles(t *testing.T) {
 	articleListerMock := newMockArticleLister(t)
 	profileGetterMock := newMockProfileGetter(t)
 	isFollowedCheckerMock := newMockIsFollowedChecker(t)
-	handler := &ListArticlesHandler{articleListerMock, profileGetterMock, isFollowedCheckerMock}
+	isFavoritedCheckerMock := newMockIsFavoritedChecker(t)
+	handler := &ListArticlesHandler{articleListerMock, profileGetterMock, isFollowedCheckerMock, isFavoritedCheckerMock}
 	e := echo.New()
 
 	t.Run("Should list all articles", func(t *testing.T) {
@@ -39,9 +44,10 @@ func TestListArticles(t *testing.T) {
 		urlValues.Add("limit", strconv.Itoa(limit))
 		c.Request().URL.RawQuery = urlValues.Encode()
 		ctx := c.Request().Context()
-		articleListerMock.EXPECT().ListArticles(ctx, "", "", int64(limit), int64(0)).Return(expectedArticles, nil).Once()
+		articleListerMock.EXPECT().ListArticles(ctx, "", "", "", "", int64(limit), int64(0)).Return(expectedArticles, nil).Once()
 		profileGetterMock.EXPECT().GetProfileByID(ctx, articleAuthorID.Hex()).Return(expectedAuthor, nil).Times(limit)
 		isFollowedCheckerMock.EXPECT().IsFollowedBy(ctx, articleAuthorID.Hex(), "").Return(false).Times(limit)
+		isFavoritedCheckerMock.EXPECT().IsFavoritedBy(ctx, mock.Anything, "").Return(false).Times(limit)
 
 		// Act
 		err := handler.ListArticles(c)
@@ -74,9 +80,10 @@ func TestListArticles(t *testing.T) {
 		urlValues.Add("tag", tag)
 		c.Request().URL.RawQuery = urlValues.Encode()
 		ctx := c.Request().Context()
-		articleListerMock.EXPECT().ListArticles(ctx, "", tag, int64(limit), int64(0)).Return(expectedArticles, nil).Once()
+		articleListerMock.EXPECT().ListArticles(ctx, "", tag, "", "", int64(limit), int64(0)).Return(expectedArticles, nil).Once()
 		profileGetterMock.EXPECT().GetProfileByID(ctx, expectedAuthor.ID.Hex()).Return(expectedAuthor, nil).Times(limit)
 		isFollowedCheckerMock.EXPECT().IsFollowedBy(ctx, expectedAuthor.ID.Hex(), "").Return(false).Times(limit)
+		isFavoritedCheckerMock.EXPECT().IsFavoritedBy(ctx, mock.Anything, "").Return(false).Times(limit)
 
 		// Act
 		err := handler.ListArticles(c)
@@ -106,9 +113,10 @@ func TestListArticles(t *testing.T) {
 		c.Request().URL.RawQuery = urlValues.Encode()
 		ctx := c.Request().Context()
 		profileGetterMock.EXPECT().GetProfileByUsername(ctx, *expectedAuthor.Username).Return(expectedAuthor, nil).Once()
-		articleListerMock.EXPECT().ListArticles(ctx, expectedAuthor.ID.Hex(), "", int64(limit), int64(0)).Return(expectedArticles, nil).Once()
+		articleListerMock.EXPECT().ListArticles(ctx, expectedAuthor.ID.Hex(), "", "", "", int64(limit), int64(0)).Return(expectedArticles, nil).Once()
 		profileGetterMock.EXPECT().GetProfileByID(ctx, expectedAuthor.ID.Hex()).Return(expectedAuthor, nil).Times(limit)
 		isFollowedCheckerMock.EXPECT().IsFollowedBy(ctx, expectedAuthor.ID.Hex(), "").Return(false).Times(limit)
+		isFavoritedCheckerMock.EXPECT().IsFavoritedBy(ctx, mock.Anything, "").Return(false).Times(limit)
 
 		// Act
 		err := handler.ListArticles(c)
@@ -121,6 +129,128 @@ func TestListArticles(t *testing.T) {
 		require.NoError(t, err)
 		checkListArticlesResponse(t, "", "", limit, listArticlesResponse)
 	})
+
+	t.Run("Should filter articles favorited by user", func(t *testing.T) {
+		// Arrange
+		limit := 30
+		articleAuthorID := primitive.NewObjectID()
+		expectedArticles := assembleRandomArticles(limit, articleAuthorID)
+		expectedAuthor := assembleArticleAuthor(articleAuthorID.Hex())
+		favoritingUser := assembleArticleAuthor(primitive.NewObjectID().Hex())
+		req := httptest.NewRequest(http.MethodGet, "/api/articles", nil)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		urlValues := c.QueryParams()
+		urlValues.Add("limit", strconv.Itoa(limit))
+		urlValues.Add("favorited", *favoritingUser.Username)
+		c.Request().URL.RawQuery = urlValues.Encode()
+		ctx := c.Request().Context()
+		profileGetterMock.EXPECT().GetProfileByUsername(ctx, *favoritingUser.Username).Return(favoritingUser, nil).Once()
+		articleListerMock.EXPECT().ListArticles(ctx, "", "", favoritingUser.ID.Hex(), "", int64(limit), int64(0)).Return(expectedArticles, nil).Once()
+		profileGetterMock.EXPECT().GetProfileByID(ctx, expectedAuthor.ID.Hex()).Return(expectedAuthor, nil).Times(limit)
+		isFollowedCheckerMock.EXPECT().IsFollowedBy(ctx, expectedAuthor.ID.Hex(), "").Return(false).Times(limit)
+		isFavoritedCheckerMock.EXPECT().IsFavoritedBy(ctx, mock.Anything, "").Return(false).Times(limit)
+
+		// Act
+		err := handler.ListArticles(c)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Code)
+		listArticlesResponse := new(articlePublisherResponses.ArticlesResponse)
+		err = json.Unmarshal(rec.Body.Bytes(), listArticlesResponse)
+		require.NoError(t, err)
+		checkListArticlesResponse(t, "", "", limit, listArticlesResponse)
+	})
+
+	t.Run("Should list articles after cursor", func(t *testing.T) {
+		// Arrange
+		limit := 10
+		articleAuthorID := primitive.NewObjectID()
+		expectedArticles := assembleRandomArticles(limit, articleAuthorID)
+		expectedAuthor := assembleArticleAuthor(articleAuthorID.Hex())
+		after := primitive.NewObjectID().Hex()
+		req := httptest.NewRequest(http.MethodGet, "/api/articles", nil)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		urlValues := c.QueryParams()
+		urlValues.Add("limit", strconv.Itoa(limit))
+		urlValues.Add("cursor", pagination.EncodeCursor(after))
+		c.Request().URL.RawQuery = urlValues.Encode()
+		ctx := c.Request().Context()
+		articleListerMock.EXPECT().ListArticles(ctx, "", "", "", after, int64(limit), int64(0)).Return(expectedArticles, nil).Once()
+		profileGetterMock.EXPECT().GetProfileByID(ctx, expectedAuthor.ID.Hex()).Return(expectedAuthor, nil).Times(limit)
+		isFollowedCheckerMock.EXPECT().IsFollowedBy(ctx, expectedAuthor.ID.Hex(), "").Return(false).Times(limit)
+		isFavoritedCheckerMock.EXPECT().IsFavoritedBy(ctx, mock.Anything, "").Return(false).Times(limit)
+
+		// Act
+		err := handler.ListArticles(c)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Code)
+		listArticlesResponse := new(articlePublisherResponses.ArticlesResponse)
+		err = json.Unmarshal(rec.Body.Bytes(), listArticlesResponse)
+		require.NoError(t, err)
+		checkListArticlesResponse(t, "", "", limit, listArticlesResponse)
+		require.Equal(t, pagination.EncodeCursor(expectedArticles[limit-1].ID.Hex()), listArticlesResponse.NextCursor)
+	})
+
+	t.Run("Should not accept cursor of an unknown article", func(t *testing.T) {
+		// Arrange
+		limit := 10
+		after := primitive.NewObjectID().Hex()
+		cursor := pagination.EncodeCursor(after)
+		req := httptest.NewRequest(http.MethodGet, "/api/articles", nil)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		urlValues := c.QueryParams()
+		urlValues.Add("limit", strconv.Itoa(limit))
+		urlValues.Add("cursor", cursor)
+		c.Request().URL.RawQuery = urlValues.Encode()
+		ctx := c.Request().Context()
+		articleListerMock.EXPECT().ListArticles(ctx, "", "", "", after, int64(limit), int64(0)).Return(nil, app.ArticleNotFoundError(after, nil)).Once()
+
+		// Act
+		err := handler.ListArticles(c)
+
+		// Assert
+		require.ErrorContains(t, err, api.InvalidFieldError("Cursor", cursor).Error())
+	})
+
+	t.Run("Should not return next cursor on last page", func(t *testing.T) {
+		// Arrange
+		limit := 10
+		articleAuthorID := primitive.NewObjectID()
+		expectedArticles := assembleRandomArticles(limit-1, articleAuthorID)
+		expectedAuthor := assembleArticleAuthor(articleAuthorID.Hex())
+		req := httptest.NewRequest(http.MethodGet, "/api/articles", nil)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		urlValues := c.QueryParams()
+		urlValues.Add("limit", strconv.Itoa(limit))
+		c.Request().URL.RawQuery = urlValues.Encode()
+		ctx := c.Request().Context()
+		articleListerMock.EXPECT().ListArticles(ctx, "", "", "", "", int64(limit), int64(0)).Return(expectedArticles, nil).Once()
+		profileGetterMock.EXPECT().GetProfileByID(ctx, expectedAuthor.ID.Hex()).Return(expectedAuthor, nil).Times(limit - 1)
+		isFollowedCheckerMock.EXPECT().IsFollowedBy(ctx, expectedAuthor.ID.Hex(), "").Return(false).Times(limit - 1)
+		isFavoritedCheckerMock.EXPECT().IsFavoritedBy(ctx, mock.Anything, "").Return(false).Times(limit - 1)
+
+		// Act
+		err := handler.ListArticles(c)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Code)
+		listArticlesResponse := new(articlePublisherResponses.ArticlesResponse)
+		err = json.Unmarshal(rec.Body.Bytes(), listArticlesResponse)
+		require.NoError(t, err)
+		require.Empty(t, listArticlesResponse.NextCursor)
+	})
 }
 
 func assembleRandomArticles(amount int, authorId primitive.ObjectID) []*models.Article {
@@ -0,0 +1,107 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ravilock/goduit/api"
+	"github.com/ravilock/goduit/internal/app"
+	"github.com/ravilock/goduit/internal/articlePublisher/assemblers"
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+	"github.com/ravilock/goduit/internal/articlePublisher/requests"
+	"github.com/ravilock/goduit/internal/articlePublisher/responses"
+	"github.com/ravilock/goduit/internal/identity"
+	profileManagerAssembler "github.com/ravilock/goduit/internal/profileManager/assemblers"
+)
+
+type coAuthorInvitationLister interface {
+	ListInvitations(ctx context.Context, invitee string, limit, offset int64) ([]*models.CoAuthorInvitation, error)
+}
+
+type articleByIDGetter interface {
+	GetArticleByID(ctx context.Context, ID string) (*models.Article, error)
+}
+
+type ListCoAuthorInvitationsHandler struct {
+	service        coAuthorInvitationLister
+	articleGetter  articleByIDGetter
+	profileManager profileGetter
+}
+
+func NewListCoAuthorInvitationsHandler(service coAuthorInvitationLister, articleGetter articleByIDGetter, profileManager profileGetter) *ListCoAuthorInvitationsHandler {
+	return &ListCoAuthorInvitationsHandler{
+		service:        service,
+		articleGetter:  articleGetter,
+		profileManager: profileManager,
+	}
+}
+
+func (h *ListCoAuthorInvitationsHandler) ListInvitations(c echo.Context) error {
+	request := requests.NewListCoAuthorInvitationsRequest()
+	identity := new(identity.IdentityHeaders)
+	binder := &echo.DefaultBinder{}
+	if err := binder.BindQueryParams(c, request); err != nil {
+		return err
+	}
+	if err := binder.BindHeaders(c, identity); err != nil {
+		return err
+	}
+
+	if err := request.Validate(); err != nil {
+		return err
+	}
+
+	ctx := c.Request().Context()
+
+	invitations, err := h.service.ListInvitations(ctx, identity.Subject, int64(request.Pagination.Limit), int64(request.Pagination.Offset))
+	if err != nil {
+		return err
+	}
+
+	invitee, err := h.profileManager.GetProfileByID(ctx, identity.Subject)
+	if err != nil {
+		if appError := new(app.AppError); errors.As(err, &appError) {
+			switch appError.ErrorCode {
+			case app.UserNotFoundErrorCode:
+				return api.UserNotFound(identity.ClientUsername)
+			}
+		}
+		return err
+	}
+
+	inviteeProfile, err := profileManagerAssembler.ProfileResponse(invitee, false)
+	if err != nil {
+		return err
+	}
+
+	response := responses.CoAuthorInvitationsResponse{Invitations: make([]responses.CoAuthorInvitation, 0, len(invitations))}
+	for _, invitation := range invitations {
+		article, err := h.articleGetter.GetArticleByID(ctx, *invitation.Article)
+		if err != nil {
+			// Invitations to articles that were trashed in the meantime are left out
+			if appError := new(app.AppError); errors.As(err, &appError) && appError.ErrorCode == app.ArticleNotFoundErrorCode {
+				continue
+			}
+			return err
+		}
+
+		inviter, err := h.profileManager.GetProfileByID(ctx, *invitation.Inviter)
+		if err != nil {
+			if appError := new(app.AppError); errors.As(err, &appError) && appError.ErrorCode == app.UserNotFoundErrorCode {
+				continue
+			}
+			return err
+		}
+
+		inviterProfile, err := profileManagerAssembler.ProfileResponse(inviter, false)
+		if err != nil {
+			return err
+		}
+
+		response.Invitations = append(response.Invitations, *assemblers.MultiCoAuthorInvitationResponse(invitation, article, inviterProfile, inviteeProfile))
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
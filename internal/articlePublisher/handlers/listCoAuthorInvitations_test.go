@@ -0,0 +1,78 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ravilock/goduit/api/validators"
+	"github.com/ravilock/goduit/internal/app"
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+	articlePublisherResponses "github.com/ravilock/goduit/internal/articlePublisher/responses"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestListCoAuthorInvitations(t *testing.T) {
+	err := validators.InitValidator()
+	require.NoError(t, err)
+	coAuthorInvitationListerMock := newMockCoAuthorInvitationLister(t)
+	articleGetterMock := newMockArticleByIDGetter(t)
+	profileGetterMock := newMockProfileGetter(t)
+	handler := &ListCoAuthorInvitationsHandler{coAuthorInvitationListerMock, articleGetterMock, profileGetterMock}
+	e := echo.New()
+
+	t.Run("Should list the invitations of the authenticated user, leaving out trashed articles", func(t *testing.T) {
+		// Arrange
+		articleAuthorID := primitive.NewObjectID()
+		expectedAuthor := assembleArticleAuthor(articleAuthorID.Hex())
+		expectedInvitee := assembleArticleAuthor(primitive.NewObjectID().Hex())
+		expectedArticle := assembleArticleModel(articleAuthorID)
+		trashedArticle := assembleArticleModel(articleAuthorID)
+		expectedInvitation := assembleCoAuthorInvitationModel(expectedArticle, expectedInvitee.ID.Hex())
+		trashedInvitation := assembleCoAuthorInvitationModel(trashedArticle, expectedInvitee.ID.Hex())
+		req := httptest.NewRequest(http.MethodGet, "/api/articles/co-author-invitations", nil)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set("Goduit-Subject", expectedInvitee.ID.Hex())
+		req.Header.Set("Goduit-Client-Username", *expectedInvitee.Username)
+		req.Header.Set("Goduit-Client-Email", *expectedInvitee.Email)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		ctx := c.Request().Context()
+		coAuthorInvitationListerMock.EXPECT().ListInvitations(ctx, expectedInvitee.ID.Hex(), int64(20), int64(0)).Return([]*models.CoAuthorInvitation{expectedInvitation, trashedInvitation}, nil).Once()
+		profileGetterMock.EXPECT().GetProfileByID(ctx, expectedInvitee.ID.Hex()).Return(expectedInvitee, nil).Once()
+		articleGetterMock.EXPECT().GetArticleByID(ctx, expectedArticle.ID.Hex()).Return(expectedArticle, nil).Once()
+		profileGetterMock.EXPECT().GetProfileByID(ctx, expectedAuthor.ID.Hex()).Return(expectedAuthor, nil).Once()
+		articleGetterMock.EXPECT().GetArticleByID(ctx, trashedArticle.ID.Hex()).Return(nil, app.ArticleNotFoundError(trashedArticle.ID.Hex(), nil)).Once()
+
+		// Act
+		err := handler.ListInvitations(c)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Code)
+		invitationsResponse := new(articlePublisherResponses.CoAuthorInvitationsResponse)
+		err = json.Unmarshal(rec.Body.Bytes(), invitationsResponse)
+		require.NoError(t, err)
+		require.Len(t, invitationsResponse.Invitations, 1)
+		require.Equal(t, *expectedArticle.Slug, invitationsResponse.Invitations[0].Slug)
+		require.Equal(t, *expectedAuthor.Username, invitationsResponse.Invitations[0].Inviter.Username)
+		require.Equal(t, *expectedInvitee.Username, invitationsResponse.Invitations[0].Invitee.Username)
+	})
+}
+
+func assembleCoAuthorInvitationModel(article *models.Article, invitee string) *models.CoAuthorInvitation {
+	ID := primitive.NewObjectID()
+	articleID := article.ID.Hex()
+	now := time.Now().UTC().Truncate(time.Millisecond)
+	return &models.CoAuthorInvitation{
+		ID:        &ID,
+		Article:   &articleID,
+		Invitee:   &invitee,
+		Inviter:   article.Author,
+		CreatedAt: &now,
+	}
+}
@@ -3,6 +3,7 @@ package handlers
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net/http"
 
 	"github.com/labstack/echo/v4"
@@ -12,6 +13,7 @@ import (
 	"github.com/ravilock/goduit/internal/articlePublisher/models"
 	"github.com/ravilock/goduit/internal/articlePublisher/requests"
 	"github.com/ravilock/goduit/internal/articlePublisher/responses"
+	"github.com/ravilock/goduit/internal/etag"
 	"github.com/ravilock/goduit/internal/identity"
 	profileManagerAssembler "github.com/ravilock/goduit/internal/profileManager/assemblers"
 	profileManagerResponses "github.com/ravilock/goduit/internal/profileManager/responses"
@@ -73,11 +75,21 @@ func (h *ListCommentsHandler) ListComments(c echo.Context) error {
 		return err
 	}
 
+	if !article.IsPublished() && !article.HasAuthor(identity.Subject) {
+		return api.ArticleNotFound(request.Slug)
+	}
+
 	comments, err := h.service.ListComments(ctx, article.ID.Hex())
 	if err != nil {
 		return err
 	}
 
+	tag := commentsETag(comments)
+	c.Response().Header().Set(etag.HeaderETag, tag)
+	if etag.Matches(c.Request().Header.Get(etag.HeaderIfNoneMatch), tag) {
+		return c.NoContent(http.StatusNotModified)
+	}
+
 	authorMap := make(map[string]*profileManagerResponses.ProfileResponse)
 	for _, comment := range comments {
 		_, ok := authorMap[*comment.Author]
@@ -114,3 +126,12 @@ func (h *ListCommentsHandler) ListComments(c echo.Context) error {
 	}
 	return c.JSON(http.StatusOK, response)
 }
+
+// commentsETag tags a list of comments, so it changes whenever a comment is written, changed or deleted.
+func commentsETag(comments []*models.Comment) string {
+	parts := make([]string, 0, len(comments))
+	for _, comment := range comments {
+		parts = append(parts, fmt.Sprintf("%s:%d", comment.ID.Hex(), comment.CurrentVersion()))
+	}
+	return etag.FromDigest(parts...)
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/ravilock/goduit/api"
 	"github.com/ravilock/goduit/api/validators"
 	"github.com/ravilock/goduit/internal/app"
+	"github.com/ravilock/goduit/internal/etag"
 
 	"github.com/ravilock/goduit/internal/articlePublisher/requests"
 	"github.com/ravilock/goduit/internal/profileManager/models"
@@ -106,6 +107,48 @@ func TestListComments(t *testing.T) {
 		checkListCommentsResponse(t, listCommentsResponse, 0, authorMap)
 	})
 
+	t.Run("Should return HTTP 304 if the comments did not change since they were fetched", func(t *testing.T) {
+		// Arrange
+		articleAuthorID := primitive.NewObjectID()
+		expectedArticle := assembleArticleModel(articleAuthorID)
+		comments := []*articlePublisherModels.Comment{
+			assembleCommentModel(articleAuthorID.Hex(), expectedArticle.ID.Hex()),
+			assembleCommentModel(articleAuthorID.Hex(), expectedArticle.ID.Hex()),
+		}
+		expectedETag := commentsETag(comments)
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/article/%s/comments", *expectedArticle.Slug), nil)
+		req.Header.Set(etag.HeaderIfNoneMatch, expectedETag)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("slug")
+		c.SetParamValues(*expectedArticle.Slug)
+		ctx := c.Request().Context()
+		articleGetterMock.EXPECT().GetArticleBySlug(ctx, *expectedArticle.Slug).Return(expectedArticle, nil).Once()
+		commentListerMock.EXPECT().ListComments(ctx, expectedArticle.ID.Hex()).Return(comments, nil).Once()
+
+		// Act
+		err := handler.ListComments(c)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, http.StatusNotModified, rec.Code)
+		require.Equal(t, expectedETag, rec.Header().Get(etag.HeaderETag))
+	})
+
+	t.Run("Should tag the comments differently once one is deleted", func(t *testing.T) {
+		// Arrange
+		articleID := primitive.NewObjectID().Hex()
+		comment1 := assembleCommentModel(primitive.NewObjectID().Hex(), articleID)
+		comment2 := assembleCommentModel(primitive.NewObjectID().Hex(), articleID)
+
+		// Act
+		before := commentsETag([]*articlePublisherModels.Comment{comment1, comment2})
+		after := commentsETag([]*articlePublisherModels.Comment{comment1})
+
+		// Assert
+		require.NotEqual(t, before, after)
+	})
+
 	t.Run("Should return HTTP 404 if no article is found", func(t *testing.T) {
 		// Arrange
 		articleSlug := uuid.NewString()
@@ -0,0 +1,65 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ravilock/goduit/api/validators"
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+	articlePublisherResponses "github.com/ravilock/goduit/internal/articlePublisher/responses"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestListDrafts(t *testing.T) {
+	err := validators.InitValidator()
+	require.NoError(t, err)
+	draftListerMock := newMockDraftLister(t)
+	profileGetterMock := newMockProfileGetter(t)
+	handler := &ListDraftsHandler{draftListerMock, profileGetterMock}
+	e := echo.New()
+
+	t.Run("Should list own drafts", func(t *testing.T) {
+		// Arrange
+		limit := 10
+		authorID := primitive.NewObjectID()
+		expectedAuthor := assembleArticleAuthor(authorID.Hex())
+		expectedDrafts := assembleRandomArticles(limit, authorID)
+		draftStatus := models.ArticleStatusDraft
+		for _, draft := range expectedDrafts {
+			draft.Status = &draftStatus
+		}
+		req := httptest.NewRequest(http.MethodGet, "/api/articles/drafts", nil)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set("Goduit-Subject", authorID.Hex())
+		req.Header.Set("Goduit-Client-Username", *expectedAuthor.Username)
+		req.Header.Set("Goduit-Client-Email", *expectedAuthor.Email)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		urlValues := c.QueryParams()
+		urlValues.Add("limit", strconv.Itoa(limit))
+		c.Request().URL.RawQuery = urlValues.Encode()
+		ctx := c.Request().Context()
+		draftListerMock.EXPECT().ListDrafts(ctx, authorID.Hex(), int64(limit), int64(0)).Return(expectedDrafts, nil).Once()
+		profileGetterMock.EXPECT().GetProfileByID(ctx, authorID.Hex()).Return(expectedAuthor, nil).Once()
+
+		// Act
+		err := handler.ListDrafts(c)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Code)
+		listDraftsResponse := new(articlePublisherResponses.ArticlesResponse)
+		err = json.Unmarshal(rec.Body.Bytes(), listDraftsResponse)
+		require.NoError(t, err)
+		require.Len(t, listDraftsResponse.Articles, limit)
+		for _, draft := range listDraftsResponse.Articles {
+			require.Equal(t, models.ArticleStatusDraft, draft.Status)
+			require.Equal(t, *expectedAuthor.Username, draft.Author.Username)
+		}
+	})
+}
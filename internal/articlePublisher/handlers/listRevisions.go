@@ -0,0 +1,97 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ravilock/goduit/api"
+	"github.com/ravilock/goduit/internal/app"
+	"github.com/ravilock/goduit/internal/articlePublisher/assemblers"
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+	"github.com/ravilock/goduit/internal/articlePublisher/requests"
+	"github.com/ravilock/goduit/internal/articlePublisher/responses"
+	"github.com/ravilock/goduit/internal/identity"
+	profileManagerAssembler "github.com/ravilock/goduit/internal/profileManager/assemblers"
+	profileManagerResponses "github.com/ravilock/goduit/internal/profileManager/responses"
+)
+
+type revisionLister interface {
+	ListRevisions(ctx context.Context, article string, limit, offset int64) ([]*models.Revision, error)
+}
+
+type ListRevisionsHandler struct {
+	service        revisionLister
+	articleGetter  articleGetter
+	profileManager profileGetter
+}
+
+func NewListRevisionsHandler(service revisionLister, articleGetter articleGetter, profileManager profileGetter) *ListRevisionsHandler {
+	return &ListRevisionsHandler{
+		service:        service,
+		articleGetter:  articleGetter,
+		profileManager: profileManager,
+	}
+}
+
+func (h *ListRevisionsHandler) ListRevisions(c echo.Context) error {
+	request := requests.NewListRevisionsRequest()
+	identity := new(identity.IdentityHeaders)
+	binder := &echo.DefaultBinder{}
+	if err := binder.BindPathParams(c, request); err != nil {
+		return err
+	}
+	if err := binder.BindQueryParams(c, request); err != nil {
+		return err
+	}
+	if err := binder.BindHeaders(c, identity); err != nil {
+		return err
+	}
+
+	if err := request.Validate(); err != nil {
+		return err
+	}
+
+	ctx := c.Request().Context()
+
+	article, err := h.articleGetter.GetArticleBySlug(ctx, request.Slug)
+	if err != nil {
+		if appError := new(app.AppError); errors.As(err, &appError) {
+			switch appError.ErrorCode {
+			case app.ArticleNotFoundErrorCode:
+				return api.ArticleNotFound(request.Slug)
+			}
+		}
+		return err
+	}
+
+	if !article.HasAuthor(identity.Subject) {
+		return api.Forbidden
+	}
+
+	revisions, err := h.service.ListRevisions(ctx, article.ID.Hex(), int64(request.Pagination.Limit), int64(request.Pagination.Offset))
+	if err != nil {
+		return err
+	}
+
+	editorProfiles := make(map[string]*profileManagerResponses.ProfileResponse)
+	response := responses.RevisionsResponse{Revisions: make([]responses.MultiRevision, 0, len(revisions))}
+	for _, revision := range revisions {
+		editorProfile, ok := editorProfiles[*revision.Author]
+		if !ok {
+			editor, err := h.profileManager.GetProfileByID(ctx, *revision.Author)
+			if err != nil {
+				return err
+			}
+			editorProfile, err = profileManagerAssembler.ProfileResponse(editor, false)
+			if err != nil {
+				return err
+			}
+			editorProfiles[*revision.Author] = editorProfile
+		}
+		response.Revisions = append(response.Revisions, *assemblers.MultiRevisionResponse(revision, editorProfile))
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
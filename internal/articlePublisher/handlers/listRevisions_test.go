@@ -0,0 +1,66 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ravilock/goduit/api/validators"
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+	articlePublisherResponses "github.com/ravilock/goduit/internal/articlePublisher/responses"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestListRevisions(t *testing.T) {
+	err := validators.InitValidator()
+	require.NoError(t, err)
+	revisionListerMock := newMockRevisionLister(t)
+	articleGetterMock := newMockArticleGetter(t)
+	profileGetterMock := newMockProfileGetter(t)
+	handler := &ListRevisionsHandler{revisionListerMock, articleGetterMock, profileGetterMock}
+	e := echo.New()
+
+	t.Run("Should list an article's revisions, fetching each editor once", func(t *testing.T) {
+		// Arrange
+		articleAuthorID := primitive.NewObjectID()
+		expectedAuthor := assembleArticleAuthor(articleAuthorID.Hex())
+		expectedArticle := assembleArticleModel(articleAuthorID)
+		expectedRevisions := []*models.Revision{
+			assembleRevisionModel(expectedArticle, 3),
+			assembleRevisionModel(expectedArticle, 2),
+			assembleRevisionModel(expectedArticle, 1),
+		}
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/articles/%s/revisions", *expectedArticle.Slug), nil)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set("Goduit-Subject", articleAuthorID.Hex())
+		req.Header.Set("Goduit-Client-Username", *expectedAuthor.Username)
+		req.Header.Set("Goduit-Client-Email", *expectedAuthor.Email)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("slug")
+		c.SetParamValues(*expectedArticle.Slug)
+		ctx := c.Request().Context()
+		articleGetterMock.EXPECT().GetArticleBySlug(ctx, *expectedArticle.Slug).Return(expectedArticle, nil).Once()
+		revisionListerMock.EXPECT().ListRevisions(ctx, expectedArticle.ID.Hex(), int64(20), int64(0)).Return(expectedRevisions, nil).Once()
+		profileGetterMock.EXPECT().GetProfileByID(ctx, articleAuthorID.Hex()).Return(expectedAuthor, nil).Once()
+
+		// Act
+		err := handler.ListRevisions(c)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Code)
+		listRevisionsResponse := new(articlePublisherResponses.RevisionsResponse)
+		err = json.Unmarshal(rec.Body.Bytes(), listRevisionsResponse)
+		require.NoError(t, err)
+		require.Len(t, listRevisionsResponse.Revisions, len(expectedRevisions))
+		for i, revision := range listRevisionsResponse.Revisions {
+			require.Equal(t, *expectedRevisions[i].Number, revision.Number)
+			require.Equal(t, *expectedAuthor.Username, revision.Author.Username)
+		}
+	})
+}
@@ -0,0 +1,88 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ravilock/goduit/internal/app"
+	"github.com/ravilock/goduit/internal/articlePublisher/assemblers"
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+	"github.com/ravilock/goduit/internal/articlePublisher/requests"
+	"github.com/ravilock/goduit/internal/articlePublisher/responses"
+	"github.com/ravilock/goduit/internal/identity"
+	profileManagerAssembler "github.com/ravilock/goduit/internal/profileManager/assemblers"
+)
+
+type seriesLister interface {
+	ListSeries(ctx context.Context, owner string, limit, offset int64) ([]*models.Series, error)
+}
+
+type ListSeriesHandler struct {
+	service         seriesLister
+	profileManager  profileGetter
+	followerCentral isFollowedChecker
+}
+
+func NewListSeriesHandler(service seriesLister, profileManager profileGetter, followerCentral isFollowedChecker) *ListSeriesHandler {
+	return &ListSeriesHandler{
+		service:         service,
+		profileManager:  profileManager,
+		followerCentral: followerCentral,
+	}
+}
+
+func (h *ListSeriesHandler) ListSeries(c echo.Context) error {
+	request := requests.NewListSeriesRequest()
+	identity := new(identity.IdentityHeaders)
+	binder := &echo.DefaultBinder{}
+	if err := binder.BindQueryParams(c, request); err != nil {
+		return err
+	}
+	if err := binder.BindHeaders(c, identity); err != nil {
+		return err
+	}
+
+	if err := request.Validate(); err != nil {
+		return err
+	}
+
+	ctx := c.Request().Context()
+
+	response := responses.MultipleSeriesResponse{Series: []responses.MultiSeries{}}
+
+	if request.Filters.Owner != "" {
+		owner, err := h.profileManager.GetProfileByUsername(ctx, request.Filters.Owner)
+		if err != nil {
+			if appError := new(app.AppError); errors.As(err, &appError) && appError.ErrorCode == app.UserNotFoundErrorCode {
+				return c.JSON(http.StatusOK, response)
+			}
+			return err
+		}
+		request.Filters.Owner = owner.ID.Hex()
+	}
+
+	seriesList, err := h.service.ListSeries(ctx, request.Filters.Owner, int64(request.Pagination.Limit), int64(request.Pagination.Offset))
+	if err != nil {
+		return err
+	}
+
+	for _, series := range seriesList {
+		owner, err := h.profileManager.GetProfileByID(ctx, *series.Owner)
+		if err != nil {
+			continue
+		}
+
+		isFollowing := h.followerCentral.IsFollowedBy(ctx, *series.Owner, identity.Subject)
+
+		ownerProfile, err := profileManagerAssembler.ProfileResponse(owner, isFollowing)
+		if err != nil {
+			continue
+		}
+
+		response.Series = append(response.Series, *assemblers.MultiSeriesResponse(series, ownerProfile))
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,79 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ravilock/goduit/api/validators"
+	"github.com/ravilock/goduit/internal/app"
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+	articlePublisherResponses "github.com/ravilock/goduit/internal/articlePublisher/responses"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestListSeries(t *testing.T) {
+	err := validators.InitValidator()
+	require.NoError(t, err)
+	seriesListerMock := newMockSeriesLister(t)
+	profileGetterMock := newMockProfileGetter(t)
+	isFollowedCheckerMock := newMockIsFollowedChecker(t)
+	handler := &ListSeriesHandler{seriesListerMock, profileGetterMock, isFollowedCheckerMock}
+	e := echo.New()
+
+	t.Run("Should list the series of an owner", func(t *testing.T) {
+		// Arrange
+		ownerID := primitive.NewObjectID()
+		expectedOwner := assembleArticleAuthor(ownerID.Hex())
+		firstArticle, secondArticle := assembleSeriesArticles(ownerID)
+		series := assembleSeriesModel(ownerID.Hex(), firstArticle, secondArticle)
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/series?owner=%s", *expectedOwner.Username), nil)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		ctx := c.Request().Context()
+		profileGetterMock.EXPECT().GetProfileByUsername(ctx, *expectedOwner.Username).Return(expectedOwner, nil).Once()
+		seriesListerMock.EXPECT().ListSeries(ctx, ownerID.Hex(), int64(20), int64(0)).Return([]*models.Series{series}, nil).Once()
+		profileGetterMock.EXPECT().GetProfileByID(ctx, ownerID.Hex()).Return(expectedOwner, nil).Once()
+		isFollowedCheckerMock.EXPECT().IsFollowedBy(ctx, ownerID.Hex(), "").Return(false).Once()
+
+		// Act
+		err := handler.ListSeries(c)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Code)
+		listSeriesResponse := new(articlePublisherResponses.MultipleSeriesResponse)
+		err = json.Unmarshal(rec.Body.Bytes(), listSeriesResponse)
+		require.NoError(t, err)
+		require.Len(t, listSeriesResponse.Series, 1)
+		require.Equal(t, *series.Slug, listSeriesResponse.Series[0].Slug)
+		require.Equal(t, 2, listSeriesResponse.Series[0].ArticlesCount)
+	})
+
+	t.Run("Should return an empty list if the owner does not exist", func(t *testing.T) {
+		// Arrange
+		owner := "ghost-owner"
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/series?owner=%s", owner), nil)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		ctx := c.Request().Context()
+		profileGetterMock.EXPECT().GetProfileByUsername(ctx, owner).Return(nil, app.UserNotFoundError(owner, nil)).Once()
+
+		// Act
+		err := handler.ListSeries(c)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Code)
+		listSeriesResponse := new(articlePublisherResponses.MultipleSeriesResponse)
+		err = json.Unmarshal(rec.Body.Bytes(), listSeriesResponse)
+		require.NoError(t, err)
+		require.Empty(t, listSeriesResponse.Series)
+	})
+}
@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ravilock/goduit/internal/articlePublisher/assemblers"
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+	"github.com/ravilock/goduit/internal/articlePublisher/requests"
+)
+
+type tagAliasesLister interface {
+	ListTagAliases(ctx context.Context, tag string) (string, []*models.TagAlias, error)
+}
+
+type ListTagAliasesHandler struct {
+	service tagAliasesLister
+}
+
+func NewListTagAliasesHandler(service tagAliasesLister) *ListTagAliasesHandler {
+	return &ListTagAliasesHandler{
+		service: service,
+	}
+}
+
+func (h *ListTagAliasesHandler) ListTagAliases(c echo.Context) error {
+	request := new(requests.TagAliasesRequest)
+	binder := &echo.DefaultBinder{}
+	if err := binder.BindPathParams(c, request); err != nil {
+		return err
+	}
+
+	if err := request.Validate(); err != nil {
+		return err
+	}
+
+	ctx := c.Request().Context()
+
+	tag, aliases, err := h.service.ListTagAliases(ctx, request.Tag)
+	if err != nil {
+		return err
+	}
+
+	response := assemblers.TagAliasesResponse(tag, aliases)
+	return c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,69 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ravilock/goduit/api"
+	"github.com/ravilock/goduit/api/validators"
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+	articlePublisherResponses "github.com/ravilock/goduit/internal/articlePublisher/responses"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListTagAliases(t *testing.T) {
+	err := validators.InitValidator()
+	require.NoError(t, err)
+	tagAliasesListerMock := newMockTagAliasesLister(t)
+	handler := &ListTagAliasesHandler{tagAliasesListerMock}
+	e := echo.New()
+
+	t.Run("Should list the aliases of the canonical tag", func(t *testing.T) {
+		// Arrange
+		req := httptest.NewRequest(http.MethodGet, "/api/tags/go-lang/aliases", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("tag")
+		c.SetParamValues("go-lang")
+		ctx := c.Request().Context()
+		tagAliasesListerMock.EXPECT().ListTagAliases(ctx, "go-lang").Return("golang", assembleTagAliases("golang", "go-lang", "golang-dev"), nil).Once()
+
+		// Act
+		err := handler.ListTagAliases(c)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Code)
+		tagAliasesResponse := new(articlePublisherResponses.TagAliasesResponse)
+		err = json.Unmarshal(rec.Body.Bytes(), tagAliasesResponse)
+		require.NoError(t, err)
+		require.Equal(t, "golang", tagAliasesResponse.Tag.Name)
+		require.Equal(t, []string{"go-lang", "golang-dev"}, tagAliasesResponse.Tag.Aliases)
+	})
+
+	t.Run("Should return HTTP 400 if tag is too short", func(t *testing.T) {
+		// Arrange
+		req := httptest.NewRequest(http.MethodGet, "/api/tags/go/aliases", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("tag")
+		c.SetParamValues("go")
+
+		// Act
+		err := handler.ListTagAliases(c)
+
+		// Assert
+		require.ErrorContains(t, err, api.InvalidFieldLimit("Tag", "min", "3").Error())
+	})
+}
+
+func assembleTagAliases(tag string, names ...string) []*models.TagAlias {
+	aliases := make([]*models.TagAlias, 0, len(names))
+	for _, name := range names {
+		aliases = append(aliases, &models.TagAlias{Name: &name, Tag: &tag})
+	}
+	return aliases
+}
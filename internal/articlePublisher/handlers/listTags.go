@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ravilock/goduit/internal/articlePublisher/assemblers"
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+	"github.com/ravilock/goduit/internal/articlePublisher/requests"
+)
+
+type tagLister interface {
+	ListTags(ctx context.Context, prefix string, limit int64) ([]*models.Tag, error)
+}
+
+type ListTagsHandler struct {
+	service tagLister
+}
+
+func NewListTagsHandler(service tagLister) *ListTagsHandler {
+	return &ListTagsHandler{
+		service: service,
+	}
+}
+
+func (h *ListTagsHandler) ListTags(c echo.Context) error {
+	request := requests.NewListTagsRequest()
+	binder := &echo.DefaultBinder{}
+	if err := binder.BindQueryParams(c, request); err != nil {
+		return err
+	}
+
+	if err := request.Validate(); err != nil {
+		return err
+	}
+
+	ctx := c.Request().Context()
+
+	tags, err := h.service.ListTags(ctx, request.Prefix, int64(request.Limit))
+	if err != nil {
+		return err
+	}
+
+	response := assemblers.TagsResponse(tags)
+	return c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,107 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ravilock/goduit/api"
+	"github.com/ravilock/goduit/api/validators"
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+	articlePublisherResponses "github.com/ravilock/goduit/internal/articlePublisher/responses"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListTags(t *testing.T) {
+	err := validators.InitValidator()
+	require.NoError(t, err)
+	tagListerMock := newMockTagLister(t)
+	handler := &ListTagsHandler{tagListerMock}
+	e := echo.New()
+
+	t.Run("Should list most used tags", func(t *testing.T) {
+		// Arrange
+		expectedTags := assembleTags(map[string]int64{"golang": 3, "testing": 1})
+		req := httptest.NewRequest(http.MethodGet, "/api/tags", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		ctx := c.Request().Context()
+		tagListerMock.EXPECT().ListTags(ctx, "", int64(20)).Return(expectedTags, nil).Once()
+
+		// Act
+		err := handler.ListTags(c)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Code)
+		listTagsResponse := new(articlePublisherResponses.TagsResponse)
+		err = json.Unmarshal(rec.Body.Bytes(), listTagsResponse)
+		require.NoError(t, err)
+		checkListTagsResponse(t, expectedTags, listTagsResponse)
+	})
+
+	t.Run("Should filter tags by prefix", func(t *testing.T) {
+		// Arrange
+		prefix := "go"
+		limit := 5
+		expectedTags := assembleTags(map[string]int64{"golang": 3})
+		req := httptest.NewRequest(http.MethodGet, "/api/tags", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		urlValues := c.QueryParams()
+		urlValues.Add("prefix", prefix)
+		urlValues.Add("limit", strconv.Itoa(limit))
+		c.Request().URL.RawQuery = urlValues.Encode()
+		ctx := c.Request().Context()
+		tagListerMock.EXPECT().ListTags(ctx, prefix, int64(limit)).Return(expectedTags, nil).Once()
+
+		// Act
+		err := handler.ListTags(c)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Code)
+		listTagsResponse := new(articlePublisherResponses.TagsResponse)
+		err = json.Unmarshal(rec.Body.Bytes(), listTagsResponse)
+		require.NoError(t, err)
+		checkListTagsResponse(t, expectedTags, listTagsResponse)
+	})
+
+	t.Run("Should return HTTP 400 if limit is invalid", func(t *testing.T) {
+		// Arrange
+		req := httptest.NewRequest(http.MethodGet, "/api/tags", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		urlValues := c.QueryParams()
+		urlValues.Add("limit", "0")
+		c.Request().URL.RawQuery = urlValues.Encode()
+
+		// Act
+		err := handler.ListTags(c)
+
+		// Assert
+		require.ErrorContains(t, err, api.InvalidFieldLimit("Limit", "min", "1").Error())
+	})
+}
+
+func assembleTags(counts map[string]int64) []*models.Tag {
+	tags := make([]*models.Tag, 0, len(counts))
+	for name, count := range counts {
+		tags = append(tags, &models.Tag{Name: &name, Count: &count})
+	}
+	return tags
+}
+
+func checkListTagsResponse(t *testing.T, expectedTags []*models.Tag, response *articlePublisherResponses.TagsResponse) {
+	t.Helper()
+	require.Len(t, response.Tags, len(expectedTags))
+	require.Len(t, response.TagCounts, len(expectedTags))
+	for i, tag := range expectedTags {
+		require.Equal(t, *tag.Name, response.Tags[i], "Wrong tag name")
+		require.Equal(t, *tag.Name, response.TagCounts[i].Name, "Wrong tag count name")
+		require.Equal(t, *tag.Count, response.TagCounts[i].Count, "Wrong tag count")
+	}
+}
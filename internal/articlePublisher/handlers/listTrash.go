@@ -0,0 +1,79 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ravilock/goduit/api"
+	"github.com/ravilock/goduit/internal/app"
+	"github.com/ravilock/goduit/internal/articlePublisher/assemblers"
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+	"github.com/ravilock/goduit/internal/articlePublisher/requests"
+	"github.com/ravilock/goduit/internal/articlePublisher/responses"
+	"github.com/ravilock/goduit/internal/identity"
+	profileManagerAssembler "github.com/ravilock/goduit/internal/profileManager/assemblers"
+)
+
+type trashLister interface {
+	ListTrashedArticles(ctx context.Context, author string, limit, offset int64) ([]*models.Article, error)
+}
+
+type ListTrashHandler struct {
+	service        trashLister
+	profileManager profileGetter
+}
+
+func NewListTrashHandler(service trashLister, profileManager profileGetter) *ListTrashHandler {
+	return &ListTrashHandler{
+		service:        service,
+		profileManager: profileManager,
+	}
+}
+
+func (h *ListTrashHandler) ListTrash(c echo.Context) error {
+	request := requests.NewListTrashRequest()
+	identity := new(identity.IdentityHeaders)
+	binder := &echo.DefaultBinder{}
+	if err := binder.BindQueryParams(c, request); err != nil {
+		return err
+	}
+	if err := binder.BindHeaders(c, identity); err != nil {
+		return err
+	}
+
+	if err := request.Validate(); err != nil {
+		return err
+	}
+
+	ctx := c.Request().Context()
+
+	articles, err := h.service.ListTrashedArticles(ctx, identity.Subject, int64(request.Pagination.Limit), int64(request.Pagination.Offset))
+	if err != nil {
+		return err
+	}
+
+	author, err := h.profileManager.GetProfileByID(ctx, identity.Subject)
+	if err != nil {
+		if appError := new(app.AppError); errors.As(err, &appError) {
+			switch appError.ErrorCode {
+			case app.UserNotFoundErrorCode:
+				return api.UserNotFound(identity.ClientUsername)
+			}
+		}
+		return err
+	}
+
+	authorProfile, err := profileManagerAssembler.ProfileResponse(author, false)
+	if err != nil {
+		return err
+	}
+
+	response := responses.ArticlesResponse{Articles: make([]responses.MultiArticle, 0, len(articles))}
+	for _, article := range articles {
+		response.Articles = append(response.Articles, *assemblers.MultiArticleResponse(article, authorProfile, false))
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
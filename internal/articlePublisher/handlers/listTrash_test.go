@@ -0,0 +1,66 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ravilock/goduit/api/validators"
+	articlePublisherResponses "github.com/ravilock/goduit/internal/articlePublisher/responses"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestListTrash(t *testing.T) {
+	err := validators.InitValidator()
+	require.NoError(t, err)
+	trashListerMock := newMockTrashLister(t)
+	profileGetterMock := newMockProfileGetter(t)
+	handler := &ListTrashHandler{trashListerMock, profileGetterMock}
+	e := echo.New()
+
+	t.Run("Should list own trashed articles", func(t *testing.T) {
+		// Arrange
+		limit := 10
+		authorID := primitive.NewObjectID()
+		expectedAuthor := assembleArticleAuthor(authorID.Hex())
+		expectedArticles := assembleRandomArticles(limit, authorID)
+		trashedAt := time.Now().UTC().Truncate(time.Millisecond)
+		for _, article := range expectedArticles {
+			article.TrashedAt = &trashedAt
+		}
+		req := httptest.NewRequest(http.MethodGet, "/api/articles/trash", nil)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set("Goduit-Subject", authorID.Hex())
+		req.Header.Set("Goduit-Client-Username", *expectedAuthor.Username)
+		req.Header.Set("Goduit-Client-Email", *expectedAuthor.Email)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		urlValues := c.QueryParams()
+		urlValues.Add("limit", strconv.Itoa(limit))
+		c.Request().URL.RawQuery = urlValues.Encode()
+		ctx := c.Request().Context()
+		trashListerMock.EXPECT().ListTrashedArticles(ctx, authorID.Hex(), int64(limit), int64(0)).Return(expectedArticles, nil).Once()
+		profileGetterMock.EXPECT().GetProfileByID(ctx, authorID.Hex()).Return(expectedAuthor, nil).Once()
+
+		// Act
+		err := handler.ListTrash(c)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Code)
+		listTrashResponse := new(articlePublisherResponses.ArticlesResponse)
+		err = json.Unmarshal(rec.Body.Bytes(), listTrashResponse)
+		require.NoError(t, err)
+		require.Len(t, listTrashResponse.Articles, limit)
+		for _, article := range listTrashResponse.Articles {
+			require.NotNil(t, article.TrashedAt)
+			require.True(t, trashedAt.Equal(*article.TrashedAt))
+			require.Equal(t, *expectedAuthor.Username, article.Author.Username)
+		}
+	})
+}
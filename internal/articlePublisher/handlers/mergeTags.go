@@ -0,0 +1,61 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ravilock/goduit/api"
+	"github.com/ravilock/goduit/internal/app"
+	"github.com/ravilock/goduit/internal/articlePublisher/assemblers"
+	"github.com/ravilock/goduit/internal/articlePublisher/requests"
+)
+
+type MergeTagsHandler struct {
+	service       tagsMerger
+	aliasesLister tagAliasesLister
+}
+
+func NewMergeTagsHandler(service tagsMerger, aliasesLister tagAliasesLister) *MergeTagsHandler {
+	return &MergeTagsHandler{
+		service:       service,
+		aliasesLister: aliasesLister,
+	}
+}
+
+// MergeTags folds a tag into another one, rewriting the tag list of every article using it.
+func (h *MergeTagsHandler) MergeTags(c echo.Context) error {
+	request := new(requests.MergeTagsRequest)
+	binder := &echo.DefaultBinder{}
+	if err := binder.BindBody(c, request); err != nil {
+		return api.CouldNotUnmarshalBodyError
+	}
+	if err := binder.BindPathParams(c, request); err != nil {
+		return err
+	}
+
+	if err := request.Validate(); err != nil {
+		return err
+	}
+
+	ctx := c.Request().Context()
+
+	tag, err := h.service.MergeTags(ctx, request.Tag, request.Merge.Into)
+	if err != nil {
+		if appError := new(app.AppError); errors.As(err, &appError) {
+			switch appError.ErrorCode {
+			case app.ConflictErrorCode:
+				return api.ConfictError
+			}
+		}
+		return err
+	}
+
+	tag, aliases, err := h.aliasesLister.ListTagAliases(ctx, tag)
+	if err != nil {
+		return err
+	}
+
+	response := assemblers.TagAliasesResponse(tag, aliases)
+	return c.JSON(http.StatusOK, response)
+}
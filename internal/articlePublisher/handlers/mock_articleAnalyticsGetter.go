@@ -0,0 +1,99 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package handlers
+
+import (
+	context "context"
+
+	models "github.com/ravilock/goduit/internal/articlePublisher/models"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// mockArticleAnalyticsGetter is an autogenerated mock type for the articleAnalyticsGetter type
+type mockArticleAnalyticsGetter struct {
+	mock.Mock
+}
+
+type mockArticleAnalyticsGetter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockArticleAnalyticsGetter) EXPECT() *mockArticleAnalyticsGetter_Expecter {
+	return &mockArticleAnalyticsGetter_Expecter{mock: &_m.Mock}
+}
+
+// GetArticleAnalytics provides a mock function with given fields: ctx, articles, since
+func (_m *mockArticleAnalyticsGetter) GetArticleAnalytics(ctx context.Context, articles []string, since time.Time) (map[string][]*models.DailyActivity, error) {
+	ret := _m.Called(ctx, articles, since)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetArticleAnalytics")
+	}
+
+	var r0 map[string][]*models.DailyActivity
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string, time.Time) (map[string][]*models.DailyActivity, error)); ok {
+		return rf(ctx, articles, since)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string, time.Time) map[string][]*models.DailyActivity); ok {
+		r0 = rf(ctx, articles, since)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string][]*models.DailyActivity)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string, time.Time) error); ok {
+		r1 = rf(ctx, articles, since)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockArticleAnalyticsGetter_GetArticleAnalytics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetArticleAnalytics'
+type mockArticleAnalyticsGetter_GetArticleAnalytics_Call struct {
+	*mock.Call
+}
+
+// GetArticleAnalytics is a helper method to define mock.On call
+//   - ctx context.Context
+//   - articles []string
+//   - since time.Time
+func (_e *mockArticleAnalyticsGetter_Expecter) GetArticleAnalytics(ctx interface{}, articles interface{}, since interface{}) *mockArticleAnalyticsGetter_GetArticleAnalytics_Call {
+	return &mockArticleAnalyticsGetter_GetArticleAnalytics_Call{Call: _e.mock.On("GetArticleAnalytics", ctx, articles, since)}
+}
+
+func (_c *mockArticleAnalyticsGetter_GetArticleAnalytics_Call) Run(run func(ctx context.Context, articles []string, since time.Time)) *mockArticleAnalyticsGetter_GetArticleAnalytics_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *mockArticleAnalyticsGetter_GetArticleAnalytics_Call) Return(_a0 map[string][]*models.DailyActivity, _a1 error) *mockArticleAnalyticsGetter_GetArticleAnalytics_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *mockArticleAnalyticsGetter_GetArticleAnalytics_Call) RunAndReturn(run func(context.Context, []string, time.Time) (map[string][]*models.DailyActivity, error)) *mockArticleAnalyticsGetter_GetArticleAnalytics_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockArticleAnalyticsGetter creates a new instance of mockArticleAnalyticsGetter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockArticleAnalyticsGetter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockArticleAnalyticsGetter {
+	mock := &mockArticleAnalyticsGetter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,96 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package handlers
+
+import (
+	context "context"
+
+	models "github.com/ravilock/goduit/internal/articlePublisher/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockArticleByIDGetter is an autogenerated mock type for the articleByIDGetter type
+type mockArticleByIDGetter struct {
+	mock.Mock
+}
+
+type mockArticleByIDGetter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockArticleByIDGetter) EXPECT() *mockArticleByIDGetter_Expecter {
+	return &mockArticleByIDGetter_Expecter{mock: &_m.Mock}
+}
+
+// GetArticleByID provides a mock function with given fields: ctx, ID
+func (_m *mockArticleByIDGetter) GetArticleByID(ctx context.Context, ID string) (*models.Article, error) {
+	ret := _m.Called(ctx, ID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetArticleByID")
+	}
+
+	var r0 *models.Article
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.Article, error)); ok {
+		return rf(ctx, ID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *models.Article); ok {
+		r0 = rf(ctx, ID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Article)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, ID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockArticleByIDGetter_GetArticleByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetArticleByID'
+type mockArticleByIDGetter_GetArticleByID_Call struct {
+	*mock.Call
+}
+
+// GetArticleByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ID string
+func (_e *mockArticleByIDGetter_Expecter) GetArticleByID(ctx interface{}, ID interface{}) *mockArticleByIDGetter_GetArticleByID_Call {
+	return &mockArticleByIDGetter_GetArticleByID_Call{Call: _e.mock.On("GetArticleByID", ctx, ID)}
+}
+
+func (_c *mockArticleByIDGetter_GetArticleByID_Call) Run(run func(ctx context.Context, ID string)) *mockArticleByIDGetter_GetArticleByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *mockArticleByIDGetter_GetArticleByID_Call) Return(_a0 *models.Article, _a1 error) *mockArticleByIDGetter_GetArticleByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *mockArticleByIDGetter_GetArticleByID_Call) RunAndReturn(run func(context.Context, string) (*models.Article, error)) *mockArticleByIDGetter_GetArticleByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockArticleByIDGetter creates a new instance of mockArticleByIDGetter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockArticleByIDGetter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockArticleByIDGetter {
+	mock := &mockArticleByIDGetter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,97 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package handlers
+
+import (
+	context "context"
+
+	models "github.com/ravilock/goduit/internal/articlePublisher/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockArticleFavoriter is an autogenerated mock type for the articleFavoriter type
+type mockArticleFavoriter struct {
+	mock.Mock
+}
+
+type mockArticleFavoriter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockArticleFavoriter) EXPECT() *mockArticleFavoriter_Expecter {
+	return &mockArticleFavoriter_Expecter{mock: &_m.Mock}
+}
+
+// FavoriteArticle provides a mock function with given fields: ctx, article, user
+func (_m *mockArticleFavoriter) FavoriteArticle(ctx context.Context, article string, user string) (*models.Article, error) {
+	ret := _m.Called(ctx, article, user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FavoriteArticle")
+	}
+
+	var r0 *models.Article
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*models.Article, error)); ok {
+		return rf(ctx, article, user)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *models.Article); ok {
+		r0 = rf(ctx, article, user)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Article)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, article, user)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockArticleFavoriter_FavoriteArticle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FavoriteArticle'
+type mockArticleFavoriter_FavoriteArticle_Call struct {
+	*mock.Call
+}
+
+// FavoriteArticle is a helper method to define mock.On call
+//   - ctx context.Context
+//   - article string
+//   - user string
+func (_e *mockArticleFavoriter_Expecter) FavoriteArticle(ctx interface{}, article interface{}, user interface{}) *mockArticleFavoriter_FavoriteArticle_Call {
+	return &mockArticleFavoriter_FavoriteArticle_Call{Call: _e.mock.On("FavoriteArticle", ctx, article, user)}
+}
+
+func (_c *mockArticleFavoriter_FavoriteArticle_Call) Run(run func(ctx context.Context, article string, user string)) *mockArticleFavoriter_FavoriteArticle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *mockArticleFavoriter_FavoriteArticle_Call) Return(_a0 *models.Article, _a1 error) *mockArticleFavoriter_FavoriteArticle_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *mockArticleFavoriter_FavoriteArticle_Call) RunAndReturn(run func(context.Context, string, string) (*models.Article, error)) *mockArticleFavoriter_FavoriteArticle_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockArticleFavoriter creates a new instance of mockArticleFavoriter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockArticleFavoriter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockArticleFavoriter {
+	mock := &mockArticleFavoriter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
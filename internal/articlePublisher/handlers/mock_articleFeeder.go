@@ -22,34 +22,41 @@ func (_m *mockArticleFeeder) EXPECT() *mockArticleFeeder_Expecter {
 	return &mockArticleFeeder_Expecter{mock: &_m.Mock}
 }
 
-// FeedArticles provides a mock function with given fields: ctx, user, limit, offset
-func (_m *mockArticleFeeder) FeedArticles(ctx context.Context, user string, limit int64, offset int64) ([]*models.Article, error) {
-	ret := _m.Called(ctx, user, limit, offset)
+// FeedArticles provides a mock function with given fields: ctx, user, after, limit, offset
+func (_m *mockArticleFeeder) FeedArticles(ctx context.Context, user string, after int64, limit int64, offset int64) ([]*models.Article, int64, error) {
+	ret := _m.Called(ctx, user, after, limit, offset)
 
 	if len(ret) == 0 {
 		panic("no return value specified for FeedArticles")
 	}
 
 	var r0 []*models.Article
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, int64, int64) ([]*models.Article, error)); ok {
-		return rf(ctx, user, limit, offset)
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, int64, int64) ([]*models.Article, int64, error)); ok {
+		return rf(ctx, user, after, limit, offset)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, int64, int64) []*models.Article); ok {
-		r0 = rf(ctx, user, limit, offset)
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, int64, int64) []*models.Article); ok {
+		r0 = rf(ctx, user, after, limit, offset)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*models.Article)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, int64, int64) error); ok {
-		r1 = rf(ctx, user, limit, offset)
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64, int64, int64) int64); ok {
+		r1 = rf(ctx, user, after, limit, offset)
 	} else {
-		r1 = ret.Error(1)
+		r1 = ret.Get(1).(int64)
 	}
 
-	return r0, r1
+	if rf, ok := ret.Get(2).(func(context.Context, string, int64, int64, int64) error); ok {
+		r2 = rf(ctx, user, after, limit, offset)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
 }
 
 // mockArticleFeeder_FeedArticles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FeedArticles'
@@ -60,25 +67,26 @@ type mockArticleFeeder_FeedArticles_Call struct {
 // FeedArticles is a helper method to define mock.On call
 //   - ctx context.Context
 //   - user string
+//   - after int64
 //   - limit int64
 //   - offset int64
-func (_e *mockArticleFeeder_Expecter) FeedArticles(ctx interface{}, user interface{}, limit interface{}, offset interface{}) *mockArticleFeeder_FeedArticles_Call {
-	return &mockArticleFeeder_FeedArticles_Call{Call: _e.mock.On("FeedArticles", ctx, user, limit, offset)}
+func (_e *mockArticleFeeder_Expecter) FeedArticles(ctx interface{}, user interface{}, after interface{}, limit interface{}, offset interface{}) *mockArticleFeeder_FeedArticles_Call {
+	return &mockArticleFeeder_FeedArticles_Call{Call: _e.mock.On("FeedArticles", ctx, user, after, limit, offset)}
 }
 
-func (_c *mockArticleFeeder_FeedArticles_Call) Run(run func(ctx context.Context, user string, limit int64, offset int64)) *mockArticleFeeder_FeedArticles_Call {
+func (_c *mockArticleFeeder_FeedArticles_Call) Run(run func(ctx context.Context, user string, after int64, limit int64, offset int64)) *mockArticleFeeder_FeedArticles_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(int64), args[3].(int64))
+		run(args[0].(context.Context), args[1].(string), args[2].(int64), args[3].(int64), args[4].(int64))
 	})
 	return _c
 }
 
-func (_c *mockArticleFeeder_FeedArticles_Call) Return(_a0 []*models.Article, _a1 error) *mockArticleFeeder_FeedArticles_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *mockArticleFeeder_FeedArticles_Call) Return(_a0 []*models.Article, _a1 int64, _a2 error) *mockArticleFeeder_FeedArticles_Call {
+	_c.Call.Return(_a0, _a1, _a2)
 	return _c
 }
 
-func (_c *mockArticleFeeder_FeedArticles_Call) RunAndReturn(run func(context.Context, string, int64, int64) ([]*models.Article, error)) *mockArticleFeeder_FeedArticles_Call {
+func (_c *mockArticleFeeder_FeedArticles_Call) RunAndReturn(run func(context.Context, string, int64, int64, int64) ([]*models.Article, int64, error)) *mockArticleFeeder_FeedArticles_Call {
 	_c.Call.Return(run)
 	return _c
 }
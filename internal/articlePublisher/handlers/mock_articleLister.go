@@ -22,9 +22,9 @@ func (_m *mockArticleLister) EXPECT() *mockArticleLister_Expecter {
 	return &mockArticleLister_Expecter{mock: &_m.Mock}
 }
 
-// ListArticles provides a mock function with given fields: ctx, author, tag, limit, offset
-func (_m *mockArticleLister) ListArticles(ctx context.Context, author string, tag string, limit int64, offset int64) ([]*models.Article, error) {
-	ret := _m.Called(ctx, author, tag, limit, offset)
+// ListArticles provides a mock function with given fields: ctx, author, tag, favorited, after, limit, offset
+func (_m *mockArticleLister) ListArticles(ctx context.Context, author string, tag string, favorited string, after string, limit int64, offset int64) ([]*models.Article, error) {
+	ret := _m.Called(ctx, author, tag, favorited, after, limit, offset)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ListArticles")
@@ -32,19 +32,19 @@ func (_m *mockArticleLister) ListArticles(ctx context.Context, author string, ta
 
 	var r0 []*models.Article
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, int64, int64) ([]*models.Article, error)); ok {
-		return rf(ctx, author, tag, limit, offset)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string, int64, int64) ([]*models.Article, error)); ok {
+		return rf(ctx, author, tag, favorited, after, limit, offset)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, int64, int64) []*models.Article); ok {
-		r0 = rf(ctx, author, tag, limit, offset)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string, int64, int64) []*models.Article); ok {
+		r0 = rf(ctx, author, tag, favorited, after, limit, offset)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*models.Article)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, string, int64, int64) error); ok {
-		r1 = rf(ctx, author, tag, limit, offset)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, string, int64, int64) error); ok {
+		r1 = rf(ctx, author, tag, favorited, after, limit, offset)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -61,15 +61,17 @@ type mockArticleLister_ListArticles_Call struct {
 //   - ctx context.Context
 //   - author string
 //   - tag string
+//   - favorited string
+//   - after string
 //   - limit int64
 //   - offset int64
-func (_e *mockArticleLister_Expecter) ListArticles(ctx interface{}, author interface{}, tag interface{}, limit interface{}, offset interface{}) *mockArticleLister_ListArticles_Call {
-	return &mockArticleLister_ListArticles_Call{Call: _e.mock.On("ListArticles", ctx, author, tag, limit, offset)}
+func (_e *mockArticleLister_Expecter) ListArticles(ctx interface{}, author interface{}, tag interface{}, favorited interface{}, after interface{}, limit interface{}, offset interface{}) *mockArticleLister_ListArticles_Call {
+	return &mockArticleLister_ListArticles_Call{Call: _e.mock.On("ListArticles", ctx, author, tag, favorited, after, limit, offset)}
 }
 
-func (_c *mockArticleLister_ListArticles_Call) Run(run func(ctx context.Context, author string, tag string, limit int64, offset int64)) *mockArticleLister_ListArticles_Call {
+func (_c *mockArticleLister_ListArticles_Call) Run(run func(ctx context.Context, author string, tag string, favorited string, after string, limit int64, offset int64)) *mockArticleLister_ListArticles_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(int64), args[4].(int64))
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(string), args[5].(int64), args[6].(int64))
 	})
 	return _c
 }
@@ -79,7 +81,7 @@ func (_c *mockArticleLister_ListArticles_Call) Return(_a0 []*models.Article, _a1
 	return _c
 }
 
-func (_c *mockArticleLister_ListArticles_Call) RunAndReturn(run func(context.Context, string, string, int64, int64) ([]*models.Article, error)) *mockArticleLister_ListArticles_Call {
+func (_c *mockArticleLister_ListArticles_Call) RunAndReturn(run func(context.Context, string, string, string, string, int64, int64) ([]*models.Article, error)) *mockArticleLister_ListArticles_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,96 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package handlers
+
+import (
+	context "context"
+
+	models "github.com/ravilock/goduit/internal/articlePublisher/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockArticleRestorer is an autogenerated mock type for the articleRestorer type
+type mockArticleRestorer struct {
+	mock.Mock
+}
+
+type mockArticleRestorer_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockArticleRestorer) EXPECT() *mockArticleRestorer_Expecter {
+	return &mockArticleRestorer_Expecter{mock: &_m.Mock}
+}
+
+// RestoreArticle provides a mock function with given fields: ctx, ID
+func (_m *mockArticleRestorer) RestoreArticle(ctx context.Context, ID string) (*models.Article, error) {
+	ret := _m.Called(ctx, ID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreArticle")
+	}
+
+	var r0 *models.Article
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.Article, error)); ok {
+		return rf(ctx, ID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *models.Article); ok {
+		r0 = rf(ctx, ID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Article)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, ID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockArticleRestorer_RestoreArticle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreArticle'
+type mockArticleRestorer_RestoreArticle_Call struct {
+	*mock.Call
+}
+
+// RestoreArticle is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ID string
+func (_e *mockArticleRestorer_Expecter) RestoreArticle(ctx interface{}, ID interface{}) *mockArticleRestorer_RestoreArticle_Call {
+	return &mockArticleRestorer_RestoreArticle_Call{Call: _e.mock.On("RestoreArticle", ctx, ID)}
+}
+
+func (_c *mockArticleRestorer_RestoreArticle_Call) Run(run func(ctx context.Context, ID string)) *mockArticleRestorer_RestoreArticle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *mockArticleRestorer_RestoreArticle_Call) Return(_a0 *models.Article, _a1 error) *mockArticleRestorer_RestoreArticle_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *mockArticleRestorer_RestoreArticle_Call) RunAndReturn(run func(context.Context, string) (*models.Article, error)) *mockArticleRestorer_RestoreArticle_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockArticleRestorer creates a new instance of mockArticleRestorer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockArticleRestorer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockArticleRestorer {
+	mock := &mockArticleRestorer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,100 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package handlers
+
+import (
+	context "context"
+
+	models "github.com/ravilock/goduit/internal/articlePublisher/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockArticleSearcher is an autogenerated mock type for the articleSearcher type
+type mockArticleSearcher struct {
+	mock.Mock
+}
+
+type mockArticleSearcher_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockArticleSearcher) EXPECT() *mockArticleSearcher_Expecter {
+	return &mockArticleSearcher_Expecter{mock: &_m.Mock}
+}
+
+// SearchArticles provides a mock function with given fields: ctx, query, author, tag, limit, offset
+func (_m *mockArticleSearcher) SearchArticles(ctx context.Context, query string, author string, tag string, limit int64, offset int64) ([]*models.Article, error) {
+	ret := _m.Called(ctx, query, author, tag, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchArticles")
+	}
+
+	var r0 []*models.Article
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, int64, int64) ([]*models.Article, error)); ok {
+		return rf(ctx, query, author, tag, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, int64, int64) []*models.Article); ok {
+		r0 = rf(ctx, query, author, tag, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Article)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, int64, int64) error); ok {
+		r1 = rf(ctx, query, author, tag, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockArticleSearcher_SearchArticles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchArticles'
+type mockArticleSearcher_SearchArticles_Call struct {
+	*mock.Call
+}
+
+// SearchArticles is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query string
+//   - author string
+//   - tag string
+//   - limit int64
+//   - offset int64
+func (_e *mockArticleSearcher_Expecter) SearchArticles(ctx interface{}, query interface{}, author interface{}, tag interface{}, limit interface{}, offset interface{}) *mockArticleSearcher_SearchArticles_Call {
+	return &mockArticleSearcher_SearchArticles_Call{Call: _e.mock.On("SearchArticles", ctx, query, author, tag, limit, offset)}
+}
+
+func (_c *mockArticleSearcher_SearchArticles_Call) Run(run func(ctx context.Context, query string, author string, tag string, limit int64, offset int64)) *mockArticleSearcher_SearchArticles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(int64), args[5].(int64))
+	})
+	return _c
+}
+
+func (_c *mockArticleSearcher_SearchArticles_Call) Return(_a0 []*models.Article, _a1 error) *mockArticleSearcher_SearchArticles_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *mockArticleSearcher_SearchArticles_Call) RunAndReturn(run func(context.Context, string, string, string, int64, int64) ([]*models.Article, error)) *mockArticleSearcher_SearchArticles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockArticleSearcher creates a new instance of mockArticleSearcher. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockArticleSearcher(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockArticleSearcher {
+	mock := &mockArticleSearcher{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
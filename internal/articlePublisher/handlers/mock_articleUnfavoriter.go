@@ -0,0 +1,97 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package handlers
+
+import (
+	context "context"
+
+	models "github.com/ravilock/goduit/internal/articlePublisher/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockArticleUnfavoriter is an autogenerated mock type for the articleUnfavoriter type
+type mockArticleUnfavoriter struct {
+	mock.Mock
+}
+
+type mockArticleUnfavoriter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockArticleUnfavoriter) EXPECT() *mockArticleUnfavoriter_Expecter {
+	return &mockArticleUnfavoriter_Expecter{mock: &_m.Mock}
+}
+
+// UnfavoriteArticle provides a mock function with given fields: ctx, article, user
+func (_m *mockArticleUnfavoriter) UnfavoriteArticle(ctx context.Context, article string, user string) (*models.Article, error) {
+	ret := _m.Called(ctx, article, user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnfavoriteArticle")
+	}
+
+	var r0 *models.Article
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*models.Article, error)); ok {
+		return rf(ctx, article, user)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *models.Article); ok {
+		r0 = rf(ctx, article, user)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Article)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, article, user)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockArticleUnfavoriter_UnfavoriteArticle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnfavoriteArticle'
+type mockArticleUnfavoriter_UnfavoriteArticle_Call struct {
+	*mock.Call
+}
+
+// UnfavoriteArticle is a helper method to define mock.On call
+//   - ctx context.Context
+//   - article string
+//   - user string
+func (_e *mockArticleUnfavoriter_Expecter) UnfavoriteArticle(ctx interface{}, article interface{}, user interface{}) *mockArticleUnfavoriter_UnfavoriteArticle_Call {
+	return &mockArticleUnfavoriter_UnfavoriteArticle_Call{Call: _e.mock.On("UnfavoriteArticle", ctx, article, user)}
+}
+
+func (_c *mockArticleUnfavoriter_UnfavoriteArticle_Call) Run(run func(ctx context.Context, article string, user string)) *mockArticleUnfavoriter_UnfavoriteArticle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *mockArticleUnfavoriter_UnfavoriteArticle_Call) Return(_a0 *models.Article, _a1 error) *mockArticleUnfavoriter_UnfavoriteArticle_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *mockArticleUnfavoriter_UnfavoriteArticle_Call) RunAndReturn(run func(context.Context, string, string) (*models.Article, error)) *mockArticleUnfavoriter_UnfavoriteArticle_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockArticleUnfavoriter creates a new instance of mockArticleUnfavoriter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockArticleUnfavoriter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockArticleUnfavoriter {
+	mock := &mockArticleUnfavoriter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -22,17 +22,17 @@ func (_m *mockArticleUpdater) EXPECT() *mockArticleUpdater_Expecter {
 	return &mockArticleUpdater_Expecter{mock: &_m.Mock}
 }
 
-// UpdateArticle provides a mock function with given fields: ctx, slug, article
-func (_m *mockArticleUpdater) UpdateArticle(ctx context.Context, slug string, article *models.Article) error {
-	ret := _m.Called(ctx, slug, article)
+// UpdateArticle provides a mock function with given fields: ctx, slug, editor, article
+func (_m *mockArticleUpdater) UpdateArticle(ctx context.Context, slug string, editor string, article *models.Article) error {
+	ret := _m.Called(ctx, slug, editor, article)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UpdateArticle")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, *models.Article) error); ok {
-		r0 = rf(ctx, slug, article)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *models.Article) error); ok {
+		r0 = rf(ctx, slug, editor, article)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -48,14 +48,15 @@ type mockArticleUpdater_UpdateArticle_Call struct {
 // UpdateArticle is a helper method to define mock.On call
 //   - ctx context.Context
 //   - slug string
+//   - editor string
 //   - article *models.Article
-func (_e *mockArticleUpdater_Expecter) UpdateArticle(ctx interface{}, slug interface{}, article interface{}) *mockArticleUpdater_UpdateArticle_Call {
-	return &mockArticleUpdater_UpdateArticle_Call{Call: _e.mock.On("UpdateArticle", ctx, slug, article)}
+func (_e *mockArticleUpdater_Expecter) UpdateArticle(ctx interface{}, slug interface{}, editor interface{}, article interface{}) *mockArticleUpdater_UpdateArticle_Call {
+	return &mockArticleUpdater_UpdateArticle_Call{Call: _e.mock.On("UpdateArticle", ctx, slug, editor, article)}
 }
 
-func (_c *mockArticleUpdater_UpdateArticle_Call) Run(run func(ctx context.Context, slug string, article *models.Article)) *mockArticleUpdater_UpdateArticle_Call {
+func (_c *mockArticleUpdater_UpdateArticle_Call) Run(run func(ctx context.Context, slug string, editor string, article *models.Article)) *mockArticleUpdater_UpdateArticle_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(*models.Article))
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(*models.Article))
 	})
 	return _c
 }
@@ -65,7 +66,7 @@ func (_c *mockArticleUpdater_UpdateArticle_Call) Return(_a0 error) *mockArticleU
 	return _c
 }
 
-func (_c *mockArticleUpdater_UpdateArticle_Call) RunAndReturn(run func(context.Context, string, *models.Article) error) *mockArticleUpdater_UpdateArticle_Call {
+func (_c *mockArticleUpdater_UpdateArticle_Call) RunAndReturn(run func(context.Context, string, string, *models.Article) error) *mockArticleUpdater_UpdateArticle_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,96 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package handlers
+
+import (
+	context "context"
+	io "io"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockArticlesArchiveExporter is an autogenerated mock type for the articlesArchiveExporter type
+type mockArticlesArchiveExporter struct {
+	mock.Mock
+}
+
+type mockArticlesArchiveExporter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockArticlesArchiveExporter) EXPECT() *mockArticlesArchiveExporter_Expecter {
+	return &mockArticlesArchiveExporter_Expecter{mock: &_m.Mock}
+}
+
+// Export provides a mock function with given fields: ctx, author, format, w
+func (_m *mockArticlesArchiveExporter) Export(ctx context.Context, author string, format string, w io.Writer) (int, error) {
+	ret := _m.Called(ctx, author, format, w)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Export")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, io.Writer) (int, error)); ok {
+		return rf(ctx, author, format, w)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, io.Writer) int); ok {
+		r0 = rf(ctx, author, format, w)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, io.Writer) error); ok {
+		r1 = rf(ctx, author, format, w)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockArticlesArchiveExporter_Export_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Export'
+type mockArticlesArchiveExporter_Export_Call struct {
+	*mock.Call
+}
+
+// Export is a helper method to define mock.On call
+//   - ctx context.Context
+//   - author string
+//   - format string
+//   - w io.Writer
+func (_e *mockArticlesArchiveExporter_Expecter) Export(ctx interface{}, author interface{}, format interface{}, w interface{}) *mockArticlesArchiveExporter_Export_Call {
+	return &mockArticlesArchiveExporter_Export_Call{Call: _e.mock.On("Export", ctx, author, format, w)}
+}
+
+func (_c *mockArticlesArchiveExporter_Export_Call) Run(run func(ctx context.Context, author string, format string, w io.Writer)) *mockArticlesArchiveExporter_Export_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(io.Writer))
+	})
+	return _c
+}
+
+func (_c *mockArticlesArchiveExporter_Export_Call) Return(_a0 int, _a1 error) *mockArticlesArchiveExporter_Export_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *mockArticlesArchiveExporter_Export_Call) RunAndReturn(run func(context.Context, string, string, io.Writer) (int, error)) *mockArticlesArchiveExporter_Export_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockArticlesArchiveExporter creates a new instance of mockArticlesArchiveExporter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockArticlesArchiveExporter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockArticlesArchiveExporter {
+	mock := &mockArticlesArchiveExporter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,99 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package handlers
+
+import (
+	context "context"
+
+	archives "github.com/ravilock/goduit/internal/articlePublisher/archives"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockArticlesArchiveImporter is an autogenerated mock type for the articlesArchiveImporter type
+type mockArticlesArchiveImporter struct {
+	mock.Mock
+}
+
+type mockArticlesArchiveImporter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockArticlesArchiveImporter) EXPECT() *mockArticlesArchiveImporter_Expecter {
+	return &mockArticlesArchiveImporter_Expecter{mock: &_m.Mock}
+}
+
+// Import provides a mock function with given fields: ctx, author, archive, dryRun
+func (_m *mockArticlesArchiveImporter) Import(ctx context.Context, author string, archive []byte, dryRun bool) ([]*archives.ImportedFile, error) {
+	ret := _m.Called(ctx, author, archive, dryRun)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Import")
+	}
+
+	var r0 []*archives.ImportedFile
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []byte, bool) ([]*archives.ImportedFile, error)); ok {
+		return rf(ctx, author, archive, dryRun)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []byte, bool) []*archives.ImportedFile); ok {
+		r0 = rf(ctx, author, archive, dryRun)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*archives.ImportedFile)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, []byte, bool) error); ok {
+		r1 = rf(ctx, author, archive, dryRun)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockArticlesArchiveImporter_Import_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Import'
+type mockArticlesArchiveImporter_Import_Call struct {
+	*mock.Call
+}
+
+// Import is a helper method to define mock.On call
+//   - ctx context.Context
+//   - author string
+//   - archive []byte
+//   - dryRun bool
+func (_e *mockArticlesArchiveImporter_Expecter) Import(ctx interface{}, author interface{}, archive interface{}, dryRun interface{}) *mockArticlesArchiveImporter_Import_Call {
+	return &mockArticlesArchiveImporter_Import_Call{Call: _e.mock.On("Import", ctx, author, archive, dryRun)}
+}
+
+func (_c *mockArticlesArchiveImporter_Import_Call) Run(run func(ctx context.Context, author string, archive []byte, dryRun bool)) *mockArticlesArchiveImporter_Import_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]byte), args[3].(bool))
+	})
+	return _c
+}
+
+func (_c *mockArticlesArchiveImporter_Import_Call) Return(_a0 []*archives.ImportedFile, _a1 error) *mockArticlesArchiveImporter_Import_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *mockArticlesArchiveImporter_Import_Call) RunAndReturn(run func(context.Context, string, []byte, bool) ([]*archives.ImportedFile, error)) *mockArticlesArchiveImporter_Import_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockArticlesArchiveImporter creates a new instance of mockArticlesArchiveImporter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockArticlesArchiveImporter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockArticlesArchiveImporter {
+	mock := &mockArticlesArchiveImporter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,97 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package handlers
+
+import (
+	context "context"
+
+	models "github.com/ravilock/goduit/internal/articlePublisher/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockCoAuthorInvitationAccepter is an autogenerated mock type for the coAuthorInvitationAccepter type
+type mockCoAuthorInvitationAccepter struct {
+	mock.Mock
+}
+
+type mockCoAuthorInvitationAccepter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockCoAuthorInvitationAccepter) EXPECT() *mockCoAuthorInvitationAccepter_Expecter {
+	return &mockCoAuthorInvitationAccepter_Expecter{mock: &_m.Mock}
+}
+
+// AcceptInvitation provides a mock function with given fields: ctx, article, invitee
+func (_m *mockCoAuthorInvitationAccepter) AcceptInvitation(ctx context.Context, article string, invitee string) (*models.Article, error) {
+	ret := _m.Called(ctx, article, invitee)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AcceptInvitation")
+	}
+
+	var r0 *models.Article
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*models.Article, error)); ok {
+		return rf(ctx, article, invitee)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *models.Article); ok {
+		r0 = rf(ctx, article, invitee)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Article)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, article, invitee)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockCoAuthorInvitationAccepter_AcceptInvitation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AcceptInvitation'
+type mockCoAuthorInvitationAccepter_AcceptInvitation_Call struct {
+	*mock.Call
+}
+
+// AcceptInvitation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - article string
+//   - invitee string
+func (_e *mockCoAuthorInvitationAccepter_Expecter) AcceptInvitation(ctx interface{}, article interface{}, invitee interface{}) *mockCoAuthorInvitationAccepter_AcceptInvitation_Call {
+	return &mockCoAuthorInvitationAccepter_AcceptInvitation_Call{Call: _e.mock.On("AcceptInvitation", ctx, article, invitee)}
+}
+
+func (_c *mockCoAuthorInvitationAccepter_AcceptInvitation_Call) Run(run func(ctx context.Context, article string, invitee string)) *mockCoAuthorInvitationAccepter_AcceptInvitation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *mockCoAuthorInvitationAccepter_AcceptInvitation_Call) Return(_a0 *models.Article, _a1 error) *mockCoAuthorInvitationAccepter_AcceptInvitation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *mockCoAuthorInvitationAccepter_AcceptInvitation_Call) RunAndReturn(run func(context.Context, string, string) (*models.Article, error)) *mockCoAuthorInvitationAccepter_AcceptInvitation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockCoAuthorInvitationAccepter creates a new instance of mockCoAuthorInvitationAccepter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockCoAuthorInvitationAccepter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockCoAuthorInvitationAccepter {
+	mock := &mockCoAuthorInvitationAccepter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
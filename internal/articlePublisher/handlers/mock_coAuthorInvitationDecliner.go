@@ -0,0 +1,84 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package handlers
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockCoAuthorInvitationDecliner is an autogenerated mock type for the coAuthorInvitationDecliner type
+type mockCoAuthorInvitationDecliner struct {
+	mock.Mock
+}
+
+type mockCoAuthorInvitationDecliner_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockCoAuthorInvitationDecliner) EXPECT() *mockCoAuthorInvitationDecliner_Expecter {
+	return &mockCoAuthorInvitationDecliner_Expecter{mock: &_m.Mock}
+}
+
+// DeclineInvitation provides a mock function with given fields: ctx, article, invitee
+func (_m *mockCoAuthorInvitationDecliner) DeclineInvitation(ctx context.Context, article string, invitee string) error {
+	ret := _m.Called(ctx, article, invitee)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeclineInvitation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, article, invitee)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// mockCoAuthorInvitationDecliner_DeclineInvitation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeclineInvitation'
+type mockCoAuthorInvitationDecliner_DeclineInvitation_Call struct {
+	*mock.Call
+}
+
+// DeclineInvitation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - article string
+//   - invitee string
+func (_e *mockCoAuthorInvitationDecliner_Expecter) DeclineInvitation(ctx interface{}, article interface{}, invitee interface{}) *mockCoAuthorInvitationDecliner_DeclineInvitation_Call {
+	return &mockCoAuthorInvitationDecliner_DeclineInvitation_Call{Call: _e.mock.On("DeclineInvitation", ctx, article, invitee)}
+}
+
+func (_c *mockCoAuthorInvitationDecliner_DeclineInvitation_Call) Run(run func(ctx context.Context, article string, invitee string)) *mockCoAuthorInvitationDecliner_DeclineInvitation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *mockCoAuthorInvitationDecliner_DeclineInvitation_Call) Return(_a0 error) *mockCoAuthorInvitationDecliner_DeclineInvitation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *mockCoAuthorInvitationDecliner_DeclineInvitation_Call) RunAndReturn(run func(context.Context, string, string) error) *mockCoAuthorInvitationDecliner_DeclineInvitation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockCoAuthorInvitationDecliner creates a new instance of mockCoAuthorInvitationDecliner. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockCoAuthorInvitationDecliner(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockCoAuthorInvitationDecliner {
+	mock := &mockCoAuthorInvitationDecliner{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
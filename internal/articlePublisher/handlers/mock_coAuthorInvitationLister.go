@@ -0,0 +1,98 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package handlers
+
+import (
+	context "context"
+
+	models "github.com/ravilock/goduit/internal/articlePublisher/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockCoAuthorInvitationLister is an autogenerated mock type for the coAuthorInvitationLister type
+type mockCoAuthorInvitationLister struct {
+	mock.Mock
+}
+
+type mockCoAuthorInvitationLister_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockCoAuthorInvitationLister) EXPECT() *mockCoAuthorInvitationLister_Expecter {
+	return &mockCoAuthorInvitationLister_Expecter{mock: &_m.Mock}
+}
+
+// ListInvitations provides a mock function with given fields: ctx, invitee, limit, offset
+func (_m *mockCoAuthorInvitationLister) ListInvitations(ctx context.Context, invitee string, limit int64, offset int64) ([]*models.CoAuthorInvitation, error) {
+	ret := _m.Called(ctx, invitee, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListInvitations")
+	}
+
+	var r0 []*models.CoAuthorInvitation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, int64) ([]*models.CoAuthorInvitation, error)); ok {
+		return rf(ctx, invitee, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, int64) []*models.CoAuthorInvitation); ok {
+		r0 = rf(ctx, invitee, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.CoAuthorInvitation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64, int64) error); ok {
+		r1 = rf(ctx, invitee, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockCoAuthorInvitationLister_ListInvitations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListInvitations'
+type mockCoAuthorInvitationLister_ListInvitations_Call struct {
+	*mock.Call
+}
+
+// ListInvitations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - invitee string
+//   - limit int64
+//   - offset int64
+func (_e *mockCoAuthorInvitationLister_Expecter) ListInvitations(ctx interface{}, invitee interface{}, limit interface{}, offset interface{}) *mockCoAuthorInvitationLister_ListInvitations_Call {
+	return &mockCoAuthorInvitationLister_ListInvitations_Call{Call: _e.mock.On("ListInvitations", ctx, invitee, limit, offset)}
+}
+
+func (_c *mockCoAuthorInvitationLister_ListInvitations_Call) Run(run func(ctx context.Context, invitee string, limit int64, offset int64)) *mockCoAuthorInvitationLister_ListInvitations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *mockCoAuthorInvitationLister_ListInvitations_Call) Return(_a0 []*models.CoAuthorInvitation, _a1 error) *mockCoAuthorInvitationLister_ListInvitations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *mockCoAuthorInvitationLister_ListInvitations_Call) RunAndReturn(run func(context.Context, string, int64, int64) ([]*models.CoAuthorInvitation, error)) *mockCoAuthorInvitationLister_ListInvitations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockCoAuthorInvitationLister creates a new instance of mockCoAuthorInvitationLister. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockCoAuthorInvitationLister(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockCoAuthorInvitationLister {
+	mock := &mockCoAuthorInvitationLister{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
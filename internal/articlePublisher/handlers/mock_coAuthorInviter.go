@@ -0,0 +1,97 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package handlers
+
+import (
+	context "context"
+
+	models "github.com/ravilock/goduit/internal/articlePublisher/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockCoAuthorInviter is an autogenerated mock type for the coAuthorInviter type
+type mockCoAuthorInviter struct {
+	mock.Mock
+}
+
+type mockCoAuthorInviter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockCoAuthorInviter) EXPECT() *mockCoAuthorInviter_Expecter {
+	return &mockCoAuthorInviter_Expecter{mock: &_m.Mock}
+}
+
+// InviteCoAuthor provides a mock function with given fields: ctx, article, invitee
+func (_m *mockCoAuthorInviter) InviteCoAuthor(ctx context.Context, article *models.Article, invitee string) (*models.CoAuthorInvitation, error) {
+	ret := _m.Called(ctx, article, invitee)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InviteCoAuthor")
+	}
+
+	var r0 *models.CoAuthorInvitation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Article, string) (*models.CoAuthorInvitation, error)); ok {
+		return rf(ctx, article, invitee)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Article, string) *models.CoAuthorInvitation); ok {
+		r0 = rf(ctx, article, invitee)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.CoAuthorInvitation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *models.Article, string) error); ok {
+		r1 = rf(ctx, article, invitee)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockCoAuthorInviter_InviteCoAuthor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InviteCoAuthor'
+type mockCoAuthorInviter_InviteCoAuthor_Call struct {
+	*mock.Call
+}
+
+// InviteCoAuthor is a helper method to define mock.On call
+//   - ctx context.Context
+//   - article *models.Article
+//   - invitee string
+func (_e *mockCoAuthorInviter_Expecter) InviteCoAuthor(ctx interface{}, article interface{}, invitee interface{}) *mockCoAuthorInviter_InviteCoAuthor_Call {
+	return &mockCoAuthorInviter_InviteCoAuthor_Call{Call: _e.mock.On("InviteCoAuthor", ctx, article, invitee)}
+}
+
+func (_c *mockCoAuthorInviter_InviteCoAuthor_Call) Run(run func(ctx context.Context, article *models.Article, invitee string)) *mockCoAuthorInviter_InviteCoAuthor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.Article), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *mockCoAuthorInviter_InviteCoAuthor_Call) Return(_a0 *models.CoAuthorInvitation, _a1 error) *mockCoAuthorInviter_InviteCoAuthor_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *mockCoAuthorInviter_InviteCoAuthor_Call) RunAndReturn(run func(context.Context, *models.Article, string) (*models.CoAuthorInvitation, error)) *mockCoAuthorInviter_InviteCoAuthor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockCoAuthorInviter creates a new instance of mockCoAuthorInviter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockCoAuthorInviter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockCoAuthorInviter {
+	mock := &mockCoAuthorInviter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
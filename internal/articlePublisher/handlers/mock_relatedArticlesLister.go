@@ -0,0 +1,97 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package handlers
+
+import (
+	context "context"
+
+	models "github.com/ravilock/goduit/internal/articlePublisher/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockRelatedArticlesLister is an autogenerated mock type for the relatedArticlesLister type
+type mockRelatedArticlesLister struct {
+	mock.Mock
+}
+
+type mockRelatedArticlesLister_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockRelatedArticlesLister) EXPECT() *mockRelatedArticlesLister_Expecter {
+	return &mockRelatedArticlesLister_Expecter{mock: &_m.Mock}
+}
+
+// ListRelatedArticles provides a mock function with given fields: ctx, article, limit
+func (_m *mockRelatedArticlesLister) ListRelatedArticles(ctx context.Context, article *models.Article, limit int64) ([]*models.Article, error) {
+	ret := _m.Called(ctx, article, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRelatedArticles")
+	}
+
+	var r0 []*models.Article
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Article, int64) ([]*models.Article, error)); ok {
+		return rf(ctx, article, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Article, int64) []*models.Article); ok {
+		r0 = rf(ctx, article, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Article)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *models.Article, int64) error); ok {
+		r1 = rf(ctx, article, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockRelatedArticlesLister_ListRelatedArticles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRelatedArticles'
+type mockRelatedArticlesLister_ListRelatedArticles_Call struct {
+	*mock.Call
+}
+
+// ListRelatedArticles is a helper method to define mock.On call
+//   - ctx context.Context
+//   - article *models.Article
+//   - limit int64
+func (_e *mockRelatedArticlesLister_Expecter) ListRelatedArticles(ctx interface{}, article interface{}, limit interface{}) *mockRelatedArticlesLister_ListRelatedArticles_Call {
+	return &mockRelatedArticlesLister_ListRelatedArticles_Call{Call: _e.mock.On("ListRelatedArticles", ctx, article, limit)}
+}
+
+func (_c *mockRelatedArticlesLister_ListRelatedArticles_Call) Run(run func(ctx context.Context, article *models.Article, limit int64)) *mockRelatedArticlesLister_ListRelatedArticles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.Article), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *mockRelatedArticlesLister_ListRelatedArticles_Call) Return(_a0 []*models.Article, _a1 error) *mockRelatedArticlesLister_ListRelatedArticles_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *mockRelatedArticlesLister_ListRelatedArticles_Call) RunAndReturn(run func(context.Context, *models.Article, int64) ([]*models.Article, error)) *mockRelatedArticlesLister_ListRelatedArticles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockRelatedArticlesLister creates a new instance of mockRelatedArticlesLister. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockRelatedArticlesLister(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockRelatedArticlesLister {
+	mock := &mockRelatedArticlesLister{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
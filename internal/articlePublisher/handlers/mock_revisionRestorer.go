@@ -0,0 +1,98 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package handlers
+
+import (
+	context "context"
+
+	models "github.com/ravilock/goduit/internal/articlePublisher/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockRevisionRestorer is an autogenerated mock type for the revisionRestorer type
+type mockRevisionRestorer struct {
+	mock.Mock
+}
+
+type mockRevisionRestorer_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockRevisionRestorer) EXPECT() *mockRevisionRestorer_Expecter {
+	return &mockRevisionRestorer_Expecter{mock: &_m.Mock}
+}
+
+// RestoreRevision provides a mock function with given fields: ctx, slug, editor, revision
+func (_m *mockRevisionRestorer) RestoreRevision(ctx context.Context, slug string, editor string, revision *models.Revision) (*models.Article, error) {
+	ret := _m.Called(ctx, slug, editor, revision)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreRevision")
+	}
+
+	var r0 *models.Article
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *models.Revision) (*models.Article, error)); ok {
+		return rf(ctx, slug, editor, revision)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *models.Revision) *models.Article); ok {
+		r0 = rf(ctx, slug, editor, revision)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Article)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, *models.Revision) error); ok {
+		r1 = rf(ctx, slug, editor, revision)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockRevisionRestorer_RestoreRevision_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreRevision'
+type mockRevisionRestorer_RestoreRevision_Call struct {
+	*mock.Call
+}
+
+// RestoreRevision is a helper method to define mock.On call
+//   - ctx context.Context
+//   - slug string
+//   - editor string
+//   - revision *models.Revision
+func (_e *mockRevisionRestorer_Expecter) RestoreRevision(ctx interface{}, slug interface{}, editor interface{}, revision interface{}) *mockRevisionRestorer_RestoreRevision_Call {
+	return &mockRevisionRestorer_RestoreRevision_Call{Call: _e.mock.On("RestoreRevision", ctx, slug, editor, revision)}
+}
+
+func (_c *mockRevisionRestorer_RestoreRevision_Call) Run(run func(ctx context.Context, slug string, editor string, revision *models.Revision)) *mockRevisionRestorer_RestoreRevision_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(*models.Revision))
+	})
+	return _c
+}
+
+func (_c *mockRevisionRestorer_RestoreRevision_Call) Return(_a0 *models.Article, _a1 error) *mockRevisionRestorer_RestoreRevision_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *mockRevisionRestorer_RestoreRevision_Call) RunAndReturn(run func(context.Context, string, string, *models.Revision) (*models.Article, error)) *mockRevisionRestorer_RestoreRevision_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockRevisionRestorer creates a new instance of mockRevisionRestorer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockRevisionRestorer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockRevisionRestorer {
+	mock := &mockRevisionRestorer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,155 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package handlers
+
+import (
+	context "context"
+
+	models "github.com/ravilock/goduit/internal/articlePublisher/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockSeriesArticleGetter is an autogenerated mock type for the seriesArticleGetter type
+type mockSeriesArticleGetter struct {
+	mock.Mock
+}
+
+type mockSeriesArticleGetter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockSeriesArticleGetter) EXPECT() *mockSeriesArticleGetter_Expecter {
+	return &mockSeriesArticleGetter_Expecter{mock: &_m.Mock}
+}
+
+// GetArticleByID provides a mock function with given fields: ctx, ID
+func (_m *mockSeriesArticleGetter) GetArticleByID(ctx context.Context, ID string) (*models.Article, error) {
+	ret := _m.Called(ctx, ID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetArticleByID")
+	}
+
+	var r0 *models.Article
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.Article, error)); ok {
+		return rf(ctx, ID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *models.Article); ok {
+		r0 = rf(ctx, ID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Article)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, ID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockSeriesArticleGetter_GetArticleByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetArticleByID'
+type mockSeriesArticleGetter_GetArticleByID_Call struct {
+	*mock.Call
+}
+
+// GetArticleByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ID string
+func (_e *mockSeriesArticleGetter_Expecter) GetArticleByID(ctx interface{}, ID interface{}) *mockSeriesArticleGetter_GetArticleByID_Call {
+	return &mockSeriesArticleGetter_GetArticleByID_Call{Call: _e.mock.On("GetArticleByID", ctx, ID)}
+}
+
+func (_c *mockSeriesArticleGetter_GetArticleByID_Call) Run(run func(ctx context.Context, ID string)) *mockSeriesArticleGetter_GetArticleByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *mockSeriesArticleGetter_GetArticleByID_Call) Return(_a0 *models.Article, _a1 error) *mockSeriesArticleGetter_GetArticleByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *mockSeriesArticleGetter_GetArticleByID_Call) RunAndReturn(run func(context.Context, string) (*models.Article, error)) *mockSeriesArticleGetter_GetArticleByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetArticleBySlug provides a mock function with given fields: ctx, slug
+func (_m *mockSeriesArticleGetter) GetArticleBySlug(ctx context.Context, slug string) (*models.Article, error) {
+	ret := _m.Called(ctx, slug)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetArticleBySlug")
+	}
+
+	var r0 *models.Article
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.Article, error)); ok {
+		return rf(ctx, slug)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *models.Article); ok {
+		r0 = rf(ctx, slug)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Article)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, slug)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockSeriesArticleGetter_GetArticleBySlug_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetArticleBySlug'
+type mockSeriesArticleGetter_GetArticleBySlug_Call struct {
+	*mock.Call
+}
+
+// GetArticleBySlug is a helper method to define mock.On call
+//   - ctx context.Context
+//   - slug string
+func (_e *mockSeriesArticleGetter_Expecter) GetArticleBySlug(ctx interface{}, slug interface{}) *mockSeriesArticleGetter_GetArticleBySlug_Call {
+	return &mockSeriesArticleGetter_GetArticleBySlug_Call{Call: _e.mock.On("GetArticleBySlug", ctx, slug)}
+}
+
+func (_c *mockSeriesArticleGetter_GetArticleBySlug_Call) Run(run func(ctx context.Context, slug string)) *mockSeriesArticleGetter_GetArticleBySlug_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *mockSeriesArticleGetter_GetArticleBySlug_Call) Return(_a0 *models.Article, _a1 error) *mockSeriesArticleGetter_GetArticleBySlug_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *mockSeriesArticleGetter_GetArticleBySlug_Call) RunAndReturn(run func(context.Context, string) (*models.Article, error)) *mockSeriesArticleGetter_GetArticleBySlug_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockSeriesArticleGetter creates a new instance of mockSeriesArticleGetter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockSeriesArticleGetter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockSeriesArticleGetter {
+	mock := &mockSeriesArticleGetter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
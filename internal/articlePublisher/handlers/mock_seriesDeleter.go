@@ -0,0 +1,83 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package handlers
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockSeriesDeleter is an autogenerated mock type for the seriesDeleter type
+type mockSeriesDeleter struct {
+	mock.Mock
+}
+
+type mockSeriesDeleter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockSeriesDeleter) EXPECT() *mockSeriesDeleter_Expecter {
+	return &mockSeriesDeleter_Expecter{mock: &_m.Mock}
+}
+
+// DeleteSeries provides a mock function with given fields: ctx, ID
+func (_m *mockSeriesDeleter) DeleteSeries(ctx context.Context, ID string) error {
+	ret := _m.Called(ctx, ID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSeries")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, ID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// mockSeriesDeleter_DeleteSeries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSeries'
+type mockSeriesDeleter_DeleteSeries_Call struct {
+	*mock.Call
+}
+
+// DeleteSeries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ID string
+func (_e *mockSeriesDeleter_Expecter) DeleteSeries(ctx interface{}, ID interface{}) *mockSeriesDeleter_DeleteSeries_Call {
+	return &mockSeriesDeleter_DeleteSeries_Call{Call: _e.mock.On("DeleteSeries", ctx, ID)}
+}
+
+func (_c *mockSeriesDeleter_DeleteSeries_Call) Run(run func(ctx context.Context, ID string)) *mockSeriesDeleter_DeleteSeries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *mockSeriesDeleter_DeleteSeries_Call) Return(_a0 error) *mockSeriesDeleter_DeleteSeries_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *mockSeriesDeleter_DeleteSeries_Call) RunAndReturn(run func(context.Context, string) error) *mockSeriesDeleter_DeleteSeries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockSeriesDeleter creates a new instance of mockSeriesDeleter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockSeriesDeleter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockSeriesDeleter {
+	mock := &mockSeriesDeleter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
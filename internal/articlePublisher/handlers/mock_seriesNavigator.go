@@ -0,0 +1,96 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package handlers
+
+import (
+	context "context"
+
+	models "github.com/ravilock/goduit/internal/articlePublisher/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockSeriesNavigator is an autogenerated mock type for the seriesNavigator type
+type mockSeriesNavigator struct {
+	mock.Mock
+}
+
+type mockSeriesNavigator_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockSeriesNavigator) EXPECT() *mockSeriesNavigator_Expecter {
+	return &mockSeriesNavigator_Expecter{mock: &_m.Mock}
+}
+
+// GetSeriesNavigation provides a mock function with given fields: ctx, article
+func (_m *mockSeriesNavigator) GetSeriesNavigation(ctx context.Context, article *models.Article) (*models.SeriesNavigation, error) {
+	ret := _m.Called(ctx, article)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSeriesNavigation")
+	}
+
+	var r0 *models.SeriesNavigation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Article) (*models.SeriesNavigation, error)); ok {
+		return rf(ctx, article)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Article) *models.SeriesNavigation); ok {
+		r0 = rf(ctx, article)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.SeriesNavigation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *models.Article) error); ok {
+		r1 = rf(ctx, article)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockSeriesNavigator_GetSeriesNavigation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSeriesNavigation'
+type mockSeriesNavigator_GetSeriesNavigation_Call struct {
+	*mock.Call
+}
+
+// GetSeriesNavigation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - article *models.Article
+func (_e *mockSeriesNavigator_Expecter) GetSeriesNavigation(ctx interface{}, article interface{}) *mockSeriesNavigator_GetSeriesNavigation_Call {
+	return &mockSeriesNavigator_GetSeriesNavigation_Call{Call: _e.mock.On("GetSeriesNavigation", ctx, article)}
+}
+
+func (_c *mockSeriesNavigator_GetSeriesNavigation_Call) Run(run func(ctx context.Context, article *models.Article)) *mockSeriesNavigator_GetSeriesNavigation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.Article))
+	})
+	return _c
+}
+
+func (_c *mockSeriesNavigator_GetSeriesNavigation_Call) Return(_a0 *models.SeriesNavigation, _a1 error) *mockSeriesNavigator_GetSeriesNavigation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *mockSeriesNavigator_GetSeriesNavigation_Call) RunAndReturn(run func(context.Context, *models.Article) (*models.SeriesNavigation, error)) *mockSeriesNavigator_GetSeriesNavigation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockSeriesNavigator creates a new instance of mockSeriesNavigator. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockSeriesNavigator(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockSeriesNavigator {
+	mock := &mockSeriesNavigator{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
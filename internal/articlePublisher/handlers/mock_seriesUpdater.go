@@ -0,0 +1,97 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package handlers
+
+import (
+	context "context"
+
+	models "github.com/ravilock/goduit/internal/articlePublisher/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockSeriesUpdater is an autogenerated mock type for the seriesUpdater type
+type mockSeriesUpdater struct {
+	mock.Mock
+}
+
+type mockSeriesUpdater_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockSeriesUpdater) EXPECT() *mockSeriesUpdater_Expecter {
+	return &mockSeriesUpdater_Expecter{mock: &_m.Mock}
+}
+
+// UpdateSeries provides a mock function with given fields: ctx, currentSeries, series
+func (_m *mockSeriesUpdater) UpdateSeries(ctx context.Context, currentSeries *models.Series, series *models.Series) (*models.Series, error) {
+	ret := _m.Called(ctx, currentSeries, series)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateSeries")
+	}
+
+	var r0 *models.Series
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Series, *models.Series) (*models.Series, error)); ok {
+		return rf(ctx, currentSeries, series)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Series, *models.Series) *models.Series); ok {
+		r0 = rf(ctx, currentSeries, series)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Series)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *models.Series, *models.Series) error); ok {
+		r1 = rf(ctx, currentSeries, series)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockSeriesUpdater_UpdateSeries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSeries'
+type mockSeriesUpdater_UpdateSeries_Call struct {
+	*mock.Call
+}
+
+// UpdateSeries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - currentSeries *models.Series
+//   - series *models.Series
+func (_e *mockSeriesUpdater_Expecter) UpdateSeries(ctx interface{}, currentSeries interface{}, series interface{}) *mockSeriesUpdater_UpdateSeries_Call {
+	return &mockSeriesUpdater_UpdateSeries_Call{Call: _e.mock.On("UpdateSeries", ctx, currentSeries, series)}
+}
+
+func (_c *mockSeriesUpdater_UpdateSeries_Call) Run(run func(ctx context.Context, currentSeries *models.Series, series *models.Series)) *mockSeriesUpdater_UpdateSeries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.Series), args[2].(*models.Series))
+	})
+	return _c
+}
+
+func (_c *mockSeriesUpdater_UpdateSeries_Call) Return(_a0 *models.Series, _a1 error) *mockSeriesUpdater_UpdateSeries_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *mockSeriesUpdater_UpdateSeries_Call) RunAndReturn(run func(context.Context, *models.Series, *models.Series) (*models.Series, error)) *mockSeriesUpdater_UpdateSeries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockSeriesUpdater creates a new instance of mockSeriesUpdater. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockSeriesUpdater(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockSeriesUpdater {
+	mock := &mockSeriesUpdater{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
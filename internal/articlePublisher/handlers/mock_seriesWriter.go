@@ -0,0 +1,84 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package handlers
+
+import (
+	context "context"
+
+	models "github.com/ravilock/goduit/internal/articlePublisher/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockSeriesWriter is an autogenerated mock type for the seriesWriter type
+type mockSeriesWriter struct {
+	mock.Mock
+}
+
+type mockSeriesWriter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockSeriesWriter) EXPECT() *mockSeriesWriter_Expecter {
+	return &mockSeriesWriter_Expecter{mock: &_m.Mock}
+}
+
+// WriteSeries provides a mock function with given fields: ctx, series
+func (_m *mockSeriesWriter) WriteSeries(ctx context.Context, series *models.Series) error {
+	ret := _m.Called(ctx, series)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WriteSeries")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Series) error); ok {
+		r0 = rf(ctx, series)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// mockSeriesWriter_WriteSeries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WriteSeries'
+type mockSeriesWriter_WriteSeries_Call struct {
+	*mock.Call
+}
+
+// WriteSeries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - series *models.Series
+func (_e *mockSeriesWriter_Expecter) WriteSeries(ctx interface{}, series interface{}) *mockSeriesWriter_WriteSeries_Call {
+	return &mockSeriesWriter_WriteSeries_Call{Call: _e.mock.On("WriteSeries", ctx, series)}
+}
+
+func (_c *mockSeriesWriter_WriteSeries_Call) Run(run func(ctx context.Context, series *models.Series)) *mockSeriesWriter_WriteSeries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.Series))
+	})
+	return _c
+}
+
+func (_c *mockSeriesWriter_WriteSeries_Call) Return(_a0 error) *mockSeriesWriter_WriteSeries_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *mockSeriesWriter_WriteSeries_Call) RunAndReturn(run func(context.Context, *models.Series) error) *mockSeriesWriter_WriteSeries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockSeriesWriter creates a new instance of mockSeriesWriter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockSeriesWriter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockSeriesWriter {
+	mock := &mockSeriesWriter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,153 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package handlers
+
+import (
+	context "context"
+
+	models "github.com/ravilock/goduit/internal/articlePublisher/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockSitemapArticlesLister is an autogenerated mock type for the sitemapArticlesLister type
+type mockSitemapArticlesLister struct {
+	mock.Mock
+}
+
+type mockSitemapArticlesLister_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockSitemapArticlesLister) EXPECT() *mockSitemapArticlesLister_Expecter {
+	return &mockSitemapArticlesLister_Expecter{mock: &_m.Mock}
+}
+
+// CountSitemapArticles provides a mock function with given fields: ctx
+func (_m *mockSitemapArticlesLister) CountSitemapArticles(ctx context.Context) (int64, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountSitemapArticles")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int64, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int64); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockSitemapArticlesLister_CountSitemapArticles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountSitemapArticles'
+type mockSitemapArticlesLister_CountSitemapArticles_Call struct {
+	*mock.Call
+}
+
+// CountSitemapArticles is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *mockSitemapArticlesLister_Expecter) CountSitemapArticles(ctx interface{}) *mockSitemapArticlesLister_CountSitemapArticles_Call {
+	return &mockSitemapArticlesLister_CountSitemapArticles_Call{Call: _e.mock.On("CountSitemapArticles", ctx)}
+}
+
+func (_c *mockSitemapArticlesLister_CountSitemapArticles_Call) Run(run func(ctx context.Context)) *mockSitemapArticlesLister_CountSitemapArticles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *mockSitemapArticlesLister_CountSitemapArticles_Call) Return(_a0 int64, _a1 error) *mockSitemapArticlesLister_CountSitemapArticles_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *mockSitemapArticlesLister_CountSitemapArticles_Call) RunAndReturn(run func(context.Context) (int64, error)) *mockSitemapArticlesLister_CountSitemapArticles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSitemapArticles provides a mock function with given fields: ctx, limit, offset
+func (_m *mockSitemapArticlesLister) ListSitemapArticles(ctx context.Context, limit int64, offset int64) ([]*models.Article, error) {
+	ret := _m.Called(ctx, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSitemapArticles")
+	}
+
+	var r0 []*models.Article
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) ([]*models.Article, error)); ok {
+		return rf(ctx, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) []*models.Article); ok {
+		r0 = rf(ctx, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Article)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = rf(ctx, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockSitemapArticlesLister_ListSitemapArticles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSitemapArticles'
+type mockSitemapArticlesLister_ListSitemapArticles_Call struct {
+	*mock.Call
+}
+
+// ListSitemapArticles is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int64
+//   - offset int64
+func (_e *mockSitemapArticlesLister_Expecter) ListSitemapArticles(ctx interface{}, limit interface{}, offset interface{}) *mockSitemapArticlesLister_ListSitemapArticles_Call {
+	return &mockSitemapArticlesLister_ListSitemapArticles_Call{Call: _e.mock.On("ListSitemapArticles", ctx, limit, offset)}
+}
+
+func (_c *mockSitemapArticlesLister_ListSitemapArticles_Call) Run(run func(ctx context.Context, limit int64, offset int64)) *mockSitemapArticlesLister_ListSitemapArticles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *mockSitemapArticlesLister_ListSitemapArticles_Call) Return(_a0 []*models.Article, _a1 error) *mockSitemapArticlesLister_ListSitemapArticles_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *mockSitemapArticlesLister_ListSitemapArticles_Call) RunAndReturn(run func(context.Context, int64, int64) ([]*models.Article, error)) *mockSitemapArticlesLister_ListSitemapArticles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockSitemapArticlesLister creates a new instance of mockSitemapArticlesLister. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockSitemapArticlesLister(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockSitemapArticlesLister {
+	mock := &mockSitemapArticlesLister{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,153 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package handlers
+
+import (
+	context "context"
+
+	models "github.com/ravilock/goduit/internal/profileManager/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockSitemapProfilesLister is an autogenerated mock type for the sitemapProfilesLister type
+type mockSitemapProfilesLister struct {
+	mock.Mock
+}
+
+type mockSitemapProfilesLister_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockSitemapProfilesLister) EXPECT() *mockSitemapProfilesLister_Expecter {
+	return &mockSitemapProfilesLister_Expecter{mock: &_m.Mock}
+}
+
+// CountSitemapProfiles provides a mock function with given fields: ctx
+func (_m *mockSitemapProfilesLister) CountSitemapProfiles(ctx context.Context) (int64, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountSitemapProfiles")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int64, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int64); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockSitemapProfilesLister_CountSitemapProfiles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountSitemapProfiles'
+type mockSitemapProfilesLister_CountSitemapProfiles_Call struct {
+	*mock.Call
+}
+
+// CountSitemapProfiles is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *mockSitemapProfilesLister_Expecter) CountSitemapProfiles(ctx interface{}) *mockSitemapProfilesLister_CountSitemapProfiles_Call {
+	return &mockSitemapProfilesLister_CountSitemapProfiles_Call{Call: _e.mock.On("CountSitemapProfiles", ctx)}
+}
+
+func (_c *mockSitemapProfilesLister_CountSitemapProfiles_Call) Run(run func(ctx context.Context)) *mockSitemapProfilesLister_CountSitemapProfiles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *mockSitemapProfilesLister_CountSitemapProfiles_Call) Return(_a0 int64, _a1 error) *mockSitemapProfilesLister_CountSitemapProfiles_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *mockSitemapProfilesLister_CountSitemapProfiles_Call) RunAndReturn(run func(context.Context) (int64, error)) *mockSitemapProfilesLister_CountSitemapProfiles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSitemapProfiles provides a mock function with given fields: ctx, limit, offset
+func (_m *mockSitemapProfilesLister) ListSitemapProfiles(ctx context.Context, limit int64, offset int64) ([]*models.User, error) {
+	ret := _m.Called(ctx, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSitemapProfiles")
+	}
+
+	var r0 []*models.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) ([]*models.User, error)); ok {
+		return rf(ctx, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) []*models.User); ok {
+		r0 = rf(ctx, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = rf(ctx, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockSitemapProfilesLister_ListSitemapProfiles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSitemapProfiles'
+type mockSitemapProfilesLister_ListSitemapProfiles_Call struct {
+	*mock.Call
+}
+
+// ListSitemapProfiles is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int64
+//   - offset int64
+func (_e *mockSitemapProfilesLister_Expecter) ListSitemapProfiles(ctx interface{}, limit interface{}, offset interface{}) *mockSitemapProfilesLister_ListSitemapProfiles_Call {
+	return &mockSitemapProfilesLister_ListSitemapProfiles_Call{Call: _e.mock.On("ListSitemapProfiles", ctx, limit, offset)}
+}
+
+func (_c *mockSitemapProfilesLister_ListSitemapProfiles_Call) Run(run func(ctx context.Context, limit int64, offset int64)) *mockSitemapProfilesLister_ListSitemapProfiles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *mockSitemapProfilesLister_ListSitemapProfiles_Call) Return(_a0 []*models.User, _a1 error) *mockSitemapProfilesLister_ListSitemapProfiles_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *mockSitemapProfilesLister_ListSitemapProfiles_Call) RunAndReturn(run func(context.Context, int64, int64) ([]*models.User, error)) *mockSitemapProfilesLister_ListSitemapProfiles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockSitemapProfilesLister creates a new instance of mockSitemapProfilesLister. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockSitemapProfilesLister(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockSitemapProfilesLister {
+	mock := &mockSitemapProfilesLister{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,84 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package handlers
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockTagAliasRemover is an autogenerated mock type for the tagAliasRemover type
+type mockTagAliasRemover struct {
+	mock.Mock
+}
+
+type mockTagAliasRemover_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockTagAliasRemover) EXPECT() *mockTagAliasRemover_Expecter {
+	return &mockTagAliasRemover_Expecter{mock: &_m.Mock}
+}
+
+// RemoveTagAlias provides a mock function with given fields: ctx, tag, alias
+func (_m *mockTagAliasRemover) RemoveTagAlias(ctx context.Context, tag string, alias string) error {
+	ret := _m.Called(ctx, tag, alias)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveTagAlias")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, tag, alias)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// mockTagAliasRemover_RemoveTagAlias_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveTagAlias'
+type mockTagAliasRemover_RemoveTagAlias_Call struct {
+	*mock.Call
+}
+
+// RemoveTagAlias is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tag string
+//   - alias string
+func (_e *mockTagAliasRemover_Expecter) RemoveTagAlias(ctx interface{}, tag interface{}, alias interface{}) *mockTagAliasRemover_RemoveTagAlias_Call {
+	return &mockTagAliasRemover_RemoveTagAlias_Call{Call: _e.mock.On("RemoveTagAlias", ctx, tag, alias)}
+}
+
+func (_c *mockTagAliasRemover_RemoveTagAlias_Call) Run(run func(ctx context.Context, tag string, alias string)) *mockTagAliasRemover_RemoveTagAlias_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *mockTagAliasRemover_RemoveTagAlias_Call) Return(_a0 error) *mockTagAliasRemover_RemoveTagAlias_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *mockTagAliasRemover_RemoveTagAlias_Call) RunAndReturn(run func(context.Context, string, string) error) *mockTagAliasRemover_RemoveTagAlias_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockTagAliasRemover creates a new instance of mockTagAliasRemover. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockTagAliasRemover(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockTagAliasRemover {
+	mock := &mockTagAliasRemover{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,94 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package handlers
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockTagsMerger is an autogenerated mock type for the tagsMerger type
+type mockTagsMerger struct {
+	mock.Mock
+}
+
+type mockTagsMerger_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockTagsMerger) EXPECT() *mockTagsMerger_Expecter {
+	return &mockTagsMerger_Expecter{mock: &_m.Mock}
+}
+
+// MergeTags provides a mock function with given fields: ctx, from, into
+func (_m *mockTagsMerger) MergeTags(ctx context.Context, from string, into string) (string, error) {
+	ret := _m.Called(ctx, from, into)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MergeTags")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (string, error)); ok {
+		return rf(ctx, from, into)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) string); ok {
+		r0 = rf(ctx, from, into)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, from, into)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockTagsMerger_MergeTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MergeTags'
+type mockTagsMerger_MergeTags_Call struct {
+	*mock.Call
+}
+
+// MergeTags is a helper method to define mock.On call
+//   - ctx context.Context
+//   - from string
+//   - into string
+func (_e *mockTagsMerger_Expecter) MergeTags(ctx interface{}, from interface{}, into interface{}) *mockTagsMerger_MergeTags_Call {
+	return &mockTagsMerger_MergeTags_Call{Call: _e.mock.On("MergeTags", ctx, from, into)}
+}
+
+func (_c *mockTagsMerger_MergeTags_Call) Run(run func(ctx context.Context, from string, into string)) *mockTagsMerger_MergeTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *mockTagsMerger_MergeTags_Call) Return(_a0 string, _a1 error) *mockTagsMerger_MergeTags_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *mockTagsMerger_MergeTags_Call) RunAndReturn(run func(context.Context, string, string) (string, error)) *mockTagsMerger_MergeTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockTagsMerger creates a new instance of mockTagsMerger. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockTagsMerger(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockTagsMerger {
+	mock := &mockTagsMerger{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,99 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package handlers
+
+import (
+	context "context"
+
+	models "github.com/ravilock/goduit/internal/articlePublisher/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockTrendingArticlesLister is an autogenerated mock type for the trendingArticlesLister type
+type mockTrendingArticlesLister struct {
+	mock.Mock
+}
+
+type mockTrendingArticlesLister_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockTrendingArticlesLister) EXPECT() *mockTrendingArticlesLister_Expecter {
+	return &mockTrendingArticlesLister_Expecter{mock: &_m.Mock}
+}
+
+// ListTrendingArticles provides a mock function with given fields: ctx, window, tag, limit, offset
+func (_m *mockTrendingArticlesLister) ListTrendingArticles(ctx context.Context, window string, tag string, limit int64, offset int64) ([]*models.Article, error) {
+	ret := _m.Called(ctx, window, tag, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTrendingArticles")
+	}
+
+	var r0 []*models.Article
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int64, int64) ([]*models.Article, error)); ok {
+		return rf(ctx, window, tag, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int64, int64) []*models.Article); ok {
+		r0 = rf(ctx, window, tag, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Article)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int64, int64) error); ok {
+		r1 = rf(ctx, window, tag, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockTrendingArticlesLister_ListTrendingArticles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTrendingArticles'
+type mockTrendingArticlesLister_ListTrendingArticles_Call struct {
+	*mock.Call
+}
+
+// ListTrendingArticles is a helper method to define mock.On call
+//   - ctx context.Context
+//   - window string
+//   - tag string
+//   - limit int64
+//   - offset int64
+func (_e *mockTrendingArticlesLister_Expecter) ListTrendingArticles(ctx interface{}, window interface{}, tag interface{}, limit interface{}, offset interface{}) *mockTrendingArticlesLister_ListTrendingArticles_Call {
+	return &mockTrendingArticlesLister_ListTrendingArticles_Call{Call: _e.mock.On("ListTrendingArticles", ctx, window, tag, limit, offset)}
+}
+
+func (_c *mockTrendingArticlesLister_ListTrendingArticles_Call) Run(run func(ctx context.Context, window string, tag string, limit int64, offset int64)) *mockTrendingArticlesLister_ListTrendingArticles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(int64), args[4].(int64))
+	})
+	return _c
+}
+
+func (_c *mockTrendingArticlesLister_ListTrendingArticles_Call) Return(_a0 []*models.Article, _a1 error) *mockTrendingArticlesLister_ListTrendingArticles_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *mockTrendingArticlesLister_ListTrendingArticles_Call) RunAndReturn(run func(context.Context, string, string, int64, int64) ([]*models.Article, error)) *mockTrendingArticlesLister_ListTrendingArticles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockTrendingArticlesLister creates a new instance of mockTrendingArticlesLister. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockTrendingArticlesLister(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockTrendingArticlesLister {
+	mock := &mockTrendingArticlesLister{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,66 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package handlers
+
+import mock "github.com/stretchr/testify/mock"
+
+// mockViewCounter is an autogenerated mock type for the viewCounter type
+type mockViewCounter struct {
+	mock.Mock
+}
+
+type mockViewCounter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockViewCounter) EXPECT() *mockViewCounter_Expecter {
+	return &mockViewCounter_Expecter{mock: &_m.Mock}
+}
+
+// CountView provides a mock function with given fields: article, viewer
+func (_m *mockViewCounter) CountView(article string, viewer string) {
+	_m.Called(article, viewer)
+}
+
+// mockViewCounter_CountView_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountView'
+type mockViewCounter_CountView_Call struct {
+	*mock.Call
+}
+
+// CountView is a helper method to define mock.On call
+//   - article string
+//   - viewer string
+func (_e *mockViewCounter_Expecter) CountView(article interface{}, viewer interface{}) *mockViewCounter_CountView_Call {
+	return &mockViewCounter_CountView_Call{Call: _e.mock.On("CountView", article, viewer)}
+}
+
+func (_c *mockViewCounter_CountView_Call) Run(run func(article string, viewer string)) *mockViewCounter_CountView_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *mockViewCounter_CountView_Call) Return() *mockViewCounter_CountView_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *mockViewCounter_CountView_Call) RunAndReturn(run func(string, string)) *mockViewCounter_CountView_Call {
+	_c.Run(run)
+	return _c
+}
+
+// newMockViewCounter creates a new instance of mockViewCounter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockViewCounter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockViewCounter {
+	mock := &mockViewCounter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
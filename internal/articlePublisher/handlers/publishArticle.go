@@ -0,0 +1,100 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ravilock/goduit/api"
+	"github.com/ravilock/goduit/internal/app"
+	"github.com/ravilock/goduit/internal/articlePublisher/assemblers"
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+	"github.com/ravilock/goduit/internal/articlePublisher/requests"
+	"github.com/ravilock/goduit/internal/identity"
+	profileManagerAssembler "github.com/ravilock/goduit/internal/profileManager/assemblers"
+)
+
+type draftPublisher interface {
+	PublishArticle(ctx context.Context, ID string) (*models.Article, error)
+}
+
+type PublishArticleHandler struct {
+	service        draftPublisher
+	articleGetter  articleGetter
+	profileManager profileGetter
+}
+
+func NewPublishArticleHandler(service draftPublisher, articleGetter articleGetter, profileManager profileGetter) *PublishArticleHandler {
+	return &PublishArticleHandler{
+		service:        service,
+		articleGetter:  articleGetter,
+		profileManager: profileManager,
+	}
+}
+
+func (h *PublishArticleHandler) PublishArticle(c echo.Context) error {
+	request := new(requests.ArticleSlugRequest)
+	identity := new(identity.IdentityHeaders)
+	binder := &echo.DefaultBinder{}
+	if err := binder.BindPathParams(c, request); err != nil {
+		return err
+	}
+	if err := binder.BindHeaders(c, identity); err != nil {
+		return err
+	}
+
+	if err := request.Validate(); err != nil {
+		return err
+	}
+
+	ctx := c.Request().Context()
+
+	article, err := h.articleGetter.GetArticleBySlug(ctx, request.Slug)
+	if err != nil {
+		if appError := new(app.AppError); errors.As(err, &appError) {
+			switch appError.ErrorCode {
+			case app.ArticleNotFoundErrorCode:
+				return api.ArticleNotFound(request.Slug)
+			}
+		}
+		return err
+	}
+
+	if identity.Subject != *article.Author {
+		return api.Forbidden
+	}
+
+	article, err = h.service.PublishArticle(ctx, article.ID.Hex())
+	if err != nil {
+		if appError := new(app.AppError); errors.As(err, &appError) {
+			switch appError.ErrorCode {
+			case app.ArticleAlreadyPublishedErrorCode:
+				return api.ArticleAlreadyPublished(request.Slug)
+			}
+		}
+		return err
+	}
+
+	authorProfile, err := h.profileManager.GetProfileByID(ctx, identity.Subject)
+	if err != nil {
+		if appError := new(app.AppError); errors.As(err, &appError) {
+			switch appError.ErrorCode {
+			case app.UserNotFoundErrorCode:
+				return api.UserNotFound(identity.ClientUsername)
+			}
+		}
+		return err
+	}
+
+	profileResponse, err := profileManagerAssembler.ProfileResponse(authorProfile, false)
+	if err != nil {
+		return err
+	}
+
+	response := assemblers.ArticleResponse(article, profileResponse, false)
+	if err := withCoAuthors(ctx, h.profileManager, article, response); err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,129 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ravilock/goduit/api"
+	"github.com/ravilock/goduit/api/validators"
+	"github.com/ravilock/goduit/internal/app"
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+	articlePublisherResponses "github.com/ravilock/goduit/internal/articlePublisher/responses"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestPublishArticle(t *testing.T) {
+	err := validators.InitValidator()
+	require.NoError(t, err)
+	draftPublisherMock := newMockDraftPublisher(t)
+	articleGetterMock := newMockArticleGetter(t)
+	profileGetterMock := newMockProfileGetter(t)
+	handler := &PublishArticleHandler{draftPublisherMock, articleGetterMock, profileGetterMock}
+	e := echo.New()
+
+	t.Run("Should publish a draft", func(t *testing.T) {
+		// Arrange
+		articleAuthorID := primitive.NewObjectID()
+		expectedAuthor := assembleArticleAuthor(articleAuthorID.Hex())
+		draft := assembleArticleModel(articleAuthorID)
+		draftStatus := models.ArticleStatusDraft
+		draft.Status = &draftStatus
+		publishedArticle := *draft
+		publishedStatus := models.ArticleStatusPublished
+		publishedArticle.Status = &publishedStatus
+		req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/api/article/%s/publish", *draft.Slug), nil)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set("Goduit-Subject", expectedAuthor.ID.Hex())
+		req.Header.Set("Goduit-Client-Username", *expectedAuthor.Username)
+		req.Header.Set("Goduit-Client-Email", *expectedAuthor.Email)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("slug")
+		c.SetParamValues(*draft.Slug)
+		ctx := c.Request().Context()
+		articleGetterMock.EXPECT().GetArticleBySlug(ctx, *draft.Slug).Return(draft, nil).Once()
+		draftPublisherMock.EXPECT().PublishArticle(ctx, draft.ID.Hex()).Return(&publishedArticle, nil).Once()
+		profileGetterMock.EXPECT().GetProfileByID(ctx, expectedAuthor.ID.Hex()).Return(expectedAuthor, nil).Once()
+
+		// Act
+		err := handler.PublishArticle(c)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Code)
+		publishArticleResponse := new(articlePublisherResponses.ArticleResponse)
+		err = json.Unmarshal(rec.Body.Bytes(), publishArticleResponse)
+		require.NoError(t, err)
+		checkGetArticleResponse(t, &publishedArticle, expectedAuthor, publishArticleResponse)
+		require.Equal(t, models.ArticleStatusPublished, publishArticleResponse.Article.Status)
+	})
+
+	t.Run("Should return HTTP 404 if no article is found", func(t *testing.T) {
+		// Arrange
+		inexistentSlug := "inexistent-slug"
+		req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/api/article/%s/publish", inexistentSlug), nil)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set("Goduit-Subject", primitive.NewObjectID().Hex())
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("slug")
+		c.SetParamValues(inexistentSlug)
+		ctx := c.Request().Context()
+		articleGetterMock.EXPECT().GetArticleBySlug(ctx, inexistentSlug).Return(nil, app.ArticleNotFoundError(inexistentSlug, nil)).Once()
+
+		// Act
+		err := handler.PublishArticle(c)
+
+		// Assert
+		require.ErrorContains(t, err, api.ArticleNotFound(inexistentSlug).Error())
+	})
+
+	t.Run("Should only publish drafts authored by the currently authenticated user", func(t *testing.T) {
+		// Arrange
+		draft := assembleArticleModel(primitive.NewObjectID())
+		draftStatus := models.ArticleStatusDraft
+		draft.Status = &draftStatus
+		req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/api/article/%s/publish", *draft.Slug), nil)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set("Goduit-Subject", primitive.NewObjectID().Hex())
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("slug")
+		c.SetParamValues(*draft.Slug)
+		ctx := c.Request().Context()
+		articleGetterMock.EXPECT().GetArticleBySlug(ctx, *draft.Slug).Return(draft, nil).Once()
+
+		// Act
+		err := handler.PublishArticle(c)
+
+		// Assert
+		require.ErrorIs(t, err, api.Forbidden)
+	})
+
+	t.Run("Should return HTTP 409 if article is already published", func(t *testing.T) {
+		// Arrange
+		articleAuthorID := primitive.NewObjectID()
+		article := assembleArticleModel(articleAuthorID)
+		req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/api/article/%s/publish", *article.Slug), nil)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set("Goduit-Subject", articleAuthorID.Hex())
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("slug")
+		c.SetParamValues(*article.Slug)
+		ctx := c.Request().Context()
+		articleGetterMock.EXPECT().GetArticleBySlug(ctx, *article.Slug).Return(article, nil).Once()
+		draftPublisherMock.EXPECT().PublishArticle(ctx, article.ID.Hex()).Return(nil, app.ArticleAlreadyPublishedError(article.ID.Hex())).Once()
+
+		// Act
+		err := handler.PublishArticle(c)
+
+		// Assert
+		require.ErrorContains(t, err, api.ArticleAlreadyPublished(*article.Slug).Error())
+	})
+}
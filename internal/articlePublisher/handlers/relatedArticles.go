@@ -0,0 +1,101 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ravilock/goduit/api"
+	"github.com/ravilock/goduit/internal/app"
+	"github.com/ravilock/goduit/internal/articlePublisher/assemblers"
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+	"github.com/ravilock/goduit/internal/articlePublisher/requests"
+	"github.com/ravilock/goduit/internal/articlePublisher/responses"
+	"github.com/ravilock/goduit/internal/identity"
+	profileManagerAssembler "github.com/ravilock/goduit/internal/profileManager/assemblers"
+)
+
+type relatedArticlesLister interface {
+	ListRelatedArticles(ctx context.Context, article *models.Article, limit int64) ([]*models.Article, error)
+}
+
+type RelatedArticlesHandler struct {
+	service         relatedArticlesLister
+	articleGetter   articleGetter
+	profileManager  profileGetter
+	followerCentral isFollowedChecker
+	favorites       isFavoritedChecker
+}
+
+func NewRelatedArticlesHandler(service relatedArticlesLister, articleGetter articleGetter, profileManager profileGetter, followerCentral isFollowedChecker, favorites isFavoritedChecker) *RelatedArticlesHandler {
+	return &RelatedArticlesHandler{
+		service:         service,
+		articleGetter:   articleGetter,
+		profileManager:  profileManager,
+		followerCentral: followerCentral,
+		favorites:       favorites,
+	}
+}
+
+func (h *RelatedArticlesHandler) ListRelatedArticles(c echo.Context) error {
+	request := requests.NewRelatedArticlesRequest()
+	identity := new(identity.IdentityHeaders)
+	binder := &echo.DefaultBinder{}
+	if err := binder.BindPathParams(c, request); err != nil {
+		return err
+	}
+	if err := binder.BindQueryParams(c, request); err != nil {
+		return err
+	}
+	if err := binder.BindHeaders(c, identity); err != nil {
+		return err
+	}
+
+	if err := request.Validate(); err != nil {
+		return err
+	}
+
+	ctx := c.Request().Context()
+
+	article, err := h.articleGetter.GetArticleBySlug(ctx, request.Slug)
+	if err != nil {
+		if appError := new(app.AppError); errors.As(err, &appError) {
+			switch appError.ErrorCode {
+			case app.ArticleNotFoundErrorCode:
+				return api.ArticleNotFound(request.Slug)
+			}
+		}
+		return err
+	}
+
+	if !article.IsPublished() && !article.HasAuthor(identity.Subject) {
+		return api.ArticleNotFound(request.Slug)
+	}
+
+	articles, err := h.service.ListRelatedArticles(ctx, article, int64(request.Limit))
+	if err != nil {
+		return err
+	}
+
+	response := responses.ArticlesResponse{Articles: make([]responses.MultiArticle, 0, len(articles))}
+	for _, relatedArticle := range articles {
+		author, err := h.profileManager.GetProfileByID(ctx, *relatedArticle.Author)
+		if err != nil {
+			continue
+		}
+
+		isFollowing := h.followerCentral.IsFollowedBy(ctx, *relatedArticle.Author, identity.Subject)
+
+		authorProfile, err := profileManagerAssembler.ProfileResponse(author, isFollowing)
+		if err != nil {
+			continue
+		}
+
+		isFavorited := h.favorites.IsFavoritedBy(ctx, relatedArticle.ID.Hex(), identity.Subject)
+
+		response.Articles = append(response.Articles, *assemblers.MultiArticleResponse(relatedArticle, authorProfile, isFavorited))
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,111 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ravilock/goduit/api"
+	"github.com/ravilock/goduit/api/validators"
+	"github.com/ravilock/goduit/internal/app"
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+	articlePublisherResponses "github.com/ravilock/goduit/internal/articlePublisher/responses"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestRelatedArticles(t *testing.T) {
+	err := validators.InitValidator()
+	require.NoError(t, err)
+	relatedArticlesListerMock := newMockRelatedArticlesLister(t)
+	articleGetterMock := newMockArticleGetter(t)
+	profileGetterMock := newMockProfileGetter(t)
+	isFollowedCheckerMock := newMockIsFollowedChecker(t)
+	isFavoritedCheckerMock := newMockIsFavoritedChecker(t)
+	handler := &RelatedArticlesHandler{relatedArticlesListerMock, articleGetterMock, profileGetterMock, isFollowedCheckerMock, isFavoritedCheckerMock}
+	e := echo.New()
+
+	t.Run("Should list related articles in the order they were ranked", func(t *testing.T) {
+		// Arrange
+		article := assembleArticleModel(primitive.NewObjectID())
+		relatedAuthorID := primitive.NewObjectID()
+		relatedAuthor := assembleArticleAuthor(relatedAuthorID.Hex())
+		relatedArticles := assembleRandomArticles(3, relatedAuthorID)
+		userID := primitive.NewObjectID().Hex()
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/articles/%s/related", *article.Slug), nil)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set("Goduit-Subject", userID)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("slug")
+		c.SetParamValues(*article.Slug)
+		ctx := c.Request().Context()
+		articleGetterMock.EXPECT().GetArticleBySlug(ctx, *article.Slug).Return(article, nil).Once()
+		relatedArticlesListerMock.EXPECT().ListRelatedArticles(ctx, article, int64(5)).Return(relatedArticles, nil).Once()
+		profileGetterMock.EXPECT().GetProfileByID(ctx, relatedAuthorID.Hex()).Return(relatedAuthor, nil).Times(len(relatedArticles))
+		isFollowedCheckerMock.EXPECT().IsFollowedBy(ctx, relatedAuthorID.Hex(), userID).Return(true).Times(len(relatedArticles))
+		for _, relatedArticle := range relatedArticles {
+			isFavoritedCheckerMock.EXPECT().IsFavoritedBy(ctx, relatedArticle.ID.Hex(), userID).Return(false).Once()
+		}
+
+		// Act
+		err := handler.ListRelatedArticles(c)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Code)
+		relatedArticlesResponse := new(articlePublisherResponses.ArticlesResponse)
+		err = json.Unmarshal(rec.Body.Bytes(), relatedArticlesResponse)
+		require.NoError(t, err)
+		require.Len(t, relatedArticlesResponse.Articles, len(relatedArticles))
+		for i, relatedArticle := range relatedArticles {
+			require.Equal(t, *relatedArticle.Slug, relatedArticlesResponse.Articles[i].Slug)
+			require.Equal(t, *relatedAuthor.Username, relatedArticlesResponse.Articles[i].Author.Username)
+			require.True(t, relatedArticlesResponse.Articles[i].Author.Following)
+		}
+	})
+
+	t.Run("Should return HTTP 404 if no article is found", func(t *testing.T) {
+		// Arrange
+		slug := "missing-article"
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/articles/%s/related", slug), nil)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("slug")
+		c.SetParamValues(slug)
+		ctx := c.Request().Context()
+		articleGetterMock.EXPECT().GetArticleBySlug(ctx, slug).Return(nil, app.ArticleNotFoundError(slug, nil)).Once()
+
+		// Act
+		err := handler.ListRelatedArticles(c)
+
+		// Assert
+		require.ErrorContains(t, err, api.ArticleNotFound(slug).Error())
+	})
+
+	t.Run("Should return HTTP 404 if article is a draft of another user", func(t *testing.T) {
+		// Arrange
+		article := assembleArticleModel(primitive.NewObjectID())
+		draftStatus := models.ArticleStatusDraft
+		article.Status = &draftStatus
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/articles/%s/related", *article.Slug), nil)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set("Goduit-Subject", primitive.NewObjectID().Hex())
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("slug")
+		c.SetParamValues(*article.Slug)
+		ctx := c.Request().Context()
+		articleGetterMock.EXPECT().GetArticleBySlug(ctx, *article.Slug).Return(article, nil).Once()
+
+		// Act
+		err := handler.ListRelatedArticles(c)
+
+		// Assert
+		require.ErrorContains(t, err, api.ArticleNotFound(*article.Slug).Error())
+	})
+}
@@ -0,0 +1,52 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ravilock/goduit/api"
+	"github.com/ravilock/goduit/internal/app"
+	"github.com/ravilock/goduit/internal/articlePublisher/requests"
+)
+
+type tagAliasRemover interface {
+	RemoveTagAlias(ctx context.Context, tag, alias string) error
+}
+
+type RemoveTagAliasHandler struct {
+	service tagAliasRemover
+}
+
+func NewRemoveTagAliasHandler(service tagAliasRemover) *RemoveTagAliasHandler {
+	return &RemoveTagAliasHandler{
+		service: service,
+	}
+}
+
+func (h *RemoveTagAliasHandler) RemoveTagAlias(c echo.Context) error {
+	request := new(requests.RemoveTagAliasRequest)
+	binder := &echo.DefaultBinder{}
+	if err := binder.BindPathParams(c, request); err != nil {
+		return err
+	}
+
+	if err := request.Validate(); err != nil {
+		return err
+	}
+
+	ctx := c.Request().Context()
+
+	if err := h.service.RemoveTagAlias(ctx, request.Tag, request.Alias); err != nil {
+		if appError := new(app.AppError); errors.As(err, &appError) {
+			switch appError.ErrorCode {
+			case app.TagAliasNotFoundErrorCode:
+				return api.TagAliasNotFound(request.Tag, request.Alias)
+			}
+		}
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,56 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ravilock/goduit/api"
+	"github.com/ravilock/goduit/api/validators"
+	"github.com/ravilock/goduit/internal/app"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRemoveTagAlias(t *testing.T) {
+	err := validators.InitValidator()
+	require.NoError(t, err)
+	tagAliasRemoverMock := newMockTagAliasRemover(t)
+	handler := &RemoveTagAliasHandler{tagAliasRemoverMock}
+	e := echo.New()
+
+	t.Run("Should remove the alias", func(t *testing.T) {
+		// Arrange
+		req := httptest.NewRequest(http.MethodDelete, "/api/tags/golang/aliases/go-lang", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("tag", "alias")
+		c.SetParamValues("golang", "go-lang")
+		ctx := c.Request().Context()
+		tagAliasRemoverMock.EXPECT().RemoveTagAlias(ctx, "golang", "go-lang").Return(nil).Once()
+
+		// Act
+		err := handler.RemoveTagAlias(c)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, http.StatusNoContent, rec.Code)
+	})
+
+	t.Run("Should return HTTP 404 if alias does not resolve to the tag", func(t *testing.T) {
+		// Arrange
+		req := httptest.NewRequest(http.MethodDelete, "/api/tags/golang/aliases/rust-lang", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("tag", "alias")
+		c.SetParamValues("golang", "rust-lang")
+		ctx := c.Request().Context()
+		tagAliasRemoverMock.EXPECT().RemoveTagAlias(ctx, "golang", "rust-lang").Return(app.TagAliasNotFoundError("rust-lang", nil)).Once()
+
+		// Act
+		err := handler.RemoveTagAlias(c)
+
+		// Assert
+		require.ErrorContains(t, err, api.TagAliasNotFound("golang", "rust-lang").Error())
+	})
+}
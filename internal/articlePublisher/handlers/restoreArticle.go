@@ -0,0 +1,104 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ravilock/goduit/api"
+	"github.com/ravilock/goduit/internal/app"
+	"github.com/ravilock/goduit/internal/articlePublisher/assemblers"
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+	"github.com/ravilock/goduit/internal/articlePublisher/requests"
+	"github.com/ravilock/goduit/internal/identity"
+	profileManagerAssembler "github.com/ravilock/goduit/internal/profileManager/assemblers"
+)
+
+type articleRestorer interface {
+	RestoreArticle(ctx context.Context, ID string) (*models.Article, error)
+}
+
+type trashedArticleGetter interface {
+	GetTrashedArticleBySlug(ctx context.Context, slug string) (*models.Article, error)
+}
+
+type RestoreArticleHandler struct {
+	service        articleRestorer
+	trashGetter    trashedArticleGetter
+	profileManager profileGetter
+}
+
+func NewRestoreArticleHandler(service articleRestorer, trashGetter trashedArticleGetter, profileManager profileGetter) *RestoreArticleHandler {
+	return &RestoreArticleHandler{
+		service:        service,
+		trashGetter:    trashGetter,
+		profileManager: profileManager,
+	}
+}
+
+func (h *RestoreArticleHandler) RestoreArticle(c echo.Context) error {
+	request := new(requests.ArticleSlugRequest)
+	identity := new(identity.IdentityHeaders)
+	binder := &echo.DefaultBinder{}
+	if err := binder.BindPathParams(c, request); err != nil {
+		return err
+	}
+	if err := binder.BindHeaders(c, identity); err != nil {
+		return err
+	}
+
+	if err := request.Validate(); err != nil {
+		return err
+	}
+
+	ctx := c.Request().Context()
+
+	article, err := h.trashGetter.GetTrashedArticleBySlug(ctx, request.Slug)
+	if err != nil {
+		if appError := new(app.AppError); errors.As(err, &appError) {
+			switch appError.ErrorCode {
+			case app.ArticleNotFoundErrorCode:
+				return api.ArticleNotFound(request.Slug)
+			}
+		}
+		return err
+	}
+
+	if identity.Subject != *article.Author {
+		return api.Forbidden
+	}
+
+	article, err = h.service.RestoreArticle(ctx, article.ID.Hex())
+	if err != nil {
+		if appError := new(app.AppError); errors.As(err, &appError) {
+			switch appError.ErrorCode {
+			case app.ArticleNotFoundErrorCode:
+				return api.ArticleNotFound(request.Slug)
+			}
+		}
+		return err
+	}
+
+	authorProfile, err := h.profileManager.GetProfileByID(ctx, identity.Subject)
+	if err != nil {
+		if appError := new(app.AppError); errors.As(err, &appError) {
+			switch appError.ErrorCode {
+			case app.UserNotFoundErrorCode:
+				return api.UserNotFound(identity.ClientUsername)
+			}
+		}
+		return err
+	}
+
+	profileResponse, err := profileManagerAssembler.ProfileResponse(authorProfile, false)
+	if err != nil {
+		return err
+	}
+
+	response := assemblers.ArticleResponse(article, profileResponse, false)
+	if err := withCoAuthors(ctx, h.profileManager, article, response); err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, response)
+}
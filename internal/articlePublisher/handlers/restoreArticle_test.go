@@ -0,0 +1,112 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/ravilock/goduit/api"
+	"github.com/ravilock/goduit/api/validators"
+	"github.com/ravilock/goduit/internal/app"
+	articlePublisherResponses "github.com/ravilock/goduit/internal/articlePublisher/responses"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestRestoreArticle(t *testing.T) {
+	err := validators.InitValidator()
+	require.NoError(t, err)
+	articleRestorerMock := newMockArticleRestorer(t)
+	trashedArticleGetterMock := newMockTrashedArticleGetter(t)
+	profileGetterMock := newMockProfileGetter(t)
+	handler := &RestoreArticleHandler{articleRestorerMock, trashedArticleGetterMock, profileGetterMock}
+	e := echo.New()
+
+	t.Run("Should restore a trashed article", func(t *testing.T) {
+		// Arrange
+		articleAuthorID := primitive.NewObjectID()
+		expectedAuthor := assembleArticleAuthor(articleAuthorID.Hex())
+		trashedArticle := assembleArticleModel(articleAuthorID)
+		trashedAt := time.Now().UTC()
+		trashedArticle.TrashedAt = &trashedAt
+		restoredArticle := *trashedArticle
+		restoredArticle.TrashedAt = nil
+		req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/api/articles/%s/restore", *trashedArticle.Slug), nil)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set("Goduit-Subject", expectedAuthor.ID.Hex())
+		req.Header.Set("Goduit-Client-Username", *expectedAuthor.Username)
+		req.Header.Set("Goduit-Client-Email", *expectedAuthor.Email)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("slug")
+		c.SetParamValues(*trashedArticle.Slug)
+		ctx := c.Request().Context()
+		trashedArticleGetterMock.EXPECT().GetTrashedArticleBySlug(ctx, *trashedArticle.Slug).Return(trashedArticle, nil).Once()
+		articleRestorerMock.EXPECT().RestoreArticle(ctx, trashedArticle.ID.Hex()).Return(&restoredArticle, nil).Once()
+		profileGetterMock.EXPECT().GetProfileByID(ctx, expectedAuthor.ID.Hex()).Return(expectedAuthor, nil).Once()
+
+		// Act
+		err := handler.RestoreArticle(c)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Code)
+		restoreArticleResponse := new(articlePublisherResponses.ArticleResponse)
+		err = json.Unmarshal(rec.Body.Bytes(), restoreArticleResponse)
+		require.NoError(t, err)
+		require.Equal(t, *trashedArticle.Slug, restoreArticleResponse.Article.Slug)
+		require.Equal(t, *expectedAuthor.Username, restoreArticleResponse.Article.Author.Username)
+	})
+
+	t.Run("Should return HTTP 404 if no trashed article is found", func(t *testing.T) {
+		// Arrange
+		articleAuthorID := primitive.NewObjectID()
+		expectedAuthor := assembleArticleAuthor(articleAuthorID.Hex())
+		trashedArticle := assembleArticleModel(articleAuthorID)
+		req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/api/articles/%s/restore", *trashedArticle.Slug), nil)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set("Goduit-Subject", expectedAuthor.ID.Hex())
+		req.Header.Set("Goduit-Client-Username", *expectedAuthor.Username)
+		req.Header.Set("Goduit-Client-Email", *expectedAuthor.Email)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("slug")
+		c.SetParamValues(*trashedArticle.Slug)
+		ctx := c.Request().Context()
+		trashedArticleGetterMock.EXPECT().GetTrashedArticleBySlug(ctx, *trashedArticle.Slug).Return(nil, app.ArticleNotFoundError(*trashedArticle.Slug, nil)).Once()
+
+		// Act
+		err := handler.RestoreArticle(c)
+
+		// Assert
+		require.ErrorContains(t, err, api.ArticleNotFound(*trashedArticle.Slug).Error())
+	})
+
+	t.Run("Should only restore articles authored by the currently authenticated user", func(t *testing.T) {
+		// Arrange
+		articleAuthorID := primitive.NewObjectID()
+		trashedArticle := assembleArticleModel(articleAuthorID)
+		req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/api/articles/%s/restore", *trashedArticle.Slug), nil)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set("Goduit-Subject", uuid.NewString())
+		req.Header.Set("Goduit-Client-Username", "not-the-author")
+		req.Header.Set("Goduit-Client-Email", "not.the.author.email@test.test")
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("slug")
+		c.SetParamValues(*trashedArticle.Slug)
+		ctx := c.Request().Context()
+		trashedArticleGetterMock.EXPECT().GetTrashedArticleBySlug(ctx, *trashedArticle.Slug).Return(trashedArticle, nil).Once()
+
+		// Act
+		err := handler.RestoreArticle(c)
+
+		// Assert
+		require.ErrorContains(t, err, api.Forbidden.Error())
+	})
+}
@@ -0,0 +1,116 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ravilock/goduit/api"
+	"github.com/ravilock/goduit/internal/app"
+	"github.com/ravilock/goduit/internal/articlePublisher/assemblers"
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+	"github.com/ravilock/goduit/internal/articlePublisher/requests"
+	"github.com/ravilock/goduit/internal/identity"
+	profileManagerAssembler "github.com/ravilock/goduit/internal/profileManager/assemblers"
+)
+
+type revisionRestorer interface {
+	RestoreRevision(ctx context.Context, slug, editor string, revision *models.Revision) (*models.Article, error)
+}
+
+type RestoreRevisionHandler struct {
+	service        revisionRestorer
+	revisionGetter revisionGetter
+	articleGetter  articleGetter
+	profileManager profileGetter
+}
+
+func NewRestoreRevisionHandler(service revisionRestorer, revisionGetter revisionGetter, articleGetter articleGetter, profileManager profileGetter) *RestoreRevisionHandler {
+	return &RestoreRevisionHandler{
+		service:        service,
+		revisionGetter: revisionGetter,
+		articleGetter:  articleGetter,
+		profileManager: profileManager,
+	}
+}
+
+func (h *RestoreRevisionHandler) RestoreRevision(c echo.Context) error {
+	request := new(requests.RevisionRequest)
+	identity := new(identity.IdentityHeaders)
+	binder := &echo.DefaultBinder{}
+	if err := binder.BindPathParams(c, request); err != nil {
+		return err
+	}
+	if err := binder.BindHeaders(c, identity); err != nil {
+		return err
+	}
+
+	if err := request.Validate(); err != nil {
+		return err
+	}
+
+	ctx := c.Request().Context()
+
+	article, err := h.articleGetter.GetArticleBySlug(ctx, request.Slug)
+	if err != nil {
+		if appError := new(app.AppError); errors.As(err, &appError) {
+			switch appError.ErrorCode {
+			case app.ArticleNotFoundErrorCode:
+				return api.ArticleNotFound(request.Slug)
+			}
+		}
+		return err
+	}
+
+	if !article.HasAuthor(identity.Subject) {
+		return api.Forbidden
+	}
+
+	revision, err := h.revisionGetter.GetRevision(ctx, article.ID.Hex(), request.Revision)
+	if err != nil {
+		if appError := new(app.AppError); errors.As(err, &appError) {
+			switch appError.ErrorCode {
+			case app.RevisionNotFoundErrorCode:
+				return api.RevisionNotFound(request.Slug, request.Revision)
+			}
+		}
+		return err
+	}
+
+	author := *article.Author
+	article, err = h.service.RestoreRevision(ctx, *article.Slug, identity.Subject, revision)
+	if err != nil {
+		if appError := new(app.AppError); errors.As(err, &appError) {
+			switch appError.ErrorCode {
+			case app.ArticleNotFoundErrorCode:
+				return api.ArticleNotFound(request.Slug)
+			case app.ConflictErrorCode:
+				return api.ConfictError
+			}
+		}
+		return err
+	}
+
+	authorProfile, err := h.profileManager.GetProfileByID(ctx, author)
+	if err != nil {
+		if appError := new(app.AppError); errors.As(err, &appError) {
+			switch appError.ErrorCode {
+			case app.UserNotFoundErrorCode:
+				return api.UserNotFound(author)
+			}
+		}
+		return err
+	}
+
+	profileResponse, err := profileManagerAssembler.ProfileResponse(authorProfile, false)
+	if err != nil {
+		return err
+	}
+
+	response := assemblers.ArticleResponse(article, profileResponse, false)
+	if err := withCoAuthors(ctx, h.profileManager, article, response); err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, response)
+}
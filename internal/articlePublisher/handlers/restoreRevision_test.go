@@ -0,0 +1,91 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/ravilock/goduit/api"
+	"github.com/ravilock/goduit/api/validators"
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+	articlePublisherResponses "github.com/ravilock/goduit/internal/articlePublisher/responses"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestRestoreRevision(t *testing.T) {
+	err := validators.InitValidator()
+	require.NoError(t, err)
+	revisionRestorerMock := newMockRevisionRestorer(t)
+	revisionGetterMock := newMockRevisionGetter(t)
+	articleGetterMock := newMockArticleGetter(t)
+	profileGetterMock := newMockProfileGetter(t)
+	handler := &RestoreRevisionHandler{revisionRestorerMock, revisionGetterMock, articleGetterMock, profileGetterMock}
+	e := echo.New()
+
+	t.Run("Should restore an article to an older revision", func(t *testing.T) {
+		// Arrange
+		articleAuthorID := primitive.NewObjectID()
+		expectedAuthor := assembleArticleAuthor(articleAuthorID.Hex())
+		expectedArticle := assembleArticleModel(articleAuthorID)
+		expectedRevision := assembleRevisionModel(expectedArticle, 1)
+		req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/api/articles/%s/revisions/1/restore", *expectedArticle.Slug), nil)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set("Goduit-Subject", articleAuthorID.Hex())
+		req.Header.Set("Goduit-Client-Username", *expectedAuthor.Username)
+		req.Header.Set("Goduit-Client-Email", *expectedAuthor.Email)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("slug", "revision")
+		c.SetParamValues(*expectedArticle.Slug, "1")
+		ctx := c.Request().Context()
+		articleGetterMock.EXPECT().GetArticleBySlug(ctx, *expectedArticle.Slug).Return(expectedArticle, nil).Once()
+		revisionGetterMock.EXPECT().GetRevision(ctx, expectedArticle.ID.Hex(), int64(1)).Return(expectedRevision, nil).Once()
+		revisionRestorerMock.EXPECT().RestoreRevision(ctx, *expectedArticle.Slug, articleAuthorID.Hex(), expectedRevision).RunAndReturn(func(ctx context.Context, slug, editor string, revision *models.Revision) (*models.Article, error) {
+			restoredArticle := *expectedArticle
+			restoredArticle.Title = revision.Title
+			return &restoredArticle, nil
+		}).Once()
+		profileGetterMock.EXPECT().GetProfileByID(ctx, articleAuthorID.Hex()).Return(expectedAuthor, nil).Once()
+
+		// Act
+		err := handler.RestoreRevision(c)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Code)
+		restoreRevisionResponse := new(articlePublisherResponses.ArticleResponse)
+		err = json.Unmarshal(rec.Body.Bytes(), restoreRevisionResponse)
+		require.NoError(t, err)
+		require.Equal(t, *expectedRevision.Title, restoreRevisionResponse.Article.Title)
+		require.Equal(t, *expectedAuthor.Username, restoreRevisionResponse.Article.Author.Username)
+	})
+
+	t.Run("Should only restore articles authored by the currently authenticated user", func(t *testing.T) {
+		// Arrange
+		articleAuthorID := primitive.NewObjectID()
+		expectedArticle := assembleArticleModel(articleAuthorID)
+		req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/api/articles/%s/revisions/1/restore", *expectedArticle.Slug), nil)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set("Goduit-Subject", uuid.NewString())
+		req.Header.Set("Goduit-Client-Username", "not-the-author")
+		req.Header.Set("Goduit-Client-Email", "not.the.author.email@test.test")
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("slug", "revision")
+		c.SetParamValues(*expectedArticle.Slug, "1")
+		ctx := c.Request().Context()
+		articleGetterMock.EXPECT().GetArticleBySlug(ctx, *expectedArticle.Slug).Return(expectedArticle, nil).Once()
+
+		// Act
+		err := handler.RestoreRevision(c)
+
+		// Assert
+		require.ErrorContains(t, err, api.Forbidden.Error())
+	})
+}
@@ -0,0 +1,103 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ravilock/goduit/api/validators"
+	articlePublisherResponses "github.com/ravilock/goduit/internal/articlePublisher/responses"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestSearchArticles(t *testing.T) {
+	err := validators.InitValidator()
+	require.NoError(t, err)
+	articleSearcherMock := newMockArticleSearcher(t)
+	profileGetterMock := newMockProfileGetter(t)
+	isFollowedCheckerMock := newMockIsFollowedChecker(t)
+	isFavoritedCheckerMock := newMockIsFavoritedChecker(t)
+	handler := &SearchArticlesHandler{articleSearcherMock, profileGetterMock, isFollowedCheckerMock, isFavoritedCheckerMock}
+	e := echo.New()
+
+	t.Run("Should search articles", func(t *testing.T) {
+		// Arrange
+		limit := 10
+		query := "golang"
+		articleAuthorID := primitive.NewObjectID()
+		expectedArticles := assembleRandomArticles(limit, articleAuthorID)
+		expectedAuthor := assembleArticleAuthor(articleAuthorID.Hex())
+		req := httptest.NewRequest(http.MethodGet, "/api/articles/search", nil)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		urlValues := c.QueryParams()
+		urlValues.Add("q", query)
+		urlValues.Add("limit", strconv.Itoa(limit))
+		c.Request().URL.RawQuery = urlValues.Encode()
+		ctx := c.Request().Context()
+		articleSearcherMock.EXPECT().SearchArticles(ctx, query, "", "", int64(limit), int64(0)).Return(expectedArticles, nil).Once()
+		profileGetterMock.EXPECT().GetProfileByID(ctx, articleAuthorID.Hex()).Return(expectedAuthor, nil).Times(limit)
+		isFollowedCheckerMock.EXPECT().IsFollowedBy(ctx, articleAuthorID.Hex(), "").Return(false).Times(limit)
+		isFavoritedCheckerMock.EXPECT().IsFavoritedBy(ctx, mock.Anything, "").Return(false).Times(limit)
+
+		// Act
+		err := handler.SearchArticles(c)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Code)
+		searchArticlesResponse := new(articlePublisherResponses.SearchArticlesResponse)
+		err = json.Unmarshal(rec.Body.Bytes(), searchArticlesResponse)
+		require.NoError(t, err)
+		require.Len(t, searchArticlesResponse.Articles, limit)
+		for i, article := range searchArticlesResponse.Articles {
+			require.Equal(t, *expectedArticles[i].Slug, article.Slug)
+			require.NotEmpty(t, article.Snippet)
+		}
+	})
+
+	t.Run("Should filter searched articles by author and tag", func(t *testing.T) {
+		// Arrange
+		limit := 10
+		query := "golang"
+		articleAuthorID := primitive.NewObjectID()
+		expectedArticles := assembleRandomArticles(limit, articleAuthorID)
+		expectedAuthor := assembleArticleAuthor(articleAuthorID.Hex())
+		tag := expectedArticles[0].TagList[0]
+		req := httptest.NewRequest(http.MethodGet, "/api/articles/search", nil)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		urlValues := c.QueryParams()
+		urlValues.Add("q", query)
+		urlValues.Add("limit", strconv.Itoa(limit))
+		urlValues.Add("author", *expectedAuthor.Username)
+		urlValues.Add("tag", tag)
+		c.Request().URL.RawQuery = urlValues.Encode()
+		ctx := c.Request().Context()
+		profileGetterMock.EXPECT().GetProfileByUsername(ctx, *expectedAuthor.Username).Return(expectedAuthor, nil).Once()
+		articleSearcherMock.EXPECT().SearchArticles(ctx, query, expectedAuthor.ID.Hex(), tag, int64(limit), int64(0)).Return(expectedArticles, nil).Once()
+		profileGetterMock.EXPECT().GetProfileByID(ctx, articleAuthorID.Hex()).Return(expectedAuthor, nil).Times(limit)
+		isFollowedCheckerMock.EXPECT().IsFollowedBy(ctx, articleAuthorID.Hex(), "").Return(false).Times(limit)
+		isFavoritedCheckerMock.EXPECT().IsFavoritedBy(ctx, mock.Anything, "").Return(false).Times(limit)
+
+		// Act
+		err := handler.SearchArticles(c)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Code)
+		searchArticlesResponse := new(articlePublisherResponses.SearchArticlesResponse)
+		err = json.Unmarshal(rec.Body.Bytes(), searchArticlesResponse)
+		require.NoError(t, err)
+		for _, article := range searchArticlesResponse.Articles {
+			require.Equal(t, *expectedAuthor.Username, article.Author.Username)
+		}
+	})
+}
@@ -0,0 +1,65 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/ravilock/goduit/api"
+	"github.com/ravilock/goduit/internal/app"
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+)
+
+type seriesArticleGetter interface {
+	articleGetter
+	articleByIDGetter
+}
+
+// resolveSeriesArticles turns the article slugs of a series request into article IDs, keeping their order.
+// Every article must be authored by the series owner.
+func resolveSeriesArticles(ctx context.Context, articleGetter articleGetter, owner string, slugs []string) ([]string, error) {
+	if slugs == nil {
+		return nil, nil
+	}
+	articleIDs := make([]string, 0, len(slugs))
+	for _, slug := range slugs {
+		article, err := articleGetter.GetArticleBySlug(ctx, slug)
+		if err != nil {
+			if appError := new(app.AppError); errors.As(err, &appError) {
+				switch appError.ErrorCode {
+				case app.ArticleNotFoundErrorCode:
+					return nil, api.ArticleNotFound(slug)
+				}
+			}
+			return nil, err
+		}
+		if !article.HasAuthor(owner) {
+			return nil, api.Forbidden
+		}
+		articleID := article.ID.Hex()
+		if !slices.Contains(articleIDs, articleID) {
+			articleIDs = append(articleIDs, articleID)
+		}
+	}
+	return articleIDs, nil
+}
+
+// listSeriesArticles gets the articles of a series in order. Articles in the trash are left out, and so are
+// unpublished articles unless the viewer owns the series.
+func listSeriesArticles(ctx context.Context, articleGetter articleByIDGetter, series *models.Series, viewer string) ([]*models.Article, error) {
+	articles := make([]*models.Article, 0, len(series.Articles))
+	for _, articleID := range series.Articles {
+		article, err := articleGetter.GetArticleByID(ctx, articleID)
+		if err != nil {
+			if appError := new(app.AppError); errors.As(err, &appError) && appError.ErrorCode == app.ArticleNotFoundErrorCode {
+				continue
+			}
+			return nil, err
+		}
+		if !article.IsPublished() && viewer != *series.Owner {
+			continue
+		}
+		articles = append(articles, article)
+	}
+	return articles, nil
+}
@@ -0,0 +1,143 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ravilock/goduit/api"
+	"github.com/ravilock/goduit/internal/articlePublisher/assemblers"
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+	"github.com/ravilock/goduit/internal/articlePublisher/requests"
+	profileManagerModels "github.com/ravilock/goduit/internal/profileManager/models"
+	"github.com/ravilock/goduit/internal/sitemap"
+)
+
+// sitemapCacheControl lets crawlers and proxies reuse a sitemap for an hour.
+const sitemapCacheControl = "public, max-age=3600"
+
+type sitemapArticlesLister interface {
+	CountSitemapArticles(ctx context.Context) (int64, error)
+	ListSitemapArticles(ctx context.Context, limit, offset int64) ([]*models.Article, error)
+}
+
+type sitemapProfilesLister interface {
+	CountSitemapProfiles(ctx context.Context) (int64, error)
+	ListSitemapProfiles(ctx context.Context, limit, offset int64) ([]*profileManagerModels.User, error)
+}
+
+// SitemapHandler maps the pages of the site: every published article, followed by every profile.
+type SitemapHandler struct {
+	articles  sitemapArticlesLister
+	profiles  sitemapProfilesLister
+	siteURL   string
+	publicURL string
+	maxURLs   int64
+}
+
+func NewSitemapHandler(articles sitemapArticlesLister, profiles sitemapProfilesLister, siteURL, publicURL string, maxURLs int64) *SitemapHandler {
+	return &SitemapHandler{
+		articles:  articles,
+		profiles:  profiles,
+		siteURL:   siteURL,
+		publicURL: publicURL,
+		maxURLs:   maxURLs,
+	}
+}
+
+// Sitemap serves the sitemap of the site while it fits a single sitemap. Past that, it serves a sitemap index listing the
+// pages of the sitemap, which are served through the page query parameter.
+func (h *SitemapHandler) Sitemap(c echo.Context) error {
+	request := new(requests.SitemapRequest)
+	binder := &echo.DefaultBinder{}
+	if err := binder.BindQueryParams(c, request); err != nil {
+		return err
+	}
+
+	if err := request.Validate(); err != nil {
+		return err
+	}
+
+	ctx := c.Request().Context()
+
+	articlesCount, err := h.articles.CountSitemapArticles(ctx)
+	if err != nil {
+		return err
+	}
+	profilesCount, err := h.profiles.CountSitemapProfiles(ctx)
+	if err != nil {
+		return err
+	}
+	pages := max(1, (articlesCount+profilesCount+h.maxURLs-1)/h.maxURLs)
+
+	document := new(bytes.Buffer)
+	switch {
+	case request.Page == 0 && pages > 1:
+		// Pages are linked through the configured public URL rather than the Host header, which clients control
+		sitemapURL := strings.TrimSuffix(h.publicURL, "/") + c.Request().URL.Path
+		sitemaps := make([]sitemap.URL, 0, pages)
+		for page := int64(1); page <= pages; page++ {
+			sitemaps = append(sitemaps, sitemap.URL{Location: fmt.Sprintf("%s?page=%d", sitemapURL, page)})
+		}
+		if err := sitemap.WriteIndex(document, sitemaps); err != nil {
+			return err
+		}
+	case int64(request.Page) > pages:
+		return api.SitemapNotFound(request.Page)
+	default:
+		page := max(1, int64(request.Page))
+		urls, err := h.listPage(ctx, page, articlesCount)
+		if err != nil {
+			return err
+		}
+		if err := sitemap.WriteURLSet(document, urls); err != nil {
+			return err
+		}
+	}
+
+	c.Response().Header().Set(echo.HeaderCacheControl, sitemapCacheControl)
+	return c.Blob(http.StatusOK, sitemap.ContentType, document.Bytes())
+}
+
+// listPage lists the URLs of a page of the sitemap. Pages list articles first and then profiles, so a page can hold the
+// last articles and the first profiles.
+func (h *SitemapHandler) listPage(ctx context.Context, page, articlesCount int64) ([]sitemap.URL, error) {
+	offset := (page - 1) * h.maxURLs
+	var articles []*models.Article
+	if offset < articlesCount {
+		var err error
+		articles, err = h.articles.ListSitemapArticles(ctx, min(h.maxURLs, articlesCount-offset), offset)
+		if err != nil {
+			return nil, err
+		}
+	}
+	var profiles []*profileManagerModels.User
+	if remaining := h.maxURLs - int64(len(articles)); remaining > 0 {
+		var err error
+		profiles, err = h.profiles.ListSitemapProfiles(ctx, remaining, max(0, offset-articlesCount))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	urls := make([]sitemap.URL, 0, len(articles)+len(profiles))
+	for _, article := range articles {
+		urls = append(urls, sitemap.URL{
+			Location:     assemblers.ArticleURL(h.siteURL, *article.Slug),
+			LastModified: assemblers.ArticleUpdatedAt(article),
+		})
+	}
+	for _, profile := range profiles {
+		url := sitemap.URL{Location: assemblers.ProfileURL(h.siteURL, *profile.Username)}
+		if profile.UpdatedAt != nil {
+			url.LastModified = *profile.UpdatedAt
+		} else if profile.CreatedAt != nil {
+			url.LastModified = *profile.CreatedAt
+		}
+		urls = append(urls, url)
+	}
+	return urls, nil
+}
@@ -0,0 +1,175 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ravilock/goduit/api"
+	"github.com/ravilock/goduit/api/validators"
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+	profileManagerModels "github.com/ravilock/goduit/internal/profileManager/models"
+	"github.com/ravilock/goduit/internal/sitemap"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestSitemap(t *testing.T) {
+	err := validators.InitValidator()
+	require.NoError(t, err)
+	sitemapArticlesListerMock := newMockSitemapArticlesLister(t)
+	sitemapProfilesListerMock := newMockSitemapProfilesLister(t)
+	handler := NewSitemapHandler(sitemapArticlesListerMock, sitemapProfilesListerMock, "http://localhost:5173", "http://localhost:3000", 3)
+	e := echo.New()
+
+	t.Run("Should list articles and profiles in a single sitemap", func(t *testing.T) {
+		// Arrange
+		articles := assembleSitemapArticles("first-article", "second-article")
+		profiles := assembleSitemapProfiles("jake-jake")
+		req := httptest.NewRequest(http.MethodGet, "/api/sitemap.xml", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		ctx := c.Request().Context()
+		sitemapArticlesListerMock.EXPECT().CountSitemapArticles(ctx).Return(int64(2), nil).Once()
+		sitemapProfilesListerMock.EXPECT().CountSitemapProfiles(ctx).Return(int64(1), nil).Once()
+		sitemapArticlesListerMock.EXPECT().ListSitemapArticles(ctx, int64(2), int64(0)).Return(articles, nil).Once()
+		sitemapProfilesListerMock.EXPECT().ListSitemapProfiles(ctx, int64(1), int64(0)).Return(profiles, nil).Once()
+
+		// Act
+		err := handler.Sitemap(c)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Equal(t, sitemap.ContentType, rec.Header().Get(echo.HeaderContentType))
+		require.Equal(t, sitemapCacheControl, rec.Header().Get(echo.HeaderCacheControl))
+		require.Contains(t, rec.Body.String(), "<urlset")
+		require.Contains(t, rec.Body.String(), "<loc>http://localhost:5173/article/first-article</loc>")
+		require.Contains(t, rec.Body.String(), "<loc>http://localhost:5173/article/second-article</loc>")
+		require.Contains(t, rec.Body.String(), "<lastmod>2024-01-02T10:00:00Z</lastmod>")
+		require.Contains(t, rec.Body.String(), "<loc>http://localhost:5173/profile/jake-jake</loc>")
+	})
+
+	t.Run("Should serve a sitemap index once there are too many URLs for a single sitemap", func(t *testing.T) {
+		// Arrange
+		req := httptest.NewRequest(http.MethodGet, "/api/sitemap.xml", nil)
+		req.Host = "attacker.example"
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		ctx := c.Request().Context()
+		sitemapArticlesListerMock.EXPECT().CountSitemapArticles(ctx).Return(int64(4), nil).Once()
+		sitemapProfilesListerMock.EXPECT().CountSitemapProfiles(ctx).Return(int64(3), nil).Once()
+
+		// Act
+		err := handler.Sitemap(c)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Contains(t, rec.Body.String(), "<sitemapindex")
+		require.Contains(t, rec.Body.String(), "<loc>http://localhost:3000/api/sitemap.xml?page=1</loc>")
+		require.Contains(t, rec.Body.String(), "<loc>http://localhost:3000/api/sitemap.xml?page=2</loc>")
+		require.Contains(t, rec.Body.String(), "<loc>http://localhost:3000/api/sitemap.xml?page=3</loc>")
+		require.NotContains(t, rec.Body.String(), "page=4")
+		require.NotContains(t, rec.Body.String(), "attacker.example")
+	})
+
+	t.Run("Should list the last articles and the first profiles in the same page", func(t *testing.T) {
+		// Arrange
+		articles := assembleSitemapArticles("fourth-article")
+		profiles := assembleSitemapProfiles("jake-jake", "jane-jane")
+		req := httptest.NewRequest(http.MethodGet, "/api/sitemap.xml?page=2", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		ctx := c.Request().Context()
+		sitemapArticlesListerMock.EXPECT().CountSitemapArticles(ctx).Return(int64(4), nil).Once()
+		sitemapProfilesListerMock.EXPECT().CountSitemapProfiles(ctx).Return(int64(3), nil).Once()
+		sitemapArticlesListerMock.EXPECT().ListSitemapArticles(ctx, int64(1), int64(3)).Return(articles, nil).Once()
+		sitemapProfilesListerMock.EXPECT().ListSitemapProfiles(ctx, int64(2), int64(0)).Return(profiles, nil).Once()
+
+		// Act
+		err := handler.Sitemap(c)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Contains(t, rec.Body.String(), "<loc>http://localhost:5173/article/fourth-article</loc>")
+		require.Contains(t, rec.Body.String(), "<loc>http://localhost:5173/profile/jane-jane</loc>")
+	})
+
+	t.Run("Should only list profiles in the pages after the articles", func(t *testing.T) {
+		// Arrange
+		profiles := assembleSitemapProfiles("june-june")
+		req := httptest.NewRequest(http.MethodGet, "/api/sitemap.xml?page=3", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		ctx := c.Request().Context()
+		sitemapArticlesListerMock.EXPECT().CountSitemapArticles(ctx).Return(int64(4), nil).Once()
+		sitemapProfilesListerMock.EXPECT().CountSitemapProfiles(ctx).Return(int64(3), nil).Once()
+		sitemapProfilesListerMock.EXPECT().ListSitemapProfiles(ctx, int64(3), int64(2)).Return(profiles, nil).Once()
+
+		// Act
+		err := handler.Sitemap(c)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Contains(t, rec.Body.String(), "<loc>http://localhost:5173/profile/june-june</loc>")
+	})
+
+	t.Run("Should return HTTP 404 if page is past the last one", func(t *testing.T) {
+		// Arrange
+		req := httptest.NewRequest(http.MethodGet, "/api/sitemap.xml?page=4", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		ctx := c.Request().Context()
+		sitemapArticlesListerMock.EXPECT().CountSitemapArticles(ctx).Return(int64(4), nil).Once()
+		sitemapProfilesListerMock.EXPECT().CountSitemapProfiles(ctx).Return(int64(3), nil).Once()
+
+		// Act
+		err := handler.Sitemap(c)
+
+		// Assert
+		require.ErrorContains(t, err, api.SitemapNotFound(4).Error())
+	})
+
+	t.Run("Should return unexpected errors", func(t *testing.T) {
+		// Arrange
+		req := httptest.NewRequest(http.MethodGet, "/api/sitemap.xml", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		ctx := c.Request().Context()
+		unexpectedError := errors.New("unexpected error")
+		sitemapArticlesListerMock.EXPECT().CountSitemapArticles(ctx).Return(int64(0), unexpectedError).Once()
+
+		// Act
+		err := handler.Sitemap(c)
+
+		// Assert
+		require.ErrorIs(t, err, unexpectedError)
+	})
+}
+
+func assembleSitemapArticles(slugs ...string) []*models.Article {
+	articles := make([]*models.Article, 0, len(slugs))
+	for _, slug := range slugs {
+		ID := primitive.NewObjectID()
+		publishedAt := time.Date(2024, time.January, 1, 8, 0, 0, 0, time.UTC)
+		updatedAt := time.Date(2024, time.January, 2, 10, 0, 0, 0, time.UTC)
+		articles = append(articles, &models.Article{ID: &ID, Slug: &slug, PublishedAt: &publishedAt, UpdatedAt: &updatedAt})
+	}
+	return articles
+}
+
+func assembleSitemapProfiles(usernames ...string) []*profileManagerModels.User {
+	profiles := make([]*profileManagerModels.User, 0, len(usernames))
+	for _, username := range usernames {
+		ID := primitive.NewObjectID()
+		createdAt := time.Date(2023, time.December, 1, 8, 0, 0, 0, time.UTC)
+		profiles = append(profiles, &profileManagerModels.User{ID: &ID, Username: &username, CreatedAt: &createdAt})
+	}
+	return profiles
+}
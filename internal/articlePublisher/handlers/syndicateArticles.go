@@ -0,0 +1,193 @@
+package handlers
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ravilock/goduit/api"
+	"github.com/ravilock/goduit/internal/app"
+	"github.com/ravilock/goduit/internal/articlePublisher/assemblers"
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+	"github.com/ravilock/goduit/internal/articlePublisher/requests"
+	"github.com/ravilock/goduit/internal/articlePublisher/syndication"
+	"github.com/ravilock/goduit/internal/etag"
+)
+
+// feedCacheControl lets feed readers and proxies reuse a feed for a few minutes, and revalidate it afterwards.
+const feedCacheControl = "public, max-age=300"
+
+// SyndicateArticlesHandler serves the global, author and tag feeds of published articles in one format, either Atom or RSS.
+type SyndicateArticlesHandler struct {
+	service        articleLister
+	profileManager profileGetter
+	format         string
+	siteURL        string
+	publicURL      string
+	maxArticles    int64
+}
+
+func NewSyndicateArticlesHandler(service articleLister, profileManager profileGetter, format, siteURL, publicURL string, maxArticles int64) *SyndicateArticlesHandler {
+	return &SyndicateArticlesHandler{
+		service:        service,
+		profileManager: profileManager,
+		format:         format,
+		siteURL:        siteURL,
+		publicURL:      publicURL,
+		maxArticles:    maxArticles,
+	}
+}
+
+func (h *SyndicateArticlesHandler) GlobalFeed(c echo.Context) error {
+	feed := &syndication.Feed{
+		Title:       "goduit",
+		Description: "The latest articles published on goduit",
+		Link:        h.siteURL,
+	}
+	return h.serveFeed(c, feed, "", "")
+}
+
+func (h *SyndicateArticlesHandler) AuthorFeed(c echo.Context) error {
+	request := new(requests.AuthorArticlesFeedRequest)
+	binder := &echo.DefaultBinder{}
+	if err := binder.BindPathParams(c, request); err != nil {
+		return err
+	}
+
+	if err := request.Validate(); err != nil {
+		return err
+	}
+
+	ctx := c.Request().Context()
+
+	author, err := h.profileManager.GetProfileByUsername(ctx, request.Username)
+	if err != nil {
+		if appError := new(app.AppError); errors.As(err, &appError) {
+			switch appError.ErrorCode {
+			case app.UserNotFoundErrorCode:
+				return api.UserNotFound(request.Username)
+			}
+		}
+		return err
+	}
+
+	feed := &syndication.Feed{
+		Title:       fmt.Sprintf("Articles by %s", *author.Username),
+		Description: fmt.Sprintf("The latest articles published by %s on goduit", *author.Username),
+		Link:        assemblers.ProfileURL(h.siteURL, *author.Username),
+	}
+	if author.Bio != nil && *author.Bio != "" {
+		feed.Description = *author.Bio
+	}
+	return h.serveFeed(c, feed, author.ID.Hex(), "")
+}
+
+func (h *SyndicateArticlesHandler) TagFeed(c echo.Context) error {
+	request := new(requests.TagArticlesFeedRequest)
+	binder := &echo.DefaultBinder{}
+	if err := binder.BindPathParams(c, request); err != nil {
+		return err
+	}
+
+	if err := request.Validate(); err != nil {
+		return err
+	}
+
+	feed := &syndication.Feed{
+		Title:       fmt.Sprintf("Articles tagged %s", request.Tag),
+		Description: fmt.Sprintf("The latest articles tagged %s on goduit", request.Tag),
+		Link:        h.siteURL,
+	}
+	return h.serveFeed(c, feed, "", request.Tag)
+}
+
+// serveFeed lists the latest published articles the same way they are listed through the API and writes them as a feed.
+// Feeds are tagged and dated after their articles, so readers polling them are answered with HTTP 304 until one changes.
+func (h *SyndicateArticlesHandler) serveFeed(c echo.Context, feed *syndication.Feed, author, tag string) error {
+	ctx := c.Request().Context()
+
+	articles, err := h.service.ListArticles(ctx, author, tag, "", "", h.maxArticles, 0)
+	if err != nil {
+		return err
+	}
+
+	header := c.Response().Header()
+	header.Set(echo.HeaderCacheControl, feedCacheControl)
+	entityTag := feedETag(h.format, articles)
+	header.Set(etag.HeaderETag, entityTag)
+	updated := feedUpdatedAt(articles)
+	if !updated.IsZero() {
+		header.Set(echo.HeaderLastModified, updated.UTC().Format(http.TimeFormat))
+	}
+	if ifNoneMatch := c.Request().Header.Get(etag.HeaderIfNoneMatch); ifNoneMatch != "" {
+		if etag.Matches(ifNoneMatch, entityTag) {
+			return c.NoContent(http.StatusNotModified)
+		}
+	} else if !updated.IsZero() && notModifiedSince(c.Request().Header.Get(echo.HeaderIfModifiedSince), updated) {
+		return c.NoContent(http.StatusNotModified)
+	}
+
+	feed.Self = strings.TrimSuffix(h.publicURL, "/") + c.Request().URL.Path
+	feed.Updated = updated
+	if updated.IsZero() {
+		feed.Updated = time.Now()
+	}
+	feed.Entries = make([]syndication.Entry, 0, len(articles))
+	authors := make(map[string]string)
+	for _, article := range articles {
+		username, ok := authors[*article.Author]
+		if !ok {
+			author, err := h.profileManager.GetProfileByID(ctx, *article.Author)
+			if err != nil {
+				continue
+			}
+			username = *author.Username
+			authors[*article.Author] = username
+		}
+		feed.Entries = append(feed.Entries, assemblers.FeedEntry(article, username, h.siteURL))
+	}
+
+	document := new(bytes.Buffer)
+	if err := syndication.Write(document, h.format, feed); err != nil {
+		return err
+	}
+	return c.Blob(http.StatusOK, syndication.ContentType(h.format), document.Bytes())
+}
+
+// feedETag tags a feed after the articles it lists and when they last changed.
+func feedETag(format string, articles []*models.Article) string {
+	parts := make([]string, 0, len(articles)+1)
+	parts = append(parts, format)
+	for _, article := range articles {
+		parts = append(parts, fmt.Sprintf("%s:%d", article.ID.Hex(), assemblers.ArticleUpdatedAt(article).UnixMilli()))
+	}
+	return etag.FromDigest(parts...)
+}
+
+// feedUpdatedAt is when the most recently changed article of a feed changed, or the zero time for empty feeds.
+func feedUpdatedAt(articles []*models.Article) time.Time {
+	var updated time.Time
+	for _, article := range articles {
+		if articleUpdatedAt := assemblers.ArticleUpdatedAt(article); articleUpdatedAt.After(updated) {
+			updated = articleUpdatedAt
+		}
+	}
+	return updated
+}
+
+// notModifiedSince reports whether an If-Modified-Since header is a date at or after the time, which HTTP dates only
+// carry to the second.
+func notModifiedSince(header string, updated time.Time) bool {
+	if header == "" {
+		return false
+	}
+	since, err := http.ParseTime(header)
+	if err != nil {
+		return false
+	}
+	return !updated.Truncate(time.Second).After(since)
+}
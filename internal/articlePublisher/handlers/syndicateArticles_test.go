@@ -0,0 +1,220 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ravilock/goduit/api"
+	"github.com/ravilock/goduit/api/validators"
+	"github.com/ravilock/goduit/internal/app"
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+	"github.com/ravilock/goduit/internal/articlePublisher/syndication"
+	"github.com/ravilock/goduit/internal/etag"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestSyndicateArticles(t *testing.T) {
+	err := validators.InitValidator()
+	require.NoError(t, err)
+	articleListerMock := newMockArticleLister(t)
+	profileGetterMock := newMockProfileGetter(t)
+	atomHandler := NewSyndicateArticlesHandler(articleListerMock, profileGetterMock, syndication.FormatAtom, "http://localhost:5173", "http://localhost:3000", 20)
+	rssHandler := NewSyndicateArticlesHandler(articleListerMock, profileGetterMock, syndication.FormatRSS, "http://localhost:5173", "http://localhost:3000", 20)
+	e := echo.New()
+
+	t.Run("Should serve the global feed", func(t *testing.T) {
+		// Arrange
+		authorID := primitive.NewObjectID()
+		articles := assembleSyndicatedArticles(authorID)
+		author := assembleArticleAuthor(authorID.Hex())
+		req := httptest.NewRequest(http.MethodGet, "/api/articles/feed.atom", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		ctx := c.Request().Context()
+		articleListerMock.EXPECT().ListArticles(ctx, "", "", "", "", int64(20), int64(0)).Return(articles, nil).Once()
+		profileGetterMock.EXPECT().GetProfileByID(ctx, authorID.Hex()).Return(author, nil).Once()
+
+		// Act
+		err := atomHandler.GlobalFeed(c)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Equal(t, syndication.ContentType(syndication.FormatAtom), rec.Header().Get(echo.HeaderContentType))
+		require.Equal(t, feedCacheControl, rec.Header().Get(echo.HeaderCacheControl))
+		require.Equal(t, "Tue, 02 Jan 2024 10:00:00 GMT", rec.Header().Get(echo.HeaderLastModified))
+		require.NotEmpty(t, rec.Header().Get(etag.HeaderETag))
+		require.Contains(t, rec.Body.String(), `<id>http://localhost:3000/api/articles/feed.atom</id>`)
+		require.Contains(t, rec.Body.String(), `<updated>2024-01-02T10:00:00Z</updated>`)
+		require.Contains(t, rec.Body.String(), `<link rel="alternate" type="text/html" href="http://localhost:5173/article/article-title"></link>`)
+		require.Contains(t, rec.Body.String(), "<name>"+authorID.Hex()+"</name>")
+	})
+
+	t.Run("Should serve the feed of an author", func(t *testing.T) {
+		// Arrange
+		authorID := primitive.NewObjectID()
+		articles := assembleSyndicatedArticles(authorID)
+		author := assembleArticleAuthor(authorID.Hex())
+		req := httptest.NewRequest(http.MethodGet, "/api/profiles/"+authorID.Hex()+"/feed.rss", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("username")
+		c.SetParamValues(authorID.Hex())
+		ctx := c.Request().Context()
+		profileGetterMock.EXPECT().GetProfileByUsername(ctx, authorID.Hex()).Return(author, nil).Once()
+		articleListerMock.EXPECT().ListArticles(ctx, authorID.Hex(), "", "", "", int64(20), int64(0)).Return(articles, nil).Once()
+		profileGetterMock.EXPECT().GetProfileByID(ctx, authorID.Hex()).Return(author, nil).Once()
+
+		// Act
+		err := rssHandler.AuthorFeed(c)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Equal(t, syndication.ContentType(syndication.FormatRSS), rec.Header().Get(echo.HeaderContentType))
+		require.Contains(t, rec.Body.String(), "<title>Articles by "+authorID.Hex()+"</title>")
+		require.Contains(t, rec.Body.String(), "<link>http://localhost:5173/profile/"+authorID.Hex()+"</link>")
+		require.Contains(t, rec.Body.String(), "<lastBuildDate>Tue, 02 Jan 2024 10:00:00 +0000</lastBuildDate>")
+	})
+
+	t.Run("Should return HTTP 404 if author is not found", func(t *testing.T) {
+		// Arrange
+		req := httptest.NewRequest(http.MethodGet, "/api/profiles/missing-author/feed.atom", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("username")
+		c.SetParamValues("missing-author")
+		ctx := c.Request().Context()
+		profileGetterMock.EXPECT().GetProfileByUsername(ctx, "missing-author").Return(nil, app.UserNotFoundError("missing-author", nil)).Once()
+
+		// Act
+		err := atomHandler.AuthorFeed(c)
+
+		// Assert
+		require.ErrorContains(t, err, api.UserNotFound("missing-author").Error())
+	})
+
+	t.Run("Should serve the feed of a tag", func(t *testing.T) {
+		// Arrange
+		authorID := primitive.NewObjectID()
+		articles := assembleSyndicatedArticles(authorID)
+		author := assembleArticleAuthor(authorID.Hex())
+		req := httptest.NewRequest(http.MethodGet, "/api/tags/golang/feed.atom", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("tag")
+		c.SetParamValues("golang")
+		ctx := c.Request().Context()
+		articleListerMock.EXPECT().ListArticles(ctx, "", "golang", "", "", int64(20), int64(0)).Return(articles, nil).Once()
+		profileGetterMock.EXPECT().GetProfileByID(ctx, authorID.Hex()).Return(author, nil).Once()
+
+		// Act
+		err := atomHandler.TagFeed(c)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Contains(t, rec.Body.String(), "<title>Articles tagged golang</title>")
+	})
+
+	t.Run("Should return HTTP 304 if feed did not change since it was last fetched", func(t *testing.T) {
+		// Arrange
+		authorID := primitive.NewObjectID()
+		articles := assembleSyndicatedArticles(authorID)
+		req := httptest.NewRequest(http.MethodGet, "/api/articles/feed.atom", nil)
+		req.Header.Set(echo.HeaderIfModifiedSince, "Tue, 02 Jan 2024 10:00:00 GMT")
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		ctx := c.Request().Context()
+		articleListerMock.EXPECT().ListArticles(ctx, "", "", "", "", int64(20), int64(0)).Return(articles, nil).Once()
+
+		// Act
+		err := atomHandler.GlobalFeed(c)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, http.StatusNotModified, rec.Code)
+		require.Empty(t, rec.Body.String())
+	})
+
+	t.Run("Should return HTTP 304 if feed still matches its entity tag", func(t *testing.T) {
+		// Arrange
+		authorID := primitive.NewObjectID()
+		articles := assembleSyndicatedArticles(authorID)
+		req := httptest.NewRequest(http.MethodGet, "/api/articles/feed.rss", nil)
+		req.Header.Set(etag.HeaderIfNoneMatch, feedETag(syndication.FormatRSS, articles))
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		ctx := c.Request().Context()
+		articleListerMock.EXPECT().ListArticles(ctx, "", "", "", "", int64(20), int64(0)).Return(articles, nil).Once()
+
+		// Act
+		err := rssHandler.GlobalFeed(c)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, http.StatusNotModified, rec.Code)
+	})
+
+	t.Run("Should serve the feed again once an article changes", func(t *testing.T) {
+		// Arrange
+		authorID := primitive.NewObjectID()
+		articles := assembleSyndicatedArticles(authorID)
+		author := assembleArticleAuthor(authorID.Hex())
+		previousETag := feedETag(syndication.FormatAtom, articles)
+		updatedAt := articles[0].UpdatedAt.Add(time.Hour)
+		articles[0].UpdatedAt = &updatedAt
+		req := httptest.NewRequest(http.MethodGet, "/api/articles/feed.atom", nil)
+		req.Header.Set(etag.HeaderIfNoneMatch, previousETag)
+		req.Header.Set(echo.HeaderIfModifiedSince, "Tue, 02 Jan 2024 10:00:00 GMT")
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		ctx := c.Request().Context()
+		articleListerMock.EXPECT().ListArticles(ctx, "", "", "", "", int64(20), int64(0)).Return(articles, nil).Once()
+		profileGetterMock.EXPECT().GetProfileByID(ctx, authorID.Hex()).Return(author, nil).Once()
+
+		// Act
+		err := atomHandler.GlobalFeed(c)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Equal(t, "Tue, 02 Jan 2024 11:00:00 GMT", rec.Header().Get(echo.HeaderLastModified))
+		require.NotEqual(t, previousETag, rec.Header().Get(etag.HeaderETag))
+	})
+
+	t.Run("Should return unexpected errors", func(t *testing.T) {
+		// Arrange
+		req := httptest.NewRequest(http.MethodGet, "/api/articles/feed.atom", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		ctx := c.Request().Context()
+		unexpectedError := errors.New("unexpected error")
+		articleListerMock.EXPECT().ListArticles(ctx, "", "", "", "", int64(20), int64(0)).Return(nil, unexpectedError).Once()
+
+		// Act
+		err := atomHandler.GlobalFeed(c)
+
+		// Assert
+		require.ErrorIs(t, err, unexpectedError)
+	})
+}
+
+// assembleSyndicatedArticles assembles two articles by the same author, the latest of them changed after being published.
+func assembleSyndicatedArticles(authorID primitive.ObjectID) []*models.Article {
+	latest := assembleArticleModel(authorID)
+	latestPublishedAt := time.Date(2024, time.January, 2, 8, 0, 0, 0, time.UTC)
+	latestUpdatedAt := time.Date(2024, time.January, 2, 10, 0, 0, 0, time.UTC)
+	latest.PublishedAt = &latestPublishedAt
+	latest.UpdatedAt = &latestUpdatedAt
+	earliest := assembleArticleModel(authorID)
+	earliestPublishedAt := time.Date(2024, time.January, 1, 8, 0, 0, 0, time.UTC)
+	earliest.PublishedAt = &earliestPublishedAt
+	earliest.UpdatedAt = &earliestPublishedAt
+	return []*models.Article{latest, earliest}
+}
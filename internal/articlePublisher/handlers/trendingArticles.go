@@ -0,0 +1,78 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ravilock/goduit/internal/articlePublisher/assemblers"
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+	"github.com/ravilock/goduit/internal/articlePublisher/requests"
+	"github.com/ravilock/goduit/internal/articlePublisher/responses"
+	"github.com/ravilock/goduit/internal/identity"
+	profileManagerAssembler "github.com/ravilock/goduit/internal/profileManager/assemblers"
+)
+
+type trendingArticlesLister interface {
+	ListTrendingArticles(ctx context.Context, window, tag string, limit, offset int64) ([]*models.Article, error)
+}
+
+type TrendingArticlesHandler struct {
+	service         trendingArticlesLister
+	profileManager  profileGetter
+	followerCentral isFollowedChecker
+	favorites       isFavoritedChecker
+}
+
+func NewTrendingArticlesHandler(service trendingArticlesLister, profileManager profileGetter, followerCentral isFollowedChecker, favorites isFavoritedChecker) *TrendingArticlesHandler {
+	return &TrendingArticlesHandler{
+		service:         service,
+		profileManager:  profileManager,
+		followerCentral: followerCentral,
+		favorites:       favorites,
+	}
+}
+
+func (h *TrendingArticlesHandler) ListTrendingArticles(c echo.Context) error {
+	request := requests.NewTrendingArticlesRequest()
+	identity := new(identity.IdentityHeaders)
+	binder := &echo.DefaultBinder{}
+	if err := binder.BindQueryParams(c, request); err != nil {
+		return err
+	}
+	if err := binder.BindHeaders(c, identity); err != nil {
+		return err
+	}
+
+	if err := request.Validate(); err != nil {
+		return err
+	}
+
+	ctx := c.Request().Context()
+
+	articles, err := h.service.ListTrendingArticles(ctx, request.Window, request.Tag, int64(request.Limit), int64(request.Offset))
+	if err != nil {
+		return err
+	}
+
+	response := responses.ArticlesResponse{Articles: make([]responses.MultiArticle, 0, len(articles))}
+	for _, article := range articles {
+		author, err := h.profileManager.GetProfileByID(ctx, *article.Author)
+		if err != nil {
+			continue
+		}
+
+		isFollowing := h.followerCentral.IsFollowedBy(ctx, *article.Author, identity.Subject)
+
+		authorProfile, err := profileManagerAssembler.ProfileResponse(author, isFollowing)
+		if err != nil {
+			continue
+		}
+
+		isFavorited := h.favorites.IsFavoritedBy(ctx, article.ID.Hex(), identity.Subject)
+
+		response.Articles = append(response.Articles, *assemblers.MultiArticleResponse(article, authorProfile, isFavorited))
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
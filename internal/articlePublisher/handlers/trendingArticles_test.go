@@ -0,0 +1,97 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ravilock/goduit/api"
+	"github.com/ravilock/goduit/api/validators"
+	articlePublisherResponses "github.com/ravilock/goduit/internal/articlePublisher/responses"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestTrendingArticles(t *testing.T) {
+	err := validators.InitValidator()
+	require.NoError(t, err)
+	trendingArticlesListerMock := newMockTrendingArticlesLister(t)
+	profileGetterMock := newMockProfileGetter(t)
+	isFollowedCheckerMock := newMockIsFollowedChecker(t)
+	isFavoritedCheckerMock := newMockIsFavoritedChecker(t)
+	handler := &TrendingArticlesHandler{trendingArticlesListerMock, profileGetterMock, isFollowedCheckerMock, isFavoritedCheckerMock}
+	e := echo.New()
+
+	t.Run("Should list trending articles of the week by default", func(t *testing.T) {
+		// Arrange
+		authorID := primitive.NewObjectID()
+		author := assembleArticleAuthor(authorID.Hex())
+		articles := assembleRandomArticles(3, authorID)
+		userID := primitive.NewObjectID().Hex()
+		req := httptest.NewRequest(http.MethodGet, "/api/articles/trending", nil)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set("Goduit-Subject", userID)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		ctx := c.Request().Context()
+		trendingArticlesListerMock.EXPECT().ListTrendingArticles(ctx, "week", "", int64(20), int64(0)).Return(articles, nil).Once()
+		profileGetterMock.EXPECT().GetProfileByID(ctx, authorID.Hex()).Return(author, nil).Times(len(articles))
+		isFollowedCheckerMock.EXPECT().IsFollowedBy(ctx, authorID.Hex(), userID).Return(false).Times(len(articles))
+		for _, article := range articles {
+			isFavoritedCheckerMock.EXPECT().IsFavoritedBy(ctx, article.ID.Hex(), userID).Return(true).Once()
+		}
+
+		// Act
+		err := handler.ListTrendingArticles(c)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Code)
+		trendingArticlesResponse := new(articlePublisherResponses.ArticlesResponse)
+		err = json.Unmarshal(rec.Body.Bytes(), trendingArticlesResponse)
+		require.NoError(t, err)
+		require.Len(t, trendingArticlesResponse.Articles, len(articles))
+		for i, article := range articles {
+			require.Equal(t, *article.Slug, trendingArticlesResponse.Articles[i].Slug)
+			require.Equal(t, *author.Username, trendingArticlesResponse.Articles[i].Author.Username)
+			require.True(t, trendingArticlesResponse.Articles[i].Favorited)
+		}
+	})
+
+	t.Run("Should filter trending articles by window and tag", func(t *testing.T) {
+		// Arrange
+		req := httptest.NewRequest(http.MethodGet, "/api/articles/trending?window=day&tag=technology&limit=5&offset=10", nil)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		ctx := c.Request().Context()
+		trendingArticlesListerMock.EXPECT().ListTrendingArticles(ctx, "day", "technology", int64(5), int64(10)).Return(nil, nil).Once()
+
+		// Act
+		err := handler.ListTrendingArticles(c)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Code)
+		trendingArticlesResponse := new(articlePublisherResponses.ArticlesResponse)
+		err = json.Unmarshal(rec.Body.Bytes(), trendingArticlesResponse)
+		require.NoError(t, err)
+		require.Empty(t, trendingArticlesResponse.Articles)
+	})
+
+	t.Run("Should return HTTP 400 if window is not supported", func(t *testing.T) {
+		// Arrange
+		req := httptest.NewRequest(http.MethodGet, "/api/articles/trending?window=year", nil)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		// Act
+		err := handler.ListTrendingArticles(c)
+
+		// Assert
+		require.ErrorContains(t, err, api.InvalidFieldError("Window", "year").Error())
+	})
+}
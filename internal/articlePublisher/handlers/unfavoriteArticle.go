@@ -0,0 +1,101 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ravilock/goduit/api"
+	"github.com/ravilock/goduit/internal/app"
+	"github.com/ravilock/goduit/internal/articlePublisher/assemblers"
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+	"github.com/ravilock/goduit/internal/articlePublisher/requests"
+	"github.com/ravilock/goduit/internal/identity"
+	profileManagerAssembler "github.com/ravilock/goduit/internal/profileManager/assemblers"
+)
+
+type articleUnfavoriter interface {
+	UnfavoriteArticle(ctx context.Context, article, user string) (*models.Article, error)
+}
+
+type UnfavoriteArticleHandler struct {
+	service          articleUnfavoriter
+	articlePublisher articleGetter
+	profileManager   profileGetter
+	followerCentral  isFollowedChecker
+}
+
+func NewUnfavoriteArticleHandler(
+	service articleUnfavoriter,
+	articlePublisher articleGetter,
+	profileManager profileGetter,
+	followerCentral isFollowedChecker,
+) *UnfavoriteArticleHandler {
+	return &UnfavoriteArticleHandler{
+		service:          service,
+		articlePublisher: articlePublisher,
+		profileManager:   profileManager,
+		followerCentral:  followerCentral,
+	}
+}
+
+func (h *UnfavoriteArticleHandler) UnfavoriteArticle(c echo.Context) error {
+	request := new(requests.ArticleSlugRequest)
+	identity := new(identity.IdentityHeaders)
+	binder := &echo.DefaultBinder{}
+	if err := binder.BindPathParams(c, request); err != nil {
+		return err
+	}
+	if err := binder.BindHeaders(c, identity); err != nil {
+		return err
+	}
+
+	if err := request.Validate(); err != nil {
+		return err
+	}
+
+	ctx := c.Request().Context()
+
+	article, err := h.articlePublisher.GetArticleBySlug(ctx, request.Slug)
+	if err != nil {
+		if appError := new(app.AppError); errors.As(err, &appError) {
+			switch appError.ErrorCode {
+			case app.ArticleNotFoundErrorCode:
+				return api.ArticleNotFound(request.Slug)
+			}
+		}
+		return err
+	}
+
+	article, err = h.service.UnfavoriteArticle(ctx, article.ID.Hex(), identity.Subject)
+	if err != nil {
+		if appError := new(app.AppError); errors.As(err, &appError) {
+			switch appError.ErrorCode {
+			case app.ArticleNotFoundErrorCode:
+				return api.ArticleNotFound(request.Slug)
+			case app.FavoriteNotFoundErrorCode:
+				return api.FavoriteNotFound(request.Slug, identity.ClientUsername)
+			}
+		}
+		return err
+	}
+
+	author, err := h.profileManager.GetProfileByID(ctx, *article.Author)
+	if err != nil {
+		return err
+	}
+
+	isFollowing := h.followerCentral.IsFollowedBy(ctx, author.ID.Hex(), identity.Subject)
+
+	authorProfile, err := profileManagerAssembler.ProfileResponse(author, isFollowing)
+	if err != nil {
+		return err
+	}
+
+	response := assemblers.ArticleResponse(article, authorProfile, false)
+	if err := withCoAuthors(ctx, h.profileManager, article, response); err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,85 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ravilock/goduit/api"
+	"github.com/ravilock/goduit/api/validators"
+	"github.com/ravilock/goduit/internal/app"
+	articlePublisherResponses "github.com/ravilock/goduit/internal/articlePublisher/responses"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestUnfavoriteArticle(t *testing.T) {
+	err := validators.InitValidator()
+	require.NoError(t, err)
+	articleUnfavoriterMock := newMockArticleUnfavoriter(t)
+	articleGetterMock := newMockArticleGetter(t)
+	profileGetterMock := newMockProfileGetter(t)
+	isFollowedCheckerMock := newMockIsFollowedChecker(t)
+	handler := &UnfavoriteArticleHandler{articleUnfavoriterMock, articleGetterMock, profileGetterMock, isFollowedCheckerMock}
+	e := echo.New()
+
+	t.Run("Should unfavorite an article", func(t *testing.T) {
+		// Arrange
+		articleAuthorID := primitive.NewObjectID()
+		expectedAuthor := assembleArticleAuthor(articleAuthorID.Hex())
+		expectedArticle := assembleArticleModel(articleAuthorID)
+		user := assembleArticleAuthor(primitive.NewObjectID().Hex())
+		req := httptest.NewRequest(http.MethodDelete, fmt.Sprintf("/api/articles/%s/favorite", *expectedArticle.Slug), nil)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set("Goduit-Subject", user.ID.Hex())
+		req.Header.Set("Goduit-Client-Username", *user.Username)
+		req.Header.Set("Goduit-Client-Email", *user.Email)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("slug")
+		c.SetParamValues(*expectedArticle.Slug)
+		ctx := c.Request().Context()
+		articleGetterMock.EXPECT().GetArticleBySlug(ctx, *expectedArticle.Slug).Return(expectedArticle, nil).Once()
+		articleUnfavoriterMock.EXPECT().UnfavoriteArticle(ctx, expectedArticle.ID.Hex(), user.ID.Hex()).Return(expectedArticle, nil).Once()
+		profileGetterMock.EXPECT().GetProfileByID(ctx, articleAuthorID.Hex()).Return(expectedAuthor, nil).Once()
+		isFollowedCheckerMock.EXPECT().IsFollowedBy(ctx, articleAuthorID.Hex(), user.ID.Hex()).Return(false).Once()
+
+		// Act
+		err := handler.UnfavoriteArticle(c)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Code)
+		unfavoriteArticleResponse := new(articlePublisherResponses.ArticleResponse)
+		err = json.Unmarshal(rec.Body.Bytes(), unfavoriteArticleResponse)
+		require.NoError(t, err)
+		require.False(t, unfavoriteArticleResponse.Article.Favorited)
+		require.Equal(t, *expectedArticle.FavoritesCount, unfavoriteArticleResponse.Article.FavoritesCount)
+	})
+
+	t.Run("Should return HTTP 404 if article is not favorited", func(t *testing.T) {
+		// Arrange
+		expectedArticle := assembleArticleModel(primitive.NewObjectID())
+		user := assembleArticleAuthor(primitive.NewObjectID().Hex())
+		req := httptest.NewRequest(http.MethodDelete, fmt.Sprintf("/api/articles/%s/favorite", *expectedArticle.Slug), nil)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set("Goduit-Subject", user.ID.Hex())
+		req.Header.Set("Goduit-Client-Username", *user.Username)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("slug")
+		c.SetParamValues(*expectedArticle.Slug)
+		ctx := c.Request().Context()
+		articleGetterMock.EXPECT().GetArticleBySlug(ctx, *expectedArticle.Slug).Return(expectedArticle, nil).Once()
+		articleUnfavoriterMock.EXPECT().UnfavoriteArticle(ctx, expectedArticle.ID.Hex(), user.ID.Hex()).Return(nil, app.FavoriteNotFoundError(expectedArticle.ID.Hex(), user.ID.Hex(), nil)).Once()
+
+		// Act
+		err := handler.UnfavoriteArticle(c)
+
+		// Assert
+		require.ErrorContains(t, err, api.FavoriteNotFound(*expectedArticle.Slug, *user.Username).Error())
+	})
+}
@@ -60,7 +60,7 @@ func (h *UnpublishArticleHandler) UnpublishArticle(c echo.Context) error {
 		return api.Forbidden
 	}
 
-	if err := h.articleUnpublisher.UnpublishArticle(ctx, request.Slug); err != nil {
+	if err := h.articleUnpublisher.UnpublishArticle(ctx, *article.Slug); err != nil {
 		if appError := new(app.AppError); errors.As(err, &appError) {
 			switch appError.ErrorCode {
 			case app.ArticleNotFoundErrorCode:
@@ -2,8 +2,10 @@ package handlers
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"net/http"
+	"strings"
 
 	"github.com/labstack/echo/v4"
 	"github.com/ravilock/goduit/api"
@@ -11,12 +13,13 @@ import (
 	"github.com/ravilock/goduit/internal/articlePublisher/assemblers"
 	"github.com/ravilock/goduit/internal/articlePublisher/models"
 	"github.com/ravilock/goduit/internal/articlePublisher/requests"
+	"github.com/ravilock/goduit/internal/etag"
 	"github.com/ravilock/goduit/internal/identity"
 	profileManagerAssembler "github.com/ravilock/goduit/internal/profileManager/assemblers"
 )
 
 type articleUpdater interface {
-	UpdateArticle(ctx context.Context, slug string, article *models.Article) error
+	UpdateArticle(ctx context.Context, slug, editor string, article *models.Article) error
 }
 
 type UpdateArticleHandler struct {
@@ -51,43 +54,85 @@ func (h *UpdateArticleHandler) UpdateArticle(c echo.Context) error {
 		return err
 	}
 
-	article := request.Model()
+	return h.updateArticle(c, request.Slug, identity, request.Model())
+}
 
+// PatchArticle applies a JSON Merge Patch to an article, changing only the fields present in the patch.
+func (h *UpdateArticleHandler) PatchArticle(c echo.Context) error {
+	if contentType := c.Request().Header.Get(echo.HeaderContentType); !strings.HasPrefix(contentType, requests.MIMEApplicationMergePatchJSON) {
+		return echo.ErrUnsupportedMediaType
+	}
+	request := new(requests.PatchArticleRequest)
+	identity := new(identity.IdentityHeaders)
+	binder := &echo.DefaultBinder{}
+	if err := json.NewDecoder(c.Request().Body).Decode(request); err != nil {
+		return api.CouldNotUnmarshalBodyError
+	}
+	if err := binder.BindPathParams(c, request); err != nil {
+		return err
+	}
+	if err := binder.BindHeaders(c, identity); err != nil {
+		return err
+	}
+
+	if err := request.Validate(); err != nil {
+		return err
+	}
+
+	return h.updateArticle(c, request.Slug, identity, request.Model())
+}
+
+func (h *UpdateArticleHandler) updateArticle(c echo.Context, slug string, identity *identity.IdentityHeaders, article *models.Article) error {
 	ctx := c.Request().Context()
 
-	currentArticle, err := h.articleGetter.GetArticleBySlug(ctx, request.Slug)
+	currentArticle, err := h.articleGetter.GetArticleBySlug(ctx, slug)
 	if err != nil {
 		if appError := new(app.AppError); errors.As(err, &appError) {
 			switch appError.ErrorCode {
 			case app.ArticleNotFoundErrorCode:
-				return api.ArticleNotFound(request.Slug)
+				return api.ArticleNotFound(slug)
 			}
 		}
 		return err
 	}
 
-	if identity.Subject != *currentArticle.Author {
+	if !currentArticle.HasAuthor(identity.Subject) {
 		return api.Forbidden
 	}
 
-	if err = h.articleUpdater.UpdateArticle(ctx, request.Slug, article); err != nil {
+	if article.PublishAt != nil && currentArticle.IsPublished() {
+		return api.ArticleAlreadyPublished(slug)
+	}
+
+	// Clients sending the version they last fetched get the update rejected if the article changed in the meantime
+	if ifMatch := c.Request().Header.Get(etag.HeaderIfMatch); ifMatch != "" {
+		currentVersion := currentArticle.CurrentVersion()
+		if !etag.MatchesVersion(ifMatch, currentVersion) {
+			return api.PreconditionFailed(slug)
+		}
+		article.Version = &currentVersion
+	}
+
+	if err = h.articleUpdater.UpdateArticle(ctx, *currentArticle.Slug, identity.Subject, article); err != nil {
 		if appError := new(app.AppError); errors.As(err, &appError) {
 			switch appError.ErrorCode {
 			case app.ArticleNotFoundErrorCode:
-				return api.ArticleNotFound(request.Slug)
+				return api.ArticleNotFound(slug)
 			case app.ConflictErrorCode:
 				return api.ConfictError
+			case app.VersionMismatchErrorCode:
+				return api.PreconditionFailed(slug)
 			}
 		}
 		return err
 	}
 
-	authorProfile, err := h.profileManager.GetProfileByID(ctx, identity.Subject)
+	authorProfile, err := h.profileManager.GetProfileByID(ctx, *currentArticle.Author)
 	if err != nil {
 		if appError := new(app.AppError); errors.As(err, &appError) {
 			switch appError.ErrorCode {
 			case app.UserNotFoundErrorCode:
-				return api.UserNotFound(identity.ClientUsername)
+				return api.UserNotFound(*currentArticle.Author)
 			}
 		}
 		return err
@@ -98,6 +143,15 @@ func (h *UpdateArticleHandler) UpdateArticle(c echo.Context) error {
 		return err
 	}
 
-	response := assemblers.ArticleResponse(article, profileResponse)
-	return c.JSON(http.StatusOK, response)
+	response := assemblers.ArticleResponse(article, profileResponse, false)
+	if err := withCoAuthors(ctx, h.profileManager, currentArticle, response); err != nil {
+		return err
+	}
+	// Tagged the same way GetArticle tags the article, so the tag can be sent back in If-Match on the next update
+	body, err := json.Marshal(response)
+	if err != nil {
+		return err
+	}
+	c.Response().Header().Set(etag.HeaderETag, etag.FromRepresentation(article.CurrentVersion(), body))
+	return c.JSONBlob(http.StatusOK, body)
 }
@@ -7,7 +7,9 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
@@ -17,6 +19,7 @@ import (
 	"github.com/ravilock/goduit/internal/articlePublisher/models"
 	articlePublisherRequests "github.com/ravilock/goduit/internal/articlePublisher/requests"
 	articlePublisherResponses "github.com/ravilock/goduit/internal/articlePublisher/responses"
+	"github.com/ravilock/goduit/internal/etag"
 	"github.com/stretchr/testify/require"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
@@ -50,7 +53,7 @@ func TestUpdateArticle(t *testing.T) {
 		c.SetParamValues(*expectedArticle.Slug)
 		ctx := c.Request().Context()
 		articleGetterMock.EXPECT().GetArticleBySlug(ctx, *expectedArticle.Slug).Return(expectedArticle, nil).Once()
-		articleUpdaterMock.EXPECT().UpdateArticle(ctx, *expectedArticle.Slug, updateArticleRequest.Model()).RunAndReturn(func(ctx context.Context, slug string, article *models.Article) error {
+		articleUpdaterMock.EXPECT().UpdateArticle(ctx, *expectedArticle.Slug, expectedAuthor.ID.Hex(), updateArticleRequest.Model()).RunAndReturn(func(ctx context.Context, slug, editor string, article *models.Article) error {
 			favoritesCount := int64(30)
 			article.FavoritesCount = &favoritesCount
 			article.TagList = expectedArticle.TagList
@@ -70,6 +73,50 @@ func TestUpdateArticle(t *testing.T) {
 		checkUpdateArticleResponse(t, updateArticleRequest, *expectedAuthor.Username, updateArticleResponse, expectedArticle.TagList)
 	})
 
+	t.Run("Should let co-authors update the article", func(t *testing.T) {
+		// Arrange
+		articleAuthorID := primitive.NewObjectID()
+		expectedAuthor := assembleArticleAuthor(articleAuthorID.Hex())
+		coAuthor := assembleArticleAuthor(primitive.NewObjectID().Hex())
+		expectedArticle := assembleArticleModel(articleAuthorID)
+		expectedArticle.CoAuthors = []string{coAuthor.ID.Hex()}
+		updateArticleRequest := generateUpdateArticleBody()
+		requestBody, err := json.Marshal(updateArticleRequest)
+		require.NoError(t, err)
+		req := httptest.NewRequest(http.MethodPut, fmt.Sprintf("/api/article/%s", *expectedArticle.Slug), bytes.NewBuffer(requestBody))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set("Goduit-Subject", coAuthor.ID.Hex())
+		req.Header.Set("Goduit-Client-Username", *coAuthor.Username)
+		req.Header.Set("Goduit-Client-Email", *coAuthor.Email)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("slug")
+		c.SetParamValues(*expectedArticle.Slug)
+		ctx := c.Request().Context()
+		articleGetterMock.EXPECT().GetArticleBySlug(ctx, *expectedArticle.Slug).Return(expectedArticle, nil).Once()
+		articleUpdaterMock.EXPECT().UpdateArticle(ctx, *expectedArticle.Slug, coAuthor.ID.Hex(), updateArticleRequest.Model()).RunAndReturn(func(ctx context.Context, slug, editor string, article *models.Article) error {
+			favoritesCount := int64(30)
+			article.FavoritesCount = &favoritesCount
+			article.TagList = expectedArticle.TagList
+			return nil
+		}).Once()
+		profileGetterMock.EXPECT().GetProfileByID(ctx, expectedAuthor.ID.Hex()).Return(expectedAuthor, nil).Once()
+		profileGetterMock.EXPECT().GetProfileByID(ctx, coAuthor.ID.Hex()).Return(coAuthor, nil).Once()
+
+		// Act
+		err = handler.UpdateArticle(c)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Code)
+		updateArticleResponse := new(articlePublisherResponses.ArticleResponse)
+		err = json.Unmarshal(rec.Body.Bytes(), updateArticleResponse)
+		require.NoError(t, err)
+		checkUpdateArticleResponse(t, updateArticleRequest, *expectedAuthor.Username, updateArticleResponse, expectedArticle.TagList)
+		require.Len(t, updateArticleResponse.Article.CoAuthors, 1)
+		require.Equal(t, *coAuthor.Username, updateArticleResponse.Article.CoAuthors[0].Username)
+	})
+
 	t.Run("Should return HTTP 404 if no article is found", func(t *testing.T) {
 		// Arrange
 		articleAuthorID := primitive.NewObjectID()
@@ -122,6 +169,244 @@ func TestUpdateArticle(t *testing.T) {
 		// Assert
 		require.ErrorContains(t, err, api.Forbidden.Error())
 	})
+
+	t.Run("Should only update the article at the version the client fetched", func(t *testing.T) {
+		// Arrange
+		articleAuthorID := primitive.NewObjectID()
+		expectedAuthor := assembleArticleAuthor(articleAuthorID.Hex())
+		expectedArticle := assembleArticleModel(articleAuthorID)
+		currentVersion := int64(2)
+		expectedArticle.Version = &currentVersion
+		updateArticleRequest := generateUpdateArticleBody()
+		requestBody, err := json.Marshal(updateArticleRequest)
+		require.NoError(t, err)
+		req := httptest.NewRequest(http.MethodPut, fmt.Sprintf("/api/article/%s", *expectedArticle.Slug), bytes.NewBuffer(requestBody))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set(etag.HeaderIfMatch, `"2"`)
+		req.Header.Set("Goduit-Subject", expectedAuthor.ID.Hex())
+		req.Header.Set("Goduit-Client-Username", *expectedAuthor.Username)
+		req.Header.Set("Goduit-Client-Email", *expectedAuthor.Email)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("slug")
+		c.SetParamValues(*expectedArticle.Slug)
+		ctx := c.Request().Context()
+		expectedModel := updateArticleRequest.Model()
+		expectedModel.Version = &currentVersion
+		articleGetterMock.EXPECT().GetArticleBySlug(ctx, *expectedArticle.Slug).Return(expectedArticle, nil).Once()
+		articleUpdaterMock.EXPECT().UpdateArticle(ctx, *expectedArticle.Slug, expectedAuthor.ID.Hex(), expectedModel).RunAndReturn(func(ctx context.Context, slug, editor string, article *models.Article) error {
+			nextVersion := currentVersion + 1
+			favoritesCount := int64(0)
+			article.Version = &nextVersion
+			article.FavoritesCount = &favoritesCount
+			article.TagList = expectedArticle.TagList
+			return nil
+		}).Once()
+		profileGetterMock.EXPECT().GetProfileByID(ctx, expectedAuthor.ID.Hex()).Return(expectedAuthor, nil).Once()
+
+		// Act
+		err = handler.UpdateArticle(c)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.True(t, etag.MatchesVersion(rec.Header().Get(etag.HeaderETag), 3))
+	})
+
+	t.Run("Should return HTTP 412 if the article changed since the client fetched it", func(t *testing.T) {
+		// Arrange
+		articleAuthorID := primitive.NewObjectID()
+		expectedAuthor := assembleArticleAuthor(articleAuthorID.Hex())
+		expectedArticle := assembleArticleModel(articleAuthorID)
+		currentVersion := int64(2)
+		expectedArticle.Version = &currentVersion
+		updateArticleRequest := generateUpdateArticleBody()
+		requestBody, err := json.Marshal(updateArticleRequest)
+		require.NoError(t, err)
+		req := httptest.NewRequest(http.MethodPut, fmt.Sprintf("/api/article/%s", *expectedArticle.Slug), bytes.NewBuffer(requestBody))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set(etag.HeaderIfMatch, `"1"`)
+		req.Header.Set("Goduit-Subject", expectedAuthor.ID.Hex())
+		req.Header.Set("Goduit-Client-Username", *expectedAuthor.Username)
+		req.Header.Set("Goduit-Client-Email", *expectedAuthor.Email)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("slug")
+		c.SetParamValues(*expectedArticle.Slug)
+		ctx := c.Request().Context()
+		articleGetterMock.EXPECT().GetArticleBySlug(ctx, *expectedArticle.Slug).Return(expectedArticle, nil).Once()
+
+		// Act
+		err = handler.UpdateArticle(c)
+
+		// Assert
+		require.ErrorContains(t, err, api.PreconditionFailed(*expectedArticle.Slug).Error())
+	})
+
+	t.Run("Should return HTTP 412 if the article changed while it was being updated", func(t *testing.T) {
+		// Arrange
+		articleAuthorID := primitive.NewObjectID()
+		expectedAuthor := assembleArticleAuthor(articleAuthorID.Hex())
+		expectedArticle := assembleArticleModel(articleAuthorID)
+		updateArticleRequest := generateUpdateArticleBody()
+		requestBody, err := json.Marshal(updateArticleRequest)
+		require.NoError(t, err)
+		req := httptest.NewRequest(http.MethodPut, fmt.Sprintf("/api/article/%s", *expectedArticle.Slug), bytes.NewBuffer(requestBody))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set(etag.HeaderIfMatch, `"0"`)
+		req.Header.Set("Goduit-Subject", expectedAuthor.ID.Hex())
+		req.Header.Set("Goduit-Client-Username", *expectedAuthor.Username)
+		req.Header.Set("Goduit-Client-Email", *expectedAuthor.Email)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("slug")
+		c.SetParamValues(*expectedArticle.Slug)
+		ctx := c.Request().Context()
+		currentVersion := int64(0)
+		expectedModel := updateArticleRequest.Model()
+		expectedModel.Version = &currentVersion
+		articleGetterMock.EXPECT().GetArticleBySlug(ctx, *expectedArticle.Slug).Return(expectedArticle, nil).Once()
+		articleUpdaterMock.EXPECT().UpdateArticle(ctx, *expectedArticle.Slug, expectedAuthor.ID.Hex(), expectedModel).Return(app.VersionMismatchError(*expectedArticle.Slug, currentVersion)).Once()
+
+		// Act
+		err = handler.UpdateArticle(c)
+
+		// Assert
+		require.ErrorContains(t, err, api.PreconditionFailed(*expectedArticle.Slug).Error())
+	})
+
+	t.Run("Should not schedule an article that is already published", func(t *testing.T) {
+		// Arrange
+		articleAuthorID := primitive.NewObjectID()
+		expectedAuthor := assembleArticleAuthor(articleAuthorID.Hex())
+		expectedArticle := assembleArticleModel(articleAuthorID)
+		updateArticleRequest := generateUpdateArticleBody()
+		publishAt := time.Now().Add(time.Hour)
+		updateArticleRequest.Article.PublishAt = &publishAt
+		requestBody, err := json.Marshal(updateArticleRequest)
+		require.NoError(t, err)
+		req := httptest.NewRequest(http.MethodPut, fmt.Sprintf("/api/article/%s", *expectedArticle.Slug), bytes.NewBuffer(requestBody))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set("Goduit-Subject", expectedAuthor.ID.Hex())
+		req.Header.Set("Goduit-Client-Username", *expectedAuthor.Username)
+		req.Header.Set("Goduit-Client-Email", *expectedAuthor.Email)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("slug")
+		c.SetParamValues(*expectedArticle.Slug)
+		ctx := c.Request().Context()
+		articleGetterMock.EXPECT().GetArticleBySlug(ctx, *expectedArticle.Slug).Return(expectedArticle, nil).Once()
+
+		// Act
+		err = handler.UpdateArticle(c)
+
+		// Assert
+		require.ErrorContains(t, err, api.ArticleAlreadyPublished(*expectedArticle.Slug).Error())
+	})
+}
+
+func TestPatchArticle(t *testing.T) {
+	err := validators.InitValidator()
+	require.NoError(t, err)
+	articleUpdaterMock := newMockArticleUpdater(t)
+	articleGetterMock := newMockArticleGetter(t)
+	profileGetterMock := newMockProfileGetter(t)
+	handler := &UpdateArticleHandler{articleUpdaterMock, articleGetterMock, profileGetterMock}
+
+	e := echo.New()
+
+	t.Run("Should only change the fields in the patch", func(t *testing.T) {
+		// Arrange
+		articleAuthorID := primitive.NewObjectID()
+		expectedAuthor := assembleArticleAuthor(articleAuthorID.Hex())
+		expectedArticle := assembleArticleModel(articleAuthorID)
+		patch := `{"article": {"description": "Patched Description", "tagList": ["Go Lang", "databases"]}}`
+		req := httptest.NewRequest(http.MethodPatch, fmt.Sprintf("/api/article/%s", *expectedArticle.Slug), strings.NewReader(patch))
+		req.Header.Set(echo.HeaderContentType, articlePublisherRequests.MIMEApplicationMergePatchJSON)
+		req.Header.Set("Goduit-Subject", expectedAuthor.ID.Hex())
+		req.Header.Set("Goduit-Client-Username", *expectedAuthor.Username)
+		req.Header.Set("Goduit-Client-Email", *expectedAuthor.Email)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("slug")
+		c.SetParamValues(*expectedArticle.Slug)
+		ctx := c.Request().Context()
+		description := "Patched Description"
+		expectedModel := &models.Article{Description: &description, TagList: []string{"go-lang", "databases"}}
+		articleGetterMock.EXPECT().GetArticleBySlug(ctx, *expectedArticle.Slug).Return(expectedArticle, nil).Once()
+		articleUpdaterMock.EXPECT().UpdateArticle(ctx, *expectedArticle.Slug, expectedAuthor.ID.Hex(), expectedModel).RunAndReturn(func(ctx context.Context, slug, editor string, article *models.Article) error {
+			article.Slug = expectedArticle.Slug
+			article.Title = expectedArticle.Title
+			article.Body = expectedArticle.Body
+			article.FavoritesCount = expectedArticle.FavoritesCount
+			return nil
+		}).Once()
+		profileGetterMock.EXPECT().GetProfileByID(ctx, expectedAuthor.ID.Hex()).Return(expectedAuthor, nil).Once()
+
+		// Act
+		err := handler.PatchArticle(c)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Code)
+		patchArticleResponse := new(articlePublisherResponses.ArticleResponse)
+		err = json.Unmarshal(rec.Body.Bytes(), patchArticleResponse)
+		require.NoError(t, err)
+		require.Equal(t, *expectedArticle.Title, patchArticleResponse.Article.Title)
+		require.Equal(t, description, patchArticleResponse.Article.Description)
+		require.Equal(t, []string{"go-lang", "databases"}, patchArticleResponse.Article.TagList)
+	})
+
+	t.Run("Should only accept merge patches", func(t *testing.T) {
+		// Arrange
+		req := httptest.NewRequest(http.MethodPatch, "/api/article/test-slug", strings.NewReader(`{"article": {"title": "Patched Title"}}`))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("slug")
+		c.SetParamValues("test-slug")
+
+		// Act
+		err := handler.PatchArticle(c)
+
+		// Assert
+		require.ErrorIs(t, err, echo.ErrUnsupportedMediaType)
+	})
+
+	t.Run("Should not remove required fields", func(t *testing.T) {
+		// Arrange
+		req := httptest.NewRequest(http.MethodPatch, "/api/article/test-slug", strings.NewReader(`{"article": {"title": null}}`))
+		req.Header.Set(echo.HeaderContentType, articlePublisherRequests.MIMEApplicationMergePatchJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("slug")
+		c.SetParamValues("test-slug")
+
+		// Act
+		err := handler.PatchArticle(c)
+
+		// Assert
+		require.ErrorContains(t, err, api.RequiredFieldError("Title").Error())
+	})
+
+	t.Run("Should only patch articles authored by the currently authenticated user", func(t *testing.T) {
+		// Arrange
+		expectedArticle := assembleArticleModel(primitive.NewObjectID())
+		req := httptest.NewRequest(http.MethodPatch, fmt.Sprintf("/api/article/%s", *expectedArticle.Slug), strings.NewReader(`{"article": {"body": "Patched Body"}}`))
+		req.Header.Set(echo.HeaderContentType, articlePublisherRequests.MIMEApplicationMergePatchJSON)
+		req.Header.Set("Goduit-Subject", primitive.NewObjectID().Hex())
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("slug")
+		c.SetParamValues(*expectedArticle.Slug)
+		articleGetterMock.EXPECT().GetArticleBySlug(c.Request().Context(), *expectedArticle.Slug).Return(expectedArticle, nil).Once()
+
+		// Act
+		err := handler.PatchArticle(c)
+
+		// Assert
+		require.ErrorIs(t, err, api.Forbidden)
+	})
 }
 
 func generateUpdateArticleBody() *articlePublisherRequests.UpdateArticleRequest {
@@ -0,0 +1,114 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ravilock/goduit/api"
+	"github.com/ravilock/goduit/internal/app"
+	"github.com/ravilock/goduit/internal/articlePublisher/assemblers"
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+	"github.com/ravilock/goduit/internal/articlePublisher/requests"
+	"github.com/ravilock/goduit/internal/identity"
+	profileManagerAssembler "github.com/ravilock/goduit/internal/profileManager/assemblers"
+)
+
+type seriesUpdater interface {
+	UpdateSeries(ctx context.Context, currentSeries, series *models.Series) (*models.Series, error)
+}
+
+type UpdateSeriesHandler struct {
+	service        seriesUpdater
+	seriesGetter   seriesGetter
+	articleGetter  seriesArticleGetter
+	profileManager profileGetter
+}
+
+func NewUpdateSeriesHandler(service seriesUpdater, seriesGetter seriesGetter, articleGetter seriesArticleGetter, profileManager profileGetter) *UpdateSeriesHandler {
+	return &UpdateSeriesHandler{
+		service:        service,
+		seriesGetter:   seriesGetter,
+		articleGetter:  articleGetter,
+		profileManager: profileManager,
+	}
+}
+
+func (h *UpdateSeriesHandler) UpdateSeries(c echo.Context) error {
+	request := new(requests.UpdateSeriesRequest)
+	identity := new(identity.IdentityHeaders)
+	binder := &echo.DefaultBinder{}
+	if err := binder.BindBody(c, request); err != nil {
+		return api.CouldNotUnmarshalBodyError
+	}
+	if err := binder.BindPathParams(c, request); err != nil {
+		return err
+	}
+	if err := binder.BindHeaders(c, identity); err != nil {
+		return err
+	}
+
+	if err := request.Validate(); err != nil {
+		return err
+	}
+
+	ctx := c.Request().Context()
+
+	currentSeries, err := h.seriesGetter.GetSeriesBySlug(ctx, request.Slug)
+	if err != nil {
+		if appError := new(app.AppError); errors.As(err, &appError) {
+			switch appError.ErrorCode {
+			case app.SeriesNotFoundErrorCode:
+				return api.SeriesNotFound(request.Slug)
+			}
+		}
+		return err
+	}
+
+	if identity.Subject != *currentSeries.Owner {
+		return api.Forbidden
+	}
+
+	articleIDs, err := resolveSeriesArticles(ctx, h.articleGetter, identity.Subject, request.Series.Articles)
+	if err != nil {
+		return err
+	}
+
+	series, err := h.service.UpdateSeries(ctx, currentSeries, request.Model(articleIDs))
+	if err != nil {
+		if appError := new(app.AppError); errors.As(err, &appError) {
+			switch appError.ErrorCode {
+			case app.SeriesNotFoundErrorCode:
+				return api.SeriesNotFound(request.Slug)
+			case app.ConflictErrorCode:
+				return api.ConfictError
+			}
+		}
+		return err
+	}
+
+	owner, err := h.profileManager.GetProfileByID(ctx, identity.Subject)
+	if err != nil {
+		if appError := new(app.AppError); errors.As(err, &appError) {
+			switch appError.ErrorCode {
+			case app.UserNotFoundErrorCode:
+				return api.UserNotFound(identity.ClientUsername)
+			}
+		}
+		return err
+	}
+
+	ownerProfile, err := profileManagerAssembler.ProfileResponse(owner, false)
+	if err != nil {
+		return err
+	}
+
+	articles, err := listSeriesArticles(ctx, h.articleGetter, series, identity.Subject)
+	if err != nil {
+		return err
+	}
+
+	response := assemblers.SeriesResponse(series, ownerProfile, articles)
+	return c.JSON(http.StatusOK, response)
+}
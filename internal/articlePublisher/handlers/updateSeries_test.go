@@ -0,0 +1,129 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/ravilock/goduit/api"
+	"github.com/ravilock/goduit/api/validators"
+	"github.com/ravilock/goduit/internal/app"
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+	articlePublisherRequests "github.com/ravilock/goduit/internal/articlePublisher/requests"
+	articlePublisherResponses "github.com/ravilock/goduit/internal/articlePublisher/responses"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestUpdateSeries(t *testing.T) {
+	err := validators.InitValidator()
+	require.NoError(t, err)
+	seriesUpdaterMock := newMockSeriesUpdater(t)
+	seriesGetterMock := newMockSeriesGetter(t)
+	articleGetterMock := newMockSeriesArticleGetter(t)
+	profileGetterMock := newMockProfileGetter(t)
+	handler := &UpdateSeriesHandler{seriesUpdaterMock, seriesGetterMock, articleGetterMock, profileGetterMock}
+	e := echo.New()
+
+	t.Run("Should reorder the articles of a series", func(t *testing.T) {
+		// Arrange
+		ownerID := primitive.NewObjectID()
+		expectedOwner := assembleArticleAuthor(ownerID.Hex())
+		firstArticle, secondArticle := assembleSeriesArticles(ownerID)
+		currentSeries := assembleSeriesModel(ownerID.Hex(), firstArticle, secondArticle)
+		updatedSeries := assembleSeriesModel(ownerID.Hex(), secondArticle, firstArticle)
+		updateSeriesRequest := generateUpdateSeriesBody(*secondArticle.Slug, *firstArticle.Slug)
+		requestBody, err := json.Marshal(updateSeriesRequest)
+		require.NoError(t, err)
+		req := httptest.NewRequest(http.MethodPut, fmt.Sprintf("/api/series/%s", *currentSeries.Slug), bytes.NewBuffer(requestBody))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set("Goduit-Subject", expectedOwner.ID.Hex())
+		req.Header.Set("Goduit-Client-Username", *expectedOwner.Username)
+		req.Header.Set("Goduit-Client-Email", *expectedOwner.Email)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("slug")
+		c.SetParamValues(*currentSeries.Slug)
+		ctx := c.Request().Context()
+		seriesGetterMock.EXPECT().GetSeriesBySlug(ctx, *currentSeries.Slug).Return(currentSeries, nil).Once()
+		articleGetterMock.EXPECT().GetArticleBySlug(ctx, *secondArticle.Slug).Return(secondArticle, nil).Once()
+		articleGetterMock.EXPECT().GetArticleBySlug(ctx, *firstArticle.Slug).Return(firstArticle, nil).Once()
+		seriesUpdaterMock.EXPECT().UpdateSeries(ctx, currentSeries, &models.Series{Articles: []string{secondArticle.ID.Hex(), firstArticle.ID.Hex()}}).Return(updatedSeries, nil).Once()
+		profileGetterMock.EXPECT().GetProfileByID(ctx, expectedOwner.ID.Hex()).Return(expectedOwner, nil).Once()
+		articleGetterMock.EXPECT().GetArticleByID(ctx, secondArticle.ID.Hex()).Return(secondArticle, nil).Once()
+		articleGetterMock.EXPECT().GetArticleByID(ctx, firstArticle.ID.Hex()).Return(firstArticle, nil).Once()
+
+		// Act
+		err = handler.UpdateSeries(c)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Code)
+		seriesResponse := new(articlePublisherResponses.SeriesResponse)
+		err = json.Unmarshal(rec.Body.Bytes(), seriesResponse)
+		require.NoError(t, err)
+		require.Len(t, seriesResponse.Series.Articles, 2)
+		require.Equal(t, *secondArticle.Slug, seriesResponse.Series.Articles[0].Slug)
+		require.Equal(t, *firstArticle.Slug, seriesResponse.Series.Articles[1].Slug)
+	})
+
+	t.Run("Should only let the owner update a series", func(t *testing.T) {
+		// Arrange
+		ownerID := primitive.NewObjectID()
+		currentSeries := assembleSeriesModel(ownerID.Hex())
+		requestBody, err := json.Marshal(generateUpdateSeriesBody())
+		require.NoError(t, err)
+		req := httptest.NewRequest(http.MethodPut, fmt.Sprintf("/api/series/%s", *currentSeries.Slug), bytes.NewBuffer(requestBody))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set("Goduit-Subject", uuid.NewString())
+		req.Header.Set("Goduit-Client-Username", "not-the-owner")
+		req.Header.Set("Goduit-Client-Email", "not.the.owner.email@test.test")
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("slug")
+		c.SetParamValues(*currentSeries.Slug)
+		ctx := c.Request().Context()
+		seriesGetterMock.EXPECT().GetSeriesBySlug(ctx, *currentSeries.Slug).Return(currentSeries, nil).Once()
+
+		// Act
+		err = handler.UpdateSeries(c)
+
+		// Assert
+		require.ErrorContains(t, err, api.Forbidden.Error())
+	})
+
+	t.Run("Should return HTTP 404 if no series is found", func(t *testing.T) {
+		// Arrange
+		slug := "missing-series"
+		requestBody, err := json.Marshal(generateUpdateSeriesBody())
+		require.NoError(t, err)
+		req := httptest.NewRequest(http.MethodPut, fmt.Sprintf("/api/series/%s", slug), bytes.NewBuffer(requestBody))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set("Goduit-Subject", uuid.NewString())
+		req.Header.Set("Goduit-Client-Username", "series-owner")
+		req.Header.Set("Goduit-Client-Email", "series.owner.email@test.test")
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("slug")
+		c.SetParamValues(slug)
+		ctx := c.Request().Context()
+		seriesGetterMock.EXPECT().GetSeriesBySlug(ctx, slug).Return(nil, app.SeriesNotFoundError(slug, nil)).Once()
+
+		// Act
+		err = handler.UpdateSeries(c)
+
+		// Assert
+		require.ErrorContains(t, err, api.SeriesNotFound(slug).Error())
+	})
+}
+
+func generateUpdateSeriesBody(articles ...string) *articlePublisherRequests.UpdateSeriesRequest {
+	request := new(articlePublisherRequests.UpdateSeriesRequest)
+	request.Series.Articles = articles
+	return request
+}
@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"net/http"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/ravilock/goduit/internal/articlePublisher/assemblers"
 	"github.com/ravilock/goduit/internal/articlePublisher/models"
 	"github.com/ravilock/goduit/internal/articlePublisher/requests"
+	"github.com/ravilock/goduit/internal/etag"
 	"github.com/ravilock/goduit/internal/identity"
 	profileManagerAssembler "github.com/ravilock/goduit/internal/profileManager/assemblers"
 )
@@ -77,6 +79,11 @@ func (h *WriteArticleHandler) WriteArticle(c echo.Context) error {
 		return err
 	}
 
-	response := assemblers.ArticleResponse(article, profileResponse)
-	return c.JSON(http.StatusCreated, response)
+	response := assemblers.ArticleResponse(article, profileResponse, false)
+	body, err := json.Marshal(response)
+	if err != nil {
+		return err
+	}
+	c.Response().Header().Set(etag.HeaderETag, etag.FromRepresentation(article.CurrentVersion(), body))
+	return c.JSONBlob(http.StatusCreated, body)
 }
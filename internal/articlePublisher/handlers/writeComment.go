@@ -11,6 +11,7 @@ import (
 	"github.com/ravilock/goduit/internal/articlePublisher/assemblers"
 	"github.com/ravilock/goduit/internal/articlePublisher/models"
 	"github.com/ravilock/goduit/internal/articlePublisher/requests"
+	"github.com/ravilock/goduit/internal/etag"
 	"github.com/ravilock/goduit/internal/identity"
 	profileManagerAssembler "github.com/ravilock/goduit/internal/profileManager/assemblers"
 )
@@ -69,6 +70,11 @@ func (h *WriteCommentHandler) WriteComment(c echo.Context) error {
 		}
 		return err
 	}
+
+	if !article.IsPublished() && !article.HasAuthor(identity.Subject) {
+		return api.ArticleNotFound(request.Slug)
+	}
+
 	articleID := article.ID.Hex()
 	comment.Article = &articleID
 
@@ -93,5 +99,6 @@ func (h *WriteCommentHandler) WriteComment(c echo.Context) error {
 	}
 
 	response := assemblers.CommentResponse(comment, profileResponse)
+	c.Response().Header().Set(etag.HeaderETag, etag.FromVersion(comment.CurrentVersion()))
 	return c.JSON(http.StatusCreated, response)
 }
@@ -0,0 +1,93 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ravilock/goduit/api"
+	"github.com/ravilock/goduit/internal/app"
+	"github.com/ravilock/goduit/internal/articlePublisher/assemblers"
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+	"github.com/ravilock/goduit/internal/articlePublisher/requests"
+	"github.com/ravilock/goduit/internal/identity"
+	profileManagerAssembler "github.com/ravilock/goduit/internal/profileManager/assemblers"
+)
+
+type seriesWriter interface {
+	WriteSeries(ctx context.Context, series *models.Series) error
+}
+
+type WriteSeriesHandler struct {
+	service        seriesWriter
+	articleGetter  seriesArticleGetter
+	profileManager profileGetter
+}
+
+func NewWriteSeriesHandler(service seriesWriter, articleGetter seriesArticleGetter, profileManager profileGetter) *WriteSeriesHandler {
+	return &WriteSeriesHandler{
+		service:        service,
+		articleGetter:  articleGetter,
+		profileManager: profileManager,
+	}
+}
+
+func (h *WriteSeriesHandler) WriteSeries(c echo.Context) error {
+	request := new(requests.WriteSeriesRequest)
+	identity := new(identity.IdentityHeaders)
+	binder := &echo.DefaultBinder{}
+	if err := binder.BindBody(c, request); err != nil {
+		return api.CouldNotUnmarshalBodyError
+	}
+	if err := binder.BindHeaders(c, identity); err != nil {
+		return err
+	}
+
+	if err := request.Validate(); err != nil {
+		return err
+	}
+
+	ctx := c.Request().Context()
+
+	articleIDs, err := resolveSeriesArticles(ctx, h.articleGetter, identity.Subject, request.Series.Articles)
+	if err != nil {
+		return err
+	}
+
+	series := request.Model(identity.Subject, articleIDs)
+
+	if err := h.service.WriteSeries(ctx, series); err != nil {
+		if appError := new(app.AppError); errors.As(err, &appError) {
+			switch appError.ErrorCode {
+			case app.ConflictErrorCode:
+				return api.ConfictError
+			}
+		}
+		return err
+	}
+
+	owner, err := h.profileManager.GetProfileByID(ctx, identity.Subject)
+	if err != nil {
+		if appError := new(app.AppError); errors.As(err, &appError) {
+			switch appError.ErrorCode {
+			case app.UserNotFoundErrorCode:
+				return api.UserNotFound(identity.ClientUsername)
+			}
+		}
+		return err
+	}
+
+	ownerProfile, err := profileManagerAssembler.ProfileResponse(owner, false)
+	if err != nil {
+		return err
+	}
+
+	articles, err := listSeriesArticles(ctx, h.articleGetter, series, identity.Subject)
+	if err != nil {
+		return err
+	}
+
+	response := assemblers.SeriesResponse(series, ownerProfile, articles)
+	return c.JSON(http.StatusCreated, response)
+}
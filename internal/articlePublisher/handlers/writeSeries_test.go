@@ -0,0 +1,170 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ravilock/goduit/api"
+	"github.com/ravilock/goduit/api/validators"
+	"github.com/ravilock/goduit/internal/app"
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+	articlePublisherRequests "github.com/ravilock/goduit/internal/articlePublisher/requests"
+	articlePublisherResponses "github.com/ravilock/goduit/internal/articlePublisher/responses"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestWriteSeries(t *testing.T) {
+	err := validators.InitValidator()
+	require.NoError(t, err)
+	seriesWriterMock := newMockSeriesWriter(t)
+	articleGetterMock := newMockSeriesArticleGetter(t)
+	profileGetterMock := newMockProfileGetter(t)
+	handler := &WriteSeriesHandler{seriesWriterMock, articleGetterMock, profileGetterMock}
+	e := echo.New()
+
+	t.Run("Should write a series with the owner's articles in order", func(t *testing.T) {
+		// Arrange
+		ownerID := primitive.NewObjectID()
+		expectedOwner := assembleArticleAuthor(ownerID.Hex())
+		firstArticle, secondArticle := assembleSeriesArticles(ownerID)
+		writeSeriesRequest := generateWriteSeriesBody(*secondArticle.Slug, *firstArticle.Slug)
+		requestBody, err := json.Marshal(writeSeriesRequest)
+		require.NoError(t, err)
+		req := httptest.NewRequest(http.MethodPost, "/api/series", bytes.NewBuffer(requestBody))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set("Goduit-Subject", expectedOwner.ID.Hex())
+		req.Header.Set("Goduit-Client-Username", *expectedOwner.Username)
+		req.Header.Set("Goduit-Client-Email", *expectedOwner.Email)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		ctx := c.Request().Context()
+		articleGetterMock.EXPECT().GetArticleBySlug(ctx, *secondArticle.Slug).Return(secondArticle, nil).Once()
+		articleGetterMock.EXPECT().GetArticleBySlug(ctx, *firstArticle.Slug).Return(firstArticle, nil).Once()
+		seriesWriterMock.EXPECT().WriteSeries(ctx, mock.MatchedBy(func(series *models.Series) bool {
+			return *series.Owner == ownerID.Hex() && series.Articles[0] == secondArticle.ID.Hex() && series.Articles[1] == firstArticle.ID.Hex()
+		})).RunAndReturn(func(ctx context.Context, series *models.Series) error {
+			seriesID := primitive.NewObjectID()
+			now := time.Now().UTC().Truncate(time.Millisecond)
+			series.ID = &seriesID
+			series.CreatedAt = &now
+			return nil
+		}).Once()
+		profileGetterMock.EXPECT().GetProfileByID(ctx, expectedOwner.ID.Hex()).Return(expectedOwner, nil).Once()
+		articleGetterMock.EXPECT().GetArticleByID(ctx, secondArticle.ID.Hex()).Return(secondArticle, nil).Once()
+		articleGetterMock.EXPECT().GetArticleByID(ctx, firstArticle.ID.Hex()).Return(firstArticle, nil).Once()
+
+		// Act
+		err = handler.WriteSeries(c)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, http.StatusCreated, rec.Code)
+		seriesResponse := new(articlePublisherResponses.SeriesResponse)
+		err = json.Unmarshal(rec.Body.Bytes(), seriesResponse)
+		require.NoError(t, err)
+		require.Equal(t, writeSeriesRequest.Series.Title, seriesResponse.Series.Title)
+		require.Equal(t, *expectedOwner.Username, seriesResponse.Series.Owner.Username)
+		require.Len(t, seriesResponse.Series.Articles, 2)
+		require.Equal(t, *secondArticle.Slug, seriesResponse.Series.Articles[0].Slug)
+		require.Equal(t, *firstArticle.Slug, seriesResponse.Series.Articles[1].Slug)
+	})
+
+	t.Run("Should only add articles authored by the series owner", func(t *testing.T) {
+		// Arrange
+		ownerID := primitive.NewObjectID()
+		expectedOwner := assembleArticleAuthor(ownerID.Hex())
+		otherArticle := assembleArticleModel(primitive.NewObjectID())
+		requestBody, err := json.Marshal(generateWriteSeriesBody(*otherArticle.Slug))
+		require.NoError(t, err)
+		req := httptest.NewRequest(http.MethodPost, "/api/series", bytes.NewBuffer(requestBody))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set("Goduit-Subject", expectedOwner.ID.Hex())
+		req.Header.Set("Goduit-Client-Username", *expectedOwner.Username)
+		req.Header.Set("Goduit-Client-Email", *expectedOwner.Email)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		ctx := c.Request().Context()
+		articleGetterMock.EXPECT().GetArticleBySlug(ctx, *otherArticle.Slug).Return(otherArticle, nil).Once()
+
+		// Act
+		err = handler.WriteSeries(c)
+
+		// Assert
+		require.ErrorContains(t, err, api.Forbidden.Error())
+	})
+
+	t.Run("Should return HTTP 404 if an article is not found", func(t *testing.T) {
+		// Arrange
+		ownerID := primitive.NewObjectID()
+		expectedOwner := assembleArticleAuthor(ownerID.Hex())
+		missingSlug := "missing-article"
+		requestBody, err := json.Marshal(generateWriteSeriesBody(missingSlug))
+		require.NoError(t, err)
+		req := httptest.NewRequest(http.MethodPost, "/api/series", bytes.NewBuffer(requestBody))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set("Goduit-Subject", expectedOwner.ID.Hex())
+		req.Header.Set("Goduit-Client-Username", *expectedOwner.Username)
+		req.Header.Set("Goduit-Client-Email", *expectedOwner.Email)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		ctx := c.Request().Context()
+		articleGetterMock.EXPECT().GetArticleBySlug(ctx, missingSlug).Return(nil, app.ArticleNotFoundError(missingSlug, nil)).Once()
+
+		// Act
+		err = handler.WriteSeries(c)
+
+		// Assert
+		require.ErrorContains(t, err, api.ArticleNotFound(missingSlug).Error())
+	})
+
+	t.Run("Should return HTTP 409 if an article already belongs to another series", func(t *testing.T) {
+		// Arrange
+		ownerID := primitive.NewObjectID()
+		expectedOwner := assembleArticleAuthor(ownerID.Hex())
+		article := assembleArticleModel(ownerID)
+		requestBody, err := json.Marshal(generateWriteSeriesBody(*article.Slug))
+		require.NoError(t, err)
+		req := httptest.NewRequest(http.MethodPost, "/api/series", bytes.NewBuffer(requestBody))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set("Goduit-Subject", expectedOwner.ID.Hex())
+		req.Header.Set("Goduit-Client-Username", *expectedOwner.Username)
+		req.Header.Set("Goduit-Client-Email", *expectedOwner.Email)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		ctx := c.Request().Context()
+		articleGetterMock.EXPECT().GetArticleBySlug(ctx, *article.Slug).Return(article, nil).Once()
+		seriesWriterMock.EXPECT().WriteSeries(ctx, mock.AnythingOfType("*models.Series")).Return(app.ConflictError("series")).Once()
+
+		// Act
+		err = handler.WriteSeries(c)
+
+		// Assert
+		require.ErrorContains(t, err, api.ConfictError.Error())
+	})
+}
+
+func generateWriteSeriesBody(articles ...string) *articlePublisherRequests.WriteSeriesRequest {
+	request := new(articlePublisherRequests.WriteSeriesRequest)
+	request.Series.Title = "Test Series"
+	request.Series.Description = "Test Description"
+	request.Series.Articles = articles
+	return request
+}
+
+func assembleSeriesArticles(authorID primitive.ObjectID) (*models.Article, *models.Article) {
+	firstArticle := assembleArticleModel(authorID)
+	firstSlug := "first-part"
+	firstArticle.Slug = &firstSlug
+	secondArticle := assembleArticleModel(authorID)
+	secondSlug := "second-part"
+	secondArticle.Slug = &secondSlug
+	return firstArticle, secondArticle
+}
@@ -0,0 +1,32 @@
+package models
+
+import "time"
+
+// ArticleViews counts the views an article got in a day.
+//   - "Article" represents the ID of the viewed article
+//   - "Day" represents the UTC midnight starting the day
+type ArticleViews struct {
+	Article *string    `bson:"article"`
+	Day     *time.Time `bson:"day"`
+	Count   *int64     `bson:"count"`
+}
+
+// DailyCount is how many times something happened to an article in a day, such as views or favorites.
+type DailyCount struct {
+	Article string    `bson:"article"`
+	Day     time.Time `bson:"day"`
+	Count   int64     `bson:"count"`
+}
+
+// DailyActivity is how much an article was viewed, favorited and commented on in a day.
+type DailyActivity struct {
+	Day       time.Time
+	Views     int64
+	Favorites int64
+	Comments  int64
+}
+
+// Day truncates t to the UTC midnight starting its day.
+func Day(t time.Time) time.Time {
+	return t.UTC().Truncate(24 * time.Hour)
+}
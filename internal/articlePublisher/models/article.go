@@ -1,20 +1,66 @@
 package models
 
 import (
+	"slices"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
+const (
+	ArticleStatusDraft     = "draft"
+	ArticleStatusScheduled = "scheduled"
+	ArticleStatusPublished = "published"
+)
+
 type Article struct {
 	ID             *primitive.ObjectID `bson:"_id,omitempty"`
 	Author         *string             `bson:"author,omitempty"`
+	CoAuthors      []string            `bson:"coAuthors,omitempty"`
 	Slug           *string             `bson:"slug,omitempty"`
 	Title          *string             `bson:"title,omitempty"`
 	Description    *string             `bson:"description,omitempty"`
 	Body           *string             `bson:"body,omitempty"`
+	BodyHTML       *string             `bson:"bodyHtml,omitempty"`
+	WordCount      *int64              `bson:"wordCount,omitempty"`
+	ReadingTime    *int64              `bson:"readingTime,omitempty"`
+	Excerpt        *string             `bson:"excerpt,omitempty"`
 	TagList        []string            `bson:"tagList,omitempty"`
 	CreatedAt      *time.Time          `bson:"createdAt,omitempty"`
 	UpdatedAt      *time.Time          `bson:"updatedAt,omitempty"`
 	FavoritesCount *int64              `bson:"favoritesCount,omitempty"`
+	Status         *string             `bson:"status,omitempty"`
+	PublishedAt    *time.Time          `bson:"publishedAt,omitempty"`
+	PublishAt      *time.Time          `bson:"publishAt,omitempty"`
+	PendingFanout  *bool               `bson:"pendingFanout,omitempty"`
+	TrashedAt      *time.Time          `bson:"trashedAt,omitempty"`
+	Version        *int64              `bson:"version,omitempty"`
+}
+
+// CurrentVersion is the number of times the article changed. Articles that did not change since versions were counted are at version 0.
+func (a *Article) CurrentVersion() int64 {
+	if a.Version == nil {
+		return 0
+	}
+	return *a.Version
+}
+
+// IsPublished reports whether the article is visible to everyone, as opposed to drafts and scheduled articles.
+// Articles written before statuses existed have no status and are considered published.
+func (a *Article) IsPublished() bool {
+	return a.Status == nil || *a.Status == ArticleStatusPublished
+}
+
+// HasAuthor reports whether the user is the article's primary author or one of its co-authors.
+func (a *Article) HasAuthor(user string) bool {
+	return (a.Author != nil && *a.Author == user) || slices.Contains(a.CoAuthors, user)
+}
+
+// Authors lists the article's primary author followed by its co-authors.
+func (a *Article) Authors() []string {
+	authors := make([]string, 0, len(a.CoAuthors)+1)
+	if a.Author != nil {
+		authors = append(authors, *a.Author)
+	}
+	return append(authors, a.CoAuthors...)
 }
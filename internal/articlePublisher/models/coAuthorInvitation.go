@@ -0,0 +1,19 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// CoAuthorInvitation represents a pending invitation to co-author an article. It is deleted once answered.
+//   - "Article" represents the ID of the article
+//   - "Invitee" represents the ID of the invited user
+//   - "Inviter" represents the ID of the article's primary author
+type CoAuthorInvitation struct {
+	ID        *primitive.ObjectID `bson:"_id,omitempty"`
+	Article   *string             `bson:"article"`
+	Invitee   *string             `bson:"invitee"`
+	Inviter   *string             `bson:"inviter"`
+	CreatedAt *time.Time          `bson:"createdAt"`
+}
@@ -13,4 +13,13 @@ type Comment struct {
 	Body      *string             `bson:"body,omitempty"`
 	CreatedAt *time.Time          `bson:"createdAt,omitempty"`
 	UpdatedAt *time.Time          `bson:"updatedAt,omitempty"`
+	Version   *int64              `bson:"version,omitempty"`
+}
+
+// CurrentVersion is the number of times the comment changed. Comments that did not change are at version 0.
+func (c *Comment) CurrentVersion() int64 {
+	if c.Version == nil {
+		return 0
+	}
+	return *c.Version
 }
@@ -0,0 +1,17 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Favorite represents a user marking an article as one of their favorites.
+//   - "Article" represents the ID of the favorited article
+//   - "User" represents the ID of the user that favorited it
+type Favorite struct {
+	ID        *primitive.ObjectID `bson:"_id,omitempty"`
+	Article   *string             `bson:"article"`
+	User      *string             `bson:"user"`
+	CreatedAt *time.Time          `bson:"createdAt,omitempty"`
+}
@@ -7,9 +7,13 @@ import (
 type Feed struct {
 	UserID   *primitive.ObjectID `bson:"_id,omitempty"`
 	Articles []FeedFragment      `bson:"articles,omitempty"`
+	// Sequence is the position given to the last fragment appended to the feed
+	Sequence int64 `bson:"sequence,omitempty"`
 }
 
 type FeedFragment struct {
 	ArticleID *string `bson:"articleID,omitempty"`
 	Author    *string `bson:"author,omitempty"`
+	// Position orders the fragment inside its feed, it grows as fragments are appended
+	Position int64 `bson:"position,omitempty"`
 }
@@ -0,0 +1,44 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Revision represents an immutable snapshot of an article's content, taken every time it is written or updated.
+//   - "Article" represents the ID of the article
+//   - "Number" represents the position of the revision in the article's history, starting at 1
+//   - "Author" represents the ID of the user that made the change
+//   - "RestoredFrom" represents the number of the revision that was restored, if any
+type Revision struct {
+	ID           *primitive.ObjectID `bson:"_id,omitempty"`
+	Article      *string             `bson:"article"`
+	Number       *int64              `bson:"number"`
+	Author       *string             `bson:"author"`
+	Slug         *string             `bson:"slug"`
+	Title        *string             `bson:"title"`
+	Description  *string             `bson:"description"`
+	Body         *string             `bson:"body"`
+	TagList      []string            `bson:"tagList"`
+	RestoredFrom *int64              `bson:"restoredFrom,omitempty"`
+	CreatedAt    *time.Time          `bson:"createdAt,omitempty"`
+}
+
+// NewRevision takes a snapshot of the article's current content, authored by the given user.
+func NewRevision(article *Article, author string) *Revision {
+	articleID := article.ID.Hex()
+	tagList := article.TagList
+	if tagList == nil {
+		tagList = []string{}
+	}
+	return &Revision{
+		Article:     &articleID,
+		Author:      &author,
+		Slug:        article.Slug,
+		Title:       article.Title,
+		Description: article.Description,
+		Body:        article.Body,
+		TagList:     tagList,
+	}
+}
@@ -0,0 +1,38 @@
+package models
+
+import (
+	"slices"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Series groups articles of a profile in reading order, such as the parts of a multi-part tutorial.
+//   - "Owner" represents the ID of the profile that owns the series
+//   - "Articles" represents the IDs of the series' articles, in reading order
+type Series struct {
+	ID          *primitive.ObjectID `bson:"_id,omitempty"`
+	Owner       *string             `bson:"owner,omitempty"`
+	Slug        *string             `bson:"slug,omitempty"`
+	Title       *string             `bson:"title,omitempty"`
+	Description *string             `bson:"description,omitempty"`
+	Articles    []string            `bson:"articles,omitempty"`
+	CreatedAt   *time.Time          `bson:"createdAt,omitempty"`
+	UpdatedAt   *time.Time          `bson:"updatedAt,omitempty"`
+}
+
+// SeriesNavigation locates an article within its series, along with the closest published parts around it.
+//   - "Position" represents the article's 1-based position in the series
+//   - "Total" represents the number of articles in the series
+type SeriesNavigation struct {
+	Series   *Series
+	Position int
+	Total    int
+	Previous *Article
+	Next     *Article
+}
+
+// Position returns the 1-based position of an article in the series, or 0 if the article is not part of it.
+func (s *Series) Position(article string) int {
+	return slices.Index(s.Articles, article) + 1
+}
@@ -0,0 +1,12 @@
+package models
+
+import "time"
+
+// Slug represents a slug reserved by an article, either its current one or one it had before its title changed.
+//   - "Slug" is the reserved slug itself and identifies the document
+//   - "Article" represents the ID of the article holding the slug
+type Slug struct {
+	Slug      *string    `bson:"_id"`
+	Article   *string    `bson:"article"`
+	CreatedAt *time.Time `bson:"createdAt,omitempty"`
+}
@@ -0,0 +1,16 @@
+package models
+
+import "time"
+
+// Tag represents a tag used in articles, alongside the number of articles currently using it.
+type Tag struct {
+	Name  *string `bson:"_id"`
+	Count *int64  `bson:"count"`
+}
+
+// TagAlias maps a variant of a tag to its canonical tag, so lookups and new articles use the canonical tag instead.
+type TagAlias struct {
+	Name      *string    `bson:"_id"`
+	Tag       *string    `bson:"tag"`
+	CreatedAt *time.Time `bson:"createdAt"`
+}
@@ -0,0 +1,49 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	TrendingWindowDay   = "day"
+	TrendingWindowWeek  = "week"
+	TrendingWindowMonth = "month"
+)
+
+// TrendingWindow is a period over which activity makes an article trend.
+//   - "Length" represents how far back activity is taken into account
+//   - "HalfLife" represents how long it takes for an activity to count half as much
+type TrendingWindow struct {
+	Name     string
+	Length   time.Duration
+	HalfLife time.Duration
+}
+
+// TrendingWindows lists every window trending scores are computed for.
+var TrendingWindows = []TrendingWindow{
+	{Name: TrendingWindowDay, Length: 24 * time.Hour, HalfLife: 6 * time.Hour},
+	{Name: TrendingWindowWeek, Length: 7 * 24 * time.Hour, HalfLife: 36 * time.Hour},
+	{Name: TrendingWindowMonth, Length: 30 * 24 * time.Hour, HalfLife: 7 * 24 * time.Hour},
+}
+
+// ArticleScore is an article's score for some activity, such as its time decayed number of favorites.
+type ArticleScore struct {
+	Article string  `bson:"article"`
+	Score   float64 `bson:"score"`
+}
+
+// TrendingArticle is an article's trending score within a window, as last computed.
+//   - "Article" represents the ID of the scored article
+//   - "TagList" represents the tags the article trends within, among the article's tags
+//   - "Overall" represents whether the article trends among every article, regardless of tags
+type TrendingArticle struct {
+	ID         *primitive.ObjectID `bson:"_id,omitempty"`
+	Window     *string             `bson:"window"`
+	Article    *string             `bson:"article"`
+	TagList    []string            `bson:"tagList"`
+	Overall    *bool               `bson:"overall"`
+	Score      *float64            `bson:"score"`
+	ComputedAt *time.Time          `bson:"computedAt"`
+}
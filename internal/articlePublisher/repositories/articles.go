@@ -22,9 +22,46 @@ func NewArticleRepository(client *mongo.Client) *ArticleRepository {
 	return &ArticleRepository{client}
 }
 
+// publishedFilter matches published articles, including the ones written before statuses existed.
+var publishedFilter = bson.E{Key: "status", Value: bson.D{{Key: "$nin", Value: unpublishedStatuses}}}
+
+var unpublishedStatuses = []string{models.ArticleStatusDraft, models.ArticleStatusScheduled}
+
+// authoredByFilter matches articles the user wrote, either as their primary author or as a co-author.
+func authoredByFilter(user string) bson.E {
+	return bson.E{Key: "$or", Value: bson.A{
+		bson.D{{Key: "author", Value: user}},
+		bson.D{{Key: "coAuthors", Value: user}},
+	}}
+}
+
+// versionFilter matches articles at a version, articles that never changed since versions were counted being at version 0.
+func versionFilter(version int64) bson.E {
+	if version == 0 {
+		return bson.E{Key: "version", Value: bson.D{{Key: "$in", Value: bson.A{0, nil}}}}
+	}
+	return bson.E{Key: "version", Value: version}
+}
+
+// incrementVersion counts a change to the article, so clients holding an older version can tell it is stale.
+var incrementVersion = bson.E{Key: "$inc", Value: bson.D{{Key: "version", Value: 1}}}
+
+// notTrashedFilter matches articles that have not been moved to the trash.
+var notTrashedFilter = bson.E{Key: "trashedAt", Value: bson.D{{Key: "$exists", Value: false}}}
+
+// trashedFilter matches articles waiting in the trash to be restored or purged.
+var trashedFilter = bson.E{Key: "trashedAt", Value: bson.D{{Key: "$exists", Value: true}}}
+
+// WriteArticle stores a new article, dating it now unless it already carries its creation and publication dates,
+// as imported articles do.
 func (r *ArticleRepository) WriteArticle(ctx context.Context, article *models.Article) error {
 	now := time.Now().UTC().Truncate(time.Millisecond)
-	article.CreatedAt = &now
+	if article.CreatedAt == nil {
+		article.CreatedAt = &now
+	}
+	if article.IsPublished() && article.PublishedAt == nil {
+		article.PublishedAt = &now
+	}
 	collection := r.DBClient.Database("conduit").Collection("articles")
 	result, err := collection.InsertOne(ctx, article)
 	if err != nil {
@@ -41,10 +78,92 @@ func (r *ArticleRepository) WriteArticle(ctx context.Context, article *models.Ar
 	return nil
 }
 
-func (r *ArticleRepository) ListArticles(ctx context.Context, author, tag string, limit, offset int64) ([]*models.Article, error) {
-	filter := bson.D{}
+// ListArticles lists articles from the most to the least recently published.
+//
+// The after parameter, when not empty, is the ID of the last article of the previous page; only articles published before it are listed.
+func (r *ArticleRepository) ListArticles(ctx context.Context, author, tag, favorited, after string, limit, offset int64) ([]*models.Article, error) {
+	filter := bson.D{publishedFilter, notTrashedFilter}
+	if author != "" {
+		filter = append(filter, authoredByFilter(author))
+	}
+	if favorited != "" {
+		favoritedIDs, err := r.listFavoritedArticleIDs(ctx, favorited)
+		if err != nil {
+			return nil, err
+		}
+		filter = append(filter, bson.E{Key: "_id", Value: bson.D{{Key: "$in", Value: favoritedIDs}}})
+	}
+	if after != "" {
+		afterFilter, err := r.publishedBeforeFilter(ctx, after)
+		if err != nil {
+			return nil, err
+		}
+		filter = append(filter, afterFilter)
+	}
+	if tag != "" {
+		filter = append(filter, bson.E{
+			Key: "tagList", Value: bson.D{{
+				Key:   "$all",
+				Value: []string{tag},
+			}},
+		})
+	}
+	opt := options.Find().SetLimit(limit).SetSkip(offset).SetSort(publishedOrder)
+	collection := r.DBClient.Database("conduit").Collection("articles")
+	results := []*models.Article{}
+	cursor, err := collection.Find(ctx, filter, opt)
+	if err != nil {
+		return results, err
+	}
+	if err = cursor.All(ctx, &results); err != nil {
+		return results, err
+	}
+	return results, nil
+}
+
+// publishedOrder sorts articles from the most to the least recently published. Articles written before publication
+// dates were stored have none and come last, newest first.
+var publishedOrder = bson.D{{Key: "publishedAt", Value: -1}, {Key: "_id", Value: -1}}
+
+// publishedBeforeFilter matches articles that come after the given one in publishedOrder.
+//
+// The after parameter represents the ID of the article the previous page ended with.
+func (r *ArticleRepository) publishedBeforeFilter(ctx context.Context, after string) (bson.E, error) {
+	afterID, err := primitive.ObjectIDFromHex(after)
+	if err != nil {
+		return bson.E{}, fmt.Errorf("could not parse ID: %s into ObjectID: %w", after, err)
+	}
+	var afterArticle models.Article
+	projection := bson.D{{Key: "publishedAt", Value: 1}}
+	collection := r.DBClient.Database("conduit").Collection("articles")
+	if err := collection.FindOne(ctx, bson.D{{Key: "_id", Value: afterID}}, options.FindOne().SetProjection(projection)).Decode(&afterArticle); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return bson.E{}, app.ArticleNotFoundError(after, err)
+		}
+		return bson.E{}, err
+	}
+	undated := bson.D{{Key: "publishedAt", Value: nil}}
+	if afterArticle.PublishedAt == nil {
+		return bson.E{Key: "$and", Value: bson.A{undated, bson.D{{Key: "_id", Value: bson.D{{Key: "$lt", Value: afterID}}}}}}, nil
+	}
+	return bson.E{Key: "$and", Value: bson.A{bson.D{{Key: "$or", Value: bson.A{
+		bson.D{{Key: "publishedAt", Value: bson.D{{Key: "$lt", Value: *afterArticle.PublishedAt}}}},
+		bson.D{{Key: "publishedAt", Value: *afterArticle.PublishedAt}, {Key: "_id", Value: bson.D{{Key: "$lt", Value: afterID}}}},
+		undated,
+	}}}}}, nil
+}
+
+// SearchArticles lists articles matching a full-text query, most relevant first.
+//
+// The query parameter follows MongoDB's $text search syntax, matching title, description and body.
+func (r *ArticleRepository) SearchArticles(ctx context.Context, query, author, tag string, limit, offset int64) ([]*models.Article, error) {
+	filter := bson.D{
+		{Key: "$text", Value: bson.D{{Key: "$search", Value: query}}},
+		publishedFilter,
+		notTrashedFilter,
+	}
 	if author != "" {
-		filter = append(filter, bson.E{Key: "author", Value: author})
+		filter = append(filter, authoredByFilter(author))
 	}
 	if tag != "" {
 		filter = append(filter, bson.E{
@@ -54,6 +173,90 @@ func (r *ArticleRepository) ListArticles(ctx context.Context, author, tag string
 			}},
 		})
 	}
+	opt := options.Find().SetLimit(limit).SetSkip(offset).SetSort(bson.D{
+		{Key: "score", Value: bson.D{{Key: "$meta", Value: "textScore"}}},
+		{Key: "_id", Value: -1},
+	})
+	collection := r.DBClient.Database("conduit").Collection("articles")
+	results := []*models.Article{}
+	cursor, err := collection.Find(ctx, filter, opt)
+	if err != nil {
+		return results, err
+	}
+	if err = cursor.All(ctx, &results); err != nil {
+		return results, err
+	}
+	return results, nil
+}
+
+// ListRelatedArticles lists published articles that share tags or an author with the given article, co-authors included.
+//
+// Articles sharing the most tags come first, then the ones sharing an author, then the most recent ones.
+func (r *ArticleRepository) ListRelatedArticles(ctx context.Context, article *models.Article, limit int64) ([]*models.Article, error) {
+	tags := article.TagList
+	if tags == nil {
+		tags = []string{}
+	}
+	authors := article.Authors()
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.D{
+			{Key: "_id", Value: bson.D{{Key: "$ne", Value: article.ID}}},
+			publishedFilter,
+			notTrashedFilter,
+			{Key: "$or", Value: bson.A{
+				bson.D{{Key: "tagList", Value: bson.D{{Key: "$in", Value: tags}}}},
+				bson.D{{Key: "author", Value: bson.D{{Key: "$in", Value: authors}}}},
+				bson.D{{Key: "coAuthors", Value: bson.D{{Key: "$in", Value: authors}}}},
+			}},
+		}}},
+		{{Key: "$addFields", Value: bson.D{
+			{Key: "sharedTags", Value: bson.D{{Key: "$size", Value: bson.D{{Key: "$setIntersection", Value: bson.A{
+				bson.D{{Key: "$ifNull", Value: bson.A{"$tagList", bson.A{}}}},
+				tags,
+			}}}}}},
+			{Key: "sameAuthor", Value: bson.D{{Key: "$gt", Value: bson.A{
+				bson.D{{Key: "$size", Value: bson.D{{Key: "$setIntersection", Value: bson.A{
+					bson.D{{Key: "$concatArrays", Value: bson.A{
+						bson.A{"$author"},
+						bson.D{{Key: "$ifNull", Value: bson.A{"$coAuthors", bson.A{}}}},
+					}}},
+					authors,
+				}}}}},
+				0,
+			}}}},
+		}}},
+		{{Key: "$sort", Value: bson.D{
+			{Key: "sharedTags", Value: -1},
+			{Key: "sameAuthor", Value: -1},
+			{Key: "_id", Value: -1},
+		}}},
+		{{Key: "$limit", Value: limit}},
+		{{Key: "$project", Value: bson.D{
+			{Key: "sharedTags", Value: 0},
+			{Key: "sameAuthor", Value: 0},
+		}}},
+	}
+	collection := r.DBClient.Database("conduit").Collection("articles")
+	results := []*models.Article{}
+	cursor, err := collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return results, err
+	}
+	if err = cursor.All(ctx, &results); err != nil {
+		return results, err
+	}
+	return results, nil
+}
+
+// ListDrafts lists an author's drafts and scheduled articles, most recently written first.
+//
+// The author parameter represents the ID of the drafts' author, co-authored drafts included.
+func (r *ArticleRepository) ListDrafts(ctx context.Context, author string, limit, offset int64) ([]*models.Article, error) {
+	filter := bson.D{
+		authoredByFilter(author),
+		{Key: "status", Value: bson.D{{Key: "$in", Value: unpublishedStatuses}}},
+		notTrashedFilter,
+	}
 	opt := options.Find().SetLimit(limit).SetSkip(offset).SetSort(bson.D{{Key: "_id", Value: -1}})
 	collection := r.DBClient.Database("conduit").Collection("articles")
 	results := []*models.Article{}
@@ -67,12 +270,172 @@ func (r *ArticleRepository) ListArticles(ctx context.Context, author, tag string
 	return results, nil
 }
 
+// ListAuthoredArticles lists every article an author wrote, whatever its status, oldest first.
+// Co-authored articles and articles in the trash are left out.
+//
+// The after parameter, when not empty, is the ID of the last article of the previous page; only newer articles are listed.
+func (r *ArticleRepository) ListAuthoredArticles(ctx context.Context, author, after string, limit int64) ([]*models.Article, error) {
+	filter := bson.D{{Key: "author", Value: author}, notTrashedFilter}
+	if after != "" {
+		afterID, err := primitive.ObjectIDFromHex(after)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse ID: %s into ObjectID: %w", after, err)
+		}
+		filter = append(filter, bson.E{Key: "_id", Value: bson.D{{Key: "$gt", Value: afterID}}})
+	}
+	opt := options.Find().SetLimit(limit).SetSort(bson.D{{Key: "_id", Value: 1}})
+	collection := r.DBClient.Database("conduit").Collection("articles")
+	results := []*models.Article{}
+	cursor, err := collection.Find(ctx, filter, opt)
+	if err != nil {
+		return results, err
+	}
+	if err = cursor.All(ctx, &results); err != nil {
+		return results, err
+	}
+	return results, nil
+}
+
+// PublishArticle marks a draft or scheduled article as published. Returns the published *models.Article.
+//
+// The update is conditional on the article not being published yet, so concurrent calls publish it only once;
+// the others get an ArticleAlreadyPublishedError.
+func (r *ArticleRepository) PublishArticle(ctx context.Context, ID string) (*models.Article, error) {
+	articleID, err := primitive.ObjectIDFromHex(ID)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse ID: %s into ObjectID: %w", ID, err)
+	}
+	now := time.Now().UTC().Truncate(time.Millisecond)
+	filter := bson.D{
+		{Key: "_id", Value: articleID},
+		{Key: "status", Value: bson.D{{Key: "$in", Value: unpublishedStatuses}}},
+		notTrashedFilter,
+	}
+	update := bson.D{
+		{Key: "$set", Value: bson.D{
+			{Key: "status", Value: models.ArticleStatusPublished},
+			{Key: "publishedAt", Value: now},
+			{Key: "pendingFanout", Value: true},
+		}},
+		{Key: "$unset", Value: bson.D{{Key: "publishAt", Value: ""}}},
+		incrementVersion,
+	}
+	opt := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	collection := r.DBClient.Database("conduit").Collection("articles")
+	var article *models.Article
+	if err := collection.FindOneAndUpdate(ctx, filter, update, opt).Decode(&article); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, app.ArticleAlreadyPublishedError(ID)
+		}
+		return nil, err
+	}
+	return article, nil
+}
+
+// ListScheduledArticles lists scheduled articles due to be published, the most overdue first.
+//
+// The until parameter represents the moment up to which scheduled articles are due.
+func (r *ArticleRepository) ListScheduledArticles(ctx context.Context, until time.Time, limit int64) ([]*models.Article, error) {
+	filter := bson.D{
+		{Key: "status", Value: models.ArticleStatusScheduled},
+		{Key: "publishAt", Value: bson.D{{Key: "$lte", Value: until}}},
+		notTrashedFilter,
+	}
+	opt := options.Find().SetLimit(limit).SetSort(bson.D{{Key: "publishAt", Value: 1}})
+	collection := r.DBClient.Database("conduit").Collection("articles")
+	results := []*models.Article{}
+	cursor, err := collection.Find(ctx, filter, opt)
+	if err != nil {
+		return results, err
+	}
+	if err = cursor.All(ctx, &results); err != nil {
+		return results, err
+	}
+	return results, nil
+}
+
+// ListPendingFanoutArticles lists published articles that were not enqueued for feed fan-out yet, the oldest first.
+//
+// The until parameter represents the moment up to which articles must have been published, leaving time for
+// whoever published them to enqueue them.
+func (r *ArticleRepository) ListPendingFanoutArticles(ctx context.Context, until time.Time, limit int64) ([]*models.Article, error) {
+	filter := bson.D{
+		{Key: "pendingFanout", Value: true},
+		{Key: "publishedAt", Value: bson.D{{Key: "$lte", Value: until}}},
+		notTrashedFilter,
+	}
+	opt := options.Find().SetLimit(limit).SetSort(bson.D{{Key: "publishedAt", Value: 1}})
+	collection := r.DBClient.Database("conduit").Collection("articles")
+	results := []*models.Article{}
+	cursor, err := collection.Find(ctx, filter, opt)
+	if err != nil {
+		return results, err
+	}
+	if err = cursor.All(ctx, &results); err != nil {
+		return results, err
+	}
+	return results, nil
+}
+
+// CompleteFanout records that an article was enqueued for feed fan-out, so it is not enqueued again.
+//
+// The ID parameter represents the ID of the article.
+func (r *ArticleRepository) CompleteFanout(ctx context.Context, ID string) error {
+	articleID, err := primitive.ObjectIDFromHex(ID)
+	if err != nil {
+		return fmt.Errorf("could not parse ID: %s into ObjectID: %w", ID, err)
+	}
+	filter := bson.D{{Key: "_id", Value: articleID}}
+	update := bson.D{{Key: "$unset", Value: bson.D{{Key: "pendingFanout", Value: ""}}}}
+	collection := r.DBClient.Database("conduit").Collection("articles")
+	_, err = collection.UpdateOne(ctx, filter, update)
+	return err
+}
+
+// ListUnsummarizedArticles lists articles written before word counts, reading times and excerpts were stored, oldest first.
+// Trashed articles are included, so they are summarized too if they are ever restored.
+func (r *ArticleRepository) ListUnsummarizedArticles(ctx context.Context, limit int64) ([]*models.Article, error) {
+	filter := bson.D{{Key: "wordCount", Value: bson.D{{Key: "$exists", Value: false}}}}
+	opt := options.Find().SetLimit(limit).SetSort(bson.D{{Key: "_id", Value: 1}})
+	collection := r.DBClient.Database("conduit").Collection("articles")
+	results := []*models.Article{}
+	cursor, err := collection.Find(ctx, filter, opt)
+	if err != nil {
+		return results, err
+	}
+	if err = cursor.All(ctx, &results); err != nil {
+		return results, err
+	}
+	return results, nil
+}
+
+// UpdateArticleSummary stores the word count, reading time and excerpt of an unsummarized article.
+//
+// Nothing is stored if the article got summarized in the meantime, since that summary comes from a more recent body.
+func (r *ArticleRepository) UpdateArticleSummary(ctx context.Context, article *models.Article) error {
+	filter := bson.D{
+		{Key: "_id", Value: article.ID},
+		{Key: "wordCount", Value: bson.D{{Key: "$exists", Value: false}}},
+	}
+	update := bson.D{
+		{Key: "$set", Value: bson.D{
+			{Key: "wordCount", Value: article.WordCount},
+			{Key: "readingTime", Value: article.ReadingTime},
+			{Key: "excerpt", Value: article.Excerpt},
+		}},
+		incrementVersion,
+	}
+	collection := r.DBClient.Database("conduit").Collection("articles")
+	_, err := collection.UpdateOne(ctx, filter, update)
+	return err
+}
+
 func (r *ArticleRepository) GetArticleBySlug(ctx context.Context, slug string) (*models.Article, error) {
 	var article *models.Article
-	filter := bson.D{{
-		Key:   "slug",
-		Value: slug,
-	}}
+	filter := bson.D{
+		{Key: "slug", Value: slug},
+		notTrashedFilter,
+	}
 	collection := r.DBClient.Database("conduit").Collection("articles")
 	if err := collection.FindOne(ctx, filter).Decode(&article); err != nil {
 		if err == mongo.ErrNoDocuments {
@@ -89,10 +452,10 @@ func (r *ArticleRepository) GetArticleByID(ctx context.Context, ID string) (*mod
 	if err != nil {
 		return nil, fmt.Errorf("could not parse ID: %s into ObjectID: %w", ID, err)
 	}
-	filter := bson.D{{
-		Key:   "_id",
-		Value: articleID,
-	}}
+	filter := bson.D{
+		{Key: "_id", Value: articleID},
+		notTrashedFilter,
+	}
 	collection := r.DBClient.Database("conduit").Collection("articles")
 	if err := collection.FindOne(ctx, filter).Decode(&article); err != nil {
 		if errors.Is(err, mongo.ErrNoDocuments) {
@@ -113,7 +476,11 @@ func (r *ArticleRepository) GetArticlesByIDs(ctx context.Context, IDs []string)
 		articleIDs = append(articleIDs, articleID)
 	}
 
-	filter := bson.M{"_id": bson.M{"$in": articleIDs}}
+	filter := bson.D{
+		{Key: "_id", Value: bson.M{"$in": articleIDs}},
+		publishedFilter,
+		notTrashedFilter,
+	}
 	opt := options.Find().SetSort(bson.D{{Key: "_id", Value: -1}}).SetLimit(int64(len(articleIDs)))
 	collection := r.DBClient.Database("conduit").Collection("articles")
 	results := make([]*models.Article, 0, len(articleIDs))
@@ -127,32 +494,264 @@ func (r *ArticleRepository) GetArticlesByIDs(ctx context.Context, IDs []string)
 	return results, nil
 }
 
-func (r *ArticleRepository) DeleteArticle(ctx context.Context, slug string) error {
-	filter := bson.D{{
-		Key:   "slug",
-		Value: slug,
-	}}
+// RankTrendingArticles picks the highest scored published articles, both overall and within each of their tags.
+//
+// The size parameter represents how many articles are picked overall, and how many are picked within each tag.
+func (r *ArticleRepository) RankTrendingArticles(ctx context.Context, scores []*models.ArticleScore, size int64) ([]*models.TrendingArticle, error) {
+	if len(scores) == 0 {
+		return []*models.TrendingArticle{}, nil
+	}
+	articleIDs := make([]primitive.ObjectID, 0, len(scores))
+	values := make([]float64, 0, len(scores))
+	byID := make(map[primitive.ObjectID]*models.ArticleScore, len(scores))
+	for _, score := range scores {
+		articleID, err := primitive.ObjectIDFromHex(score.Article)
+		if err != nil {
+			return nil, err
+		}
+		articleIDs = append(articleIDs, articleID)
+		values = append(values, score.Score)
+		byID[articleID] = score
+	}
+
+	scoreOrder := bson.D{{Key: "score", Value: -1}, {Key: "_id", Value: -1}}
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.D{
+			{Key: "_id", Value: bson.D{{Key: "$in", Value: articleIDs}}},
+			publishedFilter,
+			notTrashedFilter,
+		}}},
+		{{Key: "$project", Value: bson.D{
+			{Key: "tagList", Value: 1},
+			{Key: "score", Value: bson.D{{Key: "$arrayElemAt", Value: bson.A{
+				values,
+				bson.D{{Key: "$indexOfArray", Value: bson.A{articleIDs, "$_id"}}},
+			}}}},
+		}}},
+		{{Key: "$sort", Value: scoreOrder}},
+		{{Key: "$facet", Value: bson.D{
+			{Key: "overall", Value: bson.A{
+				bson.D{{Key: "$limit", Value: size}},
+				bson.D{{Key: "$project", Value: bson.D{{Key: "_id", Value: 1}}}},
+			}},
+			{Key: "tags", Value: bson.A{
+				bson.D{{Key: "$unwind", Value: "$tagList"}},
+				bson.D{{Key: "$group", Value: bson.D{
+					{Key: "_id", Value: "$tagList"},
+					{Key: "articles", Value: bson.D{{Key: "$topN", Value: bson.D{
+						{Key: "n", Value: size},
+						{Key: "sortBy", Value: scoreOrder},
+						{Key: "output", Value: "$_id"},
+					}}}},
+				}}},
+			}},
+		}}},
+	}
+	collection := r.DBClient.Database("conduit").Collection("articles")
+	cursor, err := collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	var rankings []struct {
+		Overall []struct {
+			ID primitive.ObjectID `bson:"_id"`
+		} `bson:"overall"`
+		Tags []struct {
+			Tag      string               `bson:"_id"`
+			Articles []primitive.ObjectID `bson:"articles"`
+		} `bson:"tags"`
+	}
+	if err = cursor.All(ctx, &rankings); err != nil {
+		return nil, err
+	}
+
+	trending := map[primitive.ObjectID]*models.TrendingArticle{}
+	trendingArticle := func(articleID primitive.ObjectID) *models.TrendingArticle {
+		if _, ok := trending[articleID]; !ok {
+			score := byID[articleID]
+			overall := false
+			trending[articleID] = &models.TrendingArticle{Article: &score.Article, TagList: []string{}, Overall: &overall, Score: &score.Score}
+		}
+		return trending[articleID]
+	}
+	for _, ranking := range rankings {
+		for _, article := range ranking.Overall {
+			*trendingArticle(article.ID).Overall = true
+		}
+		for _, tag := range ranking.Tags {
+			for _, articleID := range tag.Articles {
+				article := trendingArticle(articleID)
+				article.TagList = append(article.TagList, tag.Tag)
+			}
+		}
+	}
+	results := make([]*models.TrendingArticle, 0, len(trending))
+	for _, article := range trending {
+		results = append(results, article)
+	}
+	return results, nil
+}
+
+// TrashArticle moves an article to the trash, hiding it everywhere but in its author's trash.
+//
+// The slug parameter represents the article's current slug, which stays reserved while the article is in the trash.
+func (r *ArticleRepository) TrashArticle(ctx context.Context, slug string) error {
+	now := time.Now().UTC().Truncate(time.Millisecond)
+	filter := bson.D{
+		{Key: "slug", Value: slug},
+		notTrashedFilter,
+	}
+	update := bson.D{{Key: "$set", Value: bson.D{{Key: "trashedAt", Value: now}}}, incrementVersion}
+	collection := r.DBClient.Database("conduit").Collection("articles")
+	result, err := collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return app.ArticleNotFoundError(slug, nil)
+	}
+	return nil
+}
+
+// ListTrashedArticles lists an author's trashed articles, most recently trashed first.
+//
+// The author parameter represents the ID of the articles' author.
+func (r *ArticleRepository) ListTrashedArticles(ctx context.Context, author string, limit, offset int64) ([]*models.Article, error) {
+	filter := bson.D{
+		{Key: "author", Value: author},
+		trashedFilter,
+	}
+	opt := options.Find().SetLimit(limit).SetSkip(offset).SetSort(bson.D{{Key: "trashedAt", Value: -1}})
+	collection := r.DBClient.Database("conduit").Collection("articles")
+	results := []*models.Article{}
+	cursor, err := collection.Find(ctx, filter, opt)
+	if err != nil {
+		return results, err
+	}
+	if err = cursor.All(ctx, &results); err != nil {
+		return results, err
+	}
+	return results, nil
+}
+
+// GetTrashedArticleBySlug gets an article from the trash. Returns *models.Article.
+//
+// The slug parameter represents the slug the article had when it was trashed.
+func (r *ArticleRepository) GetTrashedArticleBySlug(ctx context.Context, slug string) (*models.Article, error) {
+	var article *models.Article
+	filter := bson.D{
+		{Key: "slug", Value: slug},
+		trashedFilter,
+	}
+	collection := r.DBClient.Database("conduit").Collection("articles")
+	if err := collection.FindOne(ctx, filter).Decode(&article); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, app.ArticleNotFoundError(slug, err)
+		}
+		return nil, err
+	}
+	return article, nil
+}
+
+// RestoreArticle takes an article out of the trash. Returns the restored *models.Article.
+//
+// The ID parameter represents the ID of the trashed article.
+func (r *ArticleRepository) RestoreArticle(ctx context.Context, ID string) (*models.Article, error) {
+	articleID, err := primitive.ObjectIDFromHex(ID)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse ID: %s into ObjectID: %w", ID, err)
+	}
+	filter := bson.D{
+		{Key: "_id", Value: articleID},
+		trashedFilter,
+	}
+	update := bson.D{{Key: "$unset", Value: bson.D{{Key: "trashedAt", Value: ""}}}, incrementVersion}
+	opt := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	collection := r.DBClient.Database("conduit").Collection("articles")
+	var article *models.Article
+	if err := collection.FindOneAndUpdate(ctx, filter, update, opt).Decode(&article); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, app.ArticleNotFoundError(ID, err)
+		}
+		return nil, err
+	}
+	return article, nil
+}
+
+// ListExpiredTrashedArticles lists articles trashed long enough ago to be purged, the oldest first.
+//
+// The until parameter represents the moment up to which trashed articles are expired.
+func (r *ArticleRepository) ListExpiredTrashedArticles(ctx context.Context, until time.Time, limit int64) ([]*models.Article, error) {
+	filter := bson.D{{Key: "trashedAt", Value: bson.D{{Key: "$lte", Value: until}}}}
+	opt := options.Find().SetLimit(limit).SetSort(bson.D{{Key: "trashedAt", Value: 1}})
+	collection := r.DBClient.Database("conduit").Collection("articles")
+	results := []*models.Article{}
+	cursor, err := collection.Find(ctx, filter, opt)
+	if err != nil {
+		return results, err
+	}
+	if err = cursor.All(ctx, &results); err != nil {
+		return results, err
+	}
+	return results, nil
+}
+
+// DeleteArticle permanently deletes a trashed article.
+//
+// The ID parameter represents the ID of the trashed article.
+func (r *ArticleRepository) DeleteArticle(ctx context.Context, ID string) error {
+	articleID, err := primitive.ObjectIDFromHex(ID)
+	if err != nil {
+		return fmt.Errorf("could not parse ID: %s into ObjectID: %w", ID, err)
+	}
+	filter := bson.D{
+		{Key: "_id", Value: articleID},
+		trashedFilter,
+	}
 	collection := r.DBClient.Database("conduit").Collection("articles")
 	result, err := collection.DeleteOne(ctx, filter)
 	if err != nil {
 		return err
 	}
 	if result.DeletedCount == 0 {
-		return app.ArticleNotFoundError(slug, nil)
+		return app.ArticleNotFoundError(ID, nil)
 	}
 	return nil
 }
 
+// UpdateArticle sets the fields of article on the article with the slug, counting a new version of it.
+//
+// When article has a version, the update only happens if the stored article is still at that version;
+// otherwise it fails with a VersionMismatchError, so concurrent edits do not silently overwrite each other.
 func (r *ArticleRepository) UpdateArticle(ctx context.Context, slug string, article *models.Article) error {
 	now := time.Now().UTC().Truncate(time.Millisecond)
 	article.UpdatedAt = &now
-	filter := bson.D{{Key: "slug", Value: slug}}
-	update := bson.D{{Key: "$set", Value: article}}
+	filter := bson.D{
+		{Key: "slug", Value: slug},
+		notTrashedFilter,
+	}
+	expectedVersion := article.Version
+	if expectedVersion != nil {
+		filter = append(filter, versionFilter(*expectedVersion))
+	}
+	// The version is only ever incremented, never set along with the other fields
+	fields := *article
+	fields.Version = nil
+	update := bson.D{{Key: "$set", Value: &fields}, incrementVersion}
 	collection := r.DBClient.Database("conduit").Collection("articles")
 	returnDocumentOption := options.After
 	err := collection.FindOneAndUpdate(ctx, filter, update, &options.FindOneAndUpdateOptions{ReturnDocument: &returnDocumentOption}).Decode(article)
 	if err != nil {
 		if err == mongo.ErrNoDocuments {
+			if expectedVersion != nil {
+				count, countErr := collection.CountDocuments(ctx, filter[:len(filter)-1])
+				if countErr != nil {
+					return countErr
+				}
+				if count > 0 {
+					return app.VersionMismatchError(slug, *expectedVersion)
+				}
+			}
 			return app.ArticleNotFoundError(slug, err)
 		}
 		if mongo.IsDuplicateKeyError(err) {
@@ -162,3 +761,165 @@ func (r *ArticleRepository) UpdateArticle(ctx context.Context, slug string, arti
 	}
 	return nil
 }
+
+// ReplaceTag rewrites the tag list of every article tagged with from, trashed ones included, so they are tagged with to
+// instead. Articles already tagged with both keep a single occurrence of to. Returns the number of rewritten articles.
+func (r *ArticleRepository) ReplaceTag(ctx context.Context, from, to string) (int64, error) {
+	filter := bson.D{{Key: "tagList", Value: from}}
+	replacedTags := bson.D{{Key: "$map", Value: bson.D{
+		{Key: "input", Value: "$tagList"},
+		{Key: "in", Value: bson.D{{Key: "$cond", Value: bson.A{bson.D{{Key: "$eq", Value: bson.A{"$$this", from}}}, to, "$$this"}}}},
+	}}}
+	deduplicatedTags := bson.D{{Key: "$reduce", Value: bson.D{
+		{Key: "input", Value: replacedTags},
+		{Key: "initialValue", Value: bson.A{}},
+		{Key: "in", Value: bson.D{{Key: "$cond", Value: bson.A{
+			bson.D{{Key: "$in", Value: bson.A{"$$this", "$$value"}}},
+			"$$value",
+			bson.D{{Key: "$concatArrays", Value: bson.A{"$$value", bson.A{"$$this"}}}},
+		}}}},
+	}}}
+	incrementedVersion := bson.D{{Key: "$add", Value: bson.A{bson.D{{Key: "$ifNull", Value: bson.A{"$version", 0}}}, 1}}}
+	update := mongo.Pipeline{{{Key: "$set", Value: bson.D{
+		{Key: "tagList", Value: deduplicatedTags},
+		{Key: "version", Value: incrementedVersion},
+	}}}}
+	collection := r.DBClient.Database("conduit").Collection("articles")
+	result, err := collection.UpdateMany(ctx, filter, update)
+	if err != nil {
+		return 0, err
+	}
+	return result.ModifiedCount, nil
+}
+
+// CountTaggedArticles counts the published articles tagged with tag, the same ones accounted for in the tag catalogue.
+func (r *ArticleRepository) CountTaggedArticles(ctx context.Context, tag string) (int64, error) {
+	filter := bson.D{
+		{Key: "tagList", Value: tag},
+		publishedFilter,
+		notTrashedFilter,
+	}
+	collection := r.DBClient.Database("conduit").Collection("articles")
+	return collection.CountDocuments(ctx, filter)
+}
+
+// CountPublishedArticles counts the published articles that are not in the trash.
+func (r *ArticleRepository) CountPublishedArticles(ctx context.Context) (int64, error) {
+	filter := bson.D{publishedFilter, notTrashedFilter}
+	collection := r.DBClient.Database("conduit").Collection("articles")
+	return collection.CountDocuments(ctx, filter)
+}
+
+// ListSitemapArticles lists published articles oldest first, loading only their slugs and dates.
+func (r *ArticleRepository) ListSitemapArticles(ctx context.Context, limit, offset int64) ([]*models.Article, error) {
+	filter := bson.D{publishedFilter, notTrashedFilter}
+	projection := bson.D{
+		{Key: "slug", Value: 1},
+		{Key: "createdAt", Value: 1},
+		{Key: "updatedAt", Value: 1},
+		{Key: "publishedAt", Value: 1},
+	}
+	opt := options.Find().SetLimit(limit).SetSkip(offset).SetSort(bson.D{{Key: "_id", Value: 1}}).SetProjection(projection)
+	collection := r.DBClient.Database("conduit").Collection("articles")
+	results := []*models.Article{}
+	cursor, err := collection.Find(ctx, filter, opt)
+	if err != nil {
+		return results, err
+	}
+	if err = cursor.All(ctx, &results); err != nil {
+		return results, err
+	}
+	return results, nil
+}
+
+// AddCoAuthor adds a user to the co-authors of an article, returning the updated article.
+//
+// The coAuthor parameter represents the ID of the user joining the article.
+func (r *ArticleRepository) AddCoAuthor(ctx context.Context, ID, coAuthor string) (*models.Article, error) {
+	var article *models.Article
+	articleID, err := primitive.ObjectIDFromHex(ID)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse ID: %s into ObjectID: %w", ID, err)
+	}
+	filter := bson.D{
+		{Key: "_id", Value: articleID},
+		notTrashedFilter,
+	}
+	update := bson.D{{Key: "$addToSet", Value: bson.D{{Key: "coAuthors", Value: coAuthor}}}, incrementVersion}
+	collection := r.DBClient.Database("conduit").Collection("articles")
+	returnDocumentOption := options.After
+	err = collection.FindOneAndUpdate(ctx, filter, update, &options.FindOneAndUpdateOptions{ReturnDocument: &returnDocumentOption}).Decode(&article)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, app.ArticleNotFoundError(ID, err)
+		}
+		return nil, err
+	}
+	return article, nil
+}
+
+// CacheBodyHTML stores the HTML rendering of an article's body.
+//
+// The body parameter is the body that was rendered; nothing is stored if the article was edited in the meantime,
+// so a stale rendering never overwrites a fresh one.
+func (r *ArticleRepository) CacheBodyHTML(ctx context.Context, ID, body, bodyHTML string) error {
+	articleID, err := primitive.ObjectIDFromHex(ID)
+	if err != nil {
+		return fmt.Errorf("could not parse ID: %s into ObjectID: %w", ID, err)
+	}
+	filter := bson.D{
+		{Key: "_id", Value: articleID},
+		{Key: "body", Value: body},
+	}
+	update := bson.D{{Key: "$set", Value: bson.D{{Key: "bodyHtml", Value: bodyHTML}}}}
+	collection := r.DBClient.Database("conduit").Collection("articles")
+	_, err = collection.UpdateOne(ctx, filter, update)
+	return err
+}
+
+// UpdateFavoritesCount atomically adds delta to the favorites count of an article, returning the updated article.
+func (r *ArticleRepository) UpdateFavoritesCount(ctx context.Context, ID string, delta int64) (*models.Article, error) {
+	var article *models.Article
+	articleID, err := primitive.ObjectIDFromHex(ID)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse ID: %s into ObjectID: %w", ID, err)
+	}
+	filter := bson.D{
+		{Key: "_id", Value: articleID},
+		notTrashedFilter,
+	}
+	// Favorites are not edited by the authors, so they do not count as a new version of the article
+	update := bson.D{{Key: "$inc", Value: bson.D{{Key: "favoritesCount", Value: delta}}}}
+	collection := r.DBClient.Database("conduit").Collection("articles")
+	returnDocumentOption := options.After
+	err = collection.FindOneAndUpdate(ctx, filter, update, &options.FindOneAndUpdateOptions{ReturnDocument: &returnDocumentOption}).Decode(&article)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, app.ArticleNotFoundError(ID, err)
+		}
+		return nil, err
+	}
+	return article, nil
+}
+
+func (r *ArticleRepository) listFavoritedArticleIDs(ctx context.Context, user string) ([]primitive.ObjectID, error) {
+	filter := bson.D{{Key: "user", Value: user}}
+	collection := r.DBClient.Database("conduit").Collection("favorites")
+	cursor, err := collection.Find(ctx, filter, nil)
+	if err != nil {
+		return nil, err
+	}
+	favorites := []*models.Favorite{}
+	if err := cursor.All(ctx, &favorites); err != nil {
+		return nil, err
+	}
+	articleIDs := make([]primitive.ObjectID, 0, len(favorites))
+	for _, favorite := range favorites {
+		articleID, err := primitive.ObjectIDFromHex(*favorite.Article)
+		if err != nil {
+			continue
+		}
+		articleIDs = append(articleIDs, articleID)
+	}
+	return articleIDs, nil
+}
@@ -0,0 +1,109 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/ravilock/goduit/internal/app"
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type CoAuthorInvitationRepository struct {
+	DBClient *mongo.Client
+}
+
+func NewCoAuthorInvitationRepository(client *mongo.Client) *CoAuthorInvitationRepository {
+	return &CoAuthorInvitationRepository{client}
+}
+
+// WriteInvitation registers an invitation to co-author an article. A user can only be invited once per article.
+func (r *CoAuthorInvitationRepository) WriteInvitation(ctx context.Context, invitation *models.CoAuthorInvitation) error {
+	now := time.Now().UTC().Truncate(time.Millisecond)
+	invitation.CreatedAt = &now
+	collection := r.DBClient.Database("conduit").Collection("coAuthorInvitations")
+	result, err := collection.InsertOne(ctx, invitation)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return app.ConflictError("coAuthorInvitations")
+		}
+		return err
+	}
+	invitationID := result.InsertedID.(primitive.ObjectID)
+	invitation.ID = &invitationID
+	return nil
+}
+
+// GetInvitation gets a user's invitation to co-author an article. Returns *models.CoAuthorInvitation.
+//
+// The article parameter represents the ID of the article.
+//
+// The invitee parameter represents the ID of the invited user.
+func (r *CoAuthorInvitationRepository) GetInvitation(ctx context.Context, article, invitee string) (*models.CoAuthorInvitation, error) {
+	var invitation *models.CoAuthorInvitation
+	filter := bson.D{
+		{Key: "article", Value: article},
+		{Key: "invitee", Value: invitee},
+	}
+	collection := r.DBClient.Database("conduit").Collection("coAuthorInvitations")
+	if err := collection.FindOne(ctx, filter).Decode(&invitation); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, app.CoAuthorInvitationNotFoundError(article, invitee, err)
+		}
+		return nil, err
+	}
+	return invitation, nil
+}
+
+// ListInvitations lists a user's pending invitations, most recent first.
+//
+// The invitee parameter represents the ID of the invited user.
+func (r *CoAuthorInvitationRepository) ListInvitations(ctx context.Context, invitee string, limit, offset int64) ([]*models.CoAuthorInvitation, error) {
+	filter := bson.D{{Key: "invitee", Value: invitee}}
+	opt := options.Find().SetLimit(limit).SetSkip(offset).SetSort(bson.D{{Key: "createdAt", Value: -1}})
+	collection := r.DBClient.Database("conduit").Collection("coAuthorInvitations")
+	results := []*models.CoAuthorInvitation{}
+	cursor, err := collection.Find(ctx, filter, opt)
+	if err != nil {
+		return results, err
+	}
+	if err = cursor.All(ctx, &results); err != nil {
+		return results, err
+	}
+	return results, nil
+}
+
+// DeleteInvitation removes a user's invitation to co-author an article.
+//
+// The article parameter represents the ID of the article.
+//
+// The invitee parameter represents the ID of the invited user.
+func (r *CoAuthorInvitationRepository) DeleteInvitation(ctx context.Context, article, invitee string) error {
+	filter := bson.D{
+		{Key: "article", Value: article},
+		{Key: "invitee", Value: invitee},
+	}
+	collection := r.DBClient.Database("conduit").Collection("coAuthorInvitations")
+	result, err := collection.DeleteOne(ctx, filter)
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return app.CoAuthorInvitationNotFoundError(article, invitee, nil)
+	}
+	return nil
+}
+
+// DeleteArticleInvitations removes every pending invitation to co-author an article.
+//
+// The article parameter represents the ID of the article.
+func (r *CoAuthorInvitationRepository) DeleteArticleInvitations(ctx context.Context, article string) error {
+	filter := bson.D{{Key: "article", Value: article}}
+	collection := r.DBClient.Database("conduit").Collection("coAuthorInvitations")
+	_, err := collection.DeleteMany(ctx, filter)
+	return err
+}
@@ -94,3 +94,30 @@ func (r *CommentRepository) DeleteComment(ctx context.Context, ID string) error
 	}
 	return nil
 }
+
+// DeleteArticleComments permanently deletes every comment of an article.
+//
+// The article parameter represents the ID of the commented article.
+func (r *CommentRepository) DeleteArticleComments(ctx context.Context, article string) error {
+	filter := bson.D{{Key: "article", Value: article}}
+	collection := r.DBClient.Database("conduit").Collection("comments")
+	_, err := collection.DeleteMany(ctx, filter)
+	return err
+}
+
+// CountDailyComments counts the comments written on articles per day, starting from the day of since.
+// Deleted comments are not counted.
+//
+// The articles parameter represents the IDs of the commented articles.
+func (r *CommentRepository) CountDailyComments(ctx context.Context, articles []string, since time.Time) ([]*models.DailyCount, error) {
+	collection := r.DBClient.Database("conduit").Collection("comments")
+	return countDaily(ctx, collection, articles, since)
+}
+
+// ScoreComments sums the comments written on each article since a given time, each comment weighing less the older it is.
+//
+// The halfLife parameter represents how long it takes for a comment to weigh half as much.
+func (r *CommentRepository) ScoreComments(ctx context.Context, since, now time.Time, halfLife time.Duration) ([]*models.ArticleScore, error) {
+	collection := r.DBClient.Database("conduit").Collection("comments")
+	return scoreDecayed(ctx, collection, "createdAt", 1, since, now, halfLife)
+}
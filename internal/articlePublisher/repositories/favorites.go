@@ -0,0 +1,103 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/ravilock/goduit/internal/app"
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type FavoriteRepository struct {
+	DBClient *mongo.Client
+}
+
+func NewFavoriteRepository(client *mongo.Client) *FavoriteRepository {
+	return &FavoriteRepository{client}
+}
+
+// Favorite registers that a user has favorited an article.
+//
+// The article parameter represents the ID of the article being favorited.
+//
+// The user parameter represents the ID of the user that is favoriting.
+func (r *FavoriteRepository) Favorite(ctx context.Context, article, user string) error {
+	now := time.Now().UTC().Truncate(time.Millisecond)
+	favorite := models.Favorite{Article: &article, User: &user, CreatedAt: &now}
+	collection := r.DBClient.Database("conduit").Collection("favorites")
+	if _, err := collection.InsertOne(ctx, favorite); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return app.ConflictError("favorites")
+		}
+		return err
+	}
+	return nil
+}
+
+// Unfavorite removes a user's favorite from an article.
+//
+// The article parameter represents the ID of the favorited article.
+//
+// The user parameter represents the ID of the user that favorited it.
+func (r *FavoriteRepository) Unfavorite(ctx context.Context, article, user string) error {
+	filter := bson.D{
+		{Key: "article", Value: article},
+		{Key: "user", Value: user},
+	}
+	collection := r.DBClient.Database("conduit").Collection("favorites")
+	result, err := collection.DeleteOne(ctx, filter)
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return app.FavoriteNotFoundError(article, user, nil)
+	}
+	return nil
+}
+
+// IsFavoritedBy queries for a user's favorite on an article. Returns *models.Favorite.
+//
+// The article parameter represents the ID of the favorited article.
+//
+// The user parameter represents the ID of the user that favorited it.
+func (r *FavoriteRepository) IsFavoritedBy(ctx context.Context, article, user string) (*models.Favorite, error) {
+	var favorite *models.Favorite
+	filter := bson.D{
+		{Key: "article", Value: article},
+		{Key: "user", Value: user},
+	}
+	collection := r.DBClient.Database("conduit").Collection("favorites")
+	if err := collection.FindOne(ctx, filter).Decode(&favorite); err != nil {
+		return nil, err
+	}
+	return favorite, nil
+}
+
+// DeleteArticleFavorites removes every user's favorite from an article.
+//
+// The article parameter represents the ID of the favorited article.
+func (r *FavoriteRepository) DeleteArticleFavorites(ctx context.Context, article string) error {
+	filter := bson.D{{Key: "article", Value: article}}
+	collection := r.DBClient.Database("conduit").Collection("favorites")
+	_, err := collection.DeleteMany(ctx, filter)
+	return err
+}
+
+// CountDailyFavorites counts the favorites articles got per day, starting from the day of since.
+// Favorites that were later removed are not counted.
+//
+// The articles parameter represents the IDs of the favorited articles.
+func (r *FavoriteRepository) CountDailyFavorites(ctx context.Context, articles []string, since time.Time) ([]*models.DailyCount, error) {
+	collection := r.DBClient.Database("conduit").Collection("favorites")
+	return countDaily(ctx, collection, articles, since)
+}
+
+// ScoreFavorites sums the favorites each article got since a given time, each favorite weighing less the older it is.
+//
+// The halfLife parameter represents how long it takes for a favorite to weigh half as much.
+func (r *FavoriteRepository) ScoreFavorites(ctx context.Context, since, now time.Time, halfLife time.Duration) ([]*models.ArticleScore, error) {
+	collection := r.DBClient.Database("conduit").Collection("favorites")
+	return scoreDecayed(ctx, collection, "createdAt", 1, since, now, halfLife)
+}
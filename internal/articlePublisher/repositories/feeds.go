@@ -3,7 +3,6 @@ package repositories
 import (
 	"context"
 	"errors"
-	"fmt"
 
 	"github.com/ravilock/goduit/internal/app"
 	"github.com/ravilock/goduit/internal/articlePublisher/models"
@@ -23,7 +22,11 @@ func NewFeedRepository(client *mongo.Client) *FeedRepository {
 	return &FeedRepository{client}
 }
 
-func (r *FeedRepository) PaginateFeed(ctx context.Context, user string, limit, offset int64) ([]models.FeedFragment, error) {
+// PaginateFeed lists the fragments of a user's feed in the order they were delivered, the oldest first.
+//
+// The after parameter, when positive, is the position of the last fragment of the previous page; only fragments
+// delivered after it are listed.
+func (r *FeedRepository) PaginateFeed(ctx context.Context, user string, after, limit, offset int64) ([]models.FeedFragment, error) {
 	var feed *models.Feed
 	feedID, err := primitive.ObjectIDFromHex(user)
 	if err != nil {
@@ -38,119 +41,107 @@ func (r *FeedRepository) PaginateFeed(ctx context.Context, user string, limit, o
 		}
 		return nil, err
 	}
+	positionFeedFragments(feed)
 
-	firstIdx, lastIdx := offset, offset+limit
-	if firstIdx > int64(len(feed.Articles)) {
-		return []models.FeedFragment{}, nil
-	}
-	if lastIdx > int64(len(feed.Articles)) {
-		lastIdx = int64(len(feed.Articles))
+	fragments := make([]models.FeedFragment, 0, limit)
+	skipped := int64(0)
+	for i := 0; i < len(feed.Articles) && int64(len(fragments)) < limit; i++ {
+		fragment := feed.Articles[i]
+		if fragment.Position <= after {
+			continue
+		}
+		if skipped < offset {
+			skipped++
+			continue
+		}
+		fragments = append(fragments, fragment)
 	}
-	return feed.Articles[firstIdx:lastIdx], nil
+	return fragments, nil
 }
 
+// AppendArticleToUserFeeds delivers an article to the feeds of the given users, creating the feeds that do not exist yet.
+//
+// Each feed is updated atomically, so concurrent deliveries never overwrite each other's fragments. Articles already
+// delivered to a feed, such as re-published or co-authored ones, are not appended twice.
 func (r *FeedRepository) AppendArticleToUserFeeds(ctx context.Context, article *models.Article, author *profileManagerModels.User, userIDs []string) error {
 	if len(userIDs) == 0 {
 		return nil
 	}
 
-	feeds, err := r.listFeedsByID(ctx, userIDs)
-	if err != nil {
-		return err
-	}
-
-	feedFragment := assembleFeedFragmentFromArticle(article)
-
-	maxFeedArticles := viper.GetInt("feed.max.articles")
-	for i := range feeds {
-		feeds[i].Articles = append(feeds[i].Articles, feedFragment)
-		if len(feeds[i].Articles) > maxFeedArticles {
-			feeds[i].Articles = feeds[i].Articles[1 : maxFeedArticles+1]
-		}
-	}
-
-	usersWithoutFeed, err := getUsersWithoutFeed(userIDs, feeds)
-	if err != nil {
-		return err
-	}
-
-	for _, userID := range usersWithoutFeed {
-		feed := models.Feed{
-			UserID:   &userID,
-			Articles: []models.FeedFragment{feedFragment},
-		}
-		feeds = append(feeds, feed)
-	}
-
-	collection := r.DBClient.Database("conduit").Collection("feeds")
-	for _, feed := range feeds {
-		filter := bson.M{"_id": feed.UserID}
-		update := bson.M{"$set": feed}
-		opt := options.Update().SetUpsert(true)
-
-		result, err := collection.UpdateOne(ctx, filter, update, opt)
+	update := appendFeedFragmentPipeline(article, viper.GetInt("feed.max.articles"))
+	writes := make([]mongo.WriteModel, 0, len(userIDs))
+	for _, userID := range userIDs {
+		feedID, err := primitive.ObjectIDFromHex(userID)
 		if err != nil {
 			return err
 		}
-		if result.ModifiedCount+result.UpsertedCount != int64(len(userIDs)) {
-			return fmt.Errorf("mismatched result count. modified: %d, upserted: %d, expected: %d", result.ModifiedCount, result.UpsertedCount, len(userIDs))
-		}
-	}
-	return nil
-}
-
-func (r *FeedRepository) listFeedsByID(ctx context.Context, IDs []string) ([]models.Feed, error) {
-	objectIDs := make([]primitive.ObjectID, 0, len(IDs))
-	for _, ID := range IDs {
-		objID, err := primitive.ObjectIDFromHex(ID)
-		if err != nil {
-			return nil, err
-		}
-		objectIDs = append(objectIDs, objID)
+		writes = append(writes, mongo.NewUpdateOneModel().
+			SetFilter(bson.D{{Key: "_id", Value: feedID}}).
+			SetUpdate(update).
+			SetUpsert(true))
 	}
 
-	filter := bson.M{"_id": bson.M{"$in": objectIDs}}
-	results := make([]models.Feed, len(objectIDs))
 	collection := r.DBClient.Database("conduit").Collection("feeds")
+	_, err := collection.BulkWrite(ctx, writes, options.BulkWrite().SetOrdered(false))
+	return err
+}
 
-	cursor, err := collection.Find(ctx, filter, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	if err = cursor.All(ctx, &results); err != nil {
-		return results, err
+// appendFeedFragmentPipeline builds the update that appends the article's fragment to a feed under the next position
+// of its sequence, keeping only the newest maxFeedArticles fragments.
+func appendFeedFragmentPipeline(article *models.Article, maxFeedArticles int) mongo.Pipeline {
+	articleID := article.ID.Hex()
+	articles := bson.D{{Key: "$ifNull", Value: bson.A{"$articles", bson.A{}}}}
+	positioned := bson.D{{Key: "$gt", Value: bson.A{bson.D{{Key: "$ifNull", Value: bson.A{"$sequence", 0}}}, 0}}}
+	delivered := bson.D{{Key: "$in", Value: bson.A{bson.D{{Key: "$literal", Value: articleID}}, "$articles.articleID"}}}
+	fragment := bson.D{
+		{Key: "articleID", Value: bson.D{{Key: "$literal", Value: articleID}}},
+		{Key: "author", Value: bson.D{{Key: "$literal", Value: article.Author}}},
+		{Key: "position", Value: "$sequence"},
+	}
+	return mongo.Pipeline{
+		// Number the fragments of feeds written before fragments had a position, as positionFeedFragments does
+		{{Key: "$set", Value: bson.D{
+			{Key: "articles", Value: bson.D{{Key: "$cond", Value: bson.A{positioned, "$articles", bson.D{{Key: "$map", Value: bson.D{
+				{Key: "input", Value: bson.D{{Key: "$range", Value: bson.A{0, bson.D{{Key: "$size", Value: articles}}}}}},
+				{Key: "as", Value: "index"},
+				{Key: "in", Value: bson.D{{Key: "$mergeObjects", Value: bson.A{
+					bson.D{{Key: "$arrayElemAt", Value: bson.A{"$articles", "$$index"}}},
+					bson.D{{Key: "position", Value: bson.D{{Key: "$add", Value: bson.A{"$$index", 1}}}}},
+				}}}},
+			}}}}}}},
+			{Key: "sequence", Value: bson.D{{Key: "$cond", Value: bson.A{positioned, "$sequence", bson.D{{Key: "$size", Value: articles}}}}}},
+		}}},
+		{{Key: "$set", Value: bson.D{
+			{Key: "sequence", Value: bson.D{{Key: "$cond", Value: bson.A{delivered, "$sequence", bson.D{{Key: "$add", Value: bson.A{"$sequence", 1}}}}}}},
+		}}},
+		{{Key: "$set", Value: bson.D{
+			{Key: "articles", Value: bson.D{{Key: "$cond", Value: bson.A{delivered, "$articles", bson.D{{Key: "$slice", Value: bson.A{
+				bson.D{{Key: "$concatArrays", Value: bson.A{"$articles", bson.A{fragment}}}},
+				-maxFeedArticles,
+			}}}}}}},
+		}}},
 	}
-
-	return results, nil
 }
 
-func getUsersWithoutFeed(IDs []string, feeds []models.Feed) ([]primitive.ObjectID, error) {
-	usersWithoutFeed := []primitive.ObjectID{}
-
-	feedIdMap := make(map[string]struct{})
-	for _, feed := range feeds {
-		feedIdMap[feed.UserID.Hex()] = struct{}{}
+// positionFeedFragments numbers the fragments of feeds written before fragments had a position, keeping their
+// order, so they can be paginated alongside the ones appended afterwards.
+func positionFeedFragments(feed *models.Feed) {
+	if feed.Sequence > 0 {
+		return
 	}
-
-	for _, ID := range IDs {
-		objID, err := primitive.ObjectIDFromHex(ID)
-		if err != nil {
-			return nil, err
-		}
-		if _, ok := feedIdMap[ID]; !ok {
-			usersWithoutFeed = append(usersWithoutFeed, objID)
-		}
+	for i := range feed.Articles {
+		feed.Articles[i].Position = int64(i + 1)
 	}
-
-	return usersWithoutFeed, nil
+	feed.Sequence = int64(len(feed.Articles))
 }
 
-func assembleFeedFragmentFromArticle(article *models.Article) models.FeedFragment {
-	articleID := article.ID.Hex()
-	feedFragment := models.FeedFragment{
-		ArticleID: &articleID,
-		Author:    article.Author,
-	}
-	return feedFragment
+// RemoveArticleFromFeeds removes an article's fragment from every feed it was delivered to.
+//
+// The article parameter represents the ID of the article.
+func (r *FeedRepository) RemoveArticleFromFeeds(ctx context.Context, article string) error {
+	filter := bson.D{{Key: "articles.articleID", Value: article}}
+	update := bson.D{{Key: "$pull", Value: bson.D{{Key: "articles", Value: bson.D{{Key: "articleID", Value: article}}}}}}
+	collection := r.DBClient.Database("conduit").Collection("feeds")
+	_, err := collection.UpdateMany(ctx, filter, update)
+	return err
 }
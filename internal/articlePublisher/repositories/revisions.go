@@ -0,0 +1,137 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/ravilock/goduit/internal/app"
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// writeRevisionAttempts bounds how many times a revision number is recomputed when concurrent updates race for it.
+const writeRevisionAttempts = 5
+
+type RevisionRepository struct {
+	DBClient *mongo.Client
+}
+
+func NewRevisionRepository(client *mongo.Client) *RevisionRepository {
+	return &RevisionRepository{client}
+}
+
+// WriteRevision appends a revision to the end of an article's history, assigning it the next revision number.
+//
+// The revision parameter represents the revision to be written, its Article field must be set.
+func (r *RevisionRepository) WriteRevision(ctx context.Context, revision *models.Revision) error {
+	if revision.CreatedAt == nil {
+		now := time.Now().UTC().Truncate(time.Millisecond)
+		revision.CreatedAt = &now
+	}
+	collection := r.DBClient.Database("conduit").Collection("revisions")
+	for range writeRevisionAttempts {
+		latest, err := r.latestRevisionNumber(ctx, *revision.Article)
+		if err != nil {
+			return err
+		}
+		number := latest + 1
+		revision.Number = &number
+		result, err := collection.InsertOne(ctx, revision)
+		if err != nil {
+			if mongo.IsDuplicateKeyError(err) {
+				continue
+			}
+			return err
+		}
+		newId, ok := result.InsertedID.(primitive.ObjectID)
+		if !ok {
+			return errors.New("could not convert revision ID")
+		}
+		revision.ID = &newId
+		return nil
+	}
+	return app.ConflictError("revisions")
+}
+
+// HasRevisions reports whether an article has any revision recorded.
+//
+// The article parameter represents the ID of the article.
+func (r *RevisionRepository) HasRevisions(ctx context.Context, article string) (bool, error) {
+	latest, err := r.latestRevisionNumber(ctx, article)
+	if err != nil {
+		return false, err
+	}
+	return latest > 0, nil
+}
+
+// ListRevisions lists an article's revisions, newest first. Returns []*models.Revision.
+//
+// The article parameter represents the ID of the article.
+//
+// The limit parameter represents the max amount of revisions to be returned.
+//
+// The offset parameter represents how many revisions to skip.
+func (r *RevisionRepository) ListRevisions(ctx context.Context, article string, limit, offset int64) ([]*models.Revision, error) {
+	filter := bson.D{{Key: "article", Value: article}}
+	opt := options.Find().SetLimit(limit).SetSkip(offset).SetSort(bson.D{{Key: "number", Value: -1}})
+	collection := r.DBClient.Database("conduit").Collection("revisions")
+	results := []*models.Revision{}
+	cursor, err := collection.Find(ctx, filter, opt)
+	if err != nil {
+		return results, err
+	}
+	if err = cursor.All(ctx, &results); err != nil {
+		return results, err
+	}
+	return results, nil
+}
+
+// GetRevision gets a single revision of an article by its number. Returns *models.Revision.
+//
+// The article parameter represents the ID of the article.
+//
+// The number parameter represents the number of the revision.
+func (r *RevisionRepository) GetRevision(ctx context.Context, article string, number int64) (*models.Revision, error) {
+	var revision *models.Revision
+	filter := bson.D{
+		{Key: "article", Value: article},
+		{Key: "number", Value: number},
+	}
+	collection := r.DBClient.Database("conduit").Collection("revisions")
+	if err := collection.FindOne(ctx, filter).Decode(&revision); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, app.RevisionNotFoundError(article, strconv.FormatInt(number, 10), err)
+		}
+		return nil, err
+	}
+	return revision, nil
+}
+
+func (r *RevisionRepository) latestRevisionNumber(ctx context.Context, article string) (int64, error) {
+	var revision *models.Revision
+	filter := bson.D{{Key: "article", Value: article}}
+	opt := options.FindOne().SetSort(bson.D{{Key: "number", Value: -1}}).SetProjection(bson.D{{Key: "number", Value: 1}})
+	collection := r.DBClient.Database("conduit").Collection("revisions")
+	if err := collection.FindOne(ctx, filter, opt).Decode(&revision); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return *revision.Number, nil
+}
+
+// DeleteRevisions permanently deletes an article's whole revision history.
+//
+// The article parameter represents the ID of the article.
+func (r *RevisionRepository) DeleteRevisions(ctx context.Context, article string) error {
+	filter := bson.D{{Key: "article", Value: article}}
+	collection := r.DBClient.Database("conduit").Collection("revisions")
+	_, err := collection.DeleteMany(ctx, filter)
+	return err
+}
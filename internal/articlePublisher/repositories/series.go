@@ -0,0 +1,160 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ravilock/goduit/internal/app"
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type SeriesRepository struct {
+	DBClient *mongo.Client
+}
+
+func NewSeriesRepository(client *mongo.Client) *SeriesRepository {
+	return &SeriesRepository{client}
+}
+
+// WriteSeries registers a new series. Slugs are unique across series.
+func (r *SeriesRepository) WriteSeries(ctx context.Context, series *models.Series) error {
+	now := time.Now().UTC().Truncate(time.Millisecond)
+	series.CreatedAt = &now
+	series.UpdatedAt = &now
+	collection := r.DBClient.Database("conduit").Collection("series")
+	if _, err := collection.InsertOne(ctx, series); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return app.ConflictError("series")
+		}
+		return err
+	}
+	return nil
+}
+
+// GetSeriesBySlug gets a series by its slug. Returns *models.Series.
+func (r *SeriesRepository) GetSeriesBySlug(ctx context.Context, slug string) (*models.Series, error) {
+	var series *models.Series
+	filter := bson.D{{Key: "slug", Value: slug}}
+	collection := r.DBClient.Database("conduit").Collection("series")
+	if err := collection.FindOne(ctx, filter).Decode(&series); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, app.SeriesNotFoundError(slug, err)
+		}
+		return nil, err
+	}
+	return series, nil
+}
+
+// GetSeriesByArticle gets the series an article belongs to. Returns *models.Series.
+//
+// The article parameter represents the ID of the article.
+func (r *SeriesRepository) GetSeriesByArticle(ctx context.Context, article string) (*models.Series, error) {
+	var series *models.Series
+	filter := bson.D{{Key: "articles", Value: article}}
+	collection := r.DBClient.Database("conduit").Collection("series")
+	if err := collection.FindOne(ctx, filter).Decode(&series); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, app.SeriesNotFoundError(article, err)
+		}
+		return nil, err
+	}
+	return series, nil
+}
+
+// ListSeries lists series, most recent first.
+//
+// The owner parameter represents the ID of the profile owning the series, an empty owner lists every series.
+func (r *SeriesRepository) ListSeries(ctx context.Context, owner string, limit, offset int64) ([]*models.Series, error) {
+	filter := bson.D{}
+	if owner != "" {
+		filter = append(filter, bson.E{Key: "owner", Value: owner})
+	}
+	opt := options.Find().SetLimit(limit).SetSkip(offset).SetSort(bson.D{{Key: "createdAt", Value: -1}})
+	collection := r.DBClient.Database("conduit").Collection("series")
+	results := []*models.Series{}
+	cursor, err := collection.Find(ctx, filter, opt)
+	if err != nil {
+		return results, err
+	}
+	if err = cursor.All(ctx, &results); err != nil {
+		return results, err
+	}
+	return results, nil
+}
+
+// UpdateSeries updates the title, slug, description and articles of a series. Fields left nil are kept as they are,
+// while an empty list of articles empties the series. Returns the updated *models.Series.
+//
+// The ID parameter represents the ID of the series.
+func (r *SeriesRepository) UpdateSeries(ctx context.Context, ID string, series *models.Series) (*models.Series, error) {
+	seriesID, err := primitive.ObjectIDFromHex(ID)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse ID: %s into ObjectID: %w", ID, err)
+	}
+	now := time.Now().UTC().Truncate(time.Millisecond)
+	set := bson.D{{Key: "updatedAt", Value: now}}
+	if series.Title != nil {
+		set = append(set, bson.E{Key: "title", Value: series.Title})
+	}
+	if series.Slug != nil {
+		set = append(set, bson.E{Key: "slug", Value: series.Slug})
+	}
+	if series.Description != nil {
+		set = append(set, bson.E{Key: "description", Value: series.Description})
+	}
+	if series.Articles != nil {
+		set = append(set, bson.E{Key: "articles", Value: series.Articles})
+	}
+	filter := bson.D{{Key: "_id", Value: seriesID}}
+	update := bson.D{{Key: "$set", Value: set}}
+	opt := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	collection := r.DBClient.Database("conduit").Collection("series")
+	var updatedSeries *models.Series
+	if err := collection.FindOneAndUpdate(ctx, filter, update, opt).Decode(&updatedSeries); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return nil, app.ConflictError("series")
+		}
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, app.SeriesNotFoundError(ID, err)
+		}
+		return nil, err
+	}
+	return updatedSeries, nil
+}
+
+// DeleteSeries deletes a series, leaving its articles untouched.
+//
+// The ID parameter represents the ID of the series.
+func (r *SeriesRepository) DeleteSeries(ctx context.Context, ID string) error {
+	seriesID, err := primitive.ObjectIDFromHex(ID)
+	if err != nil {
+		return fmt.Errorf("could not parse ID: %s into ObjectID: %w", ID, err)
+	}
+	filter := bson.D{{Key: "_id", Value: seriesID}}
+	collection := r.DBClient.Database("conduit").Collection("series")
+	result, err := collection.DeleteOne(ctx, filter)
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return app.SeriesNotFoundError(ID, nil)
+	}
+	return nil
+}
+
+// RemoveArticleFromSeries removes an article from the series it belongs to.
+//
+// The article parameter represents the ID of the article.
+func (r *SeriesRepository) RemoveArticleFromSeries(ctx context.Context, article string) error {
+	filter := bson.D{{Key: "articles", Value: article}}
+	update := bson.D{{Key: "$pull", Value: bson.D{{Key: "articles", Value: article}}}}
+	collection := r.DBClient.Database("conduit").Collection("series")
+	_, err := collection.UpdateMany(ctx, filter, update)
+	return err
+}
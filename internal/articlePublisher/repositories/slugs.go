@@ -0,0 +1,85 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/ravilock/goduit/internal/app"
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type SlugRepository struct {
+	DBClient *mongo.Client
+}
+
+func NewSlugRepository(client *mongo.Client) *SlugRepository {
+	return &SlugRepository{client}
+}
+
+// ReserveSlug reserves a slug for an article. Reserving a slug the article already holds is a no-op.
+// Returns whether the reservation was created by this call.
+//
+// The slug parameter represents the slug to be reserved.
+//
+// The article parameter represents the ID of the article holding the slug.
+func (r *SlugRepository) ReserveSlug(ctx context.Context, slug, article string) (bool, error) {
+	now := time.Now().UTC().Truncate(time.Millisecond)
+	filter := bson.D{
+		{Key: "_id", Value: slug},
+		{Key: "article", Value: article},
+	}
+	update := bson.D{{Key: "$setOnInsert", Value: bson.D{{Key: "createdAt", Value: now}}}}
+	collection := r.DBClient.Database("conduit").Collection("slugs")
+	result, err := collection.UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return false, app.ConflictError("slugs")
+		}
+		return false, err
+	}
+	return result.UpsertedCount == 1, nil
+}
+
+// ReleaseSlug frees a slug held by an article.
+//
+// The slug parameter represents the slug to be released.
+//
+// The article parameter represents the ID of the article holding the slug.
+func (r *SlugRepository) ReleaseSlug(ctx context.Context, slug, article string) error {
+	filter := bson.D{
+		{Key: "_id", Value: slug},
+		{Key: "article", Value: article},
+	}
+	collection := r.DBClient.Database("conduit").Collection("slugs")
+	_, err := collection.DeleteOne(ctx, filter)
+	return err
+}
+
+// GetArticleIDBySlug finds which article holds a slug. Returns the article's ID.
+//
+// The slug parameter represents a current or previous slug of the article.
+func (r *SlugRepository) GetArticleIDBySlug(ctx context.Context, slug string) (string, error) {
+	var reservation *models.Slug
+	filter := bson.D{{Key: "_id", Value: slug}}
+	collection := r.DBClient.Database("conduit").Collection("slugs")
+	if err := collection.FindOne(ctx, filter).Decode(&reservation); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return "", app.ArticleNotFoundError(slug, err)
+		}
+		return "", err
+	}
+	return *reservation.Article, nil
+}
+
+// ReleaseArticleSlugs frees every slug an article holds, current and previous ones.
+//
+// The article parameter represents the ID of the article holding the slugs.
+func (r *SlugRepository) ReleaseArticleSlugs(ctx context.Context, article string) error {
+	filter := bson.D{{Key: "article", Value: article}}
+	collection := r.DBClient.Database("conduit").Collection("slugs")
+	_, err := collection.DeleteMany(ctx, filter)
+	return err
+}
@@ -0,0 +1,172 @@
+package repositories
+
+import (
+	"context"
+	"regexp"
+	"time"
+
+	"github.com/ravilock/goduit/internal/app"
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type TagRepository struct {
+	DBClient *mongo.Client
+}
+
+func NewTagRepository(client *mongo.Client) *TagRepository {
+	return &TagRepository{client}
+}
+
+// UpdateTagCounts atomically adds delta to the usage count of each of the given tags.
+// Tags whose count drops to zero are removed from the catalogue.
+func (r *TagRepository) UpdateTagCounts(ctx context.Context, tags []string, delta int64) error {
+	if len(tags) == 0 || delta == 0 {
+		return nil
+	}
+	collection := r.DBClient.Database("conduit").Collection("tags")
+	writes := make([]mongo.WriteModel, 0, len(tags))
+	for _, tag := range tags {
+		writes = append(writes, mongo.NewUpdateOneModel().
+			SetFilter(bson.D{{Key: "_id", Value: tag}}).
+			SetUpdate(bson.D{{Key: "$inc", Value: bson.D{{Key: "count", Value: delta}}}}).
+			SetUpsert(delta > 0))
+	}
+	if _, err := collection.BulkWrite(ctx, writes, options.BulkWrite().SetOrdered(false)); err != nil {
+		return err
+	}
+	if delta > 0 {
+		return nil
+	}
+	filter := bson.D{
+		{Key: "_id", Value: bson.D{{Key: "$in", Value: tags}}},
+		{Key: "count", Value: bson.D{{Key: "$lte", Value: 0}}},
+	}
+	_, err := collection.DeleteMany(ctx, filter)
+	return err
+}
+
+// ListTags lists the most used tags, optionally restricted to the ones starting with prefix.
+func (r *TagRepository) ListTags(ctx context.Context, prefix string, limit int64) ([]*models.Tag, error) {
+	filter := bson.D{}
+	if prefix != "" {
+		filter = append(filter, bson.E{Key: "_id", Value: primitive.Regex{Pattern: "^" + regexp.QuoteMeta(prefix)}})
+	}
+	opt := options.Find().SetLimit(limit).SetSort(bson.D{{Key: "count", Value: -1}, {Key: "_id", Value: 1}})
+	collection := r.DBClient.Database("conduit").Collection("tags")
+	results := []*models.Tag{}
+	cursor, err := collection.Find(ctx, filter, opt)
+	if err != nil {
+		return results, err
+	}
+	if err = cursor.All(ctx, &results); err != nil {
+		return results, err
+	}
+	return results, nil
+}
+
+// SetTagCount overwrites the usage count of a tag, removing it from the catalogue when no article uses it anymore.
+func (r *TagRepository) SetTagCount(ctx context.Context, tag string, count int64) error {
+	collection := r.DBClient.Database("conduit").Collection("tags")
+	filter := bson.D{{Key: "_id", Value: tag}}
+	if count <= 0 {
+		_, err := collection.DeleteOne(ctx, filter)
+		return err
+	}
+	update := bson.D{{Key: "$set", Value: bson.D{{Key: "count", Value: count}}}}
+	_, err := collection.UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
+	return err
+}
+
+// ResolveTags replaces the aliases among tags by the canonical tag they point to, dropping the tags that end up repeated.
+// Tags are expected to already be in their canonical form.
+func (r *TagRepository) ResolveTags(ctx context.Context, tags []string) ([]string, error) {
+	if len(tags) == 0 {
+		return tags, nil
+	}
+	filter := bson.D{{Key: "_id", Value: bson.D{{Key: "$in", Value: tags}}}}
+	collection := r.DBClient.Database("conduit").Collection("tagAliases")
+	cursor, err := collection.Find(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	aliases := []*models.TagAlias{}
+	if err := cursor.All(ctx, &aliases); err != nil {
+		return nil, err
+	}
+	canonicalTags := make(map[string]string, len(aliases))
+	for _, alias := range aliases {
+		canonicalTags[*alias.Name] = *alias.Tag
+	}
+	resolvedTags := make([]string, 0, len(tags))
+	seenTags := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		if canonicalTag, ok := canonicalTags[tag]; ok {
+			tag = canonicalTag
+		}
+		if seenTags[tag] {
+			continue
+		}
+		seenTags[tag] = true
+		resolvedTags = append(resolvedTags, tag)
+	}
+	return resolvedTags, nil
+}
+
+// AliasTag makes alias resolve to tag, along with every alias that used to resolve to alias itself.
+//
+// The alias parameter represents the variant being folded into tag.
+//
+// The tag parameter represents the canonical tag, which must not be an alias itself.
+func (r *TagRepository) AliasTag(ctx context.Context, alias, tag string) error {
+	collection := r.DBClient.Database("conduit").Collection("tagAliases")
+	filter := bson.D{{Key: "tag", Value: alias}}
+	update := bson.D{{Key: "$set", Value: bson.D{{Key: "tag", Value: tag}}}}
+	if _, err := collection.UpdateMany(ctx, filter, update); err != nil {
+		return err
+	}
+	now := time.Now().UTC().Truncate(time.Millisecond)
+	filter = bson.D{{Key: "_id", Value: alias}}
+	update = bson.D{
+		{Key: "$set", Value: bson.D{{Key: "tag", Value: tag}}},
+		{Key: "$setOnInsert", Value: bson.D{{Key: "createdAt", Value: now}}},
+	}
+	_, err := collection.UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
+	return err
+}
+
+// RemoveTagAlias stops alias from resolving to tag. Articles already rewritten to tag keep it.
+func (r *TagRepository) RemoveTagAlias(ctx context.Context, tag, alias string) error {
+	filter := bson.D{
+		{Key: "_id", Value: alias},
+		{Key: "tag", Value: tag},
+	}
+	collection := r.DBClient.Database("conduit").Collection("tagAliases")
+	result, err := collection.DeleteOne(ctx, filter)
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return app.TagAliasNotFoundError(alias, nil)
+	}
+	return nil
+}
+
+// ListTagAliases lists the aliases resolving to tag, in alphabetical order.
+func (r *TagRepository) ListTagAliases(ctx context.Context, tag string) ([]*models.TagAlias, error) {
+	filter := bson.D{{Key: "tag", Value: tag}}
+	opt := options.Find().SetSort(bson.D{{Key: "_id", Value: 1}})
+	collection := r.DBClient.Database("conduit").Collection("tagAliases")
+	results := []*models.TagAlias{}
+	cursor, err := collection.Find(ctx, filter, opt)
+	if err != nil {
+		return results, err
+	}
+	if err = cursor.All(ctx, &results); err != nil {
+		return results, err
+	}
+	return results, nil
+}
@@ -0,0 +1,74 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type TrendingRepository struct {
+	DBClient *mongo.Client
+}
+
+func NewTrendingRepository(client *mongo.Client) *TrendingRepository {
+	return &TrendingRepository{client}
+}
+
+// ReplaceTrendingArticles replaces the trending articles of a window with freshly computed ones.
+//
+// The new scores are written before the old ones are removed, so the window is never seen empty.
+func (r *TrendingRepository) ReplaceTrendingArticles(ctx context.Context, window string, articles []*models.TrendingArticle) error {
+	now := time.Now().UTC().Truncate(time.Millisecond)
+	collection := r.DBClient.Database("conduit").Collection("trending")
+	if len(articles) > 0 {
+		writes := make([]mongo.WriteModel, 0, len(articles))
+		for _, article := range articles {
+			article.Window = &window
+			article.ComputedAt = &now
+			writes = append(writes, mongo.NewReplaceOneModel().
+				SetFilter(bson.D{
+					{Key: "window", Value: window},
+					{Key: "article", Value: article.Article},
+				}).
+				SetReplacement(article).
+				SetUpsert(true))
+		}
+		if _, err := collection.BulkWrite(ctx, writes, options.BulkWrite().SetOrdered(false)); err != nil {
+			return err
+		}
+	}
+	filter := bson.D{
+		{Key: "window", Value: window},
+		{Key: "computedAt", Value: bson.D{{Key: "$lt", Value: now}}},
+	}
+	_, err := collection.DeleteMany(ctx, filter)
+	return err
+}
+
+// ListTrendingArticles lists the highest scored articles of a window, optionally ranked only among the ones tagged with tag.
+//
+// Each tag's highest scored articles are kept alongside the overall ones, so articles with less common tags are listed
+// within their tag even when they do not trend overall. Without a tag, only the overall ones are listed.
+func (r *TrendingRepository) ListTrendingArticles(ctx context.Context, window, tag string, limit, offset int64) ([]*models.TrendingArticle, error) {
+	filter := bson.D{{Key: "window", Value: window}}
+	if tag != "" {
+		filter = append(filter, bson.E{Key: "tagList", Value: tag})
+	} else {
+		filter = append(filter, bson.E{Key: "overall", Value: true})
+	}
+	opt := options.Find().SetLimit(limit).SetSkip(offset).SetSort(bson.D{{Key: "score", Value: -1}, {Key: "article", Value: -1}})
+	collection := r.DBClient.Database("conduit").Collection("trending")
+	results := []*models.TrendingArticle{}
+	cursor, err := collection.Find(ctx, filter, opt)
+	if err != nil {
+		return results, err
+	}
+	if err = cursor.All(ctx, &results); err != nil {
+		return results, err
+	}
+	return results, nil
+}
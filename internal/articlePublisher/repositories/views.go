@@ -0,0 +1,146 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type ViewRepository struct {
+	DBClient *mongo.Client
+}
+
+func NewViewRepository(client *mongo.Client) *ViewRepository {
+	return &ViewRepository{client}
+}
+
+// RecordViews adds a batch of view counts to the daily view buckets of their articles, creating missing buckets.
+func (r *ViewRepository) RecordViews(ctx context.Context, views []*models.ArticleViews) error {
+	if len(views) == 0 {
+		return nil
+	}
+	collection := r.DBClient.Database("conduit").Collection("views")
+	writes := make([]mongo.WriteModel, 0, len(views))
+	for _, view := range views {
+		writes = append(writes, mongo.NewUpdateOneModel().
+			SetFilter(bson.D{
+				{Key: "article", Value: view.Article},
+				{Key: "day", Value: view.Day},
+			}).
+			SetUpdate(bson.D{{Key: "$inc", Value: bson.D{{Key: "count", Value: view.Count}}}}).
+			SetUpsert(true))
+	}
+	_, err := collection.BulkWrite(ctx, writes, options.BulkWrite().SetOrdered(false))
+	return err
+}
+
+// CountDailyViews lists the daily view counts of articles, starting from the day of since.
+//
+// The articles parameter represents the IDs of the articles to count views of.
+func (r *ViewRepository) CountDailyViews(ctx context.Context, articles []string, since time.Time) ([]*models.DailyCount, error) {
+	filter := bson.D{
+		{Key: "article", Value: bson.D{{Key: "$in", Value: articles}}},
+		{Key: "day", Value: bson.D{{Key: "$gte", Value: models.Day(since)}}},
+	}
+	collection := r.DBClient.Database("conduit").Collection("views")
+	results := []*models.DailyCount{}
+	cursor, err := collection.Find(ctx, filter)
+	if err != nil {
+		return results, err
+	}
+	if err = cursor.All(ctx, &results); err != nil {
+		return results, err
+	}
+	return results, nil
+}
+
+// DeleteArticleViews removes every view count of an article.
+//
+// The article parameter represents the ID of the viewed article.
+func (r *ViewRepository) DeleteArticleViews(ctx context.Context, article string) error {
+	filter := bson.D{{Key: "article", Value: article}}
+	collection := r.DBClient.Database("conduit").Collection("views")
+	_, err := collection.DeleteMany(ctx, filter)
+	return err
+}
+
+// ScoreViews sums the views of each article since a given day, each view weighing less the older it is.
+// Views are dated by the start of their day.
+//
+// The halfLife parameter represents how long it takes for a view to weigh half as much.
+func (r *ViewRepository) ScoreViews(ctx context.Context, since, now time.Time, halfLife time.Duration) ([]*models.ArticleScore, error) {
+	collection := r.DBClient.Database("conduit").Collection("views")
+	return scoreDecayed(ctx, collection, "day", "$count", models.Day(since), now, halfLife)
+}
+
+// scoreDecayed sums a weight per article over the documents of a collection dated since a given time.
+// Each weight is halved for every halfLife between its document's date and now.
+func scoreDecayed(ctx context.Context, collection *mongo.Collection, dateField string, weight any, since, now time.Time, halfLife time.Duration) ([]*models.ArticleScore, error) {
+	decay := bson.D{{Key: "$pow", Value: bson.A{
+		0.5,
+		bson.D{{Key: "$divide", Value: bson.A{
+			bson.D{{Key: "$subtract", Value: bson.A{now, "$" + dateField}}},
+			halfLife.Milliseconds(),
+		}}},
+	}}}
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.D{{Key: dateField, Value: bson.D{{Key: "$gte", Value: since}}}}}},
+		{{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: "$article"},
+			{Key: "score", Value: bson.D{{Key: "$sum", Value: bson.D{{Key: "$multiply", Value: bson.A{weight, decay}}}}}},
+		}}},
+		{{Key: "$project", Value: bson.D{
+			{Key: "_id", Value: 0},
+			{Key: "article", Value: "$_id"},
+			{Key: "score", Value: 1},
+		}}},
+	}
+	results := []*models.ArticleScore{}
+	cursor, err := collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return results, err
+	}
+	if err = cursor.All(ctx, &results); err != nil {
+		return results, err
+	}
+	return results, nil
+}
+
+// countDaily groups the documents of a collection created since a given day by article and day of creation.
+func countDaily(ctx context.Context, collection *mongo.Collection, articles []string, since time.Time) ([]*models.DailyCount, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.D{
+			{Key: "article", Value: bson.D{{Key: "$in", Value: articles}}},
+			{Key: "createdAt", Value: bson.D{{Key: "$gte", Value: models.Day(since)}}},
+		}}},
+		{{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: bson.D{
+				{Key: "article", Value: "$article"},
+				{Key: "day", Value: bson.D{{Key: "$dateTrunc", Value: bson.D{
+					{Key: "date", Value: "$createdAt"},
+					{Key: "unit", Value: "day"},
+				}}}},
+			}},
+			{Key: "count", Value: bson.D{{Key: "$sum", Value: 1}}},
+		}}},
+		{{Key: "$project", Value: bson.D{
+			{Key: "_id", Value: 0},
+			{Key: "article", Value: "$_id.article"},
+			{Key: "day", Value: "$_id.day"},
+			{Key: "count", Value: 1},
+		}}},
+	}
+	results := []*models.DailyCount{}
+	cursor, err := collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return results, err
+	}
+	if err = cursor.All(ctx, &results); err != nil {
+		return results, err
+	}
+	return results, nil
+}
@@ -0,0 +1,39 @@
+package requests
+
+import (
+	"errors"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/ravilock/goduit/api/validators"
+)
+
+type ArticleAnalyticsRequest struct {
+	Days       int `query:"days" validate:"min=1,max=90"`
+	Pagination ArticleAnalyticsPagination
+}
+
+type ArticleAnalyticsPagination struct {
+	Limit  int `query:"limit" validate:"min=1,max=30"`
+	Offset int `query:"offset" validate:"min=0"`
+}
+
+func NewArticleAnalyticsRequest() *ArticleAnalyticsRequest {
+	return &ArticleAnalyticsRequest{
+		Days: 30,
+		Pagination: ArticleAnalyticsPagination{
+			Limit: 20,
+		},
+	}
+}
+
+func (r *ArticleAnalyticsRequest) Validate() error {
+	if err := validators.Validate.Struct(r); err != nil {
+		if validationErrors := new(validator.ValidationErrors); errors.As(err, validationErrors) {
+			for _, validationError := range *validationErrors {
+				return validators.ToHTTP(validationError)
+			}
+		}
+		return err
+	}
+	return nil
+}
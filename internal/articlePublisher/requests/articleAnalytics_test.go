@@ -0,0 +1,56 @@
+package requests
+
+import (
+	"testing"
+
+	"github.com/ravilock/goduit/api"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArticleAnalytics(t *testing.T) {
+	t.Run("Valid request should return errors", func(t *testing.T) {
+		request := generateArticleAnalyticsRequest()
+		err := request.Validate()
+		require.NoError(t, err)
+	})
+	t.Run("Days should have min value 1", func(t *testing.T) {
+		request := generateArticleAnalyticsRequest()
+		request.Days = 0
+		err := request.Validate()
+		require.ErrorContains(t, err, api.InvalidFieldLimit("Days", "min", "1").Error())
+	})
+	t.Run("Days should have max value 90", func(t *testing.T) {
+		request := generateArticleAnalyticsRequest()
+		request.Days = 91
+		err := request.Validate()
+		require.ErrorContains(t, err, api.InvalidFieldLimit("Days", "max", "90").Error())
+	})
+	t.Run("Limit should have min value 1", func(t *testing.T) {
+		request := generateArticleAnalyticsRequest()
+		request.Pagination.Limit = 0
+		err := request.Validate()
+		require.ErrorContains(t, err, api.InvalidFieldLimit("Limit", "min", "1").Error())
+	})
+	t.Run("Limit should have max value 30", func(t *testing.T) {
+		request := generateArticleAnalyticsRequest()
+		request.Pagination.Limit = 31
+		err := request.Validate()
+		require.ErrorContains(t, err, api.InvalidFieldLimit("Limit", "max", "30").Error())
+	})
+	t.Run("Offset should have min value 0", func(t *testing.T) {
+		request := generateArticleAnalyticsRequest()
+		request.Pagination.Offset = -1
+		err := request.Validate()
+		require.ErrorContains(t, err, api.InvalidFieldLimit("Offset", "min", "0").Error())
+	})
+}
+
+func generateArticleAnalyticsRequest() *ArticleAnalyticsRequest {
+	return &ArticleAnalyticsRequest{
+		Days: 7,
+		Pagination: ArticleAnalyticsPagination{
+			Limit:  20,
+			Offset: 20,
+		},
+	}
+}
@@ -0,0 +1,128 @@
+package requests
+
+import (
+	"errors"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/ravilock/goduit/api"
+	"github.com/ravilock/goduit/api/validators"
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+	"github.com/ravilock/goduit/internal/frontmatter"
+)
+
+type ExportArticlesRequest struct {
+	Format string `query:"format" validate:"required,oneof=zip tar"`
+}
+
+func NewExportArticlesRequest() *ExportArticlesRequest {
+	return &ExportArticlesRequest{
+		Format: "zip",
+	}
+}
+
+func (r *ExportArticlesRequest) Validate() error {
+	if err := validators.Validate.Struct(r); err != nil {
+		if validationErrors := new(validator.ValidationErrors); errors.As(err, validationErrors) {
+			for _, validationError := range *validationErrors {
+				return validators.ToHTTP(validationError)
+			}
+		}
+		return err
+	}
+	return nil
+}
+
+type ImportArticlesRequest struct {
+	DryRun bool `query:"dryRun"`
+}
+
+func (r *ImportArticlesRequest) Validate() error {
+	if err := validators.Validate.Struct(r); err != nil {
+		if validationErrors := new(validator.ValidationErrors); errors.As(err, validationErrors) {
+			for _, validationError := range *validationErrors {
+				return validators.ToHTTP(validationError)
+			}
+		}
+		return err
+	}
+	return nil
+}
+
+// ImportArticleRequest is an article read from a Markdown file with YAML front matter, as written by article exports.
+type ImportArticleRequest struct {
+	Title       string     `yaml:"title"`
+	Description string     `yaml:"description"`
+	TagList     []string   `yaml:"tags"`
+	Status      string     `yaml:"status" validate:"omitempty,oneof=draft scheduled published"`
+	PublishAt   *time.Time `yaml:"publishAt"`
+	CreatedAt   *time.Time `yaml:"createdAt"`
+	PublishedAt *time.Time `yaml:"publishedAt"`
+	Body        string     `yaml:"-"`
+}
+
+// ParseImportArticleRequest reads an article from the front matter and body of a Markdown document.
+func ParseImportArticleRequest(document []byte) (*ImportArticleRequest, error) {
+	request := new(ImportArticleRequest)
+	body, err := frontmatter.Unmarshal(document, request)
+	if err != nil {
+		return nil, api.InvalidFrontMatter(err)
+	}
+	request.Body = body
+	return request, nil
+}
+
+// Model builds the imported article, keeping its original creation and publication dates when the file has them.
+func (r *ImportArticleRequest) Model(authorID string) *models.Article {
+	article := r.writeArticleRequest().Model(authorID)
+	if r.CreatedAt != nil {
+		article.CreatedAt = normalizePublishAt(*r.CreatedAt)
+	}
+	if r.PublishedAt != nil && article.IsPublished() {
+		article.PublishedAt = normalizePublishAt(*r.PublishedAt)
+	}
+	return article
+}
+
+// writeArticleRequest maps the imported article onto the request articles are written with, so both are validated alike.
+func (r *ImportArticleRequest) writeArticleRequest() *WriteArticleRequest {
+	request := &WriteArticleRequest{Article: WriteArticlePayload{
+		Title:       r.Title,
+		Description: r.Description,
+		Body:        r.Body,
+		TagList:     r.TagList,
+		Draft:       r.Status == models.ArticleStatusDraft,
+	}}
+	if r.Status == models.ArticleStatusScheduled {
+		request.Article.PublishAt = r.PublishAt
+	}
+	return request
+}
+
+func validatePastDate(field string, date *time.Time) error {
+	if date != nil && date.After(time.Now()) {
+		return api.InvalidFieldError(field, date.Format(time.RFC3339))
+	}
+	return nil
+}
+
+func (r *ImportArticleRequest) Validate() error {
+	if err := validators.Validate.Struct(r); err != nil {
+		if validationErrors := new(validator.ValidationErrors); errors.As(err, validationErrors) {
+			for _, validationError := range *validationErrors {
+				return validators.ToHTTP(validationError)
+			}
+		}
+		return err
+	}
+	if r.Status == models.ArticleStatusScheduled && r.PublishAt == nil {
+		return api.RequiredFieldError("PublishAt")
+	}
+	if err := validatePastDate("CreatedAt", r.CreatedAt); err != nil {
+		return err
+	}
+	if err := validatePastDate("PublishedAt", r.PublishedAt); err != nil {
+		return err
+	}
+	return r.writeArticleRequest().Validate()
+}
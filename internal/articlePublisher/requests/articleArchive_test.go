@@ -0,0 +1,122 @@
+package requests
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ravilock/goduit/api"
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportArticles(t *testing.T) {
+	t.Run("Valid request should not return errors", func(t *testing.T) {
+		request := NewExportArticlesRequest()
+		err := request.Validate()
+		require.NoError(t, err)
+	})
+	t.Run("Format should be zip or tar", func(t *testing.T) {
+		request := NewExportArticlesRequest()
+		request.Format = "rar"
+		err := request.Validate()
+		require.ErrorContains(t, err, api.InvalidFieldError("Format", "rar").Error())
+	})
+}
+
+func TestImportArticle(t *testing.T) {
+	t.Run("Should parse the front matter and body of a file", func(t *testing.T) {
+		request, err := ParseImportArticleRequest([]byte("---\ntitle: Test Title\ndescription: Test Description\ntags: [golang, testing]\nstatus: draft\ncreatedAt: 2024-03-01T10:30:00Z\n---\n\n# Test Body\n"))
+		require.NoError(t, err)
+		require.Equal(t, "Test Title", request.Title)
+		require.Equal(t, "Test Description", request.Description)
+		require.Equal(t, []string{"golang", "testing"}, request.TagList)
+		require.Equal(t, models.ArticleStatusDraft, request.Status)
+		require.Equal(t, time.Date(2024, time.March, 1, 10, 30, 0, 0, time.UTC), request.CreatedAt.UTC())
+		require.Equal(t, "# Test Body", request.Body)
+	})
+	t.Run("Should fail to parse a file without front matter", func(t *testing.T) {
+		_, err := ParseImportArticleRequest([]byte("# Test Body"))
+		require.ErrorContains(t, err, "Could not read front matter")
+	})
+	t.Run("Valid request should not return errors", func(t *testing.T) {
+		request := generateImportArticleRequest()
+		err := request.Validate()
+		require.NoError(t, err)
+	})
+	t.Run("Title is required", func(t *testing.T) {
+		request := generateImportArticleRequest()
+		request.Title = ""
+		err := request.Validate()
+		require.ErrorContains(t, err, api.RequiredFieldError("Title").Error())
+	})
+	t.Run("Body is required", func(t *testing.T) {
+		request := generateImportArticleRequest()
+		request.Body = ""
+		err := request.Validate()
+		require.ErrorContains(t, err, api.RequiredFieldError("Body").Error())
+	})
+	t.Run("TagList should contain at least 1 tag", func(t *testing.T) {
+		request := generateImportArticleRequest()
+		request.TagList = nil
+		err := request.Validate()
+		require.ErrorContains(t, err, api.InvalidFieldLimit("TagList", "min", "1").Error())
+	})
+	t.Run("Status should be draft, scheduled or published", func(t *testing.T) {
+		request := generateImportArticleRequest()
+		request.Status = "archived"
+		err := request.Validate()
+		require.ErrorContains(t, err, api.InvalidFieldError("Status", "archived").Error())
+	})
+	t.Run("PublishAt is required for scheduled articles", func(t *testing.T) {
+		request := generateImportArticleRequest()
+		request.Status = models.ArticleStatusScheduled
+		err := request.Validate()
+		require.ErrorContains(t, err, api.RequiredFieldError("PublishAt").Error())
+	})
+	t.Run("PublishAt should be in the future", func(t *testing.T) {
+		request := generateImportArticleRequest()
+		request.Status = models.ArticleStatusScheduled
+		publishAt := time.Now().Add(-time.Hour)
+		request.PublishAt = &publishAt
+		err := request.Validate()
+		require.ErrorContains(t, err, api.InvalidFieldError("PublishAt", publishAt.Format(time.RFC3339)).Error())
+	})
+	t.Run("CreatedAt should not be in the future", func(t *testing.T) {
+		request := generateImportArticleRequest()
+		createdAt := time.Now().Add(time.Hour)
+		request.CreatedAt = &createdAt
+		err := request.Validate()
+		require.ErrorContains(t, err, api.InvalidFieldError("CreatedAt", createdAt.Format(time.RFC3339)).Error())
+	})
+	t.Run("Should keep the original dates of published articles", func(t *testing.T) {
+		request := generateImportArticleRequest()
+		createdAt := time.Date(2024, time.March, 1, 10, 30, 0, 0, time.UTC)
+		publishedAt := createdAt.Add(time.Hour)
+		request.CreatedAt = &createdAt
+		request.PublishedAt = &publishedAt
+		article := request.Model("author-id")
+		require.Equal(t, models.ArticleStatusPublished, *article.Status)
+		require.Equal(t, createdAt, *article.CreatedAt)
+		require.Equal(t, publishedAt, *article.PublishedAt)
+		require.Equal(t, "test-title", *article.Slug)
+		require.Equal(t, []string{"test-tag"}, article.TagList)
+	})
+	t.Run("Should not keep the publication date of drafts", func(t *testing.T) {
+		request := generateImportArticleRequest()
+		request.Status = models.ArticleStatusDraft
+		publishedAt := time.Date(2024, time.March, 1, 10, 30, 0, 0, time.UTC)
+		request.PublishedAt = &publishedAt
+		article := request.Model("author-id")
+		require.Equal(t, models.ArticleStatusDraft, *article.Status)
+		require.Nil(t, article.PublishedAt)
+	})
+}
+
+func generateImportArticleRequest() *ImportArticleRequest {
+	return &ImportArticleRequest{
+		Title:       "Test Title",
+		Description: "Test Description",
+		Body:        "Test Body",
+		TagList:     []string{"Test Tag"},
+	}
+}
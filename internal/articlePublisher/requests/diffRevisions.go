@@ -0,0 +1,26 @@
+package requests
+
+import (
+	"errors"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/ravilock/goduit/api/validators"
+)
+
+type DiffRevisionsRequest struct {
+	Slug string `param:"slug" validate:"required,notblank,min=5"`
+	From int64  `query:"from" validate:"required,min=1"`
+	To   int64  `query:"to" validate:"required,min=1"`
+}
+
+func (r *DiffRevisionsRequest) Validate() error {
+	if err := validators.Validate.Struct(r); err != nil {
+		if validationErrors := new(validator.ValidationErrors); errors.As(err, validationErrors) {
+			for _, validationError := range *validationErrors {
+				return validators.ToHTTP(validationError)
+			}
+		}
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+package requests
+
+import (
+	"testing"
+
+	"github.com/ravilock/goduit/api"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffRevisions(t *testing.T) {
+	t.Run("Valid request should not return errors", func(t *testing.T) {
+		request := generateDiffRevisionsRequest()
+		err := request.Validate()
+		require.NoError(t, err)
+	})
+	t.Run("Slug is required", func(t *testing.T) {
+		request := generateDiffRevisionsRequest()
+		request.Slug = ""
+		err := request.Validate()
+		require.ErrorContains(t, err, api.RequiredFieldError("Slug").Error())
+	})
+	t.Run("From is required", func(t *testing.T) {
+		request := generateDiffRevisionsRequest()
+		request.From = 0
+		err := request.Validate()
+		require.ErrorContains(t, err, api.RequiredFieldError("From").Error())
+	})
+	t.Run("From should have min value 1", func(t *testing.T) {
+		request := generateDiffRevisionsRequest()
+		request.From = -1
+		err := request.Validate()
+		require.ErrorContains(t, err, api.InvalidFieldLimit("From", "min", "1").Error())
+	})
+	t.Run("To is required", func(t *testing.T) {
+		request := generateDiffRevisionsRequest()
+		request.To = 0
+		err := request.Validate()
+		require.ErrorContains(t, err, api.RequiredFieldError("To").Error())
+	})
+	t.Run("To should have min value 1", func(t *testing.T) {
+		request := generateDiffRevisionsRequest()
+		request.To = -1
+		err := request.Validate()
+		require.ErrorContains(t, err, api.InvalidFieldLimit("To", "min", "1").Error())
+	})
+}
+
+func generateDiffRevisionsRequest() *DiffRevisionsRequest {
+	return &DiffRevisionsRequest{
+		Slug: "test-slug",
+		From: 1,
+		To:   2,
+	}
+}
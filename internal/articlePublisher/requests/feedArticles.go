@@ -4,7 +4,9 @@ import (
 	"errors"
 
 	"github.com/go-playground/validator/v10"
+	"github.com/ravilock/goduit/api"
 	"github.com/ravilock/goduit/api/validators"
+	"github.com/ravilock/goduit/internal/pagination"
 )
 
 type FeedArticlesRequest struct {
@@ -12,8 +14,9 @@ type FeedArticlesRequest struct {
 }
 
 type FeedArticlesPagination struct {
-	Limit  int `query:"limit" validate:"min=1,max=30"`
-	Offset int `query:"offset" validate:"min=0"`
+	Limit  int    `query:"limit" validate:"min=1,max=30"`
+	Offset int    `query:"offset" validate:"min=0"`
+	Cursor string `query:"cursor" validate:"omitempty,notblank"`
 }
 
 func NewFeedArticlesRequest() *FeedArticlesRequest {
@@ -33,5 +36,14 @@ func (r *FeedArticlesRequest) Validate() error {
 		}
 		return err
 	}
+	if _, err := pagination.DecodePositionCursor(r.Pagination.Cursor); err != nil {
+		return api.InvalidFieldError("Cursor", r.Pagination.Cursor)
+	}
 	return nil
 }
+
+// After returns the feed position of the last article of the previous page, decoded from the pagination cursor.
+func (p *FeedArticlesPagination) After() int64 {
+	position, _ := pagination.DecodePositionCursor(p.Cursor)
+	return position
+}
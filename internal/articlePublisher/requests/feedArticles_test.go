@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/ravilock/goduit/api"
+	"github.com/ravilock/goduit/internal/pagination"
 	"github.com/stretchr/testify/require"
 )
 
@@ -31,6 +32,21 @@ func TestFeedArticles(t *testing.T) {
 		err := request.Validate()
 		require.ErrorContains(t, err, api.InvalidFieldLimit("Offset", "min", "0").Error())
 	})
+	t.Run("Cursor is optional, but should not be blank", func(t *testing.T) {
+		request := generateFeedArticlesRequest()
+		request.Pagination.Cursor = ""
+		err := request.Validate()
+		require.NoError(t, err)
+		request.Pagination.Cursor = " "
+		err = request.Validate()
+		require.ErrorContains(t, err, api.RequiredFieldError("Cursor").Error())
+	})
+	t.Run("Cursor should be a valid pagination cursor", func(t *testing.T) {
+		request := generateFeedArticlesRequest()
+		request.Pagination.Cursor = "not-a-cursor"
+		err := request.Validate()
+		require.ErrorContains(t, err, api.InvalidFieldError("Cursor", "not-a-cursor").Error())
+	})
 }
 
 func generateFeedArticlesRequest() *FeedArticlesRequest {
@@ -38,6 +54,7 @@ func generateFeedArticlesRequest() *FeedArticlesRequest {
 		FeedArticlesPagination{
 			Limit:  20,
 			Offset: 20,
+			Cursor: pagination.EncodePositionCursor(20),
 		},
 	}
 }
@@ -0,0 +1,29 @@
+package requests
+
+import (
+	"errors"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/ravilock/goduit/api/validators"
+)
+
+type InviteCoAuthorRequest struct {
+	Slug     string          `param:"slug" validate:"required,notblank,min=5"`
+	CoAuthor CoAuthorPayload `json:"coAuthor" validate:"required"`
+}
+
+type CoAuthorPayload struct {
+	Username string `json:"username" validate:"required,notblank,min=5,max=255"`
+}
+
+func (r *InviteCoAuthorRequest) Validate() error {
+	if err := validators.Validate.Struct(r); err != nil {
+		if validationErrors := new(validator.ValidationErrors); errors.As(err, validationErrors) {
+			for _, validationError := range *validationErrors {
+				return validators.ToHTTP(validationError)
+			}
+		}
+		return err
+	}
+	return nil
+}
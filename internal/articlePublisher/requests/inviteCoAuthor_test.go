@@ -0,0 +1,53 @@
+package requests
+
+import (
+	"testing"
+
+	"github.com/ravilock/goduit/api"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInviteCoAuthor(t *testing.T) {
+	t.Run("Valid request should not return errors", func(t *testing.T) {
+		request := generateInviteCoAuthorRequest()
+		err := request.Validate()
+		require.NoError(t, err)
+	})
+	t.Run("Slug is required", func(t *testing.T) {
+		request := generateInviteCoAuthorRequest()
+		request.Slug = ""
+		err := request.Validate()
+		require.ErrorContains(t, err, api.RequiredFieldError("Slug").Error())
+	})
+	t.Run("Username is required", func(t *testing.T) {
+		request := generateInviteCoAuthorRequest()
+		request.CoAuthor.Username = ""
+		err := request.Validate()
+		require.ErrorContains(t, err, api.RequiredFieldError("Username").Error())
+	})
+	t.Run("Username should not be blank", func(t *testing.T) {
+		request := generateInviteCoAuthorRequest()
+		request.CoAuthor.Username = " "
+		err := request.Validate()
+		require.ErrorContains(t, err, api.RequiredFieldError("Username").Error())
+	})
+	t.Run("Username should contain at least 5 chars", func(t *testing.T) {
+		request := generateInviteCoAuthorRequest()
+		request.CoAuthor.Username = "1234"
+		err := request.Validate()
+		require.ErrorContains(t, err, api.InvalidFieldLimit("Username", "min", "5").Error())
+	})
+	t.Run("Username should contain at most 255 chars", func(t *testing.T) {
+		request := generateInviteCoAuthorRequest()
+		request.CoAuthor.Username = randomString(256)
+		err := request.Validate()
+		require.ErrorContains(t, err, api.InvalidFieldLimit("Username", "max", "255").Error())
+	})
+}
+
+func generateInviteCoAuthorRequest() *InviteCoAuthorRequest {
+	request := new(InviteCoAuthorRequest)
+	request.Slug = "test-slug"
+	request.CoAuthor.Username = "co-author"
+	return request
+}
@@ -4,7 +4,9 @@ import (
 	"errors"
 
 	"github.com/go-playground/validator/v10"
+	"github.com/ravilock/goduit/api"
 	"github.com/ravilock/goduit/api/validators"
+	"github.com/ravilock/goduit/internal/pagination"
 )
 
 type ListArticlesRequest struct {
@@ -15,12 +17,13 @@ type ListArticlesRequest struct {
 type ListArticlesFilters struct {
 	Tag       string `query:"tag" validate:"omitempty,notblank,min=3,max=30"`
 	Author    string `query:"author" validate:"omitempty,notblank,min=5,max=255"`
-	Favorited string
+	Favorited string `query:"favorited" validate:"omitempty,notblank,min=5,max=255"`
 }
 
 type ListArticlesPagination struct {
-	Limit  int `query:"limit" validate:"min=1,max=30"`
-	Offset int `query:"offset" validate:"min=0"`
+	Limit  int    `query:"limit" validate:"min=1,max=30"`
+	Offset int    `query:"offset" validate:"min=0"`
+	Cursor string `query:"cursor" validate:"omitempty,notblank"`
 }
 
 func NewListArticlesRequest() *ListArticlesRequest {
@@ -41,5 +44,14 @@ func (r *ListArticlesRequest) Validate() error {
 		}
 		return err
 	}
+	if _, err := pagination.DecodeCursor(r.Pagination.Cursor); err != nil {
+		return api.InvalidFieldError("Cursor", r.Pagination.Cursor)
+	}
 	return nil
 }
+
+// After returns the ID of the last article of the previous page, decoded from the pagination cursor.
+func (p *ListArticlesPagination) After() string {
+	ID, _ := pagination.DecodeCursor(p.Cursor)
+	return ID
+}
@@ -4,7 +4,9 @@ import (
 	"testing"
 
 	"github.com/ravilock/goduit/api"
+	"github.com/ravilock/goduit/internal/pagination"
 	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
 func TestListArticles(t *testing.T) {
@@ -55,6 +57,27 @@ func TestListArticles(t *testing.T) {
 		err := request.Validate()
 		require.ErrorContains(t, err, api.InvalidFieldLimit("Author", "max", "255").Error())
 	})
+	t.Run("Favorited is optional, but should not be blank", func(t *testing.T) {
+		request := generateListArticlesRequest()
+		request.Filters.Favorited = ""
+		err := request.Validate()
+		require.NoError(t, err)
+		request.Filters.Favorited = " "
+		err = request.Validate()
+		require.ErrorContains(t, err, api.RequiredFieldError("Favorited").Error())
+	})
+	t.Run("Favorited should contain at least 5 chars", func(t *testing.T) {
+		request := generateListArticlesRequest()
+		request.Filters.Favorited = "1234"
+		err := request.Validate()
+		require.ErrorContains(t, err, api.InvalidFieldLimit("Favorited", "min", "5").Error())
+	})
+	t.Run("Favorited should contain at most 255 chars", func(t *testing.T) {
+		request := generateListArticlesRequest()
+		request.Filters.Favorited = randomString(256)
+		err := request.Validate()
+		require.ErrorContains(t, err, api.InvalidFieldLimit("Favorited", "max", "255").Error())
+	})
 	t.Run("Limit should have min value 1", func(t *testing.T) {
 		request := generateListArticlesRequest()
 		request.Pagination.Limit = 0
@@ -73,6 +96,21 @@ func TestListArticles(t *testing.T) {
 		err := request.Validate()
 		require.ErrorContains(t, err, api.InvalidFieldLimit("Offset", "min", "0").Error())
 	})
+	t.Run("Cursor is optional, but should not be blank", func(t *testing.T) {
+		request := generateListArticlesRequest()
+		request.Pagination.Cursor = ""
+		err := request.Validate()
+		require.NoError(t, err)
+		request.Pagination.Cursor = " "
+		err = request.Validate()
+		require.ErrorContains(t, err, api.RequiredFieldError("Cursor").Error())
+	})
+	t.Run("Cursor should be a valid pagination cursor", func(t *testing.T) {
+		request := generateListArticlesRequest()
+		request.Pagination.Cursor = "not-a-cursor"
+		err := request.Validate()
+		require.ErrorContains(t, err, api.InvalidFieldError("Cursor", "not-a-cursor").Error())
+	})
 }
 
 func generateListArticlesRequest() *ListArticlesRequest {
@@ -80,10 +118,12 @@ func generateListArticlesRequest() *ListArticlesRequest {
 		ListArticlesPagination{
 			Limit:  20,
 			Offset: 20,
+			Cursor: pagination.EncodeCursor(primitive.NewObjectID().Hex()),
 		},
 		ListArticlesFilters{
-			Tag:    "test-tag",
-			Author: "test-ahutor",
+			Tag:       "test-tag",
+			Author:    "test-ahutor",
+			Favorited: "test-favoriter",
 		},
 	}
 }
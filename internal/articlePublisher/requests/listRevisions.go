@@ -0,0 +1,38 @@
+package requests
+
+import (
+	"errors"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/ravilock/goduit/api/validators"
+)
+
+type ListRevisionsRequest struct {
+	Slug       string `param:"slug" validate:"required,notblank,min=5"`
+	Pagination ListRevisionsPagination
+}
+
+type ListRevisionsPagination struct {
+	Limit  int `query:"limit" validate:"min=1,max=30"`
+	Offset int `query:"offset" validate:"min=0"`
+}
+
+func NewListRevisionsRequest() *ListRevisionsRequest {
+	return &ListRevisionsRequest{
+		Pagination: ListRevisionsPagination{
+			Limit: 20,
+		},
+	}
+}
+
+func (r *ListRevisionsRequest) Validate() error {
+	if err := validators.Validate.Struct(r); err != nil {
+		if validationErrors := new(validator.ValidationErrors); errors.As(err, validationErrors) {
+			for _, validationError := range *validationErrors {
+				return validators.ToHTTP(validationError)
+			}
+		}
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package requests
+
+import (
+	"testing"
+
+	"github.com/ravilock/goduit/api"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListRevisions(t *testing.T) {
+	t.Run("Valid request should not return errors", func(t *testing.T) {
+		request := generateListRevisionsRequest()
+		err := request.Validate()
+		require.NoError(t, err)
+	})
+	t.Run("Slug is required", func(t *testing.T) {
+		request := generateListRevisionsRequest()
+		request.Slug = ""
+		err := request.Validate()
+		require.ErrorContains(t, err, api.RequiredFieldError("Slug").Error())
+	})
+	t.Run("Limit should have min value 1", func(t *testing.T) {
+		request := generateListRevisionsRequest()
+		request.Pagination.Limit = 0
+		err := request.Validate()
+		require.ErrorContains(t, err, api.InvalidFieldLimit("Limit", "min", "1").Error())
+	})
+	t.Run("Limit should have max value 30", func(t *testing.T) {
+		request := generateListRevisionsRequest()
+		request.Pagination.Limit = 31
+		err := request.Validate()
+		require.ErrorContains(t, err, api.InvalidFieldLimit("Limit", "max", "30").Error())
+	})
+	t.Run("Offset should have min value 0", func(t *testing.T) {
+		request := generateListRevisionsRequest()
+		request.Pagination.Offset = -1
+		err := request.Validate()
+		require.ErrorContains(t, err, api.InvalidFieldLimit("Offset", "min", "0").Error())
+	})
+}
+
+func generateListRevisionsRequest() *ListRevisionsRequest {
+	return &ListRevisionsRequest{
+		Slug: "test-slug",
+		Pagination: ListRevisionsPagination{
+			Limit:  20,
+			Offset: 20,
+		},
+	}
+}
@@ -0,0 +1,43 @@
+package requests
+
+import (
+	"errors"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/ravilock/goduit/api/validators"
+)
+
+type ListSeriesRequest struct {
+	Pagination ListSeriesPagination
+	Filters    ListSeriesFilters
+}
+
+type ListSeriesFilters struct {
+	Owner string `query:"owner" validate:"omitempty,notblank,min=5,max=255"`
+}
+
+type ListSeriesPagination struct {
+	Limit  int `query:"limit" validate:"min=1,max=30"`
+	Offset int `query:"offset" validate:"min=0"`
+}
+
+func NewListSeriesRequest() *ListSeriesRequest {
+	return &ListSeriesRequest{
+		ListSeriesPagination{
+			Limit: 20,
+		},
+		ListSeriesFilters{},
+	}
+}
+
+func (r *ListSeriesRequest) Validate() error {
+	if err := validators.Validate.Struct(r); err != nil {
+		if validationErrors := new(validator.ValidationErrors); errors.As(err, validationErrors) {
+			for _, validationError := range *validationErrors {
+				return validators.ToHTTP(validationError)
+			}
+		}
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package requests
+
+import (
+	"testing"
+
+	"github.com/ravilock/goduit/api"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListSeries(t *testing.T) {
+	t.Run("Valid request should return errors", func(t *testing.T) {
+		request := generateListSeriesRequest()
+		err := request.Validate()
+		require.NoError(t, err)
+	})
+	t.Run("Owner should contain at least 5 chars", func(t *testing.T) {
+		request := generateListSeriesRequest()
+		request.Filters.Owner = "1234"
+		err := request.Validate()
+		require.ErrorContains(t, err, api.InvalidFieldLimit("Owner", "min", "5").Error())
+	})
+	t.Run("Limit should have min value 1", func(t *testing.T) {
+		request := generateListSeriesRequest()
+		request.Pagination.Limit = 0
+		err := request.Validate()
+		require.ErrorContains(t, err, api.InvalidFieldLimit("Limit", "min", "1").Error())
+	})
+	t.Run("Limit should have max value 30", func(t *testing.T) {
+		request := generateListSeriesRequest()
+		request.Pagination.Limit = 31
+		err := request.Validate()
+		require.ErrorContains(t, err, api.InvalidFieldLimit("Limit", "max", "30").Error())
+	})
+	t.Run("Offset should have min value 0", func(t *testing.T) {
+		request := generateListSeriesRequest()
+		request.Pagination.Offset = -1
+		err := request.Validate()
+		require.ErrorContains(t, err, api.InvalidFieldLimit("Offset", "min", "0").Error())
+	})
+}
+
+func generateListSeriesRequest() *ListSeriesRequest {
+	return &ListSeriesRequest{
+		ListSeriesPagination{
+			Limit:  20,
+			Offset: 20,
+		},
+		ListSeriesFilters{
+			Owner: "series-owner",
+		},
+	}
+}
@@ -0,0 +1,31 @@
+package requests
+
+import (
+	"errors"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/ravilock/goduit/api/validators"
+)
+
+type ListTagsRequest struct {
+	Prefix string `query:"prefix" validate:"omitempty,notblank,max=30"`
+	Limit  int    `query:"limit" validate:"min=1,max=100"`
+}
+
+func NewListTagsRequest() *ListTagsRequest {
+	return &ListTagsRequest{
+		Limit: 20,
+	}
+}
+
+func (r *ListTagsRequest) Validate() error {
+	if err := validators.Validate.Struct(r); err != nil {
+		if validationErrors := new(validator.ValidationErrors); errors.As(err, validationErrors) {
+			for _, validationError := range *validationErrors {
+				return validators.ToHTTP(validationError)
+			}
+		}
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package requests
+
+import (
+	"testing"
+
+	"github.com/ravilock/goduit/api"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListTags(t *testing.T) {
+	t.Run("Valid request should not return errors", func(t *testing.T) {
+		request := generateListTagsRequest()
+		err := request.Validate()
+		require.NoError(t, err)
+	})
+	t.Run("Prefix is optional, but should not be blank", func(t *testing.T) {
+		request := generateListTagsRequest()
+		request.Prefix = ""
+		err := request.Validate()
+		require.NoError(t, err)
+		request.Prefix = " "
+		err = request.Validate()
+		require.ErrorContains(t, err, api.RequiredFieldError("Prefix").Error())
+	})
+	t.Run("Prefix should contain at most 30 chars", func(t *testing.T) {
+		request := generateListTagsRequest()
+		request.Prefix = randomString(31)
+		err := request.Validate()
+		require.ErrorContains(t, err, api.InvalidFieldLimit("Prefix", "max", "30").Error())
+	})
+	t.Run("Limit should have min value 1", func(t *testing.T) {
+		request := generateListTagsRequest()
+		request.Limit = 0
+		err := request.Validate()
+		require.ErrorContains(t, err, api.InvalidFieldLimit("Limit", "min", "1").Error())
+	})
+	t.Run("Limit should have max value 100", func(t *testing.T) {
+		request := generateListTagsRequest()
+		request.Limit = 101
+		err := request.Validate()
+		require.ErrorContains(t, err, api.InvalidFieldLimit("Limit", "max", "100").Error())
+	})
+}
+
+func generateListTagsRequest() *ListTagsRequest {
+	return &ListTagsRequest{
+		Prefix: "test",
+		Limit:  20,
+	}
+}
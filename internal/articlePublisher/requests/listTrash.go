@@ -0,0 +1,37 @@
+package requests
+
+import (
+	"errors"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/ravilock/goduit/api/validators"
+)
+
+type ListTrashRequest struct {
+	Pagination ListTrashPagination
+}
+
+type ListTrashPagination struct {
+	Limit  int `query:"limit" validate:"min=1,max=30"`
+	Offset int `query:"offset" validate:"min=0"`
+}
+
+func NewListTrashRequest() *ListTrashRequest {
+	return &ListTrashRequest{
+		ListTrashPagination{
+			Limit: 20,
+		},
+	}
+}
+
+func (r *ListTrashRequest) Validate() error {
+	if err := validators.Validate.Struct(r); err != nil {
+		if validationErrors := new(validator.ValidationErrors); errors.As(err, validationErrors) {
+			for _, validationError := range *validationErrors {
+				return validators.ToHTTP(validationError)
+			}
+		}
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+package requests
+
+import (
+	"encoding/json"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/ravilock/goduit/api"
+	"github.com/ravilock/goduit/api/validators"
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+	"github.com/ravilock/goduit/internal/slugger"
+)
+
+// MIMEApplicationMergePatchJSON is the media type of JSON Merge Patch documents, as defined by RFC 7396.
+const MIMEApplicationMergePatchJSON = "application/merge-patch+json"
+
+type PatchArticleRequest struct {
+	Slug    string              `param:"slug" validate:"required,notblank,min=5"`
+	Article PatchArticlePayload `json:"article" validate:"required"`
+}
+
+// PatchArticlePayload holds the fields of an article a merge patch changes. Fields left out of the patch stay nil and are
+// left untouched, while the changed ones follow the same rules as when writing an article.
+type PatchArticlePayload struct {
+	Title       *string    `json:"title" validate:"omitempty,notblank,min=5,max=255"`
+	Description *string    `json:"description" validate:"omitempty,notblank,min=5,max=255"`
+	Body        *string    `json:"body" validate:"omitempty,notblank"`
+	TagList     *[]string  `json:"tagList" validate:"omitempty,min=1,max=10,unique,dive,min=3,max=30"`
+	PublishAt   *time.Time `json:"publishAt"`
+
+	// removedFields are the fields the patch sets to null, which a merge patch uses to remove them
+	removedFields []string
+}
+
+var patchableArticleFields = map[string]string{
+	"title":       "Title",
+	"description": "Description",
+	"body":        "Body",
+	"tagList":     "TagList",
+	"publishAt":   "PublishAt",
+}
+
+func (p *PatchArticlePayload) UnmarshalJSON(data []byte) error {
+	type payload PatchArticlePayload
+	if err := json.Unmarshal(data, (*payload)(p)); err != nil {
+		return err
+	}
+	fields := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	p.removedFields = nil
+	for name, value := range fields {
+		field, ok := patchableArticleFields[name]
+		if ok && string(value) == "null" {
+			p.removedFields = append(p.removedFields, field)
+		}
+	}
+	slices.Sort(p.removedFields)
+	return nil
+}
+
+func (p *PatchArticlePayload) isEmpty() bool {
+	return p.Title == nil && p.Description == nil && p.Body == nil && p.TagList == nil && p.PublishAt == nil
+}
+
+func (r *PatchArticleRequest) Model() *models.Article {
+	article := &models.Article{
+		Title:       r.Article.Title,
+		Description: r.Article.Description,
+		Body:        r.Article.Body,
+	}
+	if r.Article.Title != nil {
+		slug := slugger.Make(*r.Article.Title)
+		article.Slug = &slug
+	}
+	if r.Article.TagList != nil {
+		article.TagList = deduplicateTags(*r.Article.TagList)
+	}
+	if r.Article.PublishAt != nil {
+		scheduled := models.ArticleStatusScheduled
+		article.Status = &scheduled
+		article.PublishAt = normalizePublishAt(*r.Article.PublishAt)
+	}
+	return article
+}
+
+func (r *PatchArticleRequest) Validate() error {
+	// Every field of an article is required, so none of them can be removed
+	if len(r.Article.removedFields) > 0 {
+		return api.RequiredFieldError(r.Article.removedFields[0])
+	}
+	if err := validators.Validate.Struct(r); err != nil {
+		if validationErrors := new(validator.ValidationErrors); errors.As(err, validationErrors) {
+			for _, validationError := range *validationErrors {
+				return validators.ToHTTP(validationError)
+			}
+		}
+		return err
+	}
+	if r.Article.isEmpty() {
+		return api.RequiredOneOfFields([]string{"title", "description", "body", "tagList", "publishAt"})
+	}
+	if r.Article.TagList != nil {
+		if err := validateTags(*r.Article.TagList); err != nil {
+			return err
+		}
+	}
+	return validatePublishAt(r.Article.PublishAt)
+}
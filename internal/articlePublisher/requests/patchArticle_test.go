@@ -0,0 +1,134 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/ravilock/goduit/api"
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPatchArticle(t *testing.T) {
+	t.Run("Valid request should not return errors", func(t *testing.T) {
+		request := generatePatchArticleRequest()
+		err := request.Validate()
+		require.NoError(t, err)
+	})
+	t.Run("Slug is required", func(t *testing.T) {
+		request := generatePatchArticleRequest()
+		request.Slug = ""
+		err := request.Validate()
+		require.ErrorContains(t, err, api.RequiredFieldError("Slug").Error())
+	})
+	t.Run("Should change at least one field", func(t *testing.T) {
+		request := generatePatchArticleRequest()
+		request.Article = PatchArticlePayload{}
+		err := request.Validate()
+		require.ErrorContains(t, err, api.RequiredOneOfFields([]string{"title", "description", "body", "tagList", "publishAt"}).Error())
+	})
+	t.Run("Should only change the fields in the patch", func(t *testing.T) {
+		request := mustParsePatchArticleRequest(t, `{"article": {"description": "New Description"}}`)
+		err := request.Validate()
+		require.NoError(t, err)
+		article := request.Model()
+		require.Equal(t, "New Description", *article.Description)
+		require.Nil(t, article.Title)
+		require.Nil(t, article.Slug)
+		require.Nil(t, article.Body)
+		require.Nil(t, article.TagList)
+		require.Nil(t, article.Status)
+	})
+	t.Run("Fields should not be removed", func(t *testing.T) {
+		request := mustParsePatchArticleRequest(t, `{"article": {"title": "New Title", "body": null}}`)
+		err := request.Validate()
+		require.ErrorContains(t, err, api.RequiredFieldError("Body").Error())
+	})
+	t.Run("Title should not be blank", func(t *testing.T) {
+		request := generatePatchArticleRequest()
+		request.Article.Title = stringPointer(" ")
+		err := request.Validate()
+		require.ErrorContains(t, err, api.RequiredFieldError("Title").Error())
+	})
+	t.Run("Title should contain at least 5 chars", func(t *testing.T) {
+		request := generatePatchArticleRequest()
+		request.Article.Title = stringPointer("1234")
+		err := request.Validate()
+		require.ErrorContains(t, err, api.InvalidFieldLimit("Title", "min", "5").Error())
+	})
+	t.Run("Title should contain at most 255 chars", func(t *testing.T) {
+		request := generatePatchArticleRequest()
+		request.Article.Title = stringPointer(randomString(256))
+		err := request.Validate()
+		require.ErrorContains(t, err, api.InvalidFieldLimit("Title", "max", "255").Error())
+	})
+	t.Run("Description should contain at least 5 chars", func(t *testing.T) {
+		request := generatePatchArticleRequest()
+		request.Article.Description = stringPointer("1234")
+		err := request.Validate()
+		require.ErrorContains(t, err, api.InvalidFieldLimit("Description", "min", "5").Error())
+	})
+	t.Run("Body should not be blank", func(t *testing.T) {
+		request := generatePatchArticleRequest()
+		request.Article.Body = stringPointer(" ")
+		err := request.Validate()
+		require.ErrorContains(t, err, api.RequiredFieldError("Body").Error())
+	})
+	t.Run("Should require at least one tag in TagList", func(t *testing.T) {
+		request := generatePatchArticleRequest()
+		request.Article.TagList = &[]string{}
+		err := request.Validate()
+		require.ErrorContains(t, err, api.InvalidFieldLimit("TagList", "min", "1").Error())
+	})
+	t.Run("TagList should be unique", func(t *testing.T) {
+		request := generatePatchArticleRequest()
+		request.Article.TagList = &[]string{"test tag", "test tag"}
+		err := request.Validate()
+		require.ErrorContains(t, err, api.UniqueFieldError("TagList").Error())
+	})
+	t.Run("Each Tag on TagList should have at least 3 chars once canonical", func(t *testing.T) {
+		request := generatePatchArticleRequest()
+		request.Article.TagList = &[]string{"Test Tag", "!!go!!"}
+		err := request.Validate()
+		require.ErrorContains(t, err, api.InvalidFieldError("TagList[1]", "!!go!!").Error())
+	})
+	t.Run("Tags should be folded into their canonical form without duplicates", func(t *testing.T) {
+		request := generatePatchArticleRequest()
+		request.Article.TagList = &[]string{"Go Lang", "go_lang", "C++"}
+		article := request.Model()
+		require.Equal(t, []string{"go-lang", "c++"}, article.TagList)
+	})
+	t.Run("PublishAt should be in the future and schedule the article", func(t *testing.T) {
+		request := generatePatchArticleRequest()
+		past := time.Now().Add(-time.Hour)
+		request.Article.PublishAt = &past
+		err := request.Validate()
+		require.ErrorContains(t, err, api.InvalidFieldError("PublishAt", past.Format(time.RFC3339)).Error())
+		future := time.Now().Add(time.Hour)
+		request.Article.PublishAt = &future
+		article := request.Model()
+		require.Equal(t, models.ArticleStatusScheduled, *article.Status)
+		require.True(t, future.UTC().Truncate(time.Millisecond).Equal(*article.PublishAt))
+	})
+}
+
+func generatePatchArticleRequest() *PatchArticleRequest {
+	request := new(PatchArticleRequest)
+	request.Slug = "test-slug"
+	request.Article.Title = stringPointer("Test Title")
+	return request
+}
+
+func mustParsePatchArticleRequest(t *testing.T, patch string) *PatchArticleRequest {
+	t.Helper()
+	request := new(PatchArticleRequest)
+	err := json.Unmarshal([]byte(patch), request)
+	require.NoError(t, err)
+	request.Slug = "test-slug"
+	return request
+}
+
+func stringPointer(value string) *string {
+	return &value
+}
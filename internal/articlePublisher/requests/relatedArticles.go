@@ -0,0 +1,31 @@
+package requests
+
+import (
+	"errors"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/ravilock/goduit/api/validators"
+)
+
+type RelatedArticlesRequest struct {
+	Slug  string `param:"slug" validate:"required,notblank,min=5"`
+	Limit int    `query:"limit" validate:"min=1,max=20"`
+}
+
+func NewRelatedArticlesRequest() *RelatedArticlesRequest {
+	return &RelatedArticlesRequest{
+		Limit: 5,
+	}
+}
+
+func (r *RelatedArticlesRequest) Validate() error {
+	if err := validators.Validate.Struct(r); err != nil {
+		if validationErrors := new(validator.ValidationErrors); errors.As(err, validationErrors) {
+			for _, validationError := range *validationErrors {
+				return validators.ToHTTP(validationError)
+			}
+		}
+		return err
+	}
+	return nil
+}
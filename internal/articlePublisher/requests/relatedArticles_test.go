@@ -0,0 +1,47 @@
+package requests
+
+import (
+	"testing"
+
+	"github.com/ravilock/goduit/api"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRelatedArticles(t *testing.T) {
+	t.Run("Valid request should not return errors", func(t *testing.T) {
+		request := generateRelatedArticlesRequest()
+		err := request.Validate()
+		require.NoError(t, err)
+	})
+	t.Run("Slug is required", func(t *testing.T) {
+		request := generateRelatedArticlesRequest()
+		request.Slug = ""
+		err := request.Validate()
+		require.ErrorContains(t, err, api.RequiredFieldError("Slug").Error())
+	})
+	t.Run("Slug should contain at least 5 chars", func(t *testing.T) {
+		request := generateRelatedArticlesRequest()
+		request.Slug = "1234"
+		err := request.Validate()
+		require.ErrorContains(t, err, api.InvalidFieldLimit("Slug", "min", "5").Error())
+	})
+	t.Run("Limit should have min value 1", func(t *testing.T) {
+		request := generateRelatedArticlesRequest()
+		request.Limit = 0
+		err := request.Validate()
+		require.ErrorContains(t, err, api.InvalidFieldLimit("Limit", "min", "1").Error())
+	})
+	t.Run("Limit should have max value 20", func(t *testing.T) {
+		request := generateRelatedArticlesRequest()
+		request.Limit = 21
+		err := request.Validate()
+		require.ErrorContains(t, err, api.InvalidFieldLimit("Limit", "max", "20").Error())
+	})
+}
+
+func generateRelatedArticlesRequest() *RelatedArticlesRequest {
+	return &RelatedArticlesRequest{
+		Slug:  "test-slug",
+		Limit: 5,
+	}
+}
@@ -0,0 +1,25 @@
+package requests
+
+import (
+	"errors"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/ravilock/goduit/api/validators"
+)
+
+type RevisionRequest struct {
+	Slug     string `param:"slug" validate:"required,notblank,min=5"`
+	Revision int64  `param:"revision" validate:"required,min=1"`
+}
+
+func (r *RevisionRequest) Validate() error {
+	if err := validators.Validate.Struct(r); err != nil {
+		if validationErrors := new(validator.ValidationErrors); errors.As(err, validationErrors) {
+			for _, validationError := range *validationErrors {
+				return validators.ToHTTP(validationError)
+			}
+		}
+		return err
+	}
+	return nil
+}
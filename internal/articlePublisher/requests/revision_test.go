@@ -0,0 +1,47 @@
+package requests
+
+import (
+	"testing"
+
+	"github.com/ravilock/goduit/api"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRevision(t *testing.T) {
+	t.Run("Valid request should not return errors", func(t *testing.T) {
+		request := generateRevisionRequest()
+		err := request.Validate()
+		require.NoError(t, err)
+	})
+	t.Run("Slug is required", func(t *testing.T) {
+		request := generateRevisionRequest()
+		request.Slug = ""
+		err := request.Validate()
+		require.ErrorContains(t, err, api.RequiredFieldError("Slug").Error())
+	})
+	t.Run("Slug should contain at least 5 chars", func(t *testing.T) {
+		request := generateRevisionRequest()
+		request.Slug = "1234"
+		err := request.Validate()
+		require.ErrorContains(t, err, api.InvalidFieldLimit("Slug", "min", "5").Error())
+	})
+	t.Run("Revision is required", func(t *testing.T) {
+		request := generateRevisionRequest()
+		request.Revision = 0
+		err := request.Validate()
+		require.ErrorContains(t, err, api.RequiredFieldError("Revision").Error())
+	})
+	t.Run("Revision should have min value 1", func(t *testing.T) {
+		request := generateRevisionRequest()
+		request.Revision = -1
+		err := request.Validate()
+		require.ErrorContains(t, err, api.InvalidFieldLimit("Revision", "min", "1").Error())
+	})
+}
+
+func generateRevisionRequest() *RevisionRequest {
+	return &RevisionRequest{
+		Slug:     "test-slug",
+		Revision: 1,
+	}
+}
@@ -0,0 +1,44 @@
+package requests
+
+import (
+	"errors"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/ravilock/goduit/api/validators"
+)
+
+type SearchArticlesRequest struct {
+	Query      string `query:"q" validate:"required,notblank,min=2,max=100"`
+	Pagination SearchArticlesPagination
+	Filters    SearchArticlesFilters
+}
+
+type SearchArticlesFilters struct {
+	Tag    string `query:"tag" validate:"omitempty,notblank,min=3,max=30"`
+	Author string `query:"author" validate:"omitempty,notblank,min=5,max=255"`
+}
+
+type SearchArticlesPagination struct {
+	Limit  int `query:"limit" validate:"min=1,max=30"`
+	Offset int `query:"offset" validate:"min=0"`
+}
+
+func NewSearchArticlesRequest() *SearchArticlesRequest {
+	return &SearchArticlesRequest{
+		Pagination: SearchArticlesPagination{
+			Limit: 20,
+		},
+	}
+}
+
+func (r *SearchArticlesRequest) Validate() error {
+	if err := validators.Validate.Struct(r); err != nil {
+		if validationErrors := new(validator.ValidationErrors); errors.As(err, validationErrors) {
+			for _, validationError := range *validationErrors {
+				return validators.ToHTTP(validationError)
+			}
+		}
+		return err
+	}
+	return nil
+}
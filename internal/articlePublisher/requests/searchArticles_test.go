@@ -0,0 +1,81 @@
+package requests
+
+import (
+	"testing"
+
+	"github.com/ravilock/goduit/api"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearchArticles(t *testing.T) {
+	t.Run("Valid request should return errors", func(t *testing.T) {
+		request := generateSearchArticlesRequest()
+		err := request.Validate()
+		require.NoError(t, err)
+	})
+	t.Run("Query is required", func(t *testing.T) {
+		request := generateSearchArticlesRequest()
+		request.Query = ""
+		err := request.Validate()
+		require.ErrorContains(t, err, api.RequiredFieldError("Query").Error())
+		request.Query = " "
+		err = request.Validate()
+		require.ErrorContains(t, err, api.RequiredFieldError("Query").Error())
+	})
+	t.Run("Query should contain at least 2 chars", func(t *testing.T) {
+		request := generateSearchArticlesRequest()
+		request.Query = "a"
+		err := request.Validate()
+		require.ErrorContains(t, err, api.InvalidFieldLimit("Query", "min", "2").Error())
+	})
+	t.Run("Query should contain at most 100 chars", func(t *testing.T) {
+		request := generateSearchArticlesRequest()
+		request.Query = randomString(101)
+		err := request.Validate()
+		require.ErrorContains(t, err, api.InvalidFieldLimit("Query", "max", "100").Error())
+	})
+	t.Run("Tag is optional, but should not be blank", func(t *testing.T) {
+		request := generateSearchArticlesRequest()
+		request.Filters.Tag = ""
+		err := request.Validate()
+		require.NoError(t, err)
+		request.Filters.Tag = " "
+		err = request.Validate()
+		require.ErrorContains(t, err, api.RequiredFieldError("Tag").Error())
+	})
+	t.Run("Author is optional, but should not be blank", func(t *testing.T) {
+		request := generateSearchArticlesRequest()
+		request.Filters.Author = ""
+		err := request.Validate()
+		require.NoError(t, err)
+		request.Filters.Author = " "
+		err = request.Validate()
+		require.ErrorContains(t, err, api.RequiredFieldError("Author").Error())
+	})
+	t.Run("Limit should have max value 30", func(t *testing.T) {
+		request := generateSearchArticlesRequest()
+		request.Pagination.Limit = 31
+		err := request.Validate()
+		require.ErrorContains(t, err, api.InvalidFieldLimit("Limit", "max", "30").Error())
+	})
+	t.Run("Offset should have min value 0", func(t *testing.T) {
+		request := generateSearchArticlesRequest()
+		request.Pagination.Offset = -1
+		err := request.Validate()
+		require.ErrorContains(t, err, api.InvalidFieldLimit("Offset", "min", "0").Error())
+	})
+}
+
+func generateSearchArticlesRequest() *SearchArticlesRequest {
+	return &SearchArticlesRequest{
+		Query: "golang generics",
+		Pagination: SearchArticlesPagination{
+			Limit:  20,
+			Offset: 20,
+		},
+		Filters: SearchArticlesFilters{
+			Tag:    "test-tag",
+			Author: "test-author",
+		},
+	}
+}
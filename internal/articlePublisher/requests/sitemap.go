@@ -0,0 +1,26 @@
+package requests
+
+import (
+	"errors"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/ravilock/goduit/api/validators"
+)
+
+// SitemapRequest asks for a page of the sitemap. Without a page, the whole sitemap is asked for, which is a sitemap index
+// once the site has too many pages for a single sitemap.
+type SitemapRequest struct {
+	Page int `query:"page" validate:"omitempty,min=1"`
+}
+
+func (r *SitemapRequest) Validate() error {
+	if err := validators.Validate.Struct(r); err != nil {
+		if validationErrors := new(validator.ValidationErrors); errors.As(err, validationErrors) {
+			for _, validationError := range *validationErrors {
+				return validators.ToHTTP(validationError)
+			}
+		}
+		return err
+	}
+	return nil
+}
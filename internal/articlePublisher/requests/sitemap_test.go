@@ -0,0 +1,21 @@
+package requests
+
+import (
+	"testing"
+
+	"github.com/ravilock/goduit/api"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSitemap(t *testing.T) {
+	t.Run("Page is optional", func(t *testing.T) {
+		request := new(SitemapRequest)
+		err := request.Validate()
+		require.NoError(t, err)
+	})
+	t.Run("Page should be at least 1", func(t *testing.T) {
+		request := &SitemapRequest{Page: -1}
+		err := request.Validate()
+		require.ErrorContains(t, err, api.InvalidFieldLimit("Page", "min", "1").Error())
+	})
+}
@@ -0,0 +1,40 @@
+package requests
+
+import (
+	"errors"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/ravilock/goduit/api/validators"
+)
+
+type AuthorArticlesFeedRequest struct {
+	Username string `param:"username" validate:"required,notblank,min=5,max=255"`
+}
+
+func (r *AuthorArticlesFeedRequest) Validate() error {
+	if err := validators.Validate.Struct(r); err != nil {
+		if validationErrors := new(validator.ValidationErrors); errors.As(err, validationErrors) {
+			for _, validationError := range *validationErrors {
+				return validators.ToHTTP(validationError)
+			}
+		}
+		return err
+	}
+	return nil
+}
+
+type TagArticlesFeedRequest struct {
+	Tag string `param:"tag" validate:"required,notblank,min=3,max=30"`
+}
+
+func (r *TagArticlesFeedRequest) Validate() error {
+	if err := validators.Validate.Struct(r); err != nil {
+		if validationErrors := new(validator.ValidationErrors); errors.As(err, validationErrors) {
+			for _, validationError := range *validationErrors {
+				return validators.ToHTTP(validationError)
+			}
+		}
+		return err
+	}
+	return nil
+}
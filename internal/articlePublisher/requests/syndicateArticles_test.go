@@ -0,0 +1,39 @@
+package requests
+
+import (
+	"testing"
+
+	"github.com/ravilock/goduit/api"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthorArticlesFeed(t *testing.T) {
+	t.Run("Valid request should not return errors", func(t *testing.T) {
+		request := &AuthorArticlesFeedRequest{Username: "jake-jake"}
+		err := request.Validate()
+		require.NoError(t, err)
+	})
+	t.Run("Username is required", func(t *testing.T) {
+		request := &AuthorArticlesFeedRequest{Username: " "}
+		err := request.Validate()
+		require.ErrorContains(t, err, api.RequiredFieldError("Username").Error())
+	})
+	t.Run("Username should contain at least 5 chars", func(t *testing.T) {
+		request := &AuthorArticlesFeedRequest{Username: "jake"}
+		err := request.Validate()
+		require.ErrorContains(t, err, api.InvalidFieldLimit("Username", "min", "5").Error())
+	})
+}
+
+func TestTagArticlesFeed(t *testing.T) {
+	t.Run("Valid request should not return errors", func(t *testing.T) {
+		request := &TagArticlesFeedRequest{Tag: "golang"}
+		err := request.Validate()
+		require.NoError(t, err)
+	})
+	t.Run("Tag should contain at most 30 chars", func(t *testing.T) {
+		request := &TagArticlesFeedRequest{Tag: randomString(31)}
+		err := request.Validate()
+		require.ErrorContains(t, err, api.InvalidFieldLimit("Tag", "max", "30").Error())
+	})
+}
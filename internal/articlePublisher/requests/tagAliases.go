@@ -0,0 +1,95 @@
+package requests
+
+import (
+	"errors"
+	"unicode/utf8"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/ravilock/goduit/api"
+	"github.com/ravilock/goduit/api/validators"
+	"github.com/ravilock/goduit/internal/slugger"
+)
+
+type TagAliasesRequest struct {
+	Tag string `param:"tag" validate:"required,notblank,min=3,max=30"`
+}
+
+func (r *TagAliasesRequest) Validate() error {
+	if err := validators.Validate.Struct(r); err != nil {
+		if validationErrors := new(validator.ValidationErrors); errors.As(err, validationErrors) {
+			for _, validationError := range *validationErrors {
+				return validators.ToHTTP(validationError)
+			}
+		}
+		return err
+	}
+	return nil
+}
+
+type AddTagAliasRequest struct {
+	Tag   string             `param:"tag" validate:"required,notblank,min=3,max=30"`
+	Alias AddTagAliasPayload `json:"alias" validate:"required"`
+}
+
+type AddTagAliasPayload struct {
+	Name string `json:"name" validate:"required,notblank,min=3,max=30"`
+}
+
+func (r *AddTagAliasRequest) Validate() error {
+	if err := validators.Validate.Struct(r); err != nil {
+		if validationErrors := new(validator.ValidationErrors); errors.As(err, validationErrors) {
+			for _, validationError := range *validationErrors {
+				return validators.ToHTTP(validationError)
+			}
+		}
+		return err
+	}
+	return validateCanonicalTag("Name", r.Alias.Name)
+}
+
+type RemoveTagAliasRequest struct {
+	Tag   string `param:"tag" validate:"required,notblank,min=3,max=30"`
+	Alias string `param:"alias" validate:"required,notblank,min=3,max=30"`
+}
+
+func (r *RemoveTagAliasRequest) Validate() error {
+	if err := validators.Validate.Struct(r); err != nil {
+		if validationErrors := new(validator.ValidationErrors); errors.As(err, validationErrors) {
+			for _, validationError := range *validationErrors {
+				return validators.ToHTTP(validationError)
+			}
+		}
+		return err
+	}
+	return nil
+}
+
+type MergeTagsRequest struct {
+	Tag   string           `param:"tag" validate:"required,notblank,min=3,max=30"`
+	Merge MergeTagsPayload `json:"merge" validate:"required"`
+}
+
+type MergeTagsPayload struct {
+	Into string `json:"into" validate:"required,notblank,min=3,max=30"`
+}
+
+func (r *MergeTagsRequest) Validate() error {
+	if err := validators.Validate.Struct(r); err != nil {
+		if validationErrors := new(validator.ValidationErrors); errors.As(err, validationErrors) {
+			for _, validationError := range *validationErrors {
+				return validators.ToHTTP(validationError)
+			}
+		}
+		return err
+	}
+	return validateCanonicalTag("Into", r.Merge.Into)
+}
+
+// validateCanonicalTag checks that a tag that is about to be stored is still within the accepted length once folded
+// into its canonical form.
+func validateCanonicalTag(field, tag string) error {
+	if length := utf8.RuneCountInString(slugger.Tag(tag)); length < 3 || length > 30 {
+		return api.InvalidFieldError(field, tag)
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+package requests
+
+import (
+	"testing"
+
+	"github.com/ravilock/goduit/api"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTagAliases(t *testing.T) {
+	t.Run("Valid request should not return errors", func(t *testing.T) {
+		request := &TagAliasesRequest{Tag: "golang"}
+		err := request.Validate()
+		require.NoError(t, err)
+	})
+	t.Run("Tag should contain at least 3 chars", func(t *testing.T) {
+		request := &TagAliasesRequest{Tag: "go"}
+		err := request.Validate()
+		require.ErrorContains(t, err, api.InvalidFieldLimit("Tag", "min", "3").Error())
+	})
+}
+
+func TestAddTagAlias(t *testing.T) {
+	t.Run("Valid request should not return errors", func(t *testing.T) {
+		request := generateAddTagAliasRequest()
+		err := request.Validate()
+		require.NoError(t, err)
+	})
+	t.Run("Alias name is required", func(t *testing.T) {
+		request := generateAddTagAliasRequest()
+		request.Alias.Name = ""
+		err := request.Validate()
+		require.ErrorContains(t, err, api.RequiredFieldError("Name").Error())
+	})
+	t.Run("Alias name should contain at most 30 chars", func(t *testing.T) {
+		request := generateAddTagAliasRequest()
+		request.Alias.Name = randomString(31)
+		err := request.Validate()
+		require.ErrorContains(t, err, api.InvalidFieldLimit("Name", "max", "30").Error())
+	})
+	t.Run("Alias name should contain at least 3 chars once canonical", func(t *testing.T) {
+		request := generateAddTagAliasRequest()
+		request.Alias.Name = "!!go!!"
+		err := request.Validate()
+		require.ErrorContains(t, err, api.InvalidFieldError("Name", "!!go!!").Error())
+	})
+}
+
+func TestRemoveTagAlias(t *testing.T) {
+	t.Run("Valid request should not return errors", func(t *testing.T) {
+		request := &RemoveTagAliasRequest{Tag: "golang", Alias: "go-lang"}
+		err := request.Validate()
+		require.NoError(t, err)
+	})
+	t.Run("Alias is required", func(t *testing.T) {
+		request := &RemoveTagAliasRequest{Tag: "golang"}
+		err := request.Validate()
+		require.ErrorContains(t, err, api.RequiredFieldError("Alias").Error())
+	})
+}
+
+func TestMergeTags(t *testing.T) {
+	t.Run("Valid request should not return errors", func(t *testing.T) {
+		request := generateMergeTagsRequest()
+		err := request.Validate()
+		require.NoError(t, err)
+	})
+	t.Run("Into is required", func(t *testing.T) {
+		request := generateMergeTagsRequest()
+		request.Merge.Into = ""
+		err := request.Validate()
+		require.ErrorContains(t, err, api.RequiredFieldError("Into").Error())
+	})
+	t.Run("Into should contain at least 3 chars", func(t *testing.T) {
+		request := generateMergeTagsRequest()
+		request.Merge.Into = "go"
+		err := request.Validate()
+		require.ErrorContains(t, err, api.InvalidFieldLimit("Into", "min", "3").Error())
+	})
+	t.Run("Into should contain at least 3 chars once canonical", func(t *testing.T) {
+		request := generateMergeTagsRequest()
+		request.Merge.Into = "!!go!!"
+		err := request.Validate()
+		require.ErrorContains(t, err, api.InvalidFieldError("Into", "!!go!!").Error())
+	})
+}
+
+func generateAddTagAliasRequest() *AddTagAliasRequest {
+	request := &AddTagAliasRequest{Tag: "golang"}
+	request.Alias.Name = "Go Lang"
+	return request
+}
+
+func generateMergeTagsRequest() *MergeTagsRequest {
+	request := &MergeTagsRequest{Tag: "go-lang"}
+	request.Merge.Into = "golang"
+	return request
+}
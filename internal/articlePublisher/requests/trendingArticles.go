@@ -0,0 +1,35 @@
+package requests
+
+import (
+	"errors"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/ravilock/goduit/api/validators"
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+)
+
+type TrendingArticlesRequest struct {
+	Window string `query:"window" validate:"required,oneof=day week month"`
+	Tag    string `query:"tag" validate:"omitempty,notblank,min=3,max=30"`
+	Limit  int    `query:"limit" validate:"min=1,max=30"`
+	Offset int    `query:"offset" validate:"min=0"`
+}
+
+func NewTrendingArticlesRequest() *TrendingArticlesRequest {
+	return &TrendingArticlesRequest{
+		Window: models.TrendingWindowWeek,
+		Limit:  20,
+	}
+}
+
+func (r *TrendingArticlesRequest) Validate() error {
+	if err := validators.Validate.Struct(r); err != nil {
+		if validationErrors := new(validator.ValidationErrors); errors.As(err, validationErrors) {
+			for _, validationError := range *validationErrors {
+				return validators.ToHTTP(validationError)
+			}
+		}
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package requests
+
+import (
+	"testing"
+
+	"github.com/ravilock/goduit/api"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrendingArticles(t *testing.T) {
+	t.Run("Valid request should not return errors", func(t *testing.T) {
+		request := generateTrendingArticlesRequest()
+		err := request.Validate()
+		require.NoError(t, err)
+	})
+	t.Run("Tag is optional", func(t *testing.T) {
+		request := generateTrendingArticlesRequest()
+		request.Tag = ""
+		err := request.Validate()
+		require.NoError(t, err)
+	})
+	t.Run("Window is required", func(t *testing.T) {
+		request := generateTrendingArticlesRequest()
+		request.Window = ""
+		err := request.Validate()
+		require.ErrorContains(t, err, api.RequiredFieldError("Window").Error())
+	})
+	t.Run("Window should be day, week or month", func(t *testing.T) {
+		request := generateTrendingArticlesRequest()
+		request.Window = "year"
+		err := request.Validate()
+		require.ErrorContains(t, err, api.InvalidFieldError("Window", "year").Error())
+	})
+	t.Run("Tag should contain at least 3 chars", func(t *testing.T) {
+		request := generateTrendingArticlesRequest()
+		request.Tag = "go"
+		err := request.Validate()
+		require.ErrorContains(t, err, api.InvalidFieldLimit("Tag", "min", "3").Error())
+	})
+	t.Run("Tag should contain at most 30 chars", func(t *testing.T) {
+		request := generateTrendingArticlesRequest()
+		request.Tag = "abcdefghijklmnopqrstuvwxyz12345"
+		err := request.Validate()
+		require.ErrorContains(t, err, api.InvalidFieldLimit("Tag", "max", "30").Error())
+	})
+	t.Run("Limit should have min value 1", func(t *testing.T) {
+		request := generateTrendingArticlesRequest()
+		request.Limit = 0
+		err := request.Validate()
+		require.ErrorContains(t, err, api.InvalidFieldLimit("Limit", "min", "1").Error())
+	})
+	t.Run("Limit should have max value 30", func(t *testing.T) {
+		request := generateTrendingArticlesRequest()
+		request.Limit = 31
+		err := request.Validate()
+		require.ErrorContains(t, err, api.InvalidFieldLimit("Limit", "max", "30").Error())
+	})
+	t.Run("Offset should have min value 0", func(t *testing.T) {
+		request := generateTrendingArticlesRequest()
+		request.Offset = -1
+		err := request.Validate()
+		require.ErrorContains(t, err, api.InvalidFieldLimit("Offset", "min", "0").Error())
+	})
+}
+
+func generateTrendingArticlesRequest() *TrendingArticlesRequest {
+	return &TrendingArticlesRequest{
+		Window: "week",
+		Tag:    "technology",
+		Limit:  20,
+		Offset: 0,
+	}
+}
@@ -2,10 +2,12 @@ package requests
 
 import (
 	"errors"
+	"time"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/ravilock/goduit/api/validators"
 	"github.com/ravilock/goduit/internal/articlePublisher/models"
+	"github.com/ravilock/goduit/internal/slugger"
 )
 
 type UpdateArticleRequest struct {
@@ -14,13 +16,21 @@ type UpdateArticleRequest struct {
 }
 
 type UpdateArticlePayload struct {
-	Title       string `json:"title" validate:"required,notblank,min=5,max=255"`
-	Description string `json:"description" validate:"required,notblank,min=5,max=255"`
-	Body        string `json:"body" validate:"required,notblank"`
+	Title       string     `json:"title" validate:"required,notblank,min=5,max=255"`
+	Description string     `json:"description" validate:"required,notblank,min=5,max=255"`
+	Body        string     `json:"body" validate:"required,notblank"`
+	PublishAt   *time.Time `json:"publishAt"`
 }
 
 func (r *UpdateArticleRequest) Model() *models.Article {
-	slug := makeSlug(r.Article.Title)
+	slug := slugger.Make(r.Article.Title)
+	var status *string
+	var publishAt *time.Time
+	if r.Article.PublishAt != nil {
+		scheduled := models.ArticleStatusScheduled
+		status = &scheduled
+		publishAt = normalizePublishAt(*r.Article.PublishAt)
+	}
 	return &models.Article{
 		Author:         nil,
 		Slug:           &slug,
@@ -31,6 +41,8 @@ func (r *UpdateArticleRequest) Model() *models.Article {
 		CreatedAt:      nil,
 		UpdatedAt:      nil,
 		FavoritesCount: nil,
+		Status:         status,
+		PublishAt:      publishAt,
 	}
 }
 
@@ -43,5 +55,5 @@ func (r *UpdateArticleRequest) Validate() error {
 		}
 		return err
 	}
-	return nil
+	return validatePublishAt(r.Article.PublishAt)
 }
@@ -2,8 +2,10 @@ package requests
 
 import (
 	"testing"
+	"time"
 
 	"github.com/ravilock/goduit/api"
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
 	"github.com/stretchr/testify/require"
 )
 
@@ -91,6 +93,28 @@ func TestUpdateArticle(t *testing.T) {
 		err := request.Validate()
 		require.ErrorContains(t, err, api.RequiredFieldError("Body").Error())
 	})
+	t.Run("PublishAt is optional, but should be in the future", func(t *testing.T) {
+		request := generateUpdateArticleRequest()
+		request.Article.PublishAt = nil
+		err := request.Validate()
+		require.NoError(t, err)
+		future := time.Now().Add(time.Hour)
+		request.Article.PublishAt = &future
+		err = request.Validate()
+		require.NoError(t, err)
+		past := time.Now().Add(-time.Hour)
+		request.Article.PublishAt = &past
+		err = request.Validate()
+		require.ErrorContains(t, err, api.InvalidFieldError("PublishAt", past.Format(time.RFC3339)).Error())
+	})
+	t.Run("PublishAt should schedule the article", func(t *testing.T) {
+		request := generateUpdateArticleRequest()
+		future := time.Now().Add(time.Hour)
+		request.Article.PublishAt = &future
+		article := request.Model()
+		require.Equal(t, models.ArticleStatusScheduled, *article.Status)
+		require.True(t, future.UTC().Truncate(time.Millisecond).Equal(*article.PublishAt))
+	})
 }
 
 func generateUpdateArticleRequest() *UpdateArticleRequest {
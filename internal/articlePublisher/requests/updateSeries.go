@@ -0,0 +1,50 @@
+package requests
+
+import (
+	"errors"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/ravilock/goduit/api/validators"
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+	"github.com/ravilock/goduit/internal/slugger"
+)
+
+type UpdateSeriesRequest struct {
+	Slug   string              `param:"slug" validate:"required,notblank,min=5"`
+	Series UpdateSeriesPayload `json:"series" validate:"required"`
+}
+
+// UpdateSeriesPayload holds the fields to update, fields left out are kept as they are.
+// An empty list of articles empties the series.
+type UpdateSeriesPayload struct {
+	Title       string   `json:"title" validate:"omitempty,notblank,min=5,max=255"`
+	Description string   `json:"description" validate:"omitempty,notblank,min=5,max=255"`
+	Articles    []string `json:"articles" validate:"max=50,unique,dive,required,notblank,min=5"`
+}
+
+// Model assembles the series update, the articles parameter represents the IDs of the articles the request's slugs
+// point to.
+func (r *UpdateSeriesRequest) Model(articles []string) *models.Series {
+	series := &models.Series{Articles: articles}
+	if r.Series.Title != "" {
+		slug := slugger.Make(r.Series.Title)
+		series.Slug = &slug
+		series.Title = &r.Series.Title
+	}
+	if r.Series.Description != "" {
+		series.Description = &r.Series.Description
+	}
+	return series
+}
+
+func (r *UpdateSeriesRequest) Validate() error {
+	if err := validators.Validate.Struct(r); err != nil {
+		if validationErrors := new(validator.ValidationErrors); errors.As(err, validationErrors) {
+			for _, validationError := range *validationErrors {
+				return validators.ToHTTP(validationError)
+			}
+		}
+		return err
+	}
+	return nil
+}
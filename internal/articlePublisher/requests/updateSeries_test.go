@@ -0,0 +1,61 @@
+package requests
+
+import (
+	"testing"
+
+	"github.com/ravilock/goduit/api"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateSeries(t *testing.T) {
+	t.Run("Valid request should not return errors", func(t *testing.T) {
+		request := generateUpdateSeriesRequest()
+		err := request.Validate()
+		require.NoError(t, err)
+	})
+	t.Run("Every field is optional", func(t *testing.T) {
+		request := &UpdateSeriesRequest{Slug: "test-series"}
+		err := request.Validate()
+		require.NoError(t, err)
+	})
+	t.Run("Slug is required", func(t *testing.T) {
+		request := generateUpdateSeriesRequest()
+		request.Slug = ""
+		err := request.Validate()
+		require.ErrorContains(t, err, api.RequiredFieldError("Slug").Error())
+	})
+	t.Run("Title should not be blank", func(t *testing.T) {
+		request := generateUpdateSeriesRequest()
+		request.Series.Title = " "
+		err := request.Validate()
+		require.ErrorContains(t, err, api.RequiredFieldError("Title").Error())
+	})
+	t.Run("Title should contain at least 5 chars", func(t *testing.T) {
+		request := generateUpdateSeriesRequest()
+		request.Series.Title = "1234"
+		err := request.Validate()
+		require.ErrorContains(t, err, api.InvalidFieldLimit("Title", "min", "5").Error())
+	})
+	t.Run("Articles should be unique", func(t *testing.T) {
+		request := generateUpdateSeriesRequest()
+		request.Series.Articles = []string{"part-one", "part-one"}
+		err := request.Validate()
+		require.ErrorContains(t, err, api.UniqueFieldError("Articles").Error())
+	})
+	t.Run("Model should keep fields left out", func(t *testing.T) {
+		request := &UpdateSeriesRequest{Slug: "test-series"}
+		series := request.Model(nil)
+		require.Nil(t, series.Title)
+		require.Nil(t, series.Slug)
+		require.Nil(t, series.Description)
+		require.Nil(t, series.Articles)
+	})
+}
+
+func generateUpdateSeriesRequest() *UpdateSeriesRequest {
+	series := new(UpdateSeriesRequest)
+	series.Slug = "test-series"
+	series.Series.Title = "Updated Series"
+	series.Series.Articles = []string{"part-two", "part-one"}
+	return series
+}
@@ -2,11 +2,14 @@ package requests
 
 import (
 	"errors"
-	"strings"
+	"fmt"
+	"time"
 
 	"github.com/go-playground/validator/v10"
+	"github.com/ravilock/goduit/api"
 	"github.com/ravilock/goduit/api/validators"
 	"github.com/ravilock/goduit/internal/articlePublisher/models"
+	"github.com/ravilock/goduit/internal/slugger"
 )
 
 type WriteArticleRequest struct {
@@ -14,15 +17,26 @@ type WriteArticleRequest struct {
 }
 
 type WriteArticlePayload struct {
-	Title       string   `json:"title" validate:"required,notblank,min=5,max=255"`
-	Description string   `json:"description" validate:"required,notblank,min=5,max=255"`
-	Body        string   `json:"body" validate:"required,notblank"`
-	TagList     []string `json:"tagList" validate:"min=1,max=10,unique,dive,min=3,max=30"`
+	Title       string     `json:"title" validate:"required,notblank,min=5,max=255"`
+	Description string     `json:"description" validate:"required,notblank,min=5,max=255"`
+	Body        string     `json:"body" validate:"required,notblank"`
+	TagList     []string   `json:"tagList" validate:"min=1,max=10,unique,dive,min=3,max=30"`
+	Draft       bool       `json:"draft"`
+	PublishAt   *time.Time `json:"publishAt"`
 }
 
 func (r *WriteArticleRequest) Model(authorID string) *models.Article {
 	tags := deduplicateTags(r.Article.TagList)
-	slug := makeSlug(r.Article.Title)
+	slug := slugger.Make(r.Article.Title)
+	status := models.ArticleStatusPublished
+	var publishAt *time.Time
+	if r.Article.Draft {
+		status = models.ArticleStatusDraft
+	}
+	if r.Article.PublishAt != nil {
+		status = models.ArticleStatusScheduled
+		publishAt = normalizePublishAt(*r.Article.PublishAt)
+	}
 	return &models.Article{
 		Author:         &authorID,
 		Slug:           &slug,
@@ -33,14 +47,17 @@ func (r *WriteArticleRequest) Model(authorID string) *models.Article {
 		CreatedAt:      nil,
 		UpdatedAt:      nil,
 		FavoritesCount: new(int64),
+		Status:         &status,
+		PublishAt:      publishAt,
 	}
 }
 
+// deduplicateTags folds tags into their canonical form, dropping the ones that end up repeated.
 func deduplicateTags(tags []string) []string {
 	tagMap := make(map[string]bool)
 	deduplicatedTags := make([]string, 0, cap(tags))
 	for _, tag := range tags {
-		normalizedTag := strings.ToLower(tag)
+		normalizedTag := slugger.Tag(tag)
 		ok := tagMap[normalizedTag]
 		if ok {
 			continue
@@ -51,9 +68,26 @@ func deduplicateTags(tags []string) []string {
 	return deduplicatedTags
 }
 
-func makeSlug(title string) string {
-	loweredTitle := strings.ToLower(title)
-	return strings.ReplaceAll(loweredTitle, " ", "-")
+// validateTags checks that tags are still within the accepted length once folded into their canonical form.
+func validateTags(tags []string) error {
+	for i, tag := range tags {
+		if err := validateCanonicalTag(fmt.Sprintf("TagList[%d]", i), tag); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func normalizePublishAt(publishAt time.Time) *time.Time {
+	normalized := publishAt.UTC().Truncate(time.Millisecond)
+	return &normalized
+}
+
+func validatePublishAt(publishAt *time.Time) error {
+	if publishAt != nil && !publishAt.After(time.Now()) {
+		return api.InvalidFieldError("PublishAt", publishAt.Format(time.RFC3339))
+	}
+	return nil
 }
 
 func (r *WriteArticleRequest) Validate() error {
@@ -65,5 +99,8 @@ func (r *WriteArticleRequest) Validate() error {
 		}
 		return err
 	}
-	return nil
+	if err := validateTags(r.Article.TagList); err != nil {
+		return err
+	}
+	return validatePublishAt(r.Article.PublishAt)
 }
@@ -2,9 +2,12 @@ package requests
 
 import (
 	"testing"
+	"time"
 
 	"github.com/ravilock/goduit/api"
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
 	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
 func TestWriteArticle(t *testing.T) {
@@ -106,6 +109,40 @@ func TestWriteArticle(t *testing.T) {
 		err := request.Validate()
 		require.ErrorContains(t, err, api.InvalidFieldLimit("TagList[0]", "max", "30").Error())
 	})
+	t.Run("Each Tag on TagList should have at least 3 chars once canonical", func(t *testing.T) {
+		request := generateWriteArticleRequest()
+		request.Article.TagList = []string{"Test Tag", "!!go!!"}
+		err := request.Validate()
+		require.ErrorContains(t, err, api.InvalidFieldError("TagList[1]", "!!go!!").Error())
+	})
+	t.Run("Tags should be folded into their canonical form without duplicates", func(t *testing.T) {
+		request := generateWriteArticleRequest()
+		request.Article.TagList = []string{"Go Lang", "go_lang", "GO-LANG", "C++"}
+		article := request.Model(primitive.NewObjectID().Hex())
+		require.Equal(t, []string{"go-lang", "c++"}, article.TagList)
+	})
+	t.Run("PublishAt is optional, but should be in the future", func(t *testing.T) {
+		request := generateWriteArticleRequest()
+		request.Article.PublishAt = nil
+		err := request.Validate()
+		require.NoError(t, err)
+		future := time.Now().Add(time.Hour)
+		request.Article.PublishAt = &future
+		err = request.Validate()
+		require.NoError(t, err)
+		past := time.Now().Add(-time.Hour)
+		request.Article.PublishAt = &past
+		err = request.Validate()
+		require.ErrorContains(t, err, api.InvalidFieldError("PublishAt", past.Format(time.RFC3339)).Error())
+	})
+	t.Run("PublishAt should schedule the article", func(t *testing.T) {
+		request := generateWriteArticleRequest()
+		future := time.Now().Add(time.Hour)
+		request.Article.PublishAt = &future
+		article := request.Model(primitive.NewObjectID().Hex())
+		require.Equal(t, models.ArticleStatusScheduled, *article.Status)
+		require.True(t, future.UTC().Truncate(time.Millisecond).Equal(*article.PublishAt))
+	})
 }
 
 func generateWriteArticleRequest() *WriteArticleRequest {
@@ -116,10 +153,3 @@ func generateWriteArticleRequest() *WriteArticleRequest {
 	article.Article.TagList = []string{"Test Tag"}
 	return article
 }
-
-func BenchmarkMakeSlug(b *testing.B) {
-	title := randomString(255)
-	for i := 0; i < b.N; i++ {
-		makeSlug(title)
-	}
-}
@@ -0,0 +1,44 @@
+package requests
+
+import (
+	"errors"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/ravilock/goduit/api/validators"
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+	"github.com/ravilock/goduit/internal/slugger"
+)
+
+type WriteSeriesRequest struct {
+	Series WriteSeriesPayload `json:"series" validate:"required"`
+}
+
+type WriteSeriesPayload struct {
+	Title       string   `json:"title" validate:"required,notblank,min=5,max=255"`
+	Description string   `json:"description" validate:"omitempty,notblank,min=5,max=255"`
+	Articles    []string `json:"articles" validate:"max=50,unique,dive,required,notblank,min=5"`
+}
+
+// Model assembles the series, the articles parameter represents the IDs of the articles the request's slugs point to.
+func (r *WriteSeriesRequest) Model(ownerID string, articles []string) *models.Series {
+	slug := slugger.Make(r.Series.Title)
+	return &models.Series{
+		Owner:       &ownerID,
+		Slug:        &slug,
+		Title:       &r.Series.Title,
+		Description: &r.Series.Description,
+		Articles:    articles,
+	}
+}
+
+func (r *WriteSeriesRequest) Validate() error {
+	if err := validators.Validate.Struct(r); err != nil {
+		if validationErrors := new(validator.ValidationErrors); errors.As(err, validationErrors) {
+			for _, validationError := range *validationErrors {
+				return validators.ToHTTP(validationError)
+			}
+		}
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package requests
+
+import (
+	"testing"
+
+	"github.com/ravilock/goduit/api"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteSeries(t *testing.T) {
+	t.Run("Valid request should not return errors", func(t *testing.T) {
+		request := generateWriteSeriesRequest()
+		err := request.Validate()
+		require.NoError(t, err)
+	})
+	t.Run("Title is required", func(t *testing.T) {
+		request := generateWriteSeriesRequest()
+		request.Series.Title = ""
+		err := request.Validate()
+		require.ErrorContains(t, err, api.RequiredFieldError("Title").Error())
+	})
+	t.Run("Title should not be blank", func(t *testing.T) {
+		request := generateWriteSeriesRequest()
+		request.Series.Title = " "
+		err := request.Validate()
+		require.ErrorContains(t, err, api.RequiredFieldError("Title").Error())
+	})
+	t.Run("Title should contain at least 5 chars", func(t *testing.T) {
+		request := generateWriteSeriesRequest()
+		request.Series.Title = "1234"
+		err := request.Validate()
+		require.ErrorContains(t, err, api.InvalidFieldLimit("Title", "min", "5").Error())
+	})
+	t.Run("Title should contain at most 255 chars", func(t *testing.T) {
+		request := generateWriteSeriesRequest()
+		request.Series.Title = randomString(256)
+		err := request.Validate()
+		require.ErrorContains(t, err, api.InvalidFieldLimit("Title", "max", "255").Error())
+	})
+	t.Run("Description is optional", func(t *testing.T) {
+		request := generateWriteSeriesRequest()
+		request.Series.Description = ""
+		err := request.Validate()
+		require.NoError(t, err)
+	})
+	t.Run("Description should contain at most 255 chars", func(t *testing.T) {
+		request := generateWriteSeriesRequest()
+		request.Series.Description = randomString(256)
+		err := request.Validate()
+		require.ErrorContains(t, err, api.InvalidFieldLimit("Description", "max", "255").Error())
+	})
+	t.Run("Articles should be unique", func(t *testing.T) {
+		request := generateWriteSeriesRequest()
+		request.Series.Articles = []string{"part-one", "part-one"}
+		err := request.Validate()
+		require.ErrorContains(t, err, api.UniqueFieldError("Articles").Error())
+	})
+	t.Run("Articles should have at most 50 articles", func(t *testing.T) {
+		request := generateWriteSeriesRequest()
+		request.Series.Articles = make([]string, 0, 51)
+		for range 51 {
+			request.Series.Articles = append(request.Series.Articles, randomString(10))
+		}
+		err := request.Validate()
+		require.ErrorContains(t, err, api.InvalidFieldLimit("Articles", "max", "50").Error())
+	})
+	t.Run("Article slugs should contain at least 5 chars", func(t *testing.T) {
+		request := generateWriteSeriesRequest()
+		request.Series.Articles = []string{"1234"}
+		err := request.Validate()
+		require.ErrorContains(t, err, api.InvalidFieldLimit("Articles[0]", "min", "5").Error())
+	})
+}
+
+func generateWriteSeriesRequest() *WriteSeriesRequest {
+	series := new(WriteSeriesRequest)
+	series.Series.Title = "Test Series"
+	series.Series.Description = "Test Description"
+	series.Series.Articles = []string{"part-one", "part-two"}
+	return series
+}
@@ -0,0 +1,26 @@
+package responses
+
+import "time"
+
+type ArticleAnalyticsResponse struct {
+	Since    time.Time          `json:"since"`
+	Articles []ArticleAnalytics `json:"articles"`
+}
+
+type ArticleAnalytics struct {
+	Slug   string          `json:"slug"`
+	Title  string          `json:"title"`
+	Totals ActivityCounts  `json:"totals"`
+	Days   []DailyActivity `json:"days"`
+}
+
+type ActivityCounts struct {
+	Views     int64 `json:"views"`
+	Favorites int64 `json:"favorites"`
+	Comments  int64 `json:"comments"`
+}
+
+type DailyActivity struct {
+	Date string `json:"date"`
+	ActivityCounts
+}
@@ -11,20 +11,29 @@ type ArticleResponse struct {
 }
 
 type Article struct {
-	CreatedAt      *time.Time                      `json:"createdAt"`
-	UpdatedAt      *time.Time                      `json:"updatedAt,omitempty"`
-	Slug           string                          `json:"slug"`
-	Title          string                          `json:"title"`
-	Description    string                          `json:"description"`
-	Body           string                          `json:"body"`
-	Author         profileManagerResponses.Profile `json:"author"`
-	TagList        []string                        `json:"tagList"`
-	FavoritesCount int64                           `json:"favoritesCount"`
-	Favorited      bool                            `json:"favorited"`
+	CreatedAt      *time.Time                        `json:"createdAt"`
+	UpdatedAt      *time.Time                        `json:"updatedAt,omitempty"`
+	Slug           string                            `json:"slug"`
+	Title          string                            `json:"title"`
+	Description    string                            `json:"description"`
+	Body           string                            `json:"body"`
+	BodyHTML       string                            `json:"bodyHtml"`
+	WordCount      int64                             `json:"wordCount"`
+	ReadingTime    int64                             `json:"readingTime"`
+	Excerpt        string                            `json:"excerpt"`
+	Author         profileManagerResponses.Profile   `json:"author"`
+	CoAuthors      []profileManagerResponses.Profile `json:"coAuthors"`
+	TagList        []string                          `json:"tagList"`
+	FavoritesCount int64                             `json:"favoritesCount"`
+	Favorited      bool                              `json:"favorited"`
+	Status         string                            `json:"status"`
+	PublishAt      *time.Time                        `json:"publishAt,omitempty"`
+	Series         *SeriesNavigation                 `json:"series,omitempty"`
 }
 
 type ArticlesResponse struct {
-	Articles []MultiArticle `json:"articles"`
+	Articles   []MultiArticle `json:"articles"`
+	NextCursor string         `json:"nextCursor,omitempty"`
 }
 
 type MultiArticle struct {
@@ -33,8 +42,23 @@ type MultiArticle struct {
 	Slug           string                          `json:"slug"`
 	Title          string                          `json:"title"`
 	Description    string                          `json:"description"`
+	WordCount      int64                           `json:"wordCount"`
+	ReadingTime    int64                           `json:"readingTime"`
+	Excerpt        string                          `json:"excerpt"`
 	Author         profileManagerResponses.Profile `json:"author"`
 	TagList        []string                        `json:"tagList"`
 	FavoritesCount int64                           `json:"favoritesCount"`
 	Favorited      bool                            `json:"favorited"`
+	Status         string                          `json:"status"`
+	PublishAt      *time.Time                      `json:"publishAt,omitempty"`
+	TrashedAt      *time.Time                      `json:"trashedAt,omitempty"`
+}
+
+type SearchArticlesResponse struct {
+	Articles []SearchArticle `json:"articles"`
+}
+
+type SearchArticle struct {
+	MultiArticle
+	Snippet string `json:"snippet"`
 }
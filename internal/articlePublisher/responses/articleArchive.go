@@ -0,0 +1,28 @@
+package responses
+
+import "time"
+
+// ArticleFrontMatter is the YAML front matter of the Markdown files articles are exported to.
+type ArticleFrontMatter struct {
+	Title       string     `yaml:"title"`
+	Description string     `yaml:"description"`
+	Tags        []string   `yaml:"tags"`
+	Status      string     `yaml:"status"`
+	PublishAt   *time.Time `yaml:"publishAt,omitempty"`
+	CreatedAt   *time.Time `yaml:"createdAt,omitempty"`
+	UpdatedAt   *time.Time `yaml:"updatedAt,omitempty"`
+	PublishedAt *time.Time `yaml:"publishedAt,omitempty"`
+}
+
+type ImportArticlesResponse struct {
+	DryRun   bool                  `json:"dryRun"`
+	Imported int                   `json:"imported"`
+	Failed   int                   `json:"failed"`
+	Files    []ImportedArticleFile `json:"files"`
+}
+
+type ImportedArticleFile struct {
+	Name  string `json:"name"`
+	Slug  string `json:"slug,omitempty"`
+	Error string `json:"error,omitempty"`
+}
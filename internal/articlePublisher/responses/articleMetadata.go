@@ -0,0 +1,67 @@
+package responses
+
+type ArticleMetadataResponse struct {
+	Metadata ArticleMetadata `json:"metadata"`
+}
+
+// ArticleMetadata is what the page of an article needs in its head to be understood by search engines and link previews.
+type ArticleMetadata struct {
+	Title          string                `json:"title"`
+	Description    string                `json:"description"`
+	CanonicalURL   string                `json:"canonicalUrl"`
+	OpenGraph      OpenGraph             `json:"openGraph"`
+	Twitter        TwitterCard           `json:"twitter"`
+	StructuredData ArticleStructuredData `json:"jsonLd"`
+}
+
+// OpenGraph holds the Open Graph properties of an article, keyed by property name.
+type OpenGraph struct {
+	Type          string   `json:"og:type"`
+	Title         string   `json:"og:title"`
+	Description   string   `json:"og:description"`
+	URL           string   `json:"og:url"`
+	SiteName      string   `json:"og:site_name"`
+	Image         string   `json:"og:image,omitempty"`
+	PublishedTime string   `json:"article:published_time"`
+	ModifiedTime  string   `json:"article:modified_time"`
+	Author        string   `json:"article:author"`
+	Tags          []string `json:"article:tag"`
+}
+
+// TwitterCard holds the Twitter card properties of an article, keyed by property name.
+type TwitterCard struct {
+	Card        string `json:"twitter:card"`
+	Title       string `json:"twitter:title"`
+	Description string `json:"twitter:description"`
+	Image       string `json:"twitter:image,omitempty"`
+}
+
+// ArticleStructuredData is the schema.org Article an article is described as in JSON-LD.
+type ArticleStructuredData struct {
+	Context          string                  `json:"@context"`
+	Type             string                  `json:"@type"`
+	Headline         string                  `json:"headline"`
+	Description      string                  `json:"description"`
+	URL              string                  `json:"url"`
+	MainEntityOfPage string                  `json:"mainEntityOfPage"`
+	Image            string                  `json:"image,omitempty"`
+	DatePublished    string                  `json:"datePublished"`
+	DateModified     string                  `json:"dateModified"`
+	Author           StructuredDataPerson    `json:"author"`
+	Publisher        StructuredDataPublisher `json:"publisher"`
+	Keywords         []string                `json:"keywords"`
+	WordCount        int64                   `json:"wordCount,omitempty"`
+}
+
+type StructuredDataPerson struct {
+	Type  string `json:"@type"`
+	Name  string `json:"name"`
+	URL   string `json:"url"`
+	Image string `json:"image,omitempty"`
+}
+
+type StructuredDataPublisher struct {
+	Type string `json:"@type"`
+	Name string `json:"name"`
+	URL  string `json:"url"`
+}
@@ -0,0 +1,23 @@
+package responses
+
+import (
+	"time"
+
+	profileManagerResponses "github.com/ravilock/goduit/internal/profileManager/responses"
+)
+
+type CoAuthorInvitationResponse struct {
+	Invitation CoAuthorInvitation `json:"invitation"`
+}
+
+type CoAuthorInvitationsResponse struct {
+	Invitations []CoAuthorInvitation `json:"invitations"`
+}
+
+type CoAuthorInvitation struct {
+	Slug      string                          `json:"slug"`
+	Title     string                          `json:"title"`
+	Inviter   profileManagerResponses.Profile `json:"inviter"`
+	Invitee   profileManagerResponses.Profile `json:"invitee"`
+	CreatedAt *time.Time                      `json:"createdAt"`
+}
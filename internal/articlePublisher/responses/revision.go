@@ -0,0 +1,57 @@
+package responses
+
+import (
+	"time"
+
+	profileManagerResponses "github.com/ravilock/goduit/internal/profileManager/responses"
+)
+
+type RevisionResponse struct {
+	Revision Revision `json:"revision"`
+}
+
+type Revision struct {
+	Number       int64                           `json:"number"`
+	CreatedAt    *time.Time                      `json:"createdAt"`
+	Author       profileManagerResponses.Profile `json:"author"`
+	Slug         string                          `json:"slug"`
+	Title        string                          `json:"title"`
+	Description  string                          `json:"description"`
+	Body         string                          `json:"body"`
+	TagList      []string                        `json:"tagList"`
+	RestoredFrom *int64                          `json:"restoredFrom,omitempty"`
+}
+
+type RevisionsResponse struct {
+	Revisions []MultiRevision `json:"revisions"`
+}
+
+type MultiRevision struct {
+	Number       int64                           `json:"number"`
+	CreatedAt    *time.Time                      `json:"createdAt"`
+	Author       profileManagerResponses.Profile `json:"author"`
+	Slug         string                          `json:"slug"`
+	Title        string                          `json:"title"`
+	Description  string                          `json:"description"`
+	TagList      []string                        `json:"tagList"`
+	RestoredFrom *int64                          `json:"restoredFrom,omitempty"`
+}
+
+type RevisionDiffResponse struct {
+	Diff RevisionDiff `json:"diff"`
+}
+
+type RevisionDiff struct {
+	From        int64        `json:"from"`
+	To          int64        `json:"to"`
+	Title       []TextChange `json:"title"`
+	Description []TextChange `json:"description"`
+	Body        []TextChange `json:"body"`
+	AddedTags   []string     `json:"addedTags"`
+	RemovedTags []string     `json:"removedTags"`
+}
+
+type TextChange struct {
+	Operation string `json:"operation"`
+	Text      string `json:"text"`
+}
@@ -0,0 +1,50 @@
+package responses
+
+import (
+	"time"
+
+	profileManagerResponses "github.com/ravilock/goduit/internal/profileManager/responses"
+)
+
+type SeriesResponse struct {
+	Series Series `json:"series"`
+}
+
+type MultipleSeriesResponse struct {
+	Series []MultiSeries `json:"series"`
+}
+
+type Series struct {
+	CreatedAt   *time.Time                      `json:"createdAt"`
+	UpdatedAt   *time.Time                      `json:"updatedAt,omitempty"`
+	Slug        string                          `json:"slug"`
+	Title       string                          `json:"title"`
+	Description string                          `json:"description"`
+	Owner       profileManagerResponses.Profile `json:"owner"`
+	Articles    []SeriesArticle                 `json:"articles"`
+}
+
+type MultiSeries struct {
+	CreatedAt     *time.Time                      `json:"createdAt"`
+	UpdatedAt     *time.Time                      `json:"updatedAt,omitempty"`
+	Slug          string                          `json:"slug"`
+	Title         string                          `json:"title"`
+	Description   string                          `json:"description"`
+	Owner         profileManagerResponses.Profile `json:"owner"`
+	ArticlesCount int                             `json:"articlesCount"`
+}
+
+type SeriesArticle struct {
+	Slug   string `json:"slug"`
+	Title  string `json:"title"`
+	Status string `json:"status"`
+}
+
+type SeriesNavigation struct {
+	Slug     string         `json:"slug"`
+	Title    string         `json:"title"`
+	Position int            `json:"position"`
+	Total    int            `json:"total"`
+	Previous *SeriesArticle `json:"previous"`
+	Next     *SeriesArticle `json:"next"`
+}
@@ -0,0 +1,20 @@
+package responses
+
+type TagsResponse struct {
+	Tags      []string `json:"tags"`
+	TagCounts []Tag    `json:"tagCounts"`
+}
+
+type Tag struct {
+	Name  string `json:"name"`
+	Count int64  `json:"count"`
+}
+
+type TagAliasesResponse struct {
+	Tag TagAliases `json:"tag"`
+}
+
+type TagAliases struct {
+	Name    string   `json:"name"`
+	Aliases []string `json:"aliases"`
+}
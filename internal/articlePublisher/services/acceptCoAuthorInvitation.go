@@ -0,0 +1,56 @@
+package services
+
+import (
+	"context"
+
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+)
+
+type invitationAnswerer interface {
+	GetInvitation(ctx context.Context, article, invitee string) (*models.CoAuthorInvitation, error)
+	DeleteInvitation(ctx context.Context, article, invitee string) error
+}
+
+type coAuthorAdder interface {
+	AddCoAuthor(ctx context.Context, ID, coAuthor string) (*models.Article, error)
+}
+
+type AcceptCoAuthorInvitationService struct {
+	repository invitationAnswerer
+	articles   coAuthorAdder
+	queue      articlePublisher
+}
+
+func NewAcceptCoAuthorInvitationService(repository invitationAnswerer, articles coAuthorAdder, queue articlePublisher) *AcceptCoAuthorInvitationService {
+	return &AcceptCoAuthorInvitationService{
+		repository: repository,
+		articles:   articles,
+		queue:      queue,
+	}
+}
+
+// AcceptInvitation makes an invited user a co-author of an article. Published articles are enqueued again,
+// so they are delivered to the followers of their new co-author.
+//
+// The article parameter represents the ID of the article.
+//
+// The invitee parameter represents the ID of the invited user.
+func (s *AcceptCoAuthorInvitationService) AcceptInvitation(ctx context.Context, article, invitee string) (*models.Article, error) {
+	if _, err := s.repository.GetInvitation(ctx, article, invitee); err != nil {
+		return nil, err
+	}
+	updatedArticle, err := s.articles.AddCoAuthor(ctx, article, invitee)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repository.DeleteInvitation(ctx, article, invitee); err != nil {
+		return nil, err
+	}
+	if !updatedArticle.IsPublished() {
+		return updatedArticle, nil
+	}
+	if err := s.queue.PublishArticle(ctx, updatedArticle); err != nil {
+		return nil, err
+	}
+	return updatedArticle, nil
+}
@@ -0,0 +1,47 @@
+package services
+
+import (
+	"context"
+
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+)
+
+type articleSummaryBackfiller interface {
+	ListUnsummarizedArticles(ctx context.Context, limit int64) ([]*models.Article, error)
+	UpdateArticleSummary(ctx context.Context, article *models.Article) error
+}
+
+type BackfillArticleSummariesService struct {
+	repository articleSummaryBackfiller
+}
+
+func NewBackfillArticleSummariesService(repository articleSummaryBackfiller) *BackfillArticleSummariesService {
+	return &BackfillArticleSummariesService{
+		repository: repository,
+	}
+}
+
+// BackfillArticleSummaries summarizes every article written before word counts, reading times and excerpts were stored,
+// batchSize articles at a time. Returns how many articles were summarized.
+func (s *BackfillArticleSummariesService) BackfillArticleSummaries(ctx context.Context, batchSize int64) (int, error) {
+	summarized := 0
+	for {
+		articles, err := s.repository.ListUnsummarizedArticles(ctx, batchSize)
+		if err != nil {
+			return summarized, err
+		}
+		if len(articles) == 0 {
+			return summarized, nil
+		}
+		for _, article := range articles {
+			if article.Body == nil {
+				article.Body = new(string)
+			}
+			summarizeBody(article)
+			if err := s.repository.UpdateArticleSummary(ctx, article); err != nil {
+				return summarized, err
+			}
+			summarized++
+		}
+	}
+}
@@ -0,0 +1,28 @@
+package services
+
+import (
+	"context"
+)
+
+type invitationDeleter interface {
+	DeleteInvitation(ctx context.Context, article, invitee string) error
+}
+
+type DeclineCoAuthorInvitationService struct {
+	repository invitationDeleter
+}
+
+func NewDeclineCoAuthorInvitationService(repository invitationDeleter) *DeclineCoAuthorInvitationService {
+	return &DeclineCoAuthorInvitationService{
+		repository: repository,
+	}
+}
+
+// DeclineInvitation discards a user's invitation to co-author an article.
+//
+// The article parameter represents the ID of the article.
+//
+// The invitee parameter represents the ID of the invited user.
+func (s *DeclineCoAuthorInvitationService) DeclineInvitation(ctx context.Context, article, invitee string) error {
+	return s.repository.DeleteInvitation(ctx, article, invitee)
+}
@@ -0,0 +1,26 @@
+package services
+
+import (
+	"context"
+)
+
+type seriesDeleter interface {
+	DeleteSeries(ctx context.Context, ID string) error
+}
+
+type DeleteSeriesService struct {
+	repository seriesDeleter
+}
+
+func NewDeleteSeriesService(repository seriesDeleter) *DeleteSeriesService {
+	return &DeleteSeriesService{
+		repository: repository,
+	}
+}
+
+// DeleteSeries deletes a series, leaving its articles untouched.
+//
+// The ID parameter represents the ID of the series.
+func (s *DeleteSeriesService) DeleteSeries(ctx context.Context, ID string) error {
+	return s.repository.DeleteSeries(ctx, ID)
+}
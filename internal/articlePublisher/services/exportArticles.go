@@ -0,0 +1,44 @@
+package services
+
+import (
+	"context"
+
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+)
+
+type authoredArticlesLister interface {
+	ListAuthoredArticles(ctx context.Context, author, after string, limit int64) ([]*models.Article, error)
+}
+
+type ExportArticlesService struct {
+	repository authoredArticlesLister
+}
+
+func NewExportArticlesService(repository authoredArticlesLister) *ExportArticlesService {
+	return &ExportArticlesService{
+		repository: repository,
+	}
+}
+
+// ExportArticles hands every article an author wrote to export, oldest first, loading batchSize articles at a time.
+// Returns how many articles were exported.
+func (s *ExportArticlesService) ExportArticles(ctx context.Context, author string, batchSize int64, export func(article *models.Article) error) (int, error) {
+	exported := 0
+	after := ""
+	for {
+		articles, err := s.repository.ListAuthoredArticles(ctx, author, after, batchSize)
+		if err != nil {
+			return exported, err
+		}
+		for _, article := range articles {
+			if err := export(article); err != nil {
+				return exported, err
+			}
+			exported++
+		}
+		if int64(len(articles)) < batchSize {
+			return exported, nil
+		}
+		after = articles[len(articles)-1].ID.Hex()
+	}
+}
@@ -0,0 +1,46 @@
+package services
+
+import (
+	"context"
+
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+)
+
+type articleFavoriter interface {
+	Favorite(ctx context.Context, article, user string) error
+	Unfavorite(ctx context.Context, article, user string) error
+}
+
+type favoritesCounter interface {
+	UpdateFavoritesCount(ctx context.Context, ID string, delta int64) (*models.Article, error)
+}
+
+type FavoriteArticleService struct {
+	repository        articleFavoriter
+	articleRepository favoritesCounter
+}
+
+func NewFavoriteArticleService(repository articleFavoriter, articleRepository favoritesCounter) *FavoriteArticleService {
+	return &FavoriteArticleService{
+		repository:        repository,
+		articleRepository: articleRepository,
+	}
+}
+
+// FavoriteArticle marks an article as favorited by a user and increments its favorites count. Returns the updated *models.Article.
+//
+// The article parameter represents the ID of the article being favorited.
+//
+// The user parameter represents the ID of the user that is favoriting.
+func (s *FavoriteArticleService) FavoriteArticle(ctx context.Context, article, user string) (*models.Article, error) {
+	if err := s.repository.Favorite(ctx, article, user); err != nil {
+		return nil, err
+	}
+	updatedArticle, err := s.articleRepository.UpdateFavoritesCount(ctx, article, 1)
+	if err != nil {
+		// The count was not updated, so the favorite must not be kept either
+		_ = s.repository.Unfavorite(ctx, article, user)
+		return nil, err
+	}
+	return updatedArticle, nil
+}
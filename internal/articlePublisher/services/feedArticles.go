@@ -11,7 +11,7 @@ type articlesGetter interface {
 }
 
 type feedPaginator interface {
-	PaginateFeed(ctx context.Context, user string, limit, offset int64) ([]models.FeedFragment, error)
+	PaginateFeed(ctx context.Context, user string, after, limit, offset int64) ([]models.FeedFragment, error)
 }
 
 type FeedArticlesService struct {
@@ -26,14 +26,38 @@ func NewFeedArticlesService(repository articlesGetter, feedRepository feedPagina
 	}
 }
 
-func (s *FeedArticlesService) FeedArticles(ctx context.Context, user string, limit, offset int64) ([]*models.Article, error) {
-	feedFragments, err := s.feedRepository.PaginateFeed(ctx, user, limit, offset)
+// FeedArticles lists the articles of a user's feed in the order they were delivered to it.
+//
+// Besides the articles, it returns the feed position the next page starts after, or zero when the feed has no more
+// fragments. The position comes from the feed itself, so articles that are no longer available do not end the pagination.
+func (s *FeedArticlesService) FeedArticles(ctx context.Context, user string, after, limit, offset int64) ([]*models.Article, int64, error) {
+	feedFragments, err := s.feedRepository.PaginateFeed(ctx, user, after, limit, offset)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 	articleIDs := make([]string, len(feedFragments))
 	for i, fragment := range feedFragments {
 		articleIDs[i] = *fragment.ArticleID
 	}
-	return s.repository.GetArticlesByIDs(ctx, articleIDs)
+	articles, err := s.repository.GetArticlesByIDs(ctx, articleIDs)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	articlesByID := make(map[string]*models.Article, len(articles))
+	for _, article := range articles {
+		articlesByID[article.ID.Hex()] = article
+	}
+	feedArticles := make([]*models.Article, 0, len(articles))
+	for _, ID := range articleIDs {
+		if article, ok := articlesByID[ID]; ok {
+			feedArticles = append(feedArticles, article)
+		}
+	}
+
+	next := int64(0)
+	if int64(len(feedFragments)) == limit {
+		next = feedFragments[len(feedFragments)-1].Position
+	}
+	return feedArticles, next, nil
 }
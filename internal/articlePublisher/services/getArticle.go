@@ -2,28 +2,73 @@ package services
 
 import (
 	"context"
+	"errors"
 
+	"github.com/ravilock/goduit/internal/app"
 	"github.com/ravilock/goduit/internal/articlePublisher/models"
 )
 
 type articleGetter interface {
 	GetArticleBySlug(ctx context.Context, slug string) (*models.Article, error)
+	GetArticleByID(ctx context.Context, ID string) (*models.Article, error)
+	CacheBodyHTML(ctx context.Context, ID, body, bodyHTML string) error
+}
+
+type slugResolver interface {
+	GetArticleIDBySlug(ctx context.Context, slug string) (string, error)
 }
 
 type GetArticleService struct {
 	repository articleGetter
+	slugs      slugResolver
 }
 
-func NewGetArticleService(repository articleGetter) *GetArticleService {
+func NewGetArticleService(repository articleGetter, slugs slugResolver) *GetArticleService {
 	return &GetArticleService{
 		repository: repository,
+		slugs:      slugs,
 	}
 }
 
+// GetArticleBySlug gets an article by its current slug or by any slug it had before. Callers can compare the
+// returned article's slug with the requested one to tell them apart.
 func (s *GetArticleService) GetArticleBySlug(ctx context.Context, slug string) (*models.Article, error) {
 	article, err := s.repository.GetArticleBySlug(ctx, slug)
+	if err == nil {
+		return s.withBodyHTML(ctx, article)
+	}
+	if appError := new(app.AppError); !errors.As(err, &appError) || appError.ErrorCode != app.ArticleNotFoundErrorCode {
+		return nil, err
+	}
+	articleID, resolveErr := s.slugs.GetArticleIDBySlug(ctx, slug)
+	if resolveErr != nil {
+		return nil, err
+	}
+	article, err = s.repository.GetArticleByID(ctx, articleID)
 	if err != nil {
 		return nil, err
 	}
+	return s.withBodyHTML(ctx, article)
+}
+
+// GetArticleByID gets an article by its ID.
+func (s *GetArticleService) GetArticleByID(ctx context.Context, ID string) (*models.Article, error) {
+	article, err := s.repository.GetArticleByID(ctx, ID)
+	if err != nil {
+		return nil, err
+	}
+	return s.withBodyHTML(ctx, article)
+}
+
+// withBodyHTML renders the body of articles written before bodies were rendered, caching the result for the next reads.
+func (s *GetArticleService) withBodyHTML(ctx context.Context, article *models.Article) (*models.Article, error) {
+	if article.BodyHTML != nil || article.Body == nil {
+		return article, nil
+	}
+	if err := renderBody(article); err != nil {
+		return nil, err
+	}
+	// Failing to cache the rendering is not worth failing the read, the next one tries again
+	_ = s.repository.CacheBodyHTML(ctx, article.ID.Hex(), *article.Body, *article.BodyHTML)
 	return article, nil
 }
@@ -0,0 +1,100 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+)
+
+type dailyViewsCounter interface {
+	CountDailyViews(ctx context.Context, articles []string, since time.Time) ([]*models.DailyCount, error)
+}
+
+type dailyFavoritesCounter interface {
+	CountDailyFavorites(ctx context.Context, articles []string, since time.Time) ([]*models.DailyCount, error)
+}
+
+type dailyCommentsCounter interface {
+	CountDailyComments(ctx context.Context, articles []string, since time.Time) ([]*models.DailyCount, error)
+}
+
+type GetArticleAnalyticsService struct {
+	views     dailyViewsCounter
+	favorites dailyFavoritesCounter
+	comments  dailyCommentsCounter
+}
+
+func NewGetArticleAnalyticsService(views dailyViewsCounter, favorites dailyFavoritesCounter, comments dailyCommentsCounter) *GetArticleAnalyticsService {
+	return &GetArticleAnalyticsService{
+		views:     views,
+		favorites: favorites,
+		comments:  comments,
+	}
+}
+
+// GetArticleAnalytics counts the views, favorites and comments of articles per day, from the day of since until today.
+// Returns the daily activity of each article by article ID, with one entry per day in chronological order.
+func (s *GetArticleAnalyticsService) GetArticleAnalytics(ctx context.Context, articles []string, since time.Time) (map[string][]*models.DailyActivity, error) {
+	days := listDays(models.Day(since), models.Day(time.Now()))
+	analytics := make(map[string][]*models.DailyActivity, len(articles))
+	for _, article := range articles {
+		activity := make([]*models.DailyActivity, 0, len(days))
+		for _, day := range days {
+			activity = append(activity, &models.DailyActivity{Day: day})
+		}
+		analytics[article] = activity
+	}
+	if len(articles) == 0 {
+		return analytics, nil
+	}
+
+	views, err := s.views.CountDailyViews(ctx, articles, since)
+	if err != nil {
+		return nil, err
+	}
+	favorites, err := s.favorites.CountDailyFavorites(ctx, articles, since)
+	if err != nil {
+		return nil, err
+	}
+	comments, err := s.comments.CountDailyComments(ctx, articles, since)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, count := range views {
+		if activity := dailyActivityOf(analytics, count); activity != nil {
+			activity.Views += count.Count
+		}
+	}
+	for _, count := range favorites {
+		if activity := dailyActivityOf(analytics, count); activity != nil {
+			activity.Favorites += count.Count
+		}
+	}
+	for _, count := range comments {
+		if activity := dailyActivityOf(analytics, count); activity != nil {
+			activity.Comments += count.Count
+		}
+	}
+	return analytics, nil
+}
+
+// listDays lists the days from first to last, both included.
+func listDays(first, last time.Time) []time.Time {
+	days := []time.Time{}
+	for day := first; !day.After(last); day = day.AddDate(0, 0, 1) {
+		days = append(days, day)
+	}
+	return days
+}
+
+// dailyActivityOf finds the daily activity a count belongs to, or nil if it is out of the counted range.
+func dailyActivityOf(analytics map[string][]*models.DailyActivity, count *models.DailyCount) *models.DailyActivity {
+	for _, activity := range analytics[count.Article] {
+		if activity.Day.Equal(models.Day(count.Day)) {
+			return activity
+		}
+	}
+	return nil
+}
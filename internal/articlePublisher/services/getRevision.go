@@ -0,0 +1,25 @@
+package services
+
+import (
+	"context"
+
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+)
+
+type revisionGetter interface {
+	GetRevision(ctx context.Context, article string, number int64) (*models.Revision, error)
+}
+
+type GetRevisionService struct {
+	repository revisionGetter
+}
+
+func NewGetRevisionService(repository revisionGetter) *GetRevisionService {
+	return &GetRevisionService{
+		repository: repository,
+	}
+}
+
+func (s *GetRevisionService) GetRevision(ctx context.Context, article string, number int64) (*models.Revision, error) {
+	return s.repository.GetRevision(ctx, article, number)
+}
@@ -0,0 +1,25 @@
+package services
+
+import (
+	"context"
+
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+)
+
+type seriesGetter interface {
+	GetSeriesBySlug(ctx context.Context, slug string) (*models.Series, error)
+}
+
+type GetSeriesService struct {
+	repository seriesGetter
+}
+
+func NewGetSeriesService(repository seriesGetter) *GetSeriesService {
+	return &GetSeriesService{
+		repository: repository,
+	}
+}
+
+func (s *GetSeriesService) GetSeriesBySlug(ctx context.Context, slug string) (*models.Series, error) {
+	return s.repository.GetSeriesBySlug(ctx, slug)
+}
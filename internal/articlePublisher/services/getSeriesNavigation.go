@@ -0,0 +1,72 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ravilock/goduit/internal/app"
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+)
+
+type articleByIDGetter interface {
+	GetArticleByID(ctx context.Context, ID string) (*models.Article, error)
+}
+
+type GetSeriesNavigationService struct {
+	repository seriesByArticleGetter
+	articles   articleByIDGetter
+}
+
+func NewGetSeriesNavigationService(repository seriesByArticleGetter, articles articleByIDGetter) *GetSeriesNavigationService {
+	return &GetSeriesNavigationService{
+		repository: repository,
+		articles:   articles,
+	}
+}
+
+// GetSeriesNavigation locates an article within its series. The previous and next parts are the closest published
+// articles on each side, skipping drafts and articles in the trash. Returns nil if the article is not part of a series.
+func (s *GetSeriesNavigationService) GetSeriesNavigation(ctx context.Context, article *models.Article) (*models.SeriesNavigation, error) {
+	articleID := article.ID.Hex()
+	series, err := s.repository.GetSeriesByArticle(ctx, articleID)
+	if err != nil {
+		if appError := new(app.AppError); errors.As(err, &appError) && appError.ErrorCode == app.SeriesNotFoundErrorCode {
+			return nil, nil
+		}
+		return nil, err
+	}
+	position := series.Position(articleID)
+	navigation := &models.SeriesNavigation{
+		Series:   series,
+		Position: position,
+		Total:    len(series.Articles),
+	}
+	if navigation.Previous, err = s.closestPublished(ctx, series.Articles[:position-1], true); err != nil {
+		return nil, err
+	}
+	if navigation.Next, err = s.closestPublished(ctx, series.Articles[position:], false); err != nil {
+		return nil, err
+	}
+	return navigation, nil
+}
+
+// closestPublished gets the first published article among IDs, searching from the end when backwards is set.
+func (s *GetSeriesNavigationService) closestPublished(ctx context.Context, IDs []string, backwards bool) (*models.Article, error) {
+	for i := range IDs {
+		ID := IDs[i]
+		if backwards {
+			ID = IDs[len(IDs)-1-i]
+		}
+		article, err := s.articles.GetArticleByID(ctx, ID)
+		if err != nil {
+			if appError := new(app.AppError); errors.As(err, &appError) && appError.ErrorCode == app.ArticleNotFoundErrorCode {
+				continue
+			}
+			return nil, err
+		}
+		if article.IsPublished() {
+			return article, nil
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,25 @@
+package services
+
+import (
+	"context"
+
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+)
+
+type trashedArticleGetter interface {
+	GetTrashedArticleBySlug(ctx context.Context, slug string) (*models.Article, error)
+}
+
+type GetTrashedArticleService struct {
+	repository trashedArticleGetter
+}
+
+func NewGetTrashedArticleService(repository trashedArticleGetter) *GetTrashedArticleService {
+	return &GetTrashedArticleService{
+		repository: repository,
+	}
+}
+
+func (s *GetTrashedArticleService) GetTrashedArticleBySlug(ctx context.Context, slug string) (*models.Article, error) {
+	return s.repository.GetTrashedArticleBySlug(ctx, slug)
+}
@@ -0,0 +1,42 @@
+package services
+
+import (
+	"context"
+
+	"github.com/ravilock/goduit/internal/app"
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+)
+
+type invitationWriter interface {
+	WriteInvitation(ctx context.Context, invitation *models.CoAuthorInvitation) error
+}
+
+type InviteCoAuthorService struct {
+	repository invitationWriter
+}
+
+func NewInviteCoAuthorService(repository invitationWriter) *InviteCoAuthorService {
+	return &InviteCoAuthorService{
+		repository: repository,
+	}
+}
+
+// InviteCoAuthor invites a user to co-author an article on behalf of its primary author.
+// Users that are already authors of the article cannot be invited.
+//
+// The invitee parameter represents the ID of the invited user.
+func (s *InviteCoAuthorService) InviteCoAuthor(ctx context.Context, article *models.Article, invitee string) (*models.CoAuthorInvitation, error) {
+	if article.HasAuthor(invitee) {
+		return nil, app.ConflictError("coAuthors")
+	}
+	articleID := article.ID.Hex()
+	invitation := &models.CoAuthorInvitation{
+		Article: &articleID,
+		Invitee: &invitee,
+		Inviter: article.Author,
+	}
+	if err := s.repository.WriteInvitation(ctx, invitation); err != nil {
+		return nil, err
+	}
+	return invitation, nil
+}
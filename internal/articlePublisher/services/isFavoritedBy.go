@@ -0,0 +1,34 @@
+package services
+
+import (
+	"context"
+
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+)
+
+type isFavoritedChecker interface {
+	IsFavoritedBy(ctx context.Context, article, user string) (*models.Favorite, error)
+}
+
+type IsFavoritedByService struct {
+	repository isFavoritedChecker
+}
+
+func NewIsFavoritedByService(repository isFavoritedChecker) *IsFavoritedByService {
+	return &IsFavoritedByService{
+		repository: repository,
+	}
+}
+
+// IsFavoritedBy determines wether or not a user has favorited an article. Returns bool
+//
+// The article parameter represents the ID of the article.
+//
+// The user parameter represents the ID of the user.
+func (s *IsFavoritedByService) IsFavoritedBy(ctx context.Context, article, user string) bool {
+	if user == "" {
+		return false
+	}
+	_, err := s.repository.IsFavoritedBy(ctx, article, user)
+	return err == nil
+}
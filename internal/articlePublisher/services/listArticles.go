@@ -7,19 +7,30 @@ import (
 )
 
 type articleLister interface {
-	ListArticles(ctx context.Context, author, tag string, limit, offset int64) ([]*models.Article, error)
+	ListArticles(ctx context.Context, author, tag, favorited, after string, limit, offset int64) ([]*models.Article, error)
 }
 
 type ListArticlesService struct {
 	repository articleLister
+	tags       tagResolver
 }
 
-func NewListArticlesService(repository articleLister) *ListArticlesService {
+func NewListArticlesService(repository articleLister, tags tagResolver) *ListArticlesService {
 	return &ListArticlesService{
 		repository: repository,
+		tags:       tags,
 	}
 }
 
-func (s *ListArticlesService) ListArticles(ctx context.Context, author, tag string, limit, offset int64) ([]*models.Article, error) {
-	return s.repository.ListArticles(ctx, author, tag, limit, offset)
+// ListArticles lists published articles, optionally filtered by author, tag or a user's favorites.
+// Tags are looked up by their canonical form, so aliases and variants of a tag list the same articles.
+func (s *ListArticlesService) ListArticles(ctx context.Context, author, tag, favorited, after string, limit, offset int64) ([]*models.Article, error) {
+	if tag != "" {
+		resolvedTag, err := resolveTag(ctx, s.tags, tag)
+		if err != nil {
+			return nil, err
+		}
+		tag = resolvedTag
+	}
+	return s.repository.ListArticles(ctx, author, tag, favorited, after, limit, offset)
 }
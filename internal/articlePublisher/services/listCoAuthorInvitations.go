@@ -0,0 +1,26 @@
+package services
+
+import (
+	"context"
+
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+)
+
+type invitationLister interface {
+	ListInvitations(ctx context.Context, invitee string, limit, offset int64) ([]*models.CoAuthorInvitation, error)
+}
+
+type ListCoAuthorInvitationsService struct {
+	repository invitationLister
+}
+
+func NewListCoAuthorInvitationsService(repository invitationLister) *ListCoAuthorInvitationsService {
+	return &ListCoAuthorInvitationsService{
+		repository: repository,
+	}
+}
+
+// ListInvitations lists a user's pending invitations to co-author articles, most recent first.
+func (s *ListCoAuthorInvitationsService) ListInvitations(ctx context.Context, invitee string, limit, offset int64) ([]*models.CoAuthorInvitation, error) {
+	return s.repository.ListInvitations(ctx, invitee, limit, offset)
+}
@@ -0,0 +1,25 @@
+package services
+
+import (
+	"context"
+
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+)
+
+type draftLister interface {
+	ListDrafts(ctx context.Context, author string, limit, offset int64) ([]*models.Article, error)
+}
+
+type ListDraftsService struct {
+	repository draftLister
+}
+
+func NewListDraftsService(repository draftLister) *ListDraftsService {
+	return &ListDraftsService{
+		repository: repository,
+	}
+}
+
+func (s *ListDraftsService) ListDrafts(ctx context.Context, author string, limit, offset int64) ([]*models.Article, error) {
+	return s.repository.ListDrafts(ctx, author, limit, offset)
+}
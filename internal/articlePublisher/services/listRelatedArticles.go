@@ -0,0 +1,25 @@
+package services
+
+import (
+	"context"
+
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+)
+
+type relatedArticlesLister interface {
+	ListRelatedArticles(ctx context.Context, article *models.Article, limit int64) ([]*models.Article, error)
+}
+
+type ListRelatedArticlesService struct {
+	repository relatedArticlesLister
+}
+
+func NewListRelatedArticlesService(repository relatedArticlesLister) *ListRelatedArticlesService {
+	return &ListRelatedArticlesService{
+		repository: repository,
+	}
+}
+
+func (s *ListRelatedArticlesService) ListRelatedArticles(ctx context.Context, article *models.Article, limit int64) ([]*models.Article, error) {
+	return s.repository.ListRelatedArticles(ctx, article, limit)
+}
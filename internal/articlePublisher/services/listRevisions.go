@@ -0,0 +1,25 @@
+package services
+
+import (
+	"context"
+
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+)
+
+type revisionLister interface {
+	ListRevisions(ctx context.Context, article string, limit, offset int64) ([]*models.Revision, error)
+}
+
+type ListRevisionsService struct {
+	repository revisionLister
+}
+
+func NewListRevisionsService(repository revisionLister) *ListRevisionsService {
+	return &ListRevisionsService{
+		repository: repository,
+	}
+}
+
+func (s *ListRevisionsService) ListRevisions(ctx context.Context, article string, limit, offset int64) ([]*models.Revision, error) {
+	return s.repository.ListRevisions(ctx, article, limit, offset)
+}
@@ -0,0 +1,28 @@
+package services
+
+import (
+	"context"
+
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+)
+
+type seriesLister interface {
+	ListSeries(ctx context.Context, owner string, limit, offset int64) ([]*models.Series, error)
+}
+
+type ListSeriesService struct {
+	repository seriesLister
+}
+
+func NewListSeriesService(repository seriesLister) *ListSeriesService {
+	return &ListSeriesService{
+		repository: repository,
+	}
+}
+
+// ListSeries lists series, most recent first.
+//
+// The owner parameter represents the ID of the profile owning the series, an empty owner lists every series.
+func (s *ListSeriesService) ListSeries(ctx context.Context, owner string, limit, offset int64) ([]*models.Series, error) {
+	return s.repository.ListSeries(ctx, owner, limit, offset)
+}
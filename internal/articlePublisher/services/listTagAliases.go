@@ -0,0 +1,36 @@
+package services
+
+import (
+	"context"
+
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+)
+
+type tagAliasesLister interface {
+	tagResolver
+	ListTagAliases(ctx context.Context, tag string) ([]*models.TagAlias, error)
+}
+
+type ListTagAliasesService struct {
+	repository tagAliasesLister
+}
+
+func NewListTagAliasesService(repository tagAliasesLister) *ListTagAliasesService {
+	return &ListTagAliasesService{
+		repository: repository,
+	}
+}
+
+// ListTagAliases resolves a tag to its canonical tag and lists the aliases resolving to it. Returns the canonical tag
+// alongside its aliases.
+func (s *ListTagAliasesService) ListTagAliases(ctx context.Context, tag string) (string, []*models.TagAlias, error) {
+	canonicalTag, err := resolveTag(ctx, s.repository, tag)
+	if err != nil {
+		return "", nil, err
+	}
+	aliases, err := s.repository.ListTagAliases(ctx, canonicalTag)
+	if err != nil {
+		return "", nil, err
+	}
+	return canonicalTag, aliases, nil
+}
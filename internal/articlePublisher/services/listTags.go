@@ -0,0 +1,29 @@
+package services
+
+import (
+	"context"
+
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+	"github.com/ravilock/goduit/internal/slugger"
+)
+
+type tagLister interface {
+	ListTags(ctx context.Context, prefix string, limit int64) ([]*models.Tag, error)
+}
+
+type ListTagsService struct {
+	repository tagLister
+}
+
+func NewListTagsService(repository tagLister) *ListTagsService {
+	return &ListTagsService{
+		repository: repository,
+	}
+}
+
+// ListTags lists the most used tags ordered by usage count.
+//
+// The prefix parameter, when not empty, restricts the results to tags starting with it.
+func (s *ListTagsService) ListTags(ctx context.Context, prefix string, limit int64) ([]*models.Tag, error) {
+	return s.repository.ListTags(ctx, slugger.Tag(prefix), limit)
+}
@@ -0,0 +1,25 @@
+package services
+
+import (
+	"context"
+
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+)
+
+type trashLister interface {
+	ListTrashedArticles(ctx context.Context, author string, limit, offset int64) ([]*models.Article, error)
+}
+
+type ListTrashService struct {
+	repository trashLister
+}
+
+func NewListTrashService(repository trashLister) *ListTrashService {
+	return &ListTrashService{
+		repository: repository,
+	}
+}
+
+func (s *ListTrashService) ListTrashedArticles(ctx context.Context, author string, limit, offset int64) ([]*models.Article, error) {
+	return s.repository.ListTrashedArticles(ctx, author, limit, offset)
+}
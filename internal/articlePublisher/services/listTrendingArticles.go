@@ -0,0 +1,63 @@
+package services
+
+import (
+	"context"
+
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+)
+
+type trendingLister interface {
+	ListTrendingArticles(ctx context.Context, window, tag string, limit, offset int64) ([]*models.TrendingArticle, error)
+}
+
+type ListTrendingArticlesService struct {
+	repository articlesGetter
+	trending   trendingLister
+	tags       tagResolver
+}
+
+func NewListTrendingArticlesService(repository articlesGetter, trending trendingLister, tags tagResolver) *ListTrendingArticlesService {
+	return &ListTrendingArticlesService{
+		repository: repository,
+		trending:   trending,
+		tags:       tags,
+	}
+}
+
+// ListTrendingArticles lists the trending articles of a window, highest scored first.
+// Articles unpublished since the scores were last computed are left out.
+func (s *ListTrendingArticlesService) ListTrendingArticles(ctx context.Context, window, tag string, limit, offset int64) ([]*models.Article, error) {
+	if tag != "" {
+		resolvedTag, err := resolveTag(ctx, s.tags, tag)
+		if err != nil {
+			return nil, err
+		}
+		tag = resolvedTag
+	}
+	trending, err := s.trending.ListTrendingArticles(ctx, window, tag, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	if len(trending) == 0 {
+		return []*models.Article{}, nil
+	}
+	articleIDs := make([]string, 0, len(trending))
+	for _, trendingArticle := range trending {
+		articleIDs = append(articleIDs, *trendingArticle.Article)
+	}
+	articles, err := s.repository.GetArticlesByIDs(ctx, articleIDs)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[string]*models.Article, len(articles))
+	for _, article := range articles {
+		byID[article.ID.Hex()] = article
+	}
+	results := make([]*models.Article, 0, len(articles))
+	for _, articleID := range articleIDs {
+		if article, ok := byID[articleID]; ok {
+			results = append(results, article)
+		}
+	}
+	return results, nil
+}
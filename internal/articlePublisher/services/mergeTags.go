@@ -0,0 +1,75 @@
+package services
+
+import (
+	"context"
+
+	"github.com/ravilock/goduit/internal/app"
+	"github.com/ravilock/goduit/internal/slugger"
+)
+
+type taggedArticlesRewriter interface {
+	ReplaceTag(ctx context.Context, from, to string) (int64, error)
+	CountTaggedArticles(ctx context.Context, tag string) (int64, error)
+}
+
+type tagMerger interface {
+	tagResolver
+	AliasTag(ctx context.Context, alias, tag string) error
+	SetTagCount(ctx context.Context, tag string, count int64) error
+}
+
+type followedTagReplacer interface {
+	ReplaceFollowedTag(ctx context.Context, from, to string) error
+}
+
+type MergeTagsService struct {
+	repository taggedArticlesRewriter
+	tags       tagMerger
+	followers  followedTagReplacer
+}
+
+func NewMergeTagsService(repository taggedArticlesRewriter, tags tagMerger, followers followedTagReplacer) *MergeTagsService {
+	return &MergeTagsService{
+		repository: repository,
+		tags:       tags,
+		followers:  followers,
+	}
+}
+
+// MergeTags folds a tag into another one. The merged tag becomes an alias of the canonical tag, the articles and users
+// using it are moved to the canonical tag and the catalogue counts are recomputed. Returns the canonical tag.
+//
+// The from parameter represents the tag being merged.
+//
+// The into parameter represents the tag it is merged into, resolved to its canonical tag if it is an alias.
+func (s *MergeTagsService) MergeTags(ctx context.Context, from, into string) (string, error) {
+	from = slugger.Tag(from)
+	into, err := resolveTag(ctx, s.tags, into)
+	if err != nil {
+		return "", err
+	}
+	if from == into {
+		return "", app.ConflictError("tags")
+	}
+	// Aliasing first makes articles written during the merge use the canonical tag already
+	if err := s.tags.AliasTag(ctx, from, into); err != nil {
+		return "", err
+	}
+	if _, err := s.repository.ReplaceTag(ctx, from, into); err != nil {
+		return "", err
+	}
+	count, err := s.repository.CountTaggedArticles(ctx, into)
+	if err != nil {
+		return "", err
+	}
+	if err := s.tags.SetTagCount(ctx, into, count); err != nil {
+		return "", err
+	}
+	if err := s.tags.SetTagCount(ctx, from, 0); err != nil {
+		return "", err
+	}
+	if err := s.followers.ReplaceFollowedTag(ctx, from, into); err != nil {
+		return "", err
+	}
+	return into, nil
+}
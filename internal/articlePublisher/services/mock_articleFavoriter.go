@@ -0,0 +1,132 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package services
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockArticleFavoriter is an autogenerated mock type for the articleFavoriter type
+type mockArticleFavoriter struct {
+	mock.Mock
+}
+
+type mockArticleFavoriter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockArticleFavoriter) EXPECT() *mockArticleFavoriter_Expecter {
+	return &mockArticleFavoriter_Expecter{mock: &_m.Mock}
+}
+
+// Favorite provides a mock function with given fields: ctx, article, user
+func (_m *mockArticleFavoriter) Favorite(ctx context.Context, article string, user string) error {
+	ret := _m.Called(ctx, article, user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Favorite")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, article, user)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// mockArticleFavoriter_Favorite_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Favorite'
+type mockArticleFavoriter_Favorite_Call struct {
+	*mock.Call
+}
+
+// Favorite is a helper method to define mock.On call
+//   - ctx context.Context
+//   - article string
+//   - user string
+func (_e *mockArticleFavoriter_Expecter) Favorite(ctx interface{}, article interface{}, user interface{}) *mockArticleFavoriter_Favorite_Call {
+	return &mockArticleFavoriter_Favorite_Call{Call: _e.mock.On("Favorite", ctx, article, user)}
+}
+
+func (_c *mockArticleFavoriter_Favorite_Call) Run(run func(ctx context.Context, article string, user string)) *mockArticleFavoriter_Favorite_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *mockArticleFavoriter_Favorite_Call) Return(_a0 error) *mockArticleFavoriter_Favorite_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *mockArticleFavoriter_Favorite_Call) RunAndReturn(run func(context.Context, string, string) error) *mockArticleFavoriter_Favorite_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Unfavorite provides a mock function with given fields: ctx, article, user
+func (_m *mockArticleFavoriter) Unfavorite(ctx context.Context, article string, user string) error {
+	ret := _m.Called(ctx, article, user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Unfavorite")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, article, user)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// mockArticleFavoriter_Unfavorite_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unfavorite'
+type mockArticleFavoriter_Unfavorite_Call struct {
+	*mock.Call
+}
+
+// Unfavorite is a helper method to define mock.On call
+//   - ctx context.Context
+//   - article string
+//   - user string
+func (_e *mockArticleFavoriter_Expecter) Unfavorite(ctx interface{}, article interface{}, user interface{}) *mockArticleFavoriter_Unfavorite_Call {
+	return &mockArticleFavoriter_Unfavorite_Call{Call: _e.mock.On("Unfavorite", ctx, article, user)}
+}
+
+func (_c *mockArticleFavoriter_Unfavorite_Call) Run(run func(ctx context.Context, article string, user string)) *mockArticleFavoriter_Unfavorite_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *mockArticleFavoriter_Unfavorite_Call) Return(_a0 error) *mockArticleFavoriter_Unfavorite_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *mockArticleFavoriter_Unfavorite_Call) RunAndReturn(run func(context.Context, string, string) error) *mockArticleFavoriter_Unfavorite_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockArticleFavoriter creates a new instance of mockArticleFavoriter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockArticleFavoriter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockArticleFavoriter {
+	mock := &mockArticleFavoriter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -22,6 +22,114 @@ func (_m *mockArticleGetter) EXPECT() *mockArticleGetter_Expecter {
 	return &mockArticleGetter_Expecter{mock: &_m.Mock}
 }
 
+// CacheBodyHTML provides a mock function with given fields: ctx, ID, body, bodyHTML
+func (_m *mockArticleGetter) CacheBodyHTML(ctx context.Context, ID string, body string, bodyHTML string) error {
+	ret := _m.Called(ctx, ID, body, bodyHTML)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CacheBodyHTML")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(ctx, ID, body, bodyHTML)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// mockArticleGetter_CacheBodyHTML_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CacheBodyHTML'
+type mockArticleGetter_CacheBodyHTML_Call struct {
+	*mock.Call
+}
+
+// CacheBodyHTML is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ID string
+//   - body string
+//   - bodyHTML string
+func (_e *mockArticleGetter_Expecter) CacheBodyHTML(ctx interface{}, ID interface{}, body interface{}, bodyHTML interface{}) *mockArticleGetter_CacheBodyHTML_Call {
+	return &mockArticleGetter_CacheBodyHTML_Call{Call: _e.mock.On("CacheBodyHTML", ctx, ID, body, bodyHTML)}
+}
+
+func (_c *mockArticleGetter_CacheBodyHTML_Call) Run(run func(ctx context.Context, ID string, body string, bodyHTML string)) *mockArticleGetter_CacheBodyHTML_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *mockArticleGetter_CacheBodyHTML_Call) Return(_a0 error) *mockArticleGetter_CacheBodyHTML_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *mockArticleGetter_CacheBodyHTML_Call) RunAndReturn(run func(context.Context, string, string, string) error) *mockArticleGetter_CacheBodyHTML_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetArticleByID provides a mock function with given fields: ctx, ID
+func (_m *mockArticleGetter) GetArticleByID(ctx context.Context, ID string) (*models.Article, error) {
+	ret := _m.Called(ctx, ID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetArticleByID")
+	}
+
+	var r0 *models.Article
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.Article, error)); ok {
+		return rf(ctx, ID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *models.Article); ok {
+		r0 = rf(ctx, ID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Article)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, ID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockArticleGetter_GetArticleByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetArticleByID'
+type mockArticleGetter_GetArticleByID_Call struct {
+	*mock.Call
+}
+
+// GetArticleByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ID string
+func (_e *mockArticleGetter_Expecter) GetArticleByID(ctx interface{}, ID interface{}) *mockArticleGetter_GetArticleByID_Call {
+	return &mockArticleGetter_GetArticleByID_Call{Call: _e.mock.On("GetArticleByID", ctx, ID)}
+}
+
+func (_c *mockArticleGetter_GetArticleByID_Call) Run(run func(ctx context.Context, ID string)) *mockArticleGetter_GetArticleByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *mockArticleGetter_GetArticleByID_Call) Return(_a0 *models.Article, _a1 error) *mockArticleGetter_GetArticleByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *mockArticleGetter_GetArticleByID_Call) RunAndReturn(run func(context.Context, string) (*models.Article, error)) *mockArticleGetter_GetArticleByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetArticleBySlug provides a mock function with given fields: ctx, slug
 func (_m *mockArticleGetter) GetArticleBySlug(ctx context.Context, slug string) (*models.Article, error) {
 	ret := _m.Called(ctx, slug)
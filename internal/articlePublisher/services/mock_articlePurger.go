@@ -0,0 +1,83 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package services
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockArticlePurger is an autogenerated mock type for the articlePurger type
+type mockArticlePurger struct {
+	mock.Mock
+}
+
+type mockArticlePurger_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockArticlePurger) EXPECT() *mockArticlePurger_Expecter {
+	return &mockArticlePurger_Expecter{mock: &_m.Mock}
+}
+
+// DeleteArticle provides a mock function with given fields: ctx, ID
+func (_m *mockArticlePurger) DeleteArticle(ctx context.Context, ID string) error {
+	ret := _m.Called(ctx, ID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteArticle")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, ID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// mockArticlePurger_DeleteArticle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteArticle'
+type mockArticlePurger_DeleteArticle_Call struct {
+	*mock.Call
+}
+
+// DeleteArticle is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ID string
+func (_e *mockArticlePurger_Expecter) DeleteArticle(ctx interface{}, ID interface{}) *mockArticlePurger_DeleteArticle_Call {
+	return &mockArticlePurger_DeleteArticle_Call{Call: _e.mock.On("DeleteArticle", ctx, ID)}
+}
+
+func (_c *mockArticlePurger_DeleteArticle_Call) Run(run func(ctx context.Context, ID string)) *mockArticlePurger_DeleteArticle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *mockArticlePurger_DeleteArticle_Call) Return(_a0 error) *mockArticlePurger_DeleteArticle_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *mockArticlePurger_DeleteArticle_Call) RunAndReturn(run func(context.Context, string) error) *mockArticlePurger_DeleteArticle_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockArticlePurger creates a new instance of mockArticlePurger. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockArticlePurger(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockArticlePurger {
+	mock := &mockArticlePurger{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,143 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package services
+
+import (
+	context "context"
+
+	models "github.com/ravilock/goduit/internal/articlePublisher/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockArticleSummaryBackfiller is an autogenerated mock type for the articleSummaryBackfiller type
+type mockArticleSummaryBackfiller struct {
+	mock.Mock
+}
+
+type mockArticleSummaryBackfiller_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockArticleSummaryBackfiller) EXPECT() *mockArticleSummaryBackfiller_Expecter {
+	return &mockArticleSummaryBackfiller_Expecter{mock: &_m.Mock}
+}
+
+// ListUnsummarizedArticles provides a mock function with given fields: ctx, limit
+func (_m *mockArticleSummaryBackfiller) ListUnsummarizedArticles(ctx context.Context, limit int64) ([]*models.Article, error) {
+	ret := _m.Called(ctx, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListUnsummarizedArticles")
+	}
+
+	var r0 []*models.Article
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]*models.Article, error)); ok {
+		return rf(ctx, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []*models.Article); ok {
+		r0 = rf(ctx, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Article)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockArticleSummaryBackfiller_ListUnsummarizedArticles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUnsummarizedArticles'
+type mockArticleSummaryBackfiller_ListUnsummarizedArticles_Call struct {
+	*mock.Call
+}
+
+// ListUnsummarizedArticles is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int64
+func (_e *mockArticleSummaryBackfiller_Expecter) ListUnsummarizedArticles(ctx interface{}, limit interface{}) *mockArticleSummaryBackfiller_ListUnsummarizedArticles_Call {
+	return &mockArticleSummaryBackfiller_ListUnsummarizedArticles_Call{Call: _e.mock.On("ListUnsummarizedArticles", ctx, limit)}
+}
+
+func (_c *mockArticleSummaryBackfiller_ListUnsummarizedArticles_Call) Run(run func(ctx context.Context, limit int64)) *mockArticleSummaryBackfiller_ListUnsummarizedArticles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *mockArticleSummaryBackfiller_ListUnsummarizedArticles_Call) Return(_a0 []*models.Article, _a1 error) *mockArticleSummaryBackfiller_ListUnsummarizedArticles_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *mockArticleSummaryBackfiller_ListUnsummarizedArticles_Call) RunAndReturn(run func(context.Context, int64) ([]*models.Article, error)) *mockArticleSummaryBackfiller_ListUnsummarizedArticles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateArticleSummary provides a mock function with given fields: ctx, article
+func (_m *mockArticleSummaryBackfiller) UpdateArticleSummary(ctx context.Context, article *models.Article) error {
+	ret := _m.Called(ctx, article)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateArticleSummary")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Article) error); ok {
+		r0 = rf(ctx, article)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// mockArticleSummaryBackfiller_UpdateArticleSummary_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateArticleSummary'
+type mockArticleSummaryBackfiller_UpdateArticleSummary_Call struct {
+	*mock.Call
+}
+
+// UpdateArticleSummary is a helper method to define mock.On call
+//   - ctx context.Context
+//   - article *models.Article
+func (_e *mockArticleSummaryBackfiller_Expecter) UpdateArticleSummary(ctx interface{}, article interface{}) *mockArticleSummaryBackfiller_UpdateArticleSummary_Call {
+	return &mockArticleSummaryBackfiller_UpdateArticleSummary_Call{Call: _e.mock.On("UpdateArticleSummary", ctx, article)}
+}
+
+func (_c *mockArticleSummaryBackfiller_UpdateArticleSummary_Call) Run(run func(ctx context.Context, article *models.Article)) *mockArticleSummaryBackfiller_UpdateArticleSummary_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.Article))
+	})
+	return _c
+}
+
+func (_c *mockArticleSummaryBackfiller_UpdateArticleSummary_Call) Return(_a0 error) *mockArticleSummaryBackfiller_UpdateArticleSummary_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *mockArticleSummaryBackfiller_UpdateArticleSummary_Call) RunAndReturn(run func(context.Context, *models.Article) error) *mockArticleSummaryBackfiller_UpdateArticleSummary_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockArticleSummaryBackfiller creates a new instance of mockArticleSummaryBackfiller. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockArticleSummaryBackfiller(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockArticleSummaryBackfiller {
+	mock := &mockArticleSummaryBackfiller{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,143 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package services
+
+import (
+	context "context"
+
+	models "github.com/ravilock/goduit/internal/articlePublisher/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockArticleTrasher is an autogenerated mock type for the articleTrasher type
+type mockArticleTrasher struct {
+	mock.Mock
+}
+
+type mockArticleTrasher_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockArticleTrasher) EXPECT() *mockArticleTrasher_Expecter {
+	return &mockArticleTrasher_Expecter{mock: &_m.Mock}
+}
+
+// GetArticleBySlug provides a mock function with given fields: ctx, slug
+func (_m *mockArticleTrasher) GetArticleBySlug(ctx context.Context, slug string) (*models.Article, error) {
+	ret := _m.Called(ctx, slug)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetArticleBySlug")
+	}
+
+	var r0 *models.Article
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.Article, error)); ok {
+		return rf(ctx, slug)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *models.Article); ok {
+		r0 = rf(ctx, slug)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Article)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, slug)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockArticleTrasher_GetArticleBySlug_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetArticleBySlug'
+type mockArticleTrasher_GetArticleBySlug_Call struct {
+	*mock.Call
+}
+
+// GetArticleBySlug is a helper method to define mock.On call
+//   - ctx context.Context
+//   - slug string
+func (_e *mockArticleTrasher_Expecter) GetArticleBySlug(ctx interface{}, slug interface{}) *mockArticleTrasher_GetArticleBySlug_Call {
+	return &mockArticleTrasher_GetArticleBySlug_Call{Call: _e.mock.On("GetArticleBySlug", ctx, slug)}
+}
+
+func (_c *mockArticleTrasher_GetArticleBySlug_Call) Run(run func(ctx context.Context, slug string)) *mockArticleTrasher_GetArticleBySlug_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *mockArticleTrasher_GetArticleBySlug_Call) Return(_a0 *models.Article, _a1 error) *mockArticleTrasher_GetArticleBySlug_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *mockArticleTrasher_GetArticleBySlug_Call) RunAndReturn(run func(context.Context, string) (*models.Article, error)) *mockArticleTrasher_GetArticleBySlug_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TrashArticle provides a mock function with given fields: ctx, slug
+func (_m *mockArticleTrasher) TrashArticle(ctx context.Context, slug string) error {
+	ret := _m.Called(ctx, slug)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TrashArticle")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, slug)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// mockArticleTrasher_TrashArticle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TrashArticle'
+type mockArticleTrasher_TrashArticle_Call struct {
+	*mock.Call
+}
+
+// TrashArticle is a helper method to define mock.On call
+//   - ctx context.Context
+//   - slug string
+func (_e *mockArticleTrasher_Expecter) TrashArticle(ctx interface{}, slug interface{}) *mockArticleTrasher_TrashArticle_Call {
+	return &mockArticleTrasher_TrashArticle_Call{Call: _e.mock.On("TrashArticle", ctx, slug)}
+}
+
+func (_c *mockArticleTrasher_TrashArticle_Call) Run(run func(ctx context.Context, slug string)) *mockArticleTrasher_TrashArticle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *mockArticleTrasher_TrashArticle_Call) Return(_a0 error) *mockArticleTrasher_TrashArticle_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *mockArticleTrasher_TrashArticle_Call) RunAndReturn(run func(context.Context, string) error) *mockArticleTrasher_TrashArticle_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockArticleTrasher creates a new instance of mockArticleTrasher. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockArticleTrasher(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockArticleTrasher {
+	mock := &mockArticleTrasher{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -22,6 +22,53 @@ func (_m *mockArticleWriter) EXPECT() *mockArticleWriter_Expecter {
 	return &mockArticleWriter_Expecter{mock: &_m.Mock}
 }
 
+// CompleteFanout provides a mock function with given fields: ctx, ID
+func (_m *mockArticleWriter) CompleteFanout(ctx context.Context, ID string) error {
+	ret := _m.Called(ctx, ID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CompleteFanout")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, ID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// mockArticleWriter_CompleteFanout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CompleteFanout'
+type mockArticleWriter_CompleteFanout_Call struct {
+	*mock.Call
+}
+
+// CompleteFanout is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ID string
+func (_e *mockArticleWriter_Expecter) CompleteFanout(ctx interface{}, ID interface{}) *mockArticleWriter_CompleteFanout_Call {
+	return &mockArticleWriter_CompleteFanout_Call{Call: _e.mock.On("CompleteFanout", ctx, ID)}
+}
+
+func (_c *mockArticleWriter_CompleteFanout_Call) Run(run func(ctx context.Context, ID string)) *mockArticleWriter_CompleteFanout_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *mockArticleWriter_CompleteFanout_Call) Return(_a0 error) *mockArticleWriter_CompleteFanout_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *mockArticleWriter_CompleteFanout_Call) RunAndReturn(run func(context.Context, string) error) *mockArticleWriter_CompleteFanout_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // WriteArticle provides a mock function with given fields: ctx, article
 func (_m *mockArticleWriter) WriteArticle(ctx context.Context, article *models.Article) error {
 	ret := _m.Called(ctx, article)
@@ -0,0 +1,98 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package services
+
+import (
+	context "context"
+
+	models "github.com/ravilock/goduit/internal/articlePublisher/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockAuthoredArticlesLister is an autogenerated mock type for the authoredArticlesLister type
+type mockAuthoredArticlesLister struct {
+	mock.Mock
+}
+
+type mockAuthoredArticlesLister_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockAuthoredArticlesLister) EXPECT() *mockAuthoredArticlesLister_Expecter {
+	return &mockAuthoredArticlesLister_Expecter{mock: &_m.Mock}
+}
+
+// ListAuthoredArticles provides a mock function with given fields: ctx, author, after, limit
+func (_m *mockAuthoredArticlesLister) ListAuthoredArticles(ctx context.Context, author string, after string, limit int64) ([]*models.Article, error) {
+	ret := _m.Called(ctx, author, after, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAuthoredArticles")
+	}
+
+	var r0 []*models.Article
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int64) ([]*models.Article, error)); ok {
+		return rf(ctx, author, after, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int64) []*models.Article); ok {
+		r0 = rf(ctx, author, after, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Article)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int64) error); ok {
+		r1 = rf(ctx, author, after, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockAuthoredArticlesLister_ListAuthoredArticles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAuthoredArticles'
+type mockAuthoredArticlesLister_ListAuthoredArticles_Call struct {
+	*mock.Call
+}
+
+// ListAuthoredArticles is a helper method to define mock.On call
+//   - ctx context.Context
+//   - author string
+//   - after string
+//   - limit int64
+func (_e *mockAuthoredArticlesLister_Expecter) ListAuthoredArticles(ctx interface{}, author interface{}, after interface{}, limit interface{}) *mockAuthoredArticlesLister_ListAuthoredArticles_Call {
+	return &mockAuthoredArticlesLister_ListAuthoredArticles_Call{Call: _e.mock.On("ListAuthoredArticles", ctx, author, after, limit)}
+}
+
+func (_c *mockAuthoredArticlesLister_ListAuthoredArticles_Call) Run(run func(ctx context.Context, author string, after string, limit int64)) *mockAuthoredArticlesLister_ListAuthoredArticles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *mockAuthoredArticlesLister_ListAuthoredArticles_Call) Return(_a0 []*models.Article, _a1 error) *mockAuthoredArticlesLister_ListAuthoredArticles_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *mockAuthoredArticlesLister_ListAuthoredArticles_Call) RunAndReturn(run func(context.Context, string, string, int64) ([]*models.Article, error)) *mockAuthoredArticlesLister_ListAuthoredArticles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockAuthoredArticlesLister creates a new instance of mockAuthoredArticlesLister. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockAuthoredArticlesLister(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockAuthoredArticlesLister {
+	mock := &mockAuthoredArticlesLister{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,97 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package services
+
+import (
+	context "context"
+
+	models "github.com/ravilock/goduit/internal/articlePublisher/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockCoAuthorAdder is an autogenerated mock type for the coAuthorAdder type
+type mockCoAuthorAdder struct {
+	mock.Mock
+}
+
+type mockCoAuthorAdder_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockCoAuthorAdder) EXPECT() *mockCoAuthorAdder_Expecter {
+	return &mockCoAuthorAdder_Expecter{mock: &_m.Mock}
+}
+
+// AddCoAuthor provides a mock function with given fields: ctx, ID, coAuthor
+func (_m *mockCoAuthorAdder) AddCoAuthor(ctx context.Context, ID string, coAuthor string) (*models.Article, error) {
+	ret := _m.Called(ctx, ID, coAuthor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddCoAuthor")
+	}
+
+	var r0 *models.Article
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*models.Article, error)); ok {
+		return rf(ctx, ID, coAuthor)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *models.Article); ok {
+		r0 = rf(ctx, ID, coAuthor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Article)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, ID, coAuthor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockCoAuthorAdder_AddCoAuthor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddCoAuthor'
+type mockCoAuthorAdder_AddCoAuthor_Call struct {
+	*mock.Call
+}
+
+// AddCoAuthor is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ID string
+//   - coAuthor string
+func (_e *mockCoAuthorAdder_Expecter) AddCoAuthor(ctx interface{}, ID interface{}, coAuthor interface{}) *mockCoAuthorAdder_AddCoAuthor_Call {
+	return &mockCoAuthorAdder_AddCoAuthor_Call{Call: _e.mock.On("AddCoAuthor", ctx, ID, coAuthor)}
+}
+
+func (_c *mockCoAuthorAdder_AddCoAuthor_Call) Run(run func(ctx context.Context, ID string, coAuthor string)) *mockCoAuthorAdder_AddCoAuthor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *mockCoAuthorAdder_AddCoAuthor_Call) Return(_a0 *models.Article, _a1 error) *mockCoAuthorAdder_AddCoAuthor_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *mockCoAuthorAdder_AddCoAuthor_Call) RunAndReturn(run func(context.Context, string, string) (*models.Article, error)) *mockCoAuthorAdder_AddCoAuthor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockCoAuthorAdder creates a new instance of mockCoAuthorAdder. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockCoAuthorAdder(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockCoAuthorAdder {
+	mock := &mockCoAuthorAdder{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
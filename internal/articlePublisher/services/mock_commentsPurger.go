@@ -0,0 +1,83 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package services
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockCommentsPurger is an autogenerated mock type for the commentsPurger type
+type mockCommentsPurger struct {
+	mock.Mock
+}
+
+type mockCommentsPurger_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockCommentsPurger) EXPECT() *mockCommentsPurger_Expecter {
+	return &mockCommentsPurger_Expecter{mock: &_m.Mock}
+}
+
+// DeleteArticleComments provides a mock function with given fields: ctx, article
+func (_m *mockCommentsPurger) DeleteArticleComments(ctx context.Context, article string) error {
+	ret := _m.Called(ctx, article)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteArticleComments")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, article)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// mockCommentsPurger_DeleteArticleComments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteArticleComments'
+type mockCommentsPurger_DeleteArticleComments_Call struct {
+	*mock.Call
+}
+
+// DeleteArticleComments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - article string
+func (_e *mockCommentsPurger_Expecter) DeleteArticleComments(ctx interface{}, article interface{}) *mockCommentsPurger_DeleteArticleComments_Call {
+	return &mockCommentsPurger_DeleteArticleComments_Call{Call: _e.mock.On("DeleteArticleComments", ctx, article)}
+}
+
+func (_c *mockCommentsPurger_DeleteArticleComments_Call) Run(run func(ctx context.Context, article string)) *mockCommentsPurger_DeleteArticleComments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *mockCommentsPurger_DeleteArticleComments_Call) Return(_a0 error) *mockCommentsPurger_DeleteArticleComments_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *mockCommentsPurger_DeleteArticleComments_Call) RunAndReturn(run func(context.Context, string) error) *mockCommentsPurger_DeleteArticleComments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockCommentsPurger creates a new instance of mockCommentsPurger. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockCommentsPurger(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockCommentsPurger {
+	mock := &mockCommentsPurger{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,100 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package services
+
+import (
+	context "context"
+
+	models "github.com/ravilock/goduit/internal/articlePublisher/models"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// mockCommentsScorer is an autogenerated mock type for the commentsScorer type
+type mockCommentsScorer struct {
+	mock.Mock
+}
+
+type mockCommentsScorer_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockCommentsScorer) EXPECT() *mockCommentsScorer_Expecter {
+	return &mockCommentsScorer_Expecter{mock: &_m.Mock}
+}
+
+// ScoreComments provides a mock function with given fields: ctx, since, now, halfLife
+func (_m *mockCommentsScorer) ScoreComments(ctx context.Context, since time.Time, now time.Time, halfLife time.Duration) ([]*models.ArticleScore, error) {
+	ret := _m.Called(ctx, since, now, halfLife)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ScoreComments")
+	}
+
+	var r0 []*models.ArticleScore
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time, time.Duration) ([]*models.ArticleScore, error)); ok {
+		return rf(ctx, since, now, halfLife)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time, time.Duration) []*models.ArticleScore); ok {
+		r0 = rf(ctx, since, now, halfLife)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.ArticleScore)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, time.Time, time.Duration) error); ok {
+		r1 = rf(ctx, since, now, halfLife)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockCommentsScorer_ScoreComments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ScoreComments'
+type mockCommentsScorer_ScoreComments_Call struct {
+	*mock.Call
+}
+
+// ScoreComments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - since time.Time
+//   - now time.Time
+//   - halfLife time.Duration
+func (_e *mockCommentsScorer_Expecter) ScoreComments(ctx interface{}, since interface{}, now interface{}, halfLife interface{}) *mockCommentsScorer_ScoreComments_Call {
+	return &mockCommentsScorer_ScoreComments_Call{Call: _e.mock.On("ScoreComments", ctx, since, now, halfLife)}
+}
+
+func (_c *mockCommentsScorer_ScoreComments_Call) Run(run func(ctx context.Context, since time.Time, now time.Time, halfLife time.Duration)) *mockCommentsScorer_ScoreComments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(time.Time), args[3].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *mockCommentsScorer_ScoreComments_Call) Return(_a0 []*models.ArticleScore, _a1 error) *mockCommentsScorer_ScoreComments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *mockCommentsScorer_ScoreComments_Call) RunAndReturn(run func(context.Context, time.Time, time.Time, time.Duration) ([]*models.ArticleScore, error)) *mockCommentsScorer_ScoreComments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockCommentsScorer creates a new instance of mockCommentsScorer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockCommentsScorer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockCommentsScorer {
+	mock := &mockCommentsScorer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
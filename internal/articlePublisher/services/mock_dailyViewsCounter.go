@@ -0,0 +1,99 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package services
+
+import (
+	context "context"
+
+	models "github.com/ravilock/goduit/internal/articlePublisher/models"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// mockDailyViewsCounter is an autogenerated mock type for the dailyViewsCounter type
+type mockDailyViewsCounter struct {
+	mock.Mock
+}
+
+type mockDailyViewsCounter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockDailyViewsCounter) EXPECT() *mockDailyViewsCounter_Expecter {
+	return &mockDailyViewsCounter_Expecter{mock: &_m.Mock}
+}
+
+// CountDailyViews provides a mock function with given fields: ctx, articles, since
+func (_m *mockDailyViewsCounter) CountDailyViews(ctx context.Context, articles []string, since time.Time) ([]*models.DailyCount, error) {
+	ret := _m.Called(ctx, articles, since)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountDailyViews")
+	}
+
+	var r0 []*models.DailyCount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string, time.Time) ([]*models.DailyCount, error)); ok {
+		return rf(ctx, articles, since)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string, time.Time) []*models.DailyCount); ok {
+		r0 = rf(ctx, articles, since)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.DailyCount)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string, time.Time) error); ok {
+		r1 = rf(ctx, articles, since)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockDailyViewsCounter_CountDailyViews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountDailyViews'
+type mockDailyViewsCounter_CountDailyViews_Call struct {
+	*mock.Call
+}
+
+// CountDailyViews is a helper method to define mock.On call
+//   - ctx context.Context
+//   - articles []string
+//   - since time.Time
+func (_e *mockDailyViewsCounter_Expecter) CountDailyViews(ctx interface{}, articles interface{}, since interface{}) *mockDailyViewsCounter_CountDailyViews_Call {
+	return &mockDailyViewsCounter_CountDailyViews_Call{Call: _e.mock.On("CountDailyViews", ctx, articles, since)}
+}
+
+func (_c *mockDailyViewsCounter_CountDailyViews_Call) Run(run func(ctx context.Context, articles []string, since time.Time)) *mockDailyViewsCounter_CountDailyViews_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *mockDailyViewsCounter_CountDailyViews_Call) Return(_a0 []*models.DailyCount, _a1 error) *mockDailyViewsCounter_CountDailyViews_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *mockDailyViewsCounter_CountDailyViews_Call) RunAndReturn(run func(context.Context, []string, time.Time) ([]*models.DailyCount, error)) *mockDailyViewsCounter_CountDailyViews_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockDailyViewsCounter creates a new instance of mockDailyViewsCounter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockDailyViewsCounter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockDailyViewsCounter {
+	mock := &mockDailyViewsCounter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
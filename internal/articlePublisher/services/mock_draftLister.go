@@ -0,0 +1,98 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package services
+
+import (
+	context "context"
+
+	models "github.com/ravilock/goduit/internal/articlePublisher/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockDraftLister is an autogenerated mock type for the draftLister type
+type mockDraftLister struct {
+	mock.Mock
+}
+
+type mockDraftLister_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockDraftLister) EXPECT() *mockDraftLister_Expecter {
+	return &mockDraftLister_Expecter{mock: &_m.Mock}
+}
+
+// ListDrafts provides a mock function with given fields: ctx, author, limit, offset
+func (_m *mockDraftLister) ListDrafts(ctx context.Context, author string, limit int64, offset int64) ([]*models.Article, error) {
+	ret := _m.Called(ctx, author, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDrafts")
+	}
+
+	var r0 []*models.Article
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, int64) ([]*models.Article, error)); ok {
+		return rf(ctx, author, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, int64) []*models.Article); ok {
+		r0 = rf(ctx, author, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Article)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64, int64) error); ok {
+		r1 = rf(ctx, author, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockDraftLister_ListDrafts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDrafts'
+type mockDraftLister_ListDrafts_Call struct {
+	*mock.Call
+}
+
+// ListDrafts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - author string
+//   - limit int64
+//   - offset int64
+func (_e *mockDraftLister_Expecter) ListDrafts(ctx interface{}, author interface{}, limit interface{}, offset interface{}) *mockDraftLister_ListDrafts_Call {
+	return &mockDraftLister_ListDrafts_Call{Call: _e.mock.On("ListDrafts", ctx, author, limit, offset)}
+}
+
+func (_c *mockDraftLister_ListDrafts_Call) Run(run func(ctx context.Context, author string, limit int64, offset int64)) *mockDraftLister_ListDrafts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *mockDraftLister_ListDrafts_Call) Return(_a0 []*models.Article, _a1 error) *mockDraftLister_ListDrafts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *mockDraftLister_ListDrafts_Call) RunAndReturn(run func(context.Context, string, int64, int64) ([]*models.Article, error)) *mockDraftLister_ListDrafts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockDraftLister creates a new instance of mockDraftLister. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockDraftLister(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockDraftLister {
+	mock := &mockDraftLister{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
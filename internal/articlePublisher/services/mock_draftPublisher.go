@@ -0,0 +1,143 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package services
+
+import (
+	context "context"
+
+	models "github.com/ravilock/goduit/internal/articlePublisher/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockDraftPublisher is an autogenerated mock type for the draftPublisher type
+type mockDraftPublisher struct {
+	mock.Mock
+}
+
+type mockDraftPublisher_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockDraftPublisher) EXPECT() *mockDraftPublisher_Expecter {
+	return &mockDraftPublisher_Expecter{mock: &_m.Mock}
+}
+
+// CompleteFanout provides a mock function with given fields: ctx, ID
+func (_m *mockDraftPublisher) CompleteFanout(ctx context.Context, ID string) error {
+	ret := _m.Called(ctx, ID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CompleteFanout")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, ID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// mockDraftPublisher_CompleteFanout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CompleteFanout'
+type mockDraftPublisher_CompleteFanout_Call struct {
+	*mock.Call
+}
+
+// CompleteFanout is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ID string
+func (_e *mockDraftPublisher_Expecter) CompleteFanout(ctx interface{}, ID interface{}) *mockDraftPublisher_CompleteFanout_Call {
+	return &mockDraftPublisher_CompleteFanout_Call{Call: _e.mock.On("CompleteFanout", ctx, ID)}
+}
+
+func (_c *mockDraftPublisher_CompleteFanout_Call) Run(run func(ctx context.Context, ID string)) *mockDraftPublisher_CompleteFanout_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *mockDraftPublisher_CompleteFanout_Call) Return(_a0 error) *mockDraftPublisher_CompleteFanout_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *mockDraftPublisher_CompleteFanout_Call) RunAndReturn(run func(context.Context, string) error) *mockDraftPublisher_CompleteFanout_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PublishArticle provides a mock function with given fields: ctx, ID
+func (_m *mockDraftPublisher) PublishArticle(ctx context.Context, ID string) (*models.Article, error) {
+	ret := _m.Called(ctx, ID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PublishArticle")
+	}
+
+	var r0 *models.Article
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.Article, error)); ok {
+		return rf(ctx, ID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *models.Article); ok {
+		r0 = rf(ctx, ID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Article)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, ID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockDraftPublisher_PublishArticle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PublishArticle'
+type mockDraftPublisher_PublishArticle_Call struct {
+	*mock.Call
+}
+
+// PublishArticle is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ID string
+func (_e *mockDraftPublisher_Expecter) PublishArticle(ctx interface{}, ID interface{}) *mockDraftPublisher_PublishArticle_Call {
+	return &mockDraftPublisher_PublishArticle_Call{Call: _e.mock.On("PublishArticle", ctx, ID)}
+}
+
+func (_c *mockDraftPublisher_PublishArticle_Call) Run(run func(ctx context.Context, ID string)) *mockDraftPublisher_PublishArticle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *mockDraftPublisher_PublishArticle_Call) Return(_a0 *models.Article, _a1 error) *mockDraftPublisher_PublishArticle_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *mockDraftPublisher_PublishArticle_Call) RunAndReturn(run func(context.Context, string) (*models.Article, error)) *mockDraftPublisher_PublishArticle_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockDraftPublisher creates a new instance of mockDraftPublisher. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockDraftPublisher(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockDraftPublisher {
+	mock := &mockDraftPublisher{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,83 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package services
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockFanoutCompleter is an autogenerated mock type for the fanoutCompleter type
+type mockFanoutCompleter struct {
+	mock.Mock
+}
+
+type mockFanoutCompleter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockFanoutCompleter) EXPECT() *mockFanoutCompleter_Expecter {
+	return &mockFanoutCompleter_Expecter{mock: &_m.Mock}
+}
+
+// CompleteFanout provides a mock function with given fields: ctx, ID
+func (_m *mockFanoutCompleter) CompleteFanout(ctx context.Context, ID string) error {
+	ret := _m.Called(ctx, ID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CompleteFanout")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, ID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// mockFanoutCompleter_CompleteFanout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CompleteFanout'
+type mockFanoutCompleter_CompleteFanout_Call struct {
+	*mock.Call
+}
+
+// CompleteFanout is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ID string
+func (_e *mockFanoutCompleter_Expecter) CompleteFanout(ctx interface{}, ID interface{}) *mockFanoutCompleter_CompleteFanout_Call {
+	return &mockFanoutCompleter_CompleteFanout_Call{Call: _e.mock.On("CompleteFanout", ctx, ID)}
+}
+
+func (_c *mockFanoutCompleter_CompleteFanout_Call) Run(run func(ctx context.Context, ID string)) *mockFanoutCompleter_CompleteFanout_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *mockFanoutCompleter_CompleteFanout_Call) Return(_a0 error) *mockFanoutCompleter_CompleteFanout_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *mockFanoutCompleter_CompleteFanout_Call) RunAndReturn(run func(context.Context, string) error) *mockFanoutCompleter_CompleteFanout_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockFanoutCompleter creates a new instance of mockFanoutCompleter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockFanoutCompleter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockFanoutCompleter {
+	mock := &mockFanoutCompleter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,97 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package services
+
+import (
+	context "context"
+
+	models "github.com/ravilock/goduit/internal/articlePublisher/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockFavoritesCounter is an autogenerated mock type for the favoritesCounter type
+type mockFavoritesCounter struct {
+	mock.Mock
+}
+
+type mockFavoritesCounter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockFavoritesCounter) EXPECT() *mockFavoritesCounter_Expecter {
+	return &mockFavoritesCounter_Expecter{mock: &_m.Mock}
+}
+
+// UpdateFavoritesCount provides a mock function with given fields: ctx, ID, delta
+func (_m *mockFavoritesCounter) UpdateFavoritesCount(ctx context.Context, ID string, delta int64) (*models.Article, error) {
+	ret := _m.Called(ctx, ID, delta)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateFavoritesCount")
+	}
+
+	var r0 *models.Article
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) (*models.Article, error)); ok {
+		return rf(ctx, ID, delta)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) *models.Article); ok {
+		r0 = rf(ctx, ID, delta)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Article)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64) error); ok {
+		r1 = rf(ctx, ID, delta)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockFavoritesCounter_UpdateFavoritesCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateFavoritesCount'
+type mockFavoritesCounter_UpdateFavoritesCount_Call struct {
+	*mock.Call
+}
+
+// UpdateFavoritesCount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ID string
+//   - delta int64
+func (_e *mockFavoritesCounter_Expecter) UpdateFavoritesCount(ctx interface{}, ID interface{}, delta interface{}) *mockFavoritesCounter_UpdateFavoritesCount_Call {
+	return &mockFavoritesCounter_UpdateFavoritesCount_Call{Call: _e.mock.On("UpdateFavoritesCount", ctx, ID, delta)}
+}
+
+func (_c *mockFavoritesCounter_UpdateFavoritesCount_Call) Run(run func(ctx context.Context, ID string, delta int64)) *mockFavoritesCounter_UpdateFavoritesCount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *mockFavoritesCounter_UpdateFavoritesCount_Call) Return(_a0 *models.Article, _a1 error) *mockFavoritesCounter_UpdateFavoritesCount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *mockFavoritesCounter_UpdateFavoritesCount_Call) RunAndReturn(run func(context.Context, string, int64) (*models.Article, error)) *mockFavoritesCounter_UpdateFavoritesCount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockFavoritesCounter creates a new instance of mockFavoritesCounter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockFavoritesCounter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockFavoritesCounter {
+	mock := &mockFavoritesCounter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
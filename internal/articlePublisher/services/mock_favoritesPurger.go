@@ -0,0 +1,83 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package services
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockFavoritesPurger is an autogenerated mock type for the favoritesPurger type
+type mockFavoritesPurger struct {
+	mock.Mock
+}
+
+type mockFavoritesPurger_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockFavoritesPurger) EXPECT() *mockFavoritesPurger_Expecter {
+	return &mockFavoritesPurger_Expecter{mock: &_m.Mock}
+}
+
+// DeleteArticleFavorites provides a mock function with given fields: ctx, article
+func (_m *mockFavoritesPurger) DeleteArticleFavorites(ctx context.Context, article string) error {
+	ret := _m.Called(ctx, article)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteArticleFavorites")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, article)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// mockFavoritesPurger_DeleteArticleFavorites_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteArticleFavorites'
+type mockFavoritesPurger_DeleteArticleFavorites_Call struct {
+	*mock.Call
+}
+
+// DeleteArticleFavorites is a helper method to define mock.On call
+//   - ctx context.Context
+//   - article string
+func (_e *mockFavoritesPurger_Expecter) DeleteArticleFavorites(ctx interface{}, article interface{}) *mockFavoritesPurger_DeleteArticleFavorites_Call {
+	return &mockFavoritesPurger_DeleteArticleFavorites_Call{Call: _e.mock.On("DeleteArticleFavorites", ctx, article)}
+}
+
+func (_c *mockFavoritesPurger_DeleteArticleFavorites_Call) Run(run func(ctx context.Context, article string)) *mockFavoritesPurger_DeleteArticleFavorites_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *mockFavoritesPurger_DeleteArticleFavorites_Call) Return(_a0 error) *mockFavoritesPurger_DeleteArticleFavorites_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *mockFavoritesPurger_DeleteArticleFavorites_Call) RunAndReturn(run func(context.Context, string) error) *mockFavoritesPurger_DeleteArticleFavorites_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockFavoritesPurger creates a new instance of mockFavoritesPurger. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockFavoritesPurger(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockFavoritesPurger {
+	mock := &mockFavoritesPurger{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,100 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package services
+
+import (
+	context "context"
+
+	models "github.com/ravilock/goduit/internal/articlePublisher/models"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// mockFavoritesScorer is an autogenerated mock type for the favoritesScorer type
+type mockFavoritesScorer struct {
+	mock.Mock
+}
+
+type mockFavoritesScorer_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockFavoritesScorer) EXPECT() *mockFavoritesScorer_Expecter {
+	return &mockFavoritesScorer_Expecter{mock: &_m.Mock}
+}
+
+// ScoreFavorites provides a mock function with given fields: ctx, since, now, halfLife
+func (_m *mockFavoritesScorer) ScoreFavorites(ctx context.Context, since time.Time, now time.Time, halfLife time.Duration) ([]*models.ArticleScore, error) {
+	ret := _m.Called(ctx, since, now, halfLife)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ScoreFavorites")
+	}
+
+	var r0 []*models.ArticleScore
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time, time.Duration) ([]*models.ArticleScore, error)); ok {
+		return rf(ctx, since, now, halfLife)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time, time.Duration) []*models.ArticleScore); ok {
+		r0 = rf(ctx, since, now, halfLife)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.ArticleScore)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, time.Time, time.Duration) error); ok {
+		r1 = rf(ctx, since, now, halfLife)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockFavoritesScorer_ScoreFavorites_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ScoreFavorites'
+type mockFavoritesScorer_ScoreFavorites_Call struct {
+	*mock.Call
+}
+
+// ScoreFavorites is a helper method to define mock.On call
+//   - ctx context.Context
+//   - since time.Time
+//   - now time.Time
+//   - halfLife time.Duration
+func (_e *mockFavoritesScorer_Expecter) ScoreFavorites(ctx interface{}, since interface{}, now interface{}, halfLife interface{}) *mockFavoritesScorer_ScoreFavorites_Call {
+	return &mockFavoritesScorer_ScoreFavorites_Call{Call: _e.mock.On("ScoreFavorites", ctx, since, now, halfLife)}
+}
+
+func (_c *mockFavoritesScorer_ScoreFavorites_Call) Run(run func(ctx context.Context, since time.Time, now time.Time, halfLife time.Duration)) *mockFavoritesScorer_ScoreFavorites_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(time.Time), args[3].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *mockFavoritesScorer_ScoreFavorites_Call) Return(_a0 []*models.ArticleScore, _a1 error) *mockFavoritesScorer_ScoreFavorites_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *mockFavoritesScorer_ScoreFavorites_Call) RunAndReturn(run func(context.Context, time.Time, time.Time, time.Duration) ([]*models.ArticleScore, error)) *mockFavoritesScorer_ScoreFavorites_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockFavoritesScorer creates a new instance of mockFavoritesScorer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockFavoritesScorer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockFavoritesScorer {
+	mock := &mockFavoritesScorer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
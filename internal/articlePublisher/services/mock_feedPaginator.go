@@ -22,9 +22,9 @@ func (_m *mockFeedPaginator) EXPECT() *mockFeedPaginator_Expecter {
 	return &mockFeedPaginator_Expecter{mock: &_m.Mock}
 }
 
-// PaginateFeed provides a mock function with given fields: ctx, user, limit, offset
-func (_m *mockFeedPaginator) PaginateFeed(ctx context.Context, user string, limit int64, offset int64) ([]models.FeedFragment, error) {
-	ret := _m.Called(ctx, user, limit, offset)
+// PaginateFeed provides a mock function with given fields: ctx, user, after, limit, offset
+func (_m *mockFeedPaginator) PaginateFeed(ctx context.Context, user string, after int64, limit int64, offset int64) ([]models.FeedFragment, error) {
+	ret := _m.Called(ctx, user, after, limit, offset)
 
 	if len(ret) == 0 {
 		panic("no return value specified for PaginateFeed")
@@ -32,19 +32,19 @@ func (_m *mockFeedPaginator) PaginateFeed(ctx context.Context, user string, limi
 
 	var r0 []models.FeedFragment
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, int64, int64) ([]models.FeedFragment, error)); ok {
-		return rf(ctx, user, limit, offset)
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, int64, int64) ([]models.FeedFragment, error)); ok {
+		return rf(ctx, user, after, limit, offset)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, int64, int64) []models.FeedFragment); ok {
-		r0 = rf(ctx, user, limit, offset)
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, int64, int64) []models.FeedFragment); ok {
+		r0 = rf(ctx, user, after, limit, offset)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]models.FeedFragment)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, int64, int64) error); ok {
-		r1 = rf(ctx, user, limit, offset)
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64, int64, int64) error); ok {
+		r1 = rf(ctx, user, after, limit, offset)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -60,15 +60,16 @@ type mockFeedPaginator_PaginateFeed_Call struct {
 // PaginateFeed is a helper method to define mock.On call
 //   - ctx context.Context
 //   - user string
+//   - after int64
 //   - limit int64
 //   - offset int64
-func (_e *mockFeedPaginator_Expecter) PaginateFeed(ctx interface{}, user interface{}, limit interface{}, offset interface{}) *mockFeedPaginator_PaginateFeed_Call {
-	return &mockFeedPaginator_PaginateFeed_Call{Call: _e.mock.On("PaginateFeed", ctx, user, limit, offset)}
+func (_e *mockFeedPaginator_Expecter) PaginateFeed(ctx interface{}, user interface{}, after interface{}, limit interface{}, offset interface{}) *mockFeedPaginator_PaginateFeed_Call {
+	return &mockFeedPaginator_PaginateFeed_Call{Call: _e.mock.On("PaginateFeed", ctx, user, after, limit, offset)}
 }
 
-func (_c *mockFeedPaginator_PaginateFeed_Call) Run(run func(ctx context.Context, user string, limit int64, offset int64)) *mockFeedPaginator_PaginateFeed_Call {
+func (_c *mockFeedPaginator_PaginateFeed_Call) Run(run func(ctx context.Context, user string, after int64, limit int64, offset int64)) *mockFeedPaginator_PaginateFeed_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(int64), args[3].(int64))
+		run(args[0].(context.Context), args[1].(string), args[2].(int64), args[3].(int64), args[4].(int64))
 	})
 	return _c
 }
@@ -78,7 +79,7 @@ func (_c *mockFeedPaginator_PaginateFeed_Call) Return(_a0 []models.FeedFragment,
 	return _c
 }
 
-func (_c *mockFeedPaginator_PaginateFeed_Call) RunAndReturn(run func(context.Context, string, int64, int64) ([]models.FeedFragment, error)) *mockFeedPaginator_PaginateFeed_Call {
+func (_c *mockFeedPaginator_PaginateFeed_Call) RunAndReturn(run func(context.Context, string, int64, int64, int64) ([]models.FeedFragment, error)) *mockFeedPaginator_PaginateFeed_Call {
 	_c.Call.Return(run)
 	return _c
 }
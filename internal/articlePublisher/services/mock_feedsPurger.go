@@ -0,0 +1,83 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package services
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockFeedsPurger is an autogenerated mock type for the feedsPurger type
+type mockFeedsPurger struct {
+	mock.Mock
+}
+
+type mockFeedsPurger_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockFeedsPurger) EXPECT() *mockFeedsPurger_Expecter {
+	return &mockFeedsPurger_Expecter{mock: &_m.Mock}
+}
+
+// RemoveArticleFromFeeds provides a mock function with given fields: ctx, article
+func (_m *mockFeedsPurger) RemoveArticleFromFeeds(ctx context.Context, article string) error {
+	ret := _m.Called(ctx, article)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveArticleFromFeeds")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, article)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// mockFeedsPurger_RemoveArticleFromFeeds_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveArticleFromFeeds'
+type mockFeedsPurger_RemoveArticleFromFeeds_Call struct {
+	*mock.Call
+}
+
+// RemoveArticleFromFeeds is a helper method to define mock.On call
+//   - ctx context.Context
+//   - article string
+func (_e *mockFeedsPurger_Expecter) RemoveArticleFromFeeds(ctx interface{}, article interface{}) *mockFeedsPurger_RemoveArticleFromFeeds_Call {
+	return &mockFeedsPurger_RemoveArticleFromFeeds_Call{Call: _e.mock.On("RemoveArticleFromFeeds", ctx, article)}
+}
+
+func (_c *mockFeedsPurger_RemoveArticleFromFeeds_Call) Run(run func(ctx context.Context, article string)) *mockFeedsPurger_RemoveArticleFromFeeds_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *mockFeedsPurger_RemoveArticleFromFeeds_Call) Return(_a0 error) *mockFeedsPurger_RemoveArticleFromFeeds_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *mockFeedsPurger_RemoveArticleFromFeeds_Call) RunAndReturn(run func(context.Context, string) error) *mockFeedsPurger_RemoveArticleFromFeeds_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockFeedsPurger creates a new instance of mockFeedsPurger. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockFeedsPurger(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockFeedsPurger {
+	mock := &mockFeedsPurger{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
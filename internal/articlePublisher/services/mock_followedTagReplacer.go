@@ -0,0 +1,84 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package services
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockFollowedTagReplacer is an autogenerated mock type for the followedTagReplacer type
+type mockFollowedTagReplacer struct {
+	mock.Mock
+}
+
+type mockFollowedTagReplacer_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockFollowedTagReplacer) EXPECT() *mockFollowedTagReplacer_Expecter {
+	return &mockFollowedTagReplacer_Expecter{mock: &_m.Mock}
+}
+
+// ReplaceFollowedTag provides a mock function with given fields: ctx, from, to
+func (_m *mockFollowedTagReplacer) ReplaceFollowedTag(ctx context.Context, from string, to string) error {
+	ret := _m.Called(ctx, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplaceFollowedTag")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, from, to)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// mockFollowedTagReplacer_ReplaceFollowedTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplaceFollowedTag'
+type mockFollowedTagReplacer_ReplaceFollowedTag_Call struct {
+	*mock.Call
+}
+
+// ReplaceFollowedTag is a helper method to define mock.On call
+//   - ctx context.Context
+//   - from string
+//   - to string
+func (_e *mockFollowedTagReplacer_Expecter) ReplaceFollowedTag(ctx interface{}, from interface{}, to interface{}) *mockFollowedTagReplacer_ReplaceFollowedTag_Call {
+	return &mockFollowedTagReplacer_ReplaceFollowedTag_Call{Call: _e.mock.On("ReplaceFollowedTag", ctx, from, to)}
+}
+
+func (_c *mockFollowedTagReplacer_ReplaceFollowedTag_Call) Run(run func(ctx context.Context, from string, to string)) *mockFollowedTagReplacer_ReplaceFollowedTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *mockFollowedTagReplacer_ReplaceFollowedTag_Call) Return(_a0 error) *mockFollowedTagReplacer_ReplaceFollowedTag_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *mockFollowedTagReplacer_ReplaceFollowedTag_Call) RunAndReturn(run func(context.Context, string, string) error) *mockFollowedTagReplacer_ReplaceFollowedTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockFollowedTagReplacer creates a new instance of mockFollowedTagReplacer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockFollowedTagReplacer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockFollowedTagReplacer {
+	mock := &mockFollowedTagReplacer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
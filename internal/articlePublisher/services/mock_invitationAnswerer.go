@@ -0,0 +1,145 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package services
+
+import (
+	context "context"
+
+	models "github.com/ravilock/goduit/internal/articlePublisher/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockInvitationAnswerer is an autogenerated mock type for the invitationAnswerer type
+type mockInvitationAnswerer struct {
+	mock.Mock
+}
+
+type mockInvitationAnswerer_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockInvitationAnswerer) EXPECT() *mockInvitationAnswerer_Expecter {
+	return &mockInvitationAnswerer_Expecter{mock: &_m.Mock}
+}
+
+// DeleteInvitation provides a mock function with given fields: ctx, article, invitee
+func (_m *mockInvitationAnswerer) DeleteInvitation(ctx context.Context, article string, invitee string) error {
+	ret := _m.Called(ctx, article, invitee)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteInvitation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, article, invitee)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// mockInvitationAnswerer_DeleteInvitation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteInvitation'
+type mockInvitationAnswerer_DeleteInvitation_Call struct {
+	*mock.Call
+}
+
+// DeleteInvitation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - article string
+//   - invitee string
+func (_e *mockInvitationAnswerer_Expecter) DeleteInvitation(ctx interface{}, article interface{}, invitee interface{}) *mockInvitationAnswerer_DeleteInvitation_Call {
+	return &mockInvitationAnswerer_DeleteInvitation_Call{Call: _e.mock.On("DeleteInvitation", ctx, article, invitee)}
+}
+
+func (_c *mockInvitationAnswerer_DeleteInvitation_Call) Run(run func(ctx context.Context, article string, invitee string)) *mockInvitationAnswerer_DeleteInvitation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *mockInvitationAnswerer_DeleteInvitation_Call) Return(_a0 error) *mockInvitationAnswerer_DeleteInvitation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *mockInvitationAnswerer_DeleteInvitation_Call) RunAndReturn(run func(context.Context, string, string) error) *mockInvitationAnswerer_DeleteInvitation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetInvitation provides a mock function with given fields: ctx, article, invitee
+func (_m *mockInvitationAnswerer) GetInvitation(ctx context.Context, article string, invitee string) (*models.CoAuthorInvitation, error) {
+	ret := _m.Called(ctx, article, invitee)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetInvitation")
+	}
+
+	var r0 *models.CoAuthorInvitation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*models.CoAuthorInvitation, error)); ok {
+		return rf(ctx, article, invitee)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *models.CoAuthorInvitation); ok {
+		r0 = rf(ctx, article, invitee)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.CoAuthorInvitation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, article, invitee)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockInvitationAnswerer_GetInvitation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetInvitation'
+type mockInvitationAnswerer_GetInvitation_Call struct {
+	*mock.Call
+}
+
+// GetInvitation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - article string
+//   - invitee string
+func (_e *mockInvitationAnswerer_Expecter) GetInvitation(ctx interface{}, article interface{}, invitee interface{}) *mockInvitationAnswerer_GetInvitation_Call {
+	return &mockInvitationAnswerer_GetInvitation_Call{Call: _e.mock.On("GetInvitation", ctx, article, invitee)}
+}
+
+func (_c *mockInvitationAnswerer_GetInvitation_Call) Run(run func(ctx context.Context, article string, invitee string)) *mockInvitationAnswerer_GetInvitation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *mockInvitationAnswerer_GetInvitation_Call) Return(_a0 *models.CoAuthorInvitation, _a1 error) *mockInvitationAnswerer_GetInvitation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *mockInvitationAnswerer_GetInvitation_Call) RunAndReturn(run func(context.Context, string, string) (*models.CoAuthorInvitation, error)) *mockInvitationAnswerer_GetInvitation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockInvitationAnswerer creates a new instance of mockInvitationAnswerer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockInvitationAnswerer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockInvitationAnswerer {
+	mock := &mockInvitationAnswerer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,84 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package services
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockInvitationDeleter is an autogenerated mock type for the invitationDeleter type
+type mockInvitationDeleter struct {
+	mock.Mock
+}
+
+type mockInvitationDeleter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockInvitationDeleter) EXPECT() *mockInvitationDeleter_Expecter {
+	return &mockInvitationDeleter_Expecter{mock: &_m.Mock}
+}
+
+// DeleteInvitation provides a mock function with given fields: ctx, article, invitee
+func (_m *mockInvitationDeleter) DeleteInvitation(ctx context.Context, article string, invitee string) error {
+	ret := _m.Called(ctx, article, invitee)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteInvitation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, article, invitee)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// mockInvitationDeleter_DeleteInvitation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteInvitation'
+type mockInvitationDeleter_DeleteInvitation_Call struct {
+	*mock.Call
+}
+
+// DeleteInvitation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - article string
+//   - invitee string
+func (_e *mockInvitationDeleter_Expecter) DeleteInvitation(ctx interface{}, article interface{}, invitee interface{}) *mockInvitationDeleter_DeleteInvitation_Call {
+	return &mockInvitationDeleter_DeleteInvitation_Call{Call: _e.mock.On("DeleteInvitation", ctx, article, invitee)}
+}
+
+func (_c *mockInvitationDeleter_DeleteInvitation_Call) Run(run func(ctx context.Context, article string, invitee string)) *mockInvitationDeleter_DeleteInvitation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *mockInvitationDeleter_DeleteInvitation_Call) Return(_a0 error) *mockInvitationDeleter_DeleteInvitation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *mockInvitationDeleter_DeleteInvitation_Call) RunAndReturn(run func(context.Context, string, string) error) *mockInvitationDeleter_DeleteInvitation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockInvitationDeleter creates a new instance of mockInvitationDeleter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockInvitationDeleter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockInvitationDeleter {
+	mock := &mockInvitationDeleter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,84 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package services
+
+import (
+	context "context"
+
+	models "github.com/ravilock/goduit/internal/articlePublisher/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockInvitationWriter is an autogenerated mock type for the invitationWriter type
+type mockInvitationWriter struct {
+	mock.Mock
+}
+
+type mockInvitationWriter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockInvitationWriter) EXPECT() *mockInvitationWriter_Expecter {
+	return &mockInvitationWriter_Expecter{mock: &_m.Mock}
+}
+
+// WriteInvitation provides a mock function with given fields: ctx, invitation
+func (_m *mockInvitationWriter) WriteInvitation(ctx context.Context, invitation *models.CoAuthorInvitation) error {
+	ret := _m.Called(ctx, invitation)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WriteInvitation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.CoAuthorInvitation) error); ok {
+		r0 = rf(ctx, invitation)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// mockInvitationWriter_WriteInvitation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WriteInvitation'
+type mockInvitationWriter_WriteInvitation_Call struct {
+	*mock.Call
+}
+
+// WriteInvitation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - invitation *models.CoAuthorInvitation
+func (_e *mockInvitationWriter_Expecter) WriteInvitation(ctx interface{}, invitation interface{}) *mockInvitationWriter_WriteInvitation_Call {
+	return &mockInvitationWriter_WriteInvitation_Call{Call: _e.mock.On("WriteInvitation", ctx, invitation)}
+}
+
+func (_c *mockInvitationWriter_WriteInvitation_Call) Run(run func(ctx context.Context, invitation *models.CoAuthorInvitation)) *mockInvitationWriter_WriteInvitation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.CoAuthorInvitation))
+	})
+	return _c
+}
+
+func (_c *mockInvitationWriter_WriteInvitation_Call) Return(_a0 error) *mockInvitationWriter_WriteInvitation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *mockInvitationWriter_WriteInvitation_Call) RunAndReturn(run func(context.Context, *models.CoAuthorInvitation) error) *mockInvitationWriter_WriteInvitation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockInvitationWriter creates a new instance of mockInvitationWriter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockInvitationWriter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockInvitationWriter {
+	mock := &mockInvitationWriter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,83 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package services
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockInvitationsPurger is an autogenerated mock type for the invitationsPurger type
+type mockInvitationsPurger struct {
+	mock.Mock
+}
+
+type mockInvitationsPurger_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockInvitationsPurger) EXPECT() *mockInvitationsPurger_Expecter {
+	return &mockInvitationsPurger_Expecter{mock: &_m.Mock}
+}
+
+// DeleteArticleInvitations provides a mock function with given fields: ctx, article
+func (_m *mockInvitationsPurger) DeleteArticleInvitations(ctx context.Context, article string) error {
+	ret := _m.Called(ctx, article)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteArticleInvitations")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, article)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// mockInvitationsPurger_DeleteArticleInvitations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteArticleInvitations'
+type mockInvitationsPurger_DeleteArticleInvitations_Call struct {
+	*mock.Call
+}
+
+// DeleteArticleInvitations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - article string
+func (_e *mockInvitationsPurger_Expecter) DeleteArticleInvitations(ctx interface{}, article interface{}) *mockInvitationsPurger_DeleteArticleInvitations_Call {
+	return &mockInvitationsPurger_DeleteArticleInvitations_Call{Call: _e.mock.On("DeleteArticleInvitations", ctx, article)}
+}
+
+func (_c *mockInvitationsPurger_DeleteArticleInvitations_Call) Run(run func(ctx context.Context, article string)) *mockInvitationsPurger_DeleteArticleInvitations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *mockInvitationsPurger_DeleteArticleInvitations_Call) Return(_a0 error) *mockInvitationsPurger_DeleteArticleInvitations_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *mockInvitationsPurger_DeleteArticleInvitations_Call) RunAndReturn(run func(context.Context, string) error) *mockInvitationsPurger_DeleteArticleInvitations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockInvitationsPurger creates a new instance of mockInvitationsPurger. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockInvitationsPurger(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockInvitationsPurger {
+	mock := &mockInvitationsPurger{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
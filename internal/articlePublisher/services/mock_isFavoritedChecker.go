@@ -0,0 +1,97 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package services
+
+import (
+	context "context"
+
+	models "github.com/ravilock/goduit/internal/articlePublisher/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockIsFavoritedChecker is an autogenerated mock type for the isFavoritedChecker type
+type mockIsFavoritedChecker struct {
+	mock.Mock
+}
+
+type mockIsFavoritedChecker_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockIsFavoritedChecker) EXPECT() *mockIsFavoritedChecker_Expecter {
+	return &mockIsFavoritedChecker_Expecter{mock: &_m.Mock}
+}
+
+// IsFavoritedBy provides a mock function with given fields: ctx, article, user
+func (_m *mockIsFavoritedChecker) IsFavoritedBy(ctx context.Context, article string, user string) (*models.Favorite, error) {
+	ret := _m.Called(ctx, article, user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsFavoritedBy")
+	}
+
+	var r0 *models.Favorite
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*models.Favorite, error)); ok {
+		return rf(ctx, article, user)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *models.Favorite); ok {
+		r0 = rf(ctx, article, user)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Favorite)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, article, user)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockIsFavoritedChecker_IsFavoritedBy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsFavoritedBy'
+type mockIsFavoritedChecker_IsFavoritedBy_Call struct {
+	*mock.Call
+}
+
+// IsFavoritedBy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - article string
+//   - user string
+func (_e *mockIsFavoritedChecker_Expecter) IsFavoritedBy(ctx interface{}, article interface{}, user interface{}) *mockIsFavoritedChecker_IsFavoritedBy_Call {
+	return &mockIsFavoritedChecker_IsFavoritedBy_Call{Call: _e.mock.On("IsFavoritedBy", ctx, article, user)}
+}
+
+func (_c *mockIsFavoritedChecker_IsFavoritedBy_Call) Run(run func(ctx context.Context, article string, user string)) *mockIsFavoritedChecker_IsFavoritedBy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *mockIsFavoritedChecker_IsFavoritedBy_Call) Return(_a0 *models.Favorite, _a1 error) *mockIsFavoritedChecker_IsFavoritedBy_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *mockIsFavoritedChecker_IsFavoritedBy_Call) RunAndReturn(run func(context.Context, string, string) (*models.Favorite, error)) *mockIsFavoritedChecker_IsFavoritedBy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockIsFavoritedChecker creates a new instance of mockIsFavoritedChecker. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockIsFavoritedChecker(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockIsFavoritedChecker {
+	mock := &mockIsFavoritedChecker{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,97 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package services
+
+import (
+	context "context"
+
+	models "github.com/ravilock/goduit/internal/articlePublisher/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockRevisionGetter is an autogenerated mock type for the revisionGetter type
+type mockRevisionGetter struct {
+	mock.Mock
+}
+
+type mockRevisionGetter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockRevisionGetter) EXPECT() *mockRevisionGetter_Expecter {
+	return &mockRevisionGetter_Expecter{mock: &_m.Mock}
+}
+
+// GetRevision provides a mock function with given fields: ctx, article, number
+func (_m *mockRevisionGetter) GetRevision(ctx context.Context, article string, number int64) (*models.Revision, error) {
+	ret := _m.Called(ctx, article, number)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRevision")
+	}
+
+	var r0 *models.Revision
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) (*models.Revision, error)); ok {
+		return rf(ctx, article, number)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) *models.Revision); ok {
+		r0 = rf(ctx, article, number)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Revision)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64) error); ok {
+		r1 = rf(ctx, article, number)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockRevisionGetter_GetRevision_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRevision'
+type mockRevisionGetter_GetRevision_Call struct {
+	*mock.Call
+}
+
+// GetRevision is a helper method to define mock.On call
+//   - ctx context.Context
+//   - article string
+//   - number int64
+func (_e *mockRevisionGetter_Expecter) GetRevision(ctx interface{}, article interface{}, number interface{}) *mockRevisionGetter_GetRevision_Call {
+	return &mockRevisionGetter_GetRevision_Call{Call: _e.mock.On("GetRevision", ctx, article, number)}
+}
+
+func (_c *mockRevisionGetter_GetRevision_Call) Run(run func(ctx context.Context, article string, number int64)) *mockRevisionGetter_GetRevision_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *mockRevisionGetter_GetRevision_Call) Return(_a0 *models.Revision, _a1 error) *mockRevisionGetter_GetRevision_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *mockRevisionGetter_GetRevision_Call) RunAndReturn(run func(context.Context, string, int64) (*models.Revision, error)) *mockRevisionGetter_GetRevision_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockRevisionGetter creates a new instance of mockRevisionGetter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockRevisionGetter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockRevisionGetter {
+	mock := &mockRevisionGetter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,98 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package services
+
+import (
+	context "context"
+
+	models "github.com/ravilock/goduit/internal/articlePublisher/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockRevisionLister is an autogenerated mock type for the revisionLister type
+type mockRevisionLister struct {
+	mock.Mock
+}
+
+type mockRevisionLister_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockRevisionLister) EXPECT() *mockRevisionLister_Expecter {
+	return &mockRevisionLister_Expecter{mock: &_m.Mock}
+}
+
+// ListRevisions provides a mock function with given fields: ctx, article, limit, offset
+func (_m *mockRevisionLister) ListRevisions(ctx context.Context, article string, limit int64, offset int64) ([]*models.Revision, error) {
+	ret := _m.Called(ctx, article, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRevisions")
+	}
+
+	var r0 []*models.Revision
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, int64) ([]*models.Revision, error)); ok {
+		return rf(ctx, article, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, int64) []*models.Revision); ok {
+		r0 = rf(ctx, article, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Revision)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64, int64) error); ok {
+		r1 = rf(ctx, article, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockRevisionLister_ListRevisions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRevisions'
+type mockRevisionLister_ListRevisions_Call struct {
+	*mock.Call
+}
+
+// ListRevisions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - article string
+//   - limit int64
+//   - offset int64
+func (_e *mockRevisionLister_Expecter) ListRevisions(ctx interface{}, article interface{}, limit interface{}, offset interface{}) *mockRevisionLister_ListRevisions_Call {
+	return &mockRevisionLister_ListRevisions_Call{Call: _e.mock.On("ListRevisions", ctx, article, limit, offset)}
+}
+
+func (_c *mockRevisionLister_ListRevisions_Call) Run(run func(ctx context.Context, article string, limit int64, offset int64)) *mockRevisionLister_ListRevisions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *mockRevisionLister_ListRevisions_Call) Return(_a0 []*models.Revision, _a1 error) *mockRevisionLister_ListRevisions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *mockRevisionLister_ListRevisions_Call) RunAndReturn(run func(context.Context, string, int64, int64) ([]*models.Revision, error)) *mockRevisionLister_ListRevisions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockRevisionLister creates a new instance of mockRevisionLister. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockRevisionLister(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockRevisionLister {
+	mock := &mockRevisionLister{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,141 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package services
+
+import (
+	context "context"
+
+	models "github.com/ravilock/goduit/internal/articlePublisher/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockRevisionRecorder is an autogenerated mock type for the revisionRecorder type
+type mockRevisionRecorder struct {
+	mock.Mock
+}
+
+type mockRevisionRecorder_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockRevisionRecorder) EXPECT() *mockRevisionRecorder_Expecter {
+	return &mockRevisionRecorder_Expecter{mock: &_m.Mock}
+}
+
+// HasRevisions provides a mock function with given fields: ctx, article
+func (_m *mockRevisionRecorder) HasRevisions(ctx context.Context, article string) (bool, error) {
+	ret := _m.Called(ctx, article)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HasRevisions")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (bool, error)); ok {
+		return rf(ctx, article)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) bool); ok {
+		r0 = rf(ctx, article)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, article)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockRevisionRecorder_HasRevisions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HasRevisions'
+type mockRevisionRecorder_HasRevisions_Call struct {
+	*mock.Call
+}
+
+// HasRevisions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - article string
+func (_e *mockRevisionRecorder_Expecter) HasRevisions(ctx interface{}, article interface{}) *mockRevisionRecorder_HasRevisions_Call {
+	return &mockRevisionRecorder_HasRevisions_Call{Call: _e.mock.On("HasRevisions", ctx, article)}
+}
+
+func (_c *mockRevisionRecorder_HasRevisions_Call) Run(run func(ctx context.Context, article string)) *mockRevisionRecorder_HasRevisions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *mockRevisionRecorder_HasRevisions_Call) Return(_a0 bool, _a1 error) *mockRevisionRecorder_HasRevisions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *mockRevisionRecorder_HasRevisions_Call) RunAndReturn(run func(context.Context, string) (bool, error)) *mockRevisionRecorder_HasRevisions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WriteRevision provides a mock function with given fields: ctx, revision
+func (_m *mockRevisionRecorder) WriteRevision(ctx context.Context, revision *models.Revision) error {
+	ret := _m.Called(ctx, revision)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WriteRevision")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Revision) error); ok {
+		r0 = rf(ctx, revision)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// mockRevisionRecorder_WriteRevision_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WriteRevision'
+type mockRevisionRecorder_WriteRevision_Call struct {
+	*mock.Call
+}
+
+// WriteRevision is a helper method to define mock.On call
+//   - ctx context.Context
+//   - revision *models.Revision
+func (_e *mockRevisionRecorder_Expecter) WriteRevision(ctx interface{}, revision interface{}) *mockRevisionRecorder_WriteRevision_Call {
+	return &mockRevisionRecorder_WriteRevision_Call{Call: _e.mock.On("WriteRevision", ctx, revision)}
+}
+
+func (_c *mockRevisionRecorder_WriteRevision_Call) Run(run func(ctx context.Context, revision *models.Revision)) *mockRevisionRecorder_WriteRevision_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.Revision))
+	})
+	return _c
+}
+
+func (_c *mockRevisionRecorder_WriteRevision_Call) Return(_a0 error) *mockRevisionRecorder_WriteRevision_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *mockRevisionRecorder_WriteRevision_Call) RunAndReturn(run func(context.Context, *models.Revision) error) *mockRevisionRecorder_WriteRevision_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockRevisionRecorder creates a new instance of mockRevisionRecorder. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockRevisionRecorder(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockRevisionRecorder {
+	mock := &mockRevisionRecorder{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
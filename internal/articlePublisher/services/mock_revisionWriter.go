@@ -0,0 +1,84 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package services
+
+import (
+	context "context"
+
+	models "github.com/ravilock/goduit/internal/articlePublisher/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockRevisionWriter is an autogenerated mock type for the revisionWriter type
+type mockRevisionWriter struct {
+	mock.Mock
+}
+
+type mockRevisionWriter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockRevisionWriter) EXPECT() *mockRevisionWriter_Expecter {
+	return &mockRevisionWriter_Expecter{mock: &_m.Mock}
+}
+
+// WriteRevision provides a mock function with given fields: ctx, revision
+func (_m *mockRevisionWriter) WriteRevision(ctx context.Context, revision *models.Revision) error {
+	ret := _m.Called(ctx, revision)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WriteRevision")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Revision) error); ok {
+		r0 = rf(ctx, revision)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// mockRevisionWriter_WriteRevision_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WriteRevision'
+type mockRevisionWriter_WriteRevision_Call struct {
+	*mock.Call
+}
+
+// WriteRevision is a helper method to define mock.On call
+//   - ctx context.Context
+//   - revision *models.Revision
+func (_e *mockRevisionWriter_Expecter) WriteRevision(ctx interface{}, revision interface{}) *mockRevisionWriter_WriteRevision_Call {
+	return &mockRevisionWriter_WriteRevision_Call{Call: _e.mock.On("WriteRevision", ctx, revision)}
+}
+
+func (_c *mockRevisionWriter_WriteRevision_Call) Run(run func(ctx context.Context, revision *models.Revision)) *mockRevisionWriter_WriteRevision_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.Revision))
+	})
+	return _c
+}
+
+func (_c *mockRevisionWriter_WriteRevision_Call) Return(_a0 error) *mockRevisionWriter_WriteRevision_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *mockRevisionWriter_WriteRevision_Call) RunAndReturn(run func(context.Context, *models.Revision) error) *mockRevisionWriter_WriteRevision_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockRevisionWriter creates a new instance of mockRevisionWriter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockRevisionWriter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockRevisionWriter {
+	mock := &mockRevisionWriter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
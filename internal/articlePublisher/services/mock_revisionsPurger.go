@@ -0,0 +1,83 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package services
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockRevisionsPurger is an autogenerated mock type for the revisionsPurger type
+type mockRevisionsPurger struct {
+	mock.Mock
+}
+
+type mockRevisionsPurger_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockRevisionsPurger) EXPECT() *mockRevisionsPurger_Expecter {
+	return &mockRevisionsPurger_Expecter{mock: &_m.Mock}
+}
+
+// DeleteRevisions provides a mock function with given fields: ctx, article
+func (_m *mockRevisionsPurger) DeleteRevisions(ctx context.Context, article string) error {
+	ret := _m.Called(ctx, article)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteRevisions")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, article)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// mockRevisionsPurger_DeleteRevisions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteRevisions'
+type mockRevisionsPurger_DeleteRevisions_Call struct {
+	*mock.Call
+}
+
+// DeleteRevisions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - article string
+func (_e *mockRevisionsPurger_Expecter) DeleteRevisions(ctx interface{}, article interface{}) *mockRevisionsPurger_DeleteRevisions_Call {
+	return &mockRevisionsPurger_DeleteRevisions_Call{Call: _e.mock.On("DeleteRevisions", ctx, article)}
+}
+
+func (_c *mockRevisionsPurger_DeleteRevisions_Call) Run(run func(ctx context.Context, article string)) *mockRevisionsPurger_DeleteRevisions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *mockRevisionsPurger_DeleteRevisions_Call) Return(_a0 error) *mockRevisionsPurger_DeleteRevisions_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *mockRevisionsPurger_DeleteRevisions_Call) RunAndReturn(run func(context.Context, string) error) *mockRevisionsPurger_DeleteRevisions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockRevisionsPurger creates a new instance of mockRevisionsPurger. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockRevisionsPurger(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockRevisionsPurger {
+	mock := &mockRevisionsPurger{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
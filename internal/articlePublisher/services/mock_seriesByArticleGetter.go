@@ -0,0 +1,96 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package services
+
+import (
+	context "context"
+
+	models "github.com/ravilock/goduit/internal/articlePublisher/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockSeriesByArticleGetter is an autogenerated mock type for the seriesByArticleGetter type
+type mockSeriesByArticleGetter struct {
+	mock.Mock
+}
+
+type mockSeriesByArticleGetter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockSeriesByArticleGetter) EXPECT() *mockSeriesByArticleGetter_Expecter {
+	return &mockSeriesByArticleGetter_Expecter{mock: &_m.Mock}
+}
+
+// GetSeriesByArticle provides a mock function with given fields: ctx, article
+func (_m *mockSeriesByArticleGetter) GetSeriesByArticle(ctx context.Context, article string) (*models.Series, error) {
+	ret := _m.Called(ctx, article)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSeriesByArticle")
+	}
+
+	var r0 *models.Series
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.Series, error)); ok {
+		return rf(ctx, article)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *models.Series); ok {
+		r0 = rf(ctx, article)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Series)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, article)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockSeriesByArticleGetter_GetSeriesByArticle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSeriesByArticle'
+type mockSeriesByArticleGetter_GetSeriesByArticle_Call struct {
+	*mock.Call
+}
+
+// GetSeriesByArticle is a helper method to define mock.On call
+//   - ctx context.Context
+//   - article string
+func (_e *mockSeriesByArticleGetter_Expecter) GetSeriesByArticle(ctx interface{}, article interface{}) *mockSeriesByArticleGetter_GetSeriesByArticle_Call {
+	return &mockSeriesByArticleGetter_GetSeriesByArticle_Call{Call: _e.mock.On("GetSeriesByArticle", ctx, article)}
+}
+
+func (_c *mockSeriesByArticleGetter_GetSeriesByArticle_Call) Run(run func(ctx context.Context, article string)) *mockSeriesByArticleGetter_GetSeriesByArticle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *mockSeriesByArticleGetter_GetSeriesByArticle_Call) Return(_a0 *models.Series, _a1 error) *mockSeriesByArticleGetter_GetSeriesByArticle_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *mockSeriesByArticleGetter_GetSeriesByArticle_Call) RunAndReturn(run func(context.Context, string) (*models.Series, error)) *mockSeriesByArticleGetter_GetSeriesByArticle_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockSeriesByArticleGetter creates a new instance of mockSeriesByArticleGetter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockSeriesByArticleGetter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockSeriesByArticleGetter {
+	mock := &mockSeriesByArticleGetter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
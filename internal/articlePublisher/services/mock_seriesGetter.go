@@ -0,0 +1,96 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package services
+
+import (
+	context "context"
+
+	models "github.com/ravilock/goduit/internal/articlePublisher/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockSeriesGetter is an autogenerated mock type for the seriesGetter type
+type mockSeriesGetter struct {
+	mock.Mock
+}
+
+type mockSeriesGetter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockSeriesGetter) EXPECT() *mockSeriesGetter_Expecter {
+	return &mockSeriesGetter_Expecter{mock: &_m.Mock}
+}
+
+// GetSeriesBySlug provides a mock function with given fields: ctx, slug
+func (_m *mockSeriesGetter) GetSeriesBySlug(ctx context.Context, slug string) (*models.Series, error) {
+	ret := _m.Called(ctx, slug)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSeriesBySlug")
+	}
+
+	var r0 *models.Series
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.Series, error)); ok {
+		return rf(ctx, slug)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *models.Series); ok {
+		r0 = rf(ctx, slug)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Series)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, slug)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockSeriesGetter_GetSeriesBySlug_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSeriesBySlug'
+type mockSeriesGetter_GetSeriesBySlug_Call struct {
+	*mock.Call
+}
+
+// GetSeriesBySlug is a helper method to define mock.On call
+//   - ctx context.Context
+//   - slug string
+func (_e *mockSeriesGetter_Expecter) GetSeriesBySlug(ctx interface{}, slug interface{}) *mockSeriesGetter_GetSeriesBySlug_Call {
+	return &mockSeriesGetter_GetSeriesBySlug_Call{Call: _e.mock.On("GetSeriesBySlug", ctx, slug)}
+}
+
+func (_c *mockSeriesGetter_GetSeriesBySlug_Call) Run(run func(ctx context.Context, slug string)) *mockSeriesGetter_GetSeriesBySlug_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *mockSeriesGetter_GetSeriesBySlug_Call) Return(_a0 *models.Series, _a1 error) *mockSeriesGetter_GetSeriesBySlug_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *mockSeriesGetter_GetSeriesBySlug_Call) RunAndReturn(run func(context.Context, string) (*models.Series, error)) *mockSeriesGetter_GetSeriesBySlug_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockSeriesGetter creates a new instance of mockSeriesGetter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockSeriesGetter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockSeriesGetter {
+	mock := &mockSeriesGetter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
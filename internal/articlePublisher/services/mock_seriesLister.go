@@ -0,0 +1,98 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package services
+
+import (
+	context "context"
+
+	models "github.com/ravilock/goduit/internal/articlePublisher/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockSeriesLister is an autogenerated mock type for the seriesLister type
+type mockSeriesLister struct {
+	mock.Mock
+}
+
+type mockSeriesLister_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockSeriesLister) EXPECT() *mockSeriesLister_Expecter {
+	return &mockSeriesLister_Expecter{mock: &_m.Mock}
+}
+
+// ListSeries provides a mock function with given fields: ctx, owner, limit, offset
+func (_m *mockSeriesLister) ListSeries(ctx context.Context, owner string, limit int64, offset int64) ([]*models.Series, error) {
+	ret := _m.Called(ctx, owner, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSeries")
+	}
+
+	var r0 []*models.Series
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, int64) ([]*models.Series, error)); ok {
+		return rf(ctx, owner, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, int64) []*models.Series); ok {
+		r0 = rf(ctx, owner, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Series)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64, int64) error); ok {
+		r1 = rf(ctx, owner, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockSeriesLister_ListSeries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSeries'
+type mockSeriesLister_ListSeries_Call struct {
+	*mock.Call
+}
+
+// ListSeries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - owner string
+//   - limit int64
+//   - offset int64
+func (_e *mockSeriesLister_Expecter) ListSeries(ctx interface{}, owner interface{}, limit interface{}, offset interface{}) *mockSeriesLister_ListSeries_Call {
+	return &mockSeriesLister_ListSeries_Call{Call: _e.mock.On("ListSeries", ctx, owner, limit, offset)}
+}
+
+func (_c *mockSeriesLister_ListSeries_Call) Run(run func(ctx context.Context, owner string, limit int64, offset int64)) *mockSeriesLister_ListSeries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *mockSeriesLister_ListSeries_Call) Return(_a0 []*models.Series, _a1 error) *mockSeriesLister_ListSeries_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *mockSeriesLister_ListSeries_Call) RunAndReturn(run func(context.Context, string, int64, int64) ([]*models.Series, error)) *mockSeriesLister_ListSeries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockSeriesLister creates a new instance of mockSeriesLister. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockSeriesLister(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockSeriesLister {
+	mock := &mockSeriesLister{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
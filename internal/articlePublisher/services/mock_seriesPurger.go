@@ -0,0 +1,83 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package services
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockSeriesPurger is an autogenerated mock type for the seriesPurger type
+type mockSeriesPurger struct {
+	mock.Mock
+}
+
+type mockSeriesPurger_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockSeriesPurger) EXPECT() *mockSeriesPurger_Expecter {
+	return &mockSeriesPurger_Expecter{mock: &_m.Mock}
+}
+
+// RemoveArticleFromSeries provides a mock function with given fields: ctx, article
+func (_m *mockSeriesPurger) RemoveArticleFromSeries(ctx context.Context, article string) error {
+	ret := _m.Called(ctx, article)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveArticleFromSeries")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, article)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// mockSeriesPurger_RemoveArticleFromSeries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveArticleFromSeries'
+type mockSeriesPurger_RemoveArticleFromSeries_Call struct {
+	*mock.Call
+}
+
+// RemoveArticleFromSeries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - article string
+func (_e *mockSeriesPurger_Expecter) RemoveArticleFromSeries(ctx interface{}, article interface{}) *mockSeriesPurger_RemoveArticleFromSeries_Call {
+	return &mockSeriesPurger_RemoveArticleFromSeries_Call{Call: _e.mock.On("RemoveArticleFromSeries", ctx, article)}
+}
+
+func (_c *mockSeriesPurger_RemoveArticleFromSeries_Call) Run(run func(ctx context.Context, article string)) *mockSeriesPurger_RemoveArticleFromSeries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *mockSeriesPurger_RemoveArticleFromSeries_Call) Return(_a0 error) *mockSeriesPurger_RemoveArticleFromSeries_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *mockSeriesPurger_RemoveArticleFromSeries_Call) RunAndReturn(run func(context.Context, string) error) *mockSeriesPurger_RemoveArticleFromSeries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockSeriesPurger creates a new instance of mockSeriesPurger. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockSeriesPurger(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockSeriesPurger {
+	mock := &mockSeriesPurger{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,143 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package services
+
+import (
+	context "context"
+
+	models "github.com/ravilock/goduit/internal/articlePublisher/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockSeriesWriter is an autogenerated mock type for the seriesWriter type
+type mockSeriesWriter struct {
+	mock.Mock
+}
+
+type mockSeriesWriter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockSeriesWriter) EXPECT() *mockSeriesWriter_Expecter {
+	return &mockSeriesWriter_Expecter{mock: &_m.Mock}
+}
+
+// GetSeriesByArticle provides a mock function with given fields: ctx, article
+func (_m *mockSeriesWriter) GetSeriesByArticle(ctx context.Context, article string) (*models.Series, error) {
+	ret := _m.Called(ctx, article)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSeriesByArticle")
+	}
+
+	var r0 *models.Series
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.Series, error)); ok {
+		return rf(ctx, article)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *models.Series); ok {
+		r0 = rf(ctx, article)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Series)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, article)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockSeriesWriter_GetSeriesByArticle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSeriesByArticle'
+type mockSeriesWriter_GetSeriesByArticle_Call struct {
+	*mock.Call
+}
+
+// GetSeriesByArticle is a helper method to define mock.On call
+//   - ctx context.Context
+//   - article string
+func (_e *mockSeriesWriter_Expecter) GetSeriesByArticle(ctx interface{}, article interface{}) *mockSeriesWriter_GetSeriesByArticle_Call {
+	return &mockSeriesWriter_GetSeriesByArticle_Call{Call: _e.mock.On("GetSeriesByArticle", ctx, article)}
+}
+
+func (_c *mockSeriesWriter_GetSeriesByArticle_Call) Run(run func(ctx context.Context, article string)) *mockSeriesWriter_GetSeriesByArticle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *mockSeriesWriter_GetSeriesByArticle_Call) Return(_a0 *models.Series, _a1 error) *mockSeriesWriter_GetSeriesByArticle_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *mockSeriesWriter_GetSeriesByArticle_Call) RunAndReturn(run func(context.Context, string) (*models.Series, error)) *mockSeriesWriter_GetSeriesByArticle_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WriteSeries provides a mock function with given fields: ctx, series
+func (_m *mockSeriesWriter) WriteSeries(ctx context.Context, series *models.Series) error {
+	ret := _m.Called(ctx, series)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WriteSeries")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Series) error); ok {
+		r0 = rf(ctx, series)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// mockSeriesWriter_WriteSeries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WriteSeries'
+type mockSeriesWriter_WriteSeries_Call struct {
+	*mock.Call
+}
+
+// WriteSeries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - series *models.Series
+func (_e *mockSeriesWriter_Expecter) WriteSeries(ctx interface{}, series interface{}) *mockSeriesWriter_WriteSeries_Call {
+	return &mockSeriesWriter_WriteSeries_Call{Call: _e.mock.On("WriteSeries", ctx, series)}
+}
+
+func (_c *mockSeriesWriter_WriteSeries_Call) Run(run func(ctx context.Context, series *models.Series)) *mockSeriesWriter_WriteSeries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.Series))
+	})
+	return _c
+}
+
+func (_c *mockSeriesWriter_WriteSeries_Call) Return(_a0 error) *mockSeriesWriter_WriteSeries_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *mockSeriesWriter_WriteSeries_Call) RunAndReturn(run func(context.Context, *models.Series) error) *mockSeriesWriter_WriteSeries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockSeriesWriter creates a new instance of mockSeriesWriter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockSeriesWriter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockSeriesWriter {
+	mock := &mockSeriesWriter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
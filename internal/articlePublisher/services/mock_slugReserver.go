@@ -0,0 +1,142 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package services
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockSlugReserver is an autogenerated mock type for the slugReserver type
+type mockSlugReserver struct {
+	mock.Mock
+}
+
+type mockSlugReserver_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockSlugReserver) EXPECT() *mockSlugReserver_Expecter {
+	return &mockSlugReserver_Expecter{mock: &_m.Mock}
+}
+
+// ReleaseSlug provides a mock function with given fields: ctx, slug, article
+func (_m *mockSlugReserver) ReleaseSlug(ctx context.Context, slug string, article string) error {
+	ret := _m.Called(ctx, slug, article)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleaseSlug")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, slug, article)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// mockSlugReserver_ReleaseSlug_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleaseSlug'
+type mockSlugReserver_ReleaseSlug_Call struct {
+	*mock.Call
+}
+
+// ReleaseSlug is a helper method to define mock.On call
+//   - ctx context.Context
+//   - slug string
+//   - article string
+func (_e *mockSlugReserver_Expecter) ReleaseSlug(ctx interface{}, slug interface{}, article interface{}) *mockSlugReserver_ReleaseSlug_Call {
+	return &mockSlugReserver_ReleaseSlug_Call{Call: _e.mock.On("ReleaseSlug", ctx, slug, article)}
+}
+
+func (_c *mockSlugReserver_ReleaseSlug_Call) Run(run func(ctx context.Context, slug string, article string)) *mockSlugReserver_ReleaseSlug_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *mockSlugReserver_ReleaseSlug_Call) Return(_a0 error) *mockSlugReserver_ReleaseSlug_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *mockSlugReserver_ReleaseSlug_Call) RunAndReturn(run func(context.Context, string, string) error) *mockSlugReserver_ReleaseSlug_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReserveSlug provides a mock function with given fields: ctx, slug, article
+func (_m *mockSlugReserver) ReserveSlug(ctx context.Context, slug string, article string) (bool, error) {
+	ret := _m.Called(ctx, slug, article)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReserveSlug")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (bool, error)); ok {
+		return rf(ctx, slug, article)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) bool); ok {
+		r0 = rf(ctx, slug, article)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, slug, article)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockSlugReserver_ReserveSlug_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReserveSlug'
+type mockSlugReserver_ReserveSlug_Call struct {
+	*mock.Call
+}
+
+// ReserveSlug is a helper method to define mock.On call
+//   - ctx context.Context
+//   - slug string
+//   - article string
+func (_e *mockSlugReserver_Expecter) ReserveSlug(ctx interface{}, slug interface{}, article interface{}) *mockSlugReserver_ReserveSlug_Call {
+	return &mockSlugReserver_ReserveSlug_Call{Call: _e.mock.On("ReserveSlug", ctx, slug, article)}
+}
+
+func (_c *mockSlugReserver_ReserveSlug_Call) Run(run func(ctx context.Context, slug string, article string)) *mockSlugReserver_ReserveSlug_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *mockSlugReserver_ReserveSlug_Call) Return(_a0 bool, _a1 error) *mockSlugReserver_ReserveSlug_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *mockSlugReserver_ReserveSlug_Call) RunAndReturn(run func(context.Context, string, string) (bool, error)) *mockSlugReserver_ReserveSlug_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockSlugReserver creates a new instance of mockSlugReserver. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockSlugReserver(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockSlugReserver {
+	mock := &mockSlugReserver{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,93 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package services
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockSlugResolver is an autogenerated mock type for the slugResolver type
+type mockSlugResolver struct {
+	mock.Mock
+}
+
+type mockSlugResolver_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockSlugResolver) EXPECT() *mockSlugResolver_Expecter {
+	return &mockSlugResolver_Expecter{mock: &_m.Mock}
+}
+
+// GetArticleIDBySlug provides a mock function with given fields: ctx, slug
+func (_m *mockSlugResolver) GetArticleIDBySlug(ctx context.Context, slug string) (string, error) {
+	ret := _m.Called(ctx, slug)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetArticleIDBySlug")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (string, error)); ok {
+		return rf(ctx, slug)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = rf(ctx, slug)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, slug)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockSlugResolver_GetArticleIDBySlug_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetArticleIDBySlug'
+type mockSlugResolver_GetArticleIDBySlug_Call struct {
+	*mock.Call
+}
+
+// GetArticleIDBySlug is a helper method to define mock.On call
+//   - ctx context.Context
+//   - slug string
+func (_e *mockSlugResolver_Expecter) GetArticleIDBySlug(ctx interface{}, slug interface{}) *mockSlugResolver_GetArticleIDBySlug_Call {
+	return &mockSlugResolver_GetArticleIDBySlug_Call{Call: _e.mock.On("GetArticleIDBySlug", ctx, slug)}
+}
+
+func (_c *mockSlugResolver_GetArticleIDBySlug_Call) Run(run func(ctx context.Context, slug string)) *mockSlugResolver_GetArticleIDBySlug_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *mockSlugResolver_GetArticleIDBySlug_Call) Return(_a0 string, _a1 error) *mockSlugResolver_GetArticleIDBySlug_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *mockSlugResolver_GetArticleIDBySlug_Call) RunAndReturn(run func(context.Context, string) (string, error)) *mockSlugResolver_GetArticleIDBySlug_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockSlugResolver creates a new instance of mockSlugResolver. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockSlugResolver(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockSlugResolver {
+	mock := &mockSlugResolver{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,83 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package services
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockSlugsReleaser is an autogenerated mock type for the slugsReleaser type
+type mockSlugsReleaser struct {
+	mock.Mock
+}
+
+type mockSlugsReleaser_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockSlugsReleaser) EXPECT() *mockSlugsReleaser_Expecter {
+	return &mockSlugsReleaser_Expecter{mock: &_m.Mock}
+}
+
+// ReleaseArticleSlugs provides a mock function with given fields: ctx, article
+func (_m *mockSlugsReleaser) ReleaseArticleSlugs(ctx context.Context, article string) error {
+	ret := _m.Called(ctx, article)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleaseArticleSlugs")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, article)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// mockSlugsReleaser_ReleaseArticleSlugs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleaseArticleSlugs'
+type mockSlugsReleaser_ReleaseArticleSlugs_Call struct {
+	*mock.Call
+}
+
+// ReleaseArticleSlugs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - article string
+func (_e *mockSlugsReleaser_Expecter) ReleaseArticleSlugs(ctx interface{}, article interface{}) *mockSlugsReleaser_ReleaseArticleSlugs_Call {
+	return &mockSlugsReleaser_ReleaseArticleSlugs_Call{Call: _e.mock.On("ReleaseArticleSlugs", ctx, article)}
+}
+
+func (_c *mockSlugsReleaser_ReleaseArticleSlugs_Call) Run(run func(ctx context.Context, article string)) *mockSlugsReleaser_ReleaseArticleSlugs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *mockSlugsReleaser_ReleaseArticleSlugs_Call) Return(_a0 error) *mockSlugsReleaser_ReleaseArticleSlugs_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *mockSlugsReleaser_ReleaseArticleSlugs_Call) RunAndReturn(run func(context.Context, string) error) *mockSlugsReleaser_ReleaseArticleSlugs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockSlugsReleaser creates a new instance of mockSlugsReleaser. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockSlugsReleaser(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockSlugsReleaser {
+	mock := &mockSlugsReleaser{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,155 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package services
+
+import (
+	context "context"
+
+	models "github.com/ravilock/goduit/internal/articlePublisher/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockTagAliasesLister is an autogenerated mock type for the tagAliasesLister type
+type mockTagAliasesLister struct {
+	mock.Mock
+}
+
+type mockTagAliasesLister_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockTagAliasesLister) EXPECT() *mockTagAliasesLister_Expecter {
+	return &mockTagAliasesLister_Expecter{mock: &_m.Mock}
+}
+
+// ListTagAliases provides a mock function with given fields: ctx, tag
+func (_m *mockTagAliasesLister) ListTagAliases(ctx context.Context, tag string) ([]*models.TagAlias, error) {
+	ret := _m.Called(ctx, tag)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTagAliases")
+	}
+
+	var r0 []*models.TagAlias
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*models.TagAlias, error)); ok {
+		return rf(ctx, tag)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*models.TagAlias); ok {
+		r0 = rf(ctx, tag)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.TagAlias)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, tag)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockTagAliasesLister_ListTagAliases_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTagAliases'
+type mockTagAliasesLister_ListTagAliases_Call struct {
+	*mock.Call
+}
+
+// ListTagAliases is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tag string
+func (_e *mockTagAliasesLister_Expecter) ListTagAliases(ctx interface{}, tag interface{}) *mockTagAliasesLister_ListTagAliases_Call {
+	return &mockTagAliasesLister_ListTagAliases_Call{Call: _e.mock.On("ListTagAliases", ctx, tag)}
+}
+
+func (_c *mockTagAliasesLister_ListTagAliases_Call) Run(run func(ctx context.Context, tag string)) *mockTagAliasesLister_ListTagAliases_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *mockTagAliasesLister_ListTagAliases_Call) Return(_a0 []*models.TagAlias, _a1 error) *mockTagAliasesLister_ListTagAliases_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *mockTagAliasesLister_ListTagAliases_Call) RunAndReturn(run func(context.Context, string) ([]*models.TagAlias, error)) *mockTagAliasesLister_ListTagAliases_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResolveTags provides a mock function with given fields: ctx, tags
+func (_m *mockTagAliasesLister) ResolveTags(ctx context.Context, tags []string) ([]string, error) {
+	ret := _m.Called(ctx, tags)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResolveTags")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) ([]string, error)); ok {
+		return rf(ctx, tags)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []string); ok {
+		r0 = rf(ctx, tags)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, tags)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockTagAliasesLister_ResolveTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResolveTags'
+type mockTagAliasesLister_ResolveTags_Call struct {
+	*mock.Call
+}
+
+// ResolveTags is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tags []string
+func (_e *mockTagAliasesLister_Expecter) ResolveTags(ctx interface{}, tags interface{}) *mockTagAliasesLister_ResolveTags_Call {
+	return &mockTagAliasesLister_ResolveTags_Call{Call: _e.mock.On("ResolveTags", ctx, tags)}
+}
+
+func (_c *mockTagAliasesLister_ResolveTags_Call) Run(run func(ctx context.Context, tags []string)) *mockTagAliasesLister_ResolveTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *mockTagAliasesLister_ResolveTags_Call) Return(_a0 []string, _a1 error) *mockTagAliasesLister_ResolveTags_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *mockTagAliasesLister_ResolveTags_Call) RunAndReturn(run func(context.Context, []string) ([]string, error)) *mockTagAliasesLister_ResolveTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockTagAliasesLister creates a new instance of mockTagAliasesLister. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockTagAliasesLister(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockTagAliasesLister {
+	mock := &mockTagAliasesLister{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,143 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package services
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockTagCanonicalizer is an autogenerated mock type for the tagCanonicalizer type
+type mockTagCanonicalizer struct {
+	mock.Mock
+}
+
+type mockTagCanonicalizer_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockTagCanonicalizer) EXPECT() *mockTagCanonicalizer_Expecter {
+	return &mockTagCanonicalizer_Expecter{mock: &_m.Mock}
+}
+
+// ResolveTags provides a mock function with given fields: ctx, tags
+func (_m *mockTagCanonicalizer) ResolveTags(ctx context.Context, tags []string) ([]string, error) {
+	ret := _m.Called(ctx, tags)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResolveTags")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) ([]string, error)); ok {
+		return rf(ctx, tags)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []string); ok {
+		r0 = rf(ctx, tags)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, tags)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockTagCanonicalizer_ResolveTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResolveTags'
+type mockTagCanonicalizer_ResolveTags_Call struct {
+	*mock.Call
+}
+
+// ResolveTags is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tags []string
+func (_e *mockTagCanonicalizer_Expecter) ResolveTags(ctx interface{}, tags interface{}) *mockTagCanonicalizer_ResolveTags_Call {
+	return &mockTagCanonicalizer_ResolveTags_Call{Call: _e.mock.On("ResolveTags", ctx, tags)}
+}
+
+func (_c *mockTagCanonicalizer_ResolveTags_Call) Run(run func(ctx context.Context, tags []string)) *mockTagCanonicalizer_ResolveTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *mockTagCanonicalizer_ResolveTags_Call) Return(_a0 []string, _a1 error) *mockTagCanonicalizer_ResolveTags_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *mockTagCanonicalizer_ResolveTags_Call) RunAndReturn(run func(context.Context, []string) ([]string, error)) *mockTagCanonicalizer_ResolveTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateTagCounts provides a mock function with given fields: ctx, tags, delta
+func (_m *mockTagCanonicalizer) UpdateTagCounts(ctx context.Context, tags []string, delta int64) error {
+	ret := _m.Called(ctx, tags, delta)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTagCounts")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string, int64) error); ok {
+		r0 = rf(ctx, tags, delta)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// mockTagCanonicalizer_UpdateTagCounts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTagCounts'
+type mockTagCanonicalizer_UpdateTagCounts_Call struct {
+	*mock.Call
+}
+
+// UpdateTagCounts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tags []string
+//   - delta int64
+func (_e *mockTagCanonicalizer_Expecter) UpdateTagCounts(ctx interface{}, tags interface{}, delta interface{}) *mockTagCanonicalizer_UpdateTagCounts_Call {
+	return &mockTagCanonicalizer_UpdateTagCounts_Call{Call: _e.mock.On("UpdateTagCounts", ctx, tags, delta)}
+}
+
+func (_c *mockTagCanonicalizer_UpdateTagCounts_Call) Run(run func(ctx context.Context, tags []string, delta int64)) *mockTagCanonicalizer_UpdateTagCounts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *mockTagCanonicalizer_UpdateTagCounts_Call) Return(_a0 error) *mockTagCanonicalizer_UpdateTagCounts_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *mockTagCanonicalizer_UpdateTagCounts_Call) RunAndReturn(run func(context.Context, []string, int64) error) *mockTagCanonicalizer_UpdateTagCounts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockTagCanonicalizer creates a new instance of mockTagCanonicalizer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockTagCanonicalizer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockTagCanonicalizer {
+	mock := &mockTagCanonicalizer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,84 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package services
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockTagCounter is an autogenerated mock type for the tagCounter type
+type mockTagCounter struct {
+	mock.Mock
+}
+
+type mockTagCounter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockTagCounter) EXPECT() *mockTagCounter_Expecter {
+	return &mockTagCounter_Expecter{mock: &_m.Mock}
+}
+
+// UpdateTagCounts provides a mock function with given fields: ctx, tags, delta
+func (_m *mockTagCounter) UpdateTagCounts(ctx context.Context, tags []string, delta int64) error {
+	ret := _m.Called(ctx, tags, delta)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTagCounts")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string, int64) error); ok {
+		r0 = rf(ctx, tags, delta)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// mockTagCounter_UpdateTagCounts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTagCounts'
+type mockTagCounter_UpdateTagCounts_Call struct {
+	*mock.Call
+}
+
+// UpdateTagCounts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tags []string
+//   - delta int64
+func (_e *mockTagCounter_Expecter) UpdateTagCounts(ctx interface{}, tags interface{}, delta interface{}) *mockTagCounter_UpdateTagCounts_Call {
+	return &mockTagCounter_UpdateTagCounts_Call{Call: _e.mock.On("UpdateTagCounts", ctx, tags, delta)}
+}
+
+func (_c *mockTagCounter_UpdateTagCounts_Call) Run(run func(ctx context.Context, tags []string, delta int64)) *mockTagCounter_UpdateTagCounts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *mockTagCounter_UpdateTagCounts_Call) Return(_a0 error) *mockTagCounter_UpdateTagCounts_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *mockTagCounter_UpdateTagCounts_Call) RunAndReturn(run func(context.Context, []string, int64) error) *mockTagCounter_UpdateTagCounts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockTagCounter creates a new instance of mockTagCounter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockTagCounter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockTagCounter {
+	mock := &mockTagCounter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,97 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package services
+
+import (
+	context "context"
+
+	models "github.com/ravilock/goduit/internal/articlePublisher/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockTagLister is an autogenerated mock type for the tagLister type
+type mockTagLister struct {
+	mock.Mock
+}
+
+type mockTagLister_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockTagLister) EXPECT() *mockTagLister_Expecter {
+	return &mockTagLister_Expecter{mock: &_m.Mock}
+}
+
+// ListTags provides a mock function with given fields: ctx, prefix, limit
+func (_m *mockTagLister) ListTags(ctx context.Context, prefix string, limit int64) ([]*models.Tag, error) {
+	ret := _m.Called(ctx, prefix, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTags")
+	}
+
+	var r0 []*models.Tag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) ([]*models.Tag, error)); ok {
+		return rf(ctx, prefix, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) []*models.Tag); ok {
+		r0 = rf(ctx, prefix, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Tag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64) error); ok {
+		r1 = rf(ctx, prefix, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockTagLister_ListTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTags'
+type mockTagLister_ListTags_Call struct {
+	*mock.Call
+}
+
+// ListTags is a helper method to define mock.On call
+//   - ctx context.Context
+//   - prefix string
+//   - limit int64
+func (_e *mockTagLister_Expecter) ListTags(ctx interface{}, prefix interface{}, limit interface{}) *mockTagLister_ListTags_Call {
+	return &mockTagLister_ListTags_Call{Call: _e.mock.On("ListTags", ctx, prefix, limit)}
+}
+
+func (_c *mockTagLister_ListTags_Call) Run(run func(ctx context.Context, prefix string, limit int64)) *mockTagLister_ListTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *mockTagLister_ListTags_Call) Return(_a0 []*models.Tag, _a1 error) *mockTagLister_ListTags_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *mockTagLister_ListTags_Call) RunAndReturn(run func(context.Context, string, int64) ([]*models.Tag, error)) *mockTagLister_ListTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockTagLister creates a new instance of mockTagLister. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockTagLister(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockTagLister {
+	mock := &mockTagLister{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,191 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package services
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockTagMerger is an autogenerated mock type for the tagMerger type
+type mockTagMerger struct {
+	mock.Mock
+}
+
+type mockTagMerger_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockTagMerger) EXPECT() *mockTagMerger_Expecter {
+	return &mockTagMerger_Expecter{mock: &_m.Mock}
+}
+
+// AliasTag provides a mock function with given fields: ctx, alias, tag
+func (_m *mockTagMerger) AliasTag(ctx context.Context, alias string, tag string) error {
+	ret := _m.Called(ctx, alias, tag)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AliasTag")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, alias, tag)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// mockTagMerger_AliasTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AliasTag'
+type mockTagMerger_AliasTag_Call struct {
+	*mock.Call
+}
+
+// AliasTag is a helper method to define mock.On call
+//   - ctx context.Context
+//   - alias string
+//   - tag string
+func (_e *mockTagMerger_Expecter) AliasTag(ctx interface{}, alias interface{}, tag interface{}) *mockTagMerger_AliasTag_Call {
+	return &mockTagMerger_AliasTag_Call{Call: _e.mock.On("AliasTag", ctx, alias, tag)}
+}
+
+func (_c *mockTagMerger_AliasTag_Call) Run(run func(ctx context.Context, alias string, tag string)) *mockTagMerger_AliasTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *mockTagMerger_AliasTag_Call) Return(_a0 error) *mockTagMerger_AliasTag_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *mockTagMerger_AliasTag_Call) RunAndReturn(run func(context.Context, string, string) error) *mockTagMerger_AliasTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResolveTags provides a mock function with given fields: ctx, tags
+func (_m *mockTagMerger) ResolveTags(ctx context.Context, tags []string) ([]string, error) {
+	ret := _m.Called(ctx, tags)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResolveTags")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) ([]string, error)); ok {
+		return rf(ctx, tags)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []string); ok {
+		r0 = rf(ctx, tags)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, tags)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockTagMerger_ResolveTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResolveTags'
+type mockTagMerger_ResolveTags_Call struct {
+	*mock.Call
+}
+
+// ResolveTags is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tags []string
+func (_e *mockTagMerger_Expecter) ResolveTags(ctx interface{}, tags interface{}) *mockTagMerger_ResolveTags_Call {
+	return &mockTagMerger_ResolveTags_Call{Call: _e.mock.On("ResolveTags", ctx, tags)}
+}
+
+func (_c *mockTagMerger_ResolveTags_Call) Run(run func(ctx context.Context, tags []string)) *mockTagMerger_ResolveTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *mockTagMerger_ResolveTags_Call) Return(_a0 []string, _a1 error) *mockTagMerger_ResolveTags_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *mockTagMerger_ResolveTags_Call) RunAndReturn(run func(context.Context, []string) ([]string, error)) *mockTagMerger_ResolveTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetTagCount provides a mock function with given fields: ctx, tag, count
+func (_m *mockTagMerger) SetTagCount(ctx context.Context, tag string, count int64) error {
+	ret := _m.Called(ctx, tag, count)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetTagCount")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) error); ok {
+		r0 = rf(ctx, tag, count)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// mockTagMerger_SetTagCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetTagCount'
+type mockTagMerger_SetTagCount_Call struct {
+	*mock.Call
+}
+
+// SetTagCount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tag string
+//   - count int64
+func (_e *mockTagMerger_Expecter) SetTagCount(ctx interface{}, tag interface{}, count interface{}) *mockTagMerger_SetTagCount_Call {
+	return &mockTagMerger_SetTagCount_Call{Call: _e.mock.On("SetTagCount", ctx, tag, count)}
+}
+
+func (_c *mockTagMerger_SetTagCount_Call) Run(run func(ctx context.Context, tag string, count int64)) *mockTagMerger_SetTagCount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *mockTagMerger_SetTagCount_Call) Return(_a0 error) *mockTagMerger_SetTagCount_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *mockTagMerger_SetTagCount_Call) RunAndReturn(run func(context.Context, string, int64) error) *mockTagMerger_SetTagCount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockTagMerger creates a new instance of mockTagMerger. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockTagMerger(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockTagMerger {
+	mock := &mockTagMerger{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,95 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package services
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockTagResolver is an autogenerated mock type for the tagResolver type
+type mockTagResolver struct {
+	mock.Mock
+}
+
+type mockTagResolver_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockTagResolver) EXPECT() *mockTagResolver_Expecter {
+	return &mockTagResolver_Expecter{mock: &_m.Mock}
+}
+
+// ResolveTags provides a mock function with given fields: ctx, tags
+func (_m *mockTagResolver) ResolveTags(ctx context.Context, tags []string) ([]string, error) {
+	ret := _m.Called(ctx, tags)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResolveTags")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) ([]string, error)); ok {
+		return rf(ctx, tags)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []string); ok {
+		r0 = rf(ctx, tags)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, tags)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockTagResolver_ResolveTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResolveTags'
+type mockTagResolver_ResolveTags_Call struct {
+	*mock.Call
+}
+
+// ResolveTags is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tags []string
+func (_e *mockTagResolver_Expecter) ResolveTags(ctx interface{}, tags interface{}) *mockTagResolver_ResolveTags_Call {
+	return &mockTagResolver_ResolveTags_Call{Call: _e.mock.On("ResolveTags", ctx, tags)}
+}
+
+func (_c *mockTagResolver_ResolveTags_Call) Run(run func(ctx context.Context, tags []string)) *mockTagResolver_ResolveTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *mockTagResolver_ResolveTags_Call) Return(_a0 []string, _a1 error) *mockTagResolver_ResolveTags_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *mockTagResolver_ResolveTags_Call) RunAndReturn(run func(context.Context, []string) ([]string, error)) *mockTagResolver_ResolveTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockTagResolver creates a new instance of mockTagResolver. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockTagResolver(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockTagResolver {
+	mock := &mockTagResolver{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
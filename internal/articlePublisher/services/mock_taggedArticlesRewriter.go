@@ -0,0 +1,151 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package services
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockTaggedArticlesRewriter is an autogenerated mock type for the taggedArticlesRewriter type
+type mockTaggedArticlesRewriter struct {
+	mock.Mock
+}
+
+type mockTaggedArticlesRewriter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockTaggedArticlesRewriter) EXPECT() *mockTaggedArticlesRewriter_Expecter {
+	return &mockTaggedArticlesRewriter_Expecter{mock: &_m.Mock}
+}
+
+// CountTaggedArticles provides a mock function with given fields: ctx, tag
+func (_m *mockTaggedArticlesRewriter) CountTaggedArticles(ctx context.Context, tag string) (int64, error) {
+	ret := _m.Called(ctx, tag)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountTaggedArticles")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (int64, error)); ok {
+		return rf(ctx, tag)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) int64); ok {
+		r0 = rf(ctx, tag)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, tag)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockTaggedArticlesRewriter_CountTaggedArticles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountTaggedArticles'
+type mockTaggedArticlesRewriter_CountTaggedArticles_Call struct {
+	*mock.Call
+}
+
+// CountTaggedArticles is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tag string
+func (_e *mockTaggedArticlesRewriter_Expecter) CountTaggedArticles(ctx interface{}, tag interface{}) *mockTaggedArticlesRewriter_CountTaggedArticles_Call {
+	return &mockTaggedArticlesRewriter_CountTaggedArticles_Call{Call: _e.mock.On("CountTaggedArticles", ctx, tag)}
+}
+
+func (_c *mockTaggedArticlesRewriter_CountTaggedArticles_Call) Run(run func(ctx context.Context, tag string)) *mockTaggedArticlesRewriter_CountTaggedArticles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *mockTaggedArticlesRewriter_CountTaggedArticles_Call) Return(_a0 int64, _a1 error) *mockTaggedArticlesRewriter_CountTaggedArticles_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *mockTaggedArticlesRewriter_CountTaggedArticles_Call) RunAndReturn(run func(context.Context, string) (int64, error)) *mockTaggedArticlesRewriter_CountTaggedArticles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReplaceTag provides a mock function with given fields: ctx, from, to
+func (_m *mockTaggedArticlesRewriter) ReplaceTag(ctx context.Context, from string, to string) (int64, error) {
+	ret := _m.Called(ctx, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplaceTag")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (int64, error)); ok {
+		return rf(ctx, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) int64); ok {
+		r0 = rf(ctx, from, to)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockTaggedArticlesRewriter_ReplaceTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplaceTag'
+type mockTaggedArticlesRewriter_ReplaceTag_Call struct {
+	*mock.Call
+}
+
+// ReplaceTag is a helper method to define mock.On call
+//   - ctx context.Context
+//   - from string
+//   - to string
+func (_e *mockTaggedArticlesRewriter_Expecter) ReplaceTag(ctx interface{}, from interface{}, to interface{}) *mockTaggedArticlesRewriter_ReplaceTag_Call {
+	return &mockTaggedArticlesRewriter_ReplaceTag_Call{Call: _e.mock.On("ReplaceTag", ctx, from, to)}
+}
+
+func (_c *mockTaggedArticlesRewriter_ReplaceTag_Call) Run(run func(ctx context.Context, from string, to string)) *mockTaggedArticlesRewriter_ReplaceTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *mockTaggedArticlesRewriter_ReplaceTag_Call) Return(_a0 int64, _a1 error) *mockTaggedArticlesRewriter_ReplaceTag_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *mockTaggedArticlesRewriter_ReplaceTag_Call) RunAndReturn(run func(context.Context, string, string) (int64, error)) *mockTaggedArticlesRewriter_ReplaceTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockTaggedArticlesRewriter creates a new instance of mockTaggedArticlesRewriter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockTaggedArticlesRewriter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockTaggedArticlesRewriter {
+	mock := &mockTaggedArticlesRewriter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,96 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package services
+
+import (
+	context "context"
+
+	models "github.com/ravilock/goduit/internal/articlePublisher/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockTrashedArticleGetter is an autogenerated mock type for the trashedArticleGetter type
+type mockTrashedArticleGetter struct {
+	mock.Mock
+}
+
+type mockTrashedArticleGetter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockTrashedArticleGetter) EXPECT() *mockTrashedArticleGetter_Expecter {
+	return &mockTrashedArticleGetter_Expecter{mock: &_m.Mock}
+}
+
+// GetTrashedArticleBySlug provides a mock function with given fields: ctx, slug
+func (_m *mockTrashedArticleGetter) GetTrashedArticleBySlug(ctx context.Context, slug string) (*models.Article, error) {
+	ret := _m.Called(ctx, slug)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTrashedArticleBySlug")
+	}
+
+	var r0 *models.Article
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.Article, error)); ok {
+		return rf(ctx, slug)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *models.Article); ok {
+		r0 = rf(ctx, slug)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Article)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, slug)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockTrashedArticleGetter_GetTrashedArticleBySlug_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTrashedArticleBySlug'
+type mockTrashedArticleGetter_GetTrashedArticleBySlug_Call struct {
+	*mock.Call
+}
+
+// GetTrashedArticleBySlug is a helper method to define mock.On call
+//   - ctx context.Context
+//   - slug string
+func (_e *mockTrashedArticleGetter_Expecter) GetTrashedArticleBySlug(ctx interface{}, slug interface{}) *mockTrashedArticleGetter_GetTrashedArticleBySlug_Call {
+	return &mockTrashedArticleGetter_GetTrashedArticleBySlug_Call{Call: _e.mock.On("GetTrashedArticleBySlug", ctx, slug)}
+}
+
+func (_c *mockTrashedArticleGetter_GetTrashedArticleBySlug_Call) Run(run func(ctx context.Context, slug string)) *mockTrashedArticleGetter_GetTrashedArticleBySlug_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *mockTrashedArticleGetter_GetTrashedArticleBySlug_Call) Return(_a0 *models.Article, _a1 error) *mockTrashedArticleGetter_GetTrashedArticleBySlug_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *mockTrashedArticleGetter_GetTrashedArticleBySlug_Call) RunAndReturn(run func(context.Context, string) (*models.Article, error)) *mockTrashedArticleGetter_GetTrashedArticleBySlug_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockTrashedArticleGetter creates a new instance of mockTrashedArticleGetter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockTrashedArticleGetter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockTrashedArticleGetter {
+	mock := &mockTrashedArticleGetter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
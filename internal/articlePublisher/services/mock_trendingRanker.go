@@ -0,0 +1,97 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package services
+
+import (
+	context "context"
+
+	models "github.com/ravilock/goduit/internal/articlePublisher/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockTrendingRanker is an autogenerated mock type for the trendingRanker type
+type mockTrendingRanker struct {
+	mock.Mock
+}
+
+type mockTrendingRanker_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockTrendingRanker) EXPECT() *mockTrendingRanker_Expecter {
+	return &mockTrendingRanker_Expecter{mock: &_m.Mock}
+}
+
+// RankTrendingArticles provides a mock function with given fields: ctx, scores, size
+func (_m *mockTrendingRanker) RankTrendingArticles(ctx context.Context, scores []*models.ArticleScore, size int64) ([]*models.TrendingArticle, error) {
+	ret := _m.Called(ctx, scores, size)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RankTrendingArticles")
+	}
+
+	var r0 []*models.TrendingArticle
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []*models.ArticleScore, int64) ([]*models.TrendingArticle, error)); ok {
+		return rf(ctx, scores, size)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []*models.ArticleScore, int64) []*models.TrendingArticle); ok {
+		r0 = rf(ctx, scores, size)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.TrendingArticle)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []*models.ArticleScore, int64) error); ok {
+		r1 = rf(ctx, scores, size)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockTrendingRanker_RankTrendingArticles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RankTrendingArticles'
+type mockTrendingRanker_RankTrendingArticles_Call struct {
+	*mock.Call
+}
+
+// RankTrendingArticles is a helper method to define mock.On call
+//   - ctx context.Context
+//   - scores []*models.ArticleScore
+//   - size int64
+func (_e *mockTrendingRanker_Expecter) RankTrendingArticles(ctx interface{}, scores interface{}, size interface{}) *mockTrendingRanker_RankTrendingArticles_Call {
+	return &mockTrendingRanker_RankTrendingArticles_Call{Call: _e.mock.On("RankTrendingArticles", ctx, scores, size)}
+}
+
+func (_c *mockTrendingRanker_RankTrendingArticles_Call) Run(run func(ctx context.Context, scores []*models.ArticleScore, size int64)) *mockTrendingRanker_RankTrendingArticles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]*models.ArticleScore), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *mockTrendingRanker_RankTrendingArticles_Call) Return(_a0 []*models.TrendingArticle, _a1 error) *mockTrendingRanker_RankTrendingArticles_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *mockTrendingRanker_RankTrendingArticles_Call) RunAndReturn(run func(context.Context, []*models.ArticleScore, int64) ([]*models.TrendingArticle, error)) *mockTrendingRanker_RankTrendingArticles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockTrendingRanker creates a new instance of mockTrendingRanker. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockTrendingRanker(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockTrendingRanker {
+	mock := &mockTrendingRanker{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,85 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package services
+
+import (
+	context "context"
+
+	models "github.com/ravilock/goduit/internal/articlePublisher/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockTrendingReplacer is an autogenerated mock type for the trendingReplacer type
+type mockTrendingReplacer struct {
+	mock.Mock
+}
+
+type mockTrendingReplacer_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockTrendingReplacer) EXPECT() *mockTrendingReplacer_Expecter {
+	return &mockTrendingReplacer_Expecter{mock: &_m.Mock}
+}
+
+// ReplaceTrendingArticles provides a mock function with given fields: ctx, window, articles
+func (_m *mockTrendingReplacer) ReplaceTrendingArticles(ctx context.Context, window string, articles []*models.TrendingArticle) error {
+	ret := _m.Called(ctx, window, articles)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplaceTrendingArticles")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []*models.TrendingArticle) error); ok {
+		r0 = rf(ctx, window, articles)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// mockTrendingReplacer_ReplaceTrendingArticles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplaceTrendingArticles'
+type mockTrendingReplacer_ReplaceTrendingArticles_Call struct {
+	*mock.Call
+}
+
+// ReplaceTrendingArticles is a helper method to define mock.On call
+//   - ctx context.Context
+//   - window string
+//   - articles []*models.TrendingArticle
+func (_e *mockTrendingReplacer_Expecter) ReplaceTrendingArticles(ctx interface{}, window interface{}, articles interface{}) *mockTrendingReplacer_ReplaceTrendingArticles_Call {
+	return &mockTrendingReplacer_ReplaceTrendingArticles_Call{Call: _e.mock.On("ReplaceTrendingArticles", ctx, window, articles)}
+}
+
+func (_c *mockTrendingReplacer_ReplaceTrendingArticles_Call) Run(run func(ctx context.Context, window string, articles []*models.TrendingArticle)) *mockTrendingReplacer_ReplaceTrendingArticles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]*models.TrendingArticle))
+	})
+	return _c
+}
+
+func (_c *mockTrendingReplacer_ReplaceTrendingArticles_Call) Return(_a0 error) *mockTrendingReplacer_ReplaceTrendingArticles_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *mockTrendingReplacer_ReplaceTrendingArticles_Call) RunAndReturn(run func(context.Context, string, []*models.TrendingArticle) error) *mockTrendingReplacer_ReplaceTrendingArticles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockTrendingReplacer creates a new instance of mockTrendingReplacer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockTrendingReplacer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockTrendingReplacer {
+	mock := &mockTrendingReplacer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
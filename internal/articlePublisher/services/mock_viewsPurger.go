@@ -0,0 +1,83 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package services
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockViewsPurger is an autogenerated mock type for the viewsPurger type
+type mockViewsPurger struct {
+	mock.Mock
+}
+
+type mockViewsPurger_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockViewsPurger) EXPECT() *mockViewsPurger_Expecter {
+	return &mockViewsPurger_Expecter{mock: &_m.Mock}
+}
+
+// DeleteArticleViews provides a mock function with given fields: ctx, article
+func (_m *mockViewsPurger) DeleteArticleViews(ctx context.Context, article string) error {
+	ret := _m.Called(ctx, article)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteArticleViews")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, article)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// mockViewsPurger_DeleteArticleViews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteArticleViews'
+type mockViewsPurger_DeleteArticleViews_Call struct {
+	*mock.Call
+}
+
+// DeleteArticleViews is a helper method to define mock.On call
+//   - ctx context.Context
+//   - article string
+func (_e *mockViewsPurger_Expecter) DeleteArticleViews(ctx interface{}, article interface{}) *mockViewsPurger_DeleteArticleViews_Call {
+	return &mockViewsPurger_DeleteArticleViews_Call{Call: _e.mock.On("DeleteArticleViews", ctx, article)}
+}
+
+func (_c *mockViewsPurger_DeleteArticleViews_Call) Run(run func(ctx context.Context, article string)) *mockViewsPurger_DeleteArticleViews_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *mockViewsPurger_DeleteArticleViews_Call) Return(_a0 error) *mockViewsPurger_DeleteArticleViews_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *mockViewsPurger_DeleteArticleViews_Call) RunAndReturn(run func(context.Context, string) error) *mockViewsPurger_DeleteArticleViews_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockViewsPurger creates a new instance of mockViewsPurger. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockViewsPurger(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockViewsPurger {
+	mock := &mockViewsPurger{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,100 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package services
+
+import (
+	context "context"
+
+	models "github.com/ravilock/goduit/internal/articlePublisher/models"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// mockViewsScorer is an autogenerated mock type for the viewsScorer type
+type mockViewsScorer struct {
+	mock.Mock
+}
+
+type mockViewsScorer_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockViewsScorer) EXPECT() *mockViewsScorer_Expecter {
+	return &mockViewsScorer_Expecter{mock: &_m.Mock}
+}
+
+// ScoreViews provides a mock function with given fields: ctx, since, now, halfLife
+func (_m *mockViewsScorer) ScoreViews(ctx context.Context, since time.Time, now time.Time, halfLife time.Duration) ([]*models.ArticleScore, error) {
+	ret := _m.Called(ctx, since, now, halfLife)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ScoreViews")
+	}
+
+	var r0 []*models.ArticleScore
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time, time.Duration) ([]*models.ArticleScore, error)); ok {
+		return rf(ctx, since, now, halfLife)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time, time.Duration) []*models.ArticleScore); ok {
+		r0 = rf(ctx, since, now, halfLife)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.ArticleScore)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, time.Time, time.Duration) error); ok {
+		r1 = rf(ctx, since, now, halfLife)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockViewsScorer_ScoreViews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ScoreViews'
+type mockViewsScorer_ScoreViews_Call struct {
+	*mock.Call
+}
+
+// ScoreViews is a helper method to define mock.On call
+//   - ctx context.Context
+//   - since time.Time
+//   - now time.Time
+//   - halfLife time.Duration
+func (_e *mockViewsScorer_Expecter) ScoreViews(ctx interface{}, since interface{}, now interface{}, halfLife interface{}) *mockViewsScorer_ScoreViews_Call {
+	return &mockViewsScorer_ScoreViews_Call{Call: _e.mock.On("ScoreViews", ctx, since, now, halfLife)}
+}
+
+func (_c *mockViewsScorer_ScoreViews_Call) Run(run func(ctx context.Context, since time.Time, now time.Time, halfLife time.Duration)) *mockViewsScorer_ScoreViews_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(time.Time), args[3].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *mockViewsScorer_ScoreViews_Call) Return(_a0 []*models.ArticleScore, _a1 error) *mockViewsScorer_ScoreViews_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *mockViewsScorer_ScoreViews_Call) RunAndReturn(run func(context.Context, time.Time, time.Time, time.Duration) ([]*models.ArticleScore, error)) *mockViewsScorer_ScoreViews_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockViewsScorer creates a new instance of mockViewsScorer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockViewsScorer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockViewsScorer {
+	mock := &mockViewsScorer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
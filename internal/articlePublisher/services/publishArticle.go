@@ -0,0 +1,66 @@
+package services
+
+import (
+	"context"
+
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+)
+
+type draftPublisher interface {
+	PublishArticle(ctx context.Context, ID string) (*models.Article, error)
+	fanoutCompleter
+}
+
+type fanoutCompleter interface {
+	CompleteFanout(ctx context.Context, ID string) error
+}
+
+type PublishArticleService struct {
+	repository draftPublisher
+	tags       tagCounter
+	queue      articlePublisher
+}
+
+func NewPublishArticleService(repository draftPublisher, tags tagCounter, queue articlePublisher) *PublishArticleService {
+	return &PublishArticleService{
+		repository: repository,
+		tags:       tags,
+		queue:      queue,
+	}
+}
+
+// PublishArticle publishes a draft and enqueues it to be delivered to the author's followers.
+//
+// The ID parameter represents the ID of the draft being published.
+func (s *PublishArticleService) PublishArticle(ctx context.Context, ID string) (*models.Article, error) {
+	article, err := s.repository.PublishArticle(ctx, ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.tags.UpdateTagCounts(ctx, article.TagList, 1); err != nil {
+		return nil, err
+	}
+	if err := s.FanoutArticle(ctx, article); err != nil {
+		return nil, err
+	}
+	return article, nil
+}
+
+// FanoutArticle enqueues a published article to be delivered to the author's followers.
+//
+// Published articles stay pending fan-out until they are enqueued, so the ones that fail here are retried by the
+// article scheduler.
+func (s *PublishArticleService) FanoutArticle(ctx context.Context, article *models.Article) error {
+	return fanoutArticle(ctx, s.repository, s.queue, article)
+}
+
+func fanoutArticle(ctx context.Context, repository fanoutCompleter, queue articlePublisher, article *models.Article) error {
+	if err := queue.PublishArticle(ctx, article); err != nil {
+		return err
+	}
+	if err := repository.CompleteFanout(ctx, article.ID.Hex()); err != nil {
+		return err
+	}
+	article.PendingFanout = nil
+	return nil
+}
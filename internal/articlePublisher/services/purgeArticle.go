@@ -0,0 +1,107 @@
+package services
+
+import (
+	"context"
+)
+
+type articlePurger interface {
+	DeleteArticle(ctx context.Context, ID string) error
+}
+
+type commentsPurger interface {
+	DeleteArticleComments(ctx context.Context, article string) error
+}
+
+type feedsPurger interface {
+	RemoveArticleFromFeeds(ctx context.Context, article string) error
+}
+
+type favoritesPurger interface {
+	DeleteArticleFavorites(ctx context.Context, article string) error
+}
+
+type revisionsPurger interface {
+	DeleteRevisions(ctx context.Context, article string) error
+}
+
+type slugsReleaser interface {
+	ReleaseArticleSlugs(ctx context.Context, article string) error
+}
+
+type invitationsPurger interface {
+	DeleteArticleInvitations(ctx context.Context, article string) error
+}
+
+type seriesPurger interface {
+	RemoveArticleFromSeries(ctx context.Context, article string) error
+}
+
+type viewsPurger interface {
+	DeleteArticleViews(ctx context.Context, article string) error
+}
+
+type PurgeArticleService struct {
+	repository  articlePurger
+	comments    commentsPurger
+	feeds       feedsPurger
+	favorites   favoritesPurger
+	revisions   revisionsPurger
+	slugs       slugsReleaser
+	invitations invitationsPurger
+	series      seriesPurger
+	views       viewsPurger
+}
+
+func NewPurgeArticleService(
+	repository articlePurger,
+	comments commentsPurger,
+	feeds feedsPurger,
+	favorites favoritesPurger,
+	revisions revisionsPurger,
+	slugs slugsReleaser,
+	invitations invitationsPurger,
+	series seriesPurger,
+	views viewsPurger,
+) *PurgeArticleService {
+	return &PurgeArticleService{
+		repository:  repository,
+		comments:    comments,
+		feeds:       feeds,
+		favorites:   favorites,
+		revisions:   revisions,
+		slugs:       slugs,
+		invitations: invitations,
+		series:      series,
+		views:       views,
+	}
+}
+
+// PurgeArticle permanently deletes a trashed article along with everything that refers to it.
+// The article itself is deleted last, so a purge interrupted midway is completed by the next one.
+func (s *PurgeArticleService) PurgeArticle(ctx context.Context, ID string) error {
+	if err := s.comments.DeleteArticleComments(ctx, ID); err != nil {
+		return err
+	}
+	if err := s.feeds.RemoveArticleFromFeeds(ctx, ID); err != nil {
+		return err
+	}
+	if err := s.favorites.DeleteArticleFavorites(ctx, ID); err != nil {
+		return err
+	}
+	if err := s.revisions.DeleteRevisions(ctx, ID); err != nil {
+		return err
+	}
+	if err := s.slugs.ReleaseArticleSlugs(ctx, ID); err != nil {
+		return err
+	}
+	if err := s.invitations.DeleteArticleInvitations(ctx, ID); err != nil {
+		return err
+	}
+	if err := s.series.RemoveArticleFromSeries(ctx, ID); err != nil {
+		return err
+	}
+	if err := s.views.DeleteArticleViews(ctx, ID); err != nil {
+		return err
+	}
+	return s.repository.DeleteArticle(ctx, ID)
+}
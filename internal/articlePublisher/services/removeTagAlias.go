@@ -0,0 +1,26 @@
+package services
+
+import (
+	"context"
+
+	"github.com/ravilock/goduit/internal/slugger"
+)
+
+type tagAliasRemover interface {
+	RemoveTagAlias(ctx context.Context, tag, alias string) error
+}
+
+type RemoveTagAliasService struct {
+	repository tagAliasRemover
+}
+
+func NewRemoveTagAliasService(repository tagAliasRemover) *RemoveTagAliasService {
+	return &RemoveTagAliasService{
+		repository: repository,
+	}
+}
+
+// RemoveTagAlias stops an alias from resolving to its canonical tag. Articles merged into the tag keep it.
+func (s *RemoveTagAliasService) RemoveTagAlias(ctx context.Context, tag, alias string) error {
+	return s.repository.RemoveTagAlias(ctx, slugger.Tag(tag), slugger.Tag(alias))
+}
@@ -0,0 +1,19 @@
+package services
+
+import (
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+	"github.com/ravilock/goduit/internal/markdown"
+)
+
+// renderBody caches the HTML rendering of the article's body on the article itself, so it is only rebuilt when the body changes.
+func renderBody(article *models.Article) error {
+	if article.Body == nil {
+		return nil
+	}
+	bodyHTML, err := markdown.Render(*article.Body)
+	if err != nil {
+		return err
+	}
+	article.BodyHTML = &bodyHTML
+	return nil
+}
@@ -0,0 +1,25 @@
+package services
+
+import (
+	"context"
+
+	"github.com/ravilock/goduit/internal/slugger"
+)
+
+type tagResolver interface {
+	ResolveTags(ctx context.Context, tags []string) ([]string, error)
+}
+
+// resolveTag folds a tag used to look articles up into its canonical form, following its alias if it has one.
+// Tags with no canonical form are kept as they are, so they match nothing rather than everything.
+func resolveTag(ctx context.Context, tags tagResolver, tag string) (string, error) {
+	canonicalTag := slugger.Tag(tag)
+	if canonicalTag == "" {
+		return tag, nil
+	}
+	resolvedTags, err := tags.ResolveTags(ctx, []string{canonicalTag})
+	if err != nil {
+		return "", err
+	}
+	return resolvedTags[0], nil
+}
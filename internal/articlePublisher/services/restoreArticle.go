@@ -0,0 +1,39 @@
+package services
+
+import (
+	"context"
+
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+)
+
+type articleRestorer interface {
+	RestoreArticle(ctx context.Context, ID string) (*models.Article, error)
+}
+
+type RestoreArticleService struct {
+	repository articleRestorer
+	tags       tagCounter
+}
+
+func NewRestoreArticleService(repository articleRestorer, tags tagCounter) *RestoreArticleService {
+	return &RestoreArticleService{
+		repository: repository,
+		tags:       tags,
+	}
+}
+
+// RestoreArticle takes an article out of the trash. Its comments and feed entries were kept while it was trashed,
+// so only the tag counts need to be brought back.
+func (s *RestoreArticleService) RestoreArticle(ctx context.Context, ID string) (*models.Article, error) {
+	article, err := s.repository.RestoreArticle(ctx, ID)
+	if err != nil {
+		return nil, err
+	}
+	if !article.IsPublished() {
+		return article, nil
+	}
+	if err := s.tags.UpdateTagCounts(ctx, article.TagList, 1); err != nil {
+		return nil, err
+	}
+	return article, nil
+}
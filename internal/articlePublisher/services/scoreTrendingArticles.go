@@ -0,0 +1,105 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+)
+
+// Weights of each kind of activity in an article's trending score.
+const (
+	trendingViewWeight     = 1
+	trendingCommentWeight  = 3
+	trendingFavoriteWeight = 5
+)
+
+type viewsScorer interface {
+	ScoreViews(ctx context.Context, since, now time.Time, halfLife time.Duration) ([]*models.ArticleScore, error)
+}
+
+type favoritesScorer interface {
+	ScoreFavorites(ctx context.Context, since, now time.Time, halfLife time.Duration) ([]*models.ArticleScore, error)
+}
+
+type commentsScorer interface {
+	ScoreComments(ctx context.Context, since, now time.Time, halfLife time.Duration) ([]*models.ArticleScore, error)
+}
+
+type trendingRanker interface {
+	RankTrendingArticles(ctx context.Context, scores []*models.ArticleScore, size int64) ([]*models.TrendingArticle, error)
+}
+
+type trendingReplacer interface {
+	ReplaceTrendingArticles(ctx context.Context, window string, articles []*models.TrendingArticle) error
+}
+
+type ScoreTrendingArticlesService struct {
+	repository trendingRanker
+	views      viewsScorer
+	favorites  favoritesScorer
+	comments   commentsScorer
+	trending   trendingReplacer
+	size       int
+}
+
+func NewScoreTrendingArticlesService(
+	repository trendingRanker,
+	views viewsScorer,
+	favorites favoritesScorer,
+	comments commentsScorer,
+	trending trendingReplacer,
+	size int,
+) *ScoreTrendingArticlesService {
+	return &ScoreTrendingArticlesService{
+		repository: repository,
+		views:      views,
+		favorites:  favorites,
+		comments:   comments,
+		trending:   trending,
+		size:       size,
+	}
+}
+
+// ScoreTrendingArticles recomputes which articles trend within a window.
+//
+// Each view, comment and favorite in the window adds to its article's score, weighing half as much every half-life.
+// The highest scored published articles are kept, both overall and within each tag, replacing the window's previous
+// trending articles; an article is kept if it ranks high enough in any of those rankings.
+func (s *ScoreTrendingArticlesService) ScoreTrendingArticles(ctx context.Context, window models.TrendingWindow, now time.Time) error {
+	since := now.Add(-window.Length)
+	views, err := s.views.ScoreViews(ctx, since, now, window.HalfLife)
+	if err != nil {
+		return err
+	}
+	favorites, err := s.favorites.ScoreFavorites(ctx, since, now, window.HalfLife)
+	if err != nil {
+		return err
+	}
+	comments, err := s.comments.ScoreComments(ctx, since, now, window.HalfLife)
+	if err != nil {
+		return err
+	}
+
+	scores := map[string]float64{}
+	addScores(scores, views, trendingViewWeight)
+	addScores(scores, favorites, trendingFavoriteWeight)
+	addScores(scores, comments, trendingCommentWeight)
+
+	ranking := make([]*models.ArticleScore, 0, len(scores))
+	for article, score := range scores {
+		ranking = append(ranking, &models.ArticleScore{Article: article, Score: score})
+	}
+	trending, err := s.repository.RankTrendingArticles(ctx, ranking, int64(s.size))
+	if err != nil {
+		return err
+	}
+
+	return s.trending.ReplaceTrendingArticles(ctx, window.Name, trending)
+}
+
+func addScores(scores map[string]float64, articleScores []*models.ArticleScore, weight float64) {
+	for _, articleScore := range articleScores {
+		scores[articleScore.Article] += articleScore.Score * weight
+	}
+}
@@ -0,0 +1,34 @@
+package services
+
+import (
+	"context"
+
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+)
+
+type articleSearcher interface {
+	SearchArticles(ctx context.Context, query, author, tag string, limit, offset int64) ([]*models.Article, error)
+}
+
+type SearchArticlesService struct {
+	repository articleSearcher
+	tags       tagResolver
+}
+
+func NewSearchArticlesService(repository articleSearcher, tags tagResolver) *SearchArticlesService {
+	return &SearchArticlesService{
+		repository: repository,
+		tags:       tags,
+	}
+}
+
+func (s *SearchArticlesService) SearchArticles(ctx context.Context, query, author, tag string, limit, offset int64) ([]*models.Article, error) {
+	if tag != "" {
+		resolvedTag, err := resolveTag(ctx, s.tags, tag)
+		if err != nil {
+			return nil, err
+		}
+		tag = resolvedTag
+	}
+	return s.repository.SearchArticles(ctx, query, author, tag, limit, offset)
+}
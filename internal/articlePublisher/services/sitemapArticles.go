@@ -0,0 +1,33 @@
+package services
+
+import (
+	"context"
+
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+)
+
+type sitemapArticlesLister interface {
+	CountPublishedArticles(ctx context.Context) (int64, error)
+	ListSitemapArticles(ctx context.Context, limit, offset int64) ([]*models.Article, error)
+}
+
+type SitemapArticlesService struct {
+	repository sitemapArticlesLister
+}
+
+func NewSitemapArticlesService(repository sitemapArticlesLister) *SitemapArticlesService {
+	return &SitemapArticlesService{
+		repository: repository,
+	}
+}
+
+// CountSitemapArticles counts the articles listed in the sitemap, which are the published ones.
+func (s *SitemapArticlesService) CountSitemapArticles(ctx context.Context) (int64, error) {
+	return s.repository.CountPublishedArticles(ctx)
+}
+
+// ListSitemapArticles lists published articles oldest first, so the pages of the sitemap stay stable as articles are published.
+// Listed articles only carry their slugs and dates.
+func (s *SitemapArticlesService) ListSitemapArticles(ctx context.Context, limit, offset int64) ([]*models.Article, error) {
+	return s.repository.ListSitemapArticles(ctx, limit, offset)
+}
@@ -0,0 +1,21 @@
+package services
+
+import (
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+	"github.com/ravilock/goduit/internal/markdown"
+	"github.com/ravilock/goduit/internal/textstats"
+)
+
+// summarizeBody stores the word count, reading time and excerpt of the article's body on the article itself.
+func summarizeBody(article *models.Article) {
+	if article.Body == nil {
+		return
+	}
+	text := markdown.PlainText(*article.Body)
+	wordCount := int64(textstats.CountWords(text))
+	readingTime := int64(textstats.ReadingTime(int(wordCount)))
+	excerpt := textstats.Excerpt(text, textstats.ExcerptLength)
+	article.WordCount = &wordCount
+	article.ReadingTime = &readingTime
+	article.Excerpt = &excerpt
+}
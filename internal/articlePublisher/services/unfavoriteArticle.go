@@ -0,0 +1,37 @@
+package services
+
+import (
+	"context"
+
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+)
+
+type UnfavoriteArticleService struct {
+	repository        articleFavoriter
+	articleRepository favoritesCounter
+}
+
+func NewUnfavoriteArticleService(repository articleFavoriter, articleRepository favoritesCounter) *UnfavoriteArticleService {
+	return &UnfavoriteArticleService{
+		repository:        repository,
+		articleRepository: articleRepository,
+	}
+}
+
+// UnfavoriteArticle removes a user's favorite from an article and decrements its favorites count. Returns the updated *models.Article.
+//
+// The article parameter represents the ID of the favorited article.
+//
+// The user parameter represents the ID of the user that favorited it.
+func (s *UnfavoriteArticleService) UnfavoriteArticle(ctx context.Context, article, user string) (*models.Article, error) {
+	if err := s.repository.Unfavorite(ctx, article, user); err != nil {
+		return nil, err
+	}
+	updatedArticle, err := s.articleRepository.UpdateFavoritesCount(ctx, article, -1)
+	if err != nil {
+		// The count was not updated, so the favorite must be restored
+		_ = s.repository.Favorite(ctx, article, user)
+		return nil, err
+	}
+	return updatedArticle, nil
+}
@@ -0,0 +1,54 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ravilock/goduit/internal/app"
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+	"github.com/ravilock/goduit/internal/slugger"
+)
+
+type slugReserver interface {
+	ReserveSlug(ctx context.Context, slug, article string) (bool, error)
+	ReleaseSlug(ctx context.Context, slug, article string) error
+}
+
+// slugAttempts bounds how many slugs are tried before a conflict is reported back to the caller.
+const slugAttempts = 5
+
+// withUniqueSlug runs write with the given slug, retrying with a suffixed slug while the slug is already taken.
+// Slugs too short to be routed are suffixed from the start.
+func withUniqueSlug(slug *string, write func() error) error {
+	base := *slug
+	if len(base) < slugger.MinLength {
+		*slug = slugger.WithSuffix(base)
+	}
+	for attempt := 1; ; attempt++ {
+		err := write()
+		if appError := new(app.AppError); attempt < slugAttempts && errors.As(err, &appError) && appError.ErrorCode == app.ConflictErrorCode {
+			*slug = slugger.WithSuffix(base)
+			continue
+		}
+		return err
+	}
+}
+
+// reserveSlug reserves the article's slug before running write, so that slugs stay reserved after the article moves
+// away from them. If write fails, the reservation is released, unless the article already held the slug before.
+func reserveSlug(ctx context.Context, slugs slugReserver, articleID string, article *models.Article, write func() error) error {
+	created, err := slugs.ReserveSlug(ctx, *article.Slug, articleID)
+	if err != nil {
+		return err
+	}
+	if err := write(); err != nil {
+		if !created {
+			return err
+		}
+		if releaseErr := slugs.ReleaseSlug(ctx, *article.Slug, articleID); releaseErr != nil {
+			return errors.Join(err, releaseErr)
+		}
+		return err
+	}
+	return nil
+}
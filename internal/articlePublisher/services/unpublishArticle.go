@@ -1,21 +1,39 @@
 package services
 
-import "context"
+import (
+	"context"
 
-type articleDeleter interface {
-	DeleteArticle(ctx context.Context, slug string) error
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+)
+
+type articleTrasher interface {
+	GetArticleBySlug(ctx context.Context, slug string) (*models.Article, error)
+	TrashArticle(ctx context.Context, slug string) error
 }
 
 type UnpublishArticleService struct {
-	repository articleDeleter
+	repository articleTrasher
+	tags       tagCounter
 }
 
-func NewUnpublishArticleService(repository articleDeleter) *UnpublishArticleService {
+func NewUnpublishArticleService(repository articleTrasher, tags tagCounter) *UnpublishArticleService {
 	return &UnpublishArticleService{
 		repository: repository,
+		tags:       tags,
 	}
 }
 
+// UnpublishArticle moves an article to the trash, from where its author can restore it until it is purged.
 func (s *UnpublishArticleService) UnpublishArticle(ctx context.Context, slug string) error {
-	return s.repository.DeleteArticle(ctx, slug)
+	article, err := s.repository.GetArticleBySlug(ctx, slug)
+	if err != nil {
+		return err
+	}
+	if err := s.repository.TrashArticle(ctx, slug); err != nil {
+		return err
+	}
+	if !article.IsPublished() {
+		return nil
+	}
+	return s.tags.UpdateTagCounts(ctx, article.TagList, -1)
 }
@@ -2,24 +2,138 @@ package services
 
 import (
 	"context"
+	"slices"
 
 	"github.com/ravilock/goduit/internal/articlePublisher/models"
+	"github.com/ravilock/goduit/internal/slugger"
 )
 
 type articleUpdater interface {
+	GetArticleBySlug(ctx context.Context, slug string) (*models.Article, error)
 	UpdateArticle(ctx context.Context, slug string, article *models.Article) error
 }
 
+type revisionRecorder interface {
+	WriteRevision(ctx context.Context, revision *models.Revision) error
+	HasRevisions(ctx context.Context, article string) (bool, error)
+}
+
 type UpdateArticleService struct {
 	repository articleUpdater
+	slugs      slugReserver
+	revisions  revisionRecorder
+	tags       tagCanonicalizer
 }
 
-func NewUpdateArticleService(repository articleUpdater) *UpdateArticleService {
+func NewUpdateArticleService(repository articleUpdater, slugs slugReserver, revisions revisionRecorder, tags tagCanonicalizer) *UpdateArticleService {
 	return &UpdateArticleService{
 		repository: repository,
+		slugs:      slugs,
+		revisions:  revisions,
+		tags:       tags,
+	}
+}
+
+func (s *UpdateArticleService) UpdateArticle(ctx context.Context, slug, editor string, article *models.Article) error {
+	return s.updateArticle(ctx, slug, editor, article, nil)
+}
+
+// RestoreRevision brings an article's content back to the one stored in an older revision, recording the restoration as a new revision.
+func (s *UpdateArticleService) RestoreRevision(ctx context.Context, slug, editor string, revision *models.Revision) (*models.Article, error) {
+	article := &models.Article{
+		Slug:        revision.Slug,
+		Title:       revision.Title,
+		Description: revision.Description,
+		Body:        revision.Body,
+		TagList:     revision.TagList,
+	}
+	if err := s.updateArticle(ctx, slug, editor, article, revision.Number); err != nil {
+		return nil, err
+	}
+	return article, nil
+}
+
+func (s *UpdateArticleService) updateArticle(ctx context.Context, slug, editor string, article *models.Article, restoredFrom *int64) error {
+	currentArticle, err := s.repository.GetArticleBySlug(ctx, slug)
+	if err != nil {
+		return err
+	}
+	// Articles written before revisions existed get their current content recorded first, so it can be restored later
+	hasRevisions, err := s.revisions.HasRevisions(ctx, currentArticle.ID.Hex())
+	if err != nil {
+		return err
+	}
+	if !hasRevisions {
+		baseline := models.NewRevision(currentArticle, *currentArticle.Author)
+		baseline.CreatedAt = currentArticle.UpdatedAt
+		if baseline.CreatedAt == nil {
+			baseline.CreatedAt = currentArticle.CreatedAt
+		}
+		if err := s.revisions.WriteRevision(ctx, baseline); err != nil {
+			return err
+		}
 	}
+	// Keep the current slug when the title still leads to it, rather than suffixing it again
+	if article.Slug != nil && slugger.Matches(*currentArticle.Slug, *article.Slug) {
+		article.Slug = currentArticle.Slug
+	}
+	tagsChanged := article.TagList != nil
+	if tagsChanged {
+		resolvedTags, err := s.tags.ResolveTags(ctx, article.TagList)
+		if err != nil {
+			return err
+		}
+		article.TagList = resolvedTags
+	}
+	if err := renderBody(article); err != nil {
+		return err
+	}
+	summarizeBody(article)
+	if err := s.updateSlug(ctx, currentArticle, article); err != nil {
+		return err
+	}
+	revision := models.NewRevision(article, editor)
+	revision.RestoredFrom = restoredFrom
+	if err := s.revisions.WriteRevision(ctx, revision); err != nil {
+		return err
+	}
+	if !tagsChanged || !currentArticle.IsPublished() {
+		return nil
+	}
+	addedTags, removedTags := diffTags(currentArticle.TagList, article.TagList)
+	if err := s.tags.UpdateTagCounts(ctx, addedTags, 1); err != nil {
+		return err
+	}
+	return s.tags.UpdateTagCounts(ctx, removedTags, -1)
 }
 
-func (s *UpdateArticleService) UpdateArticle(ctx context.Context, slug string, article *models.Article) error {
-	return s.repository.UpdateArticle(ctx, slug, article)
+// updateSlug updates the article, reserving its new slug if it changes. The current slug stays reserved, including for
+// articles written before slugs were reserved, so links to it keep resolving to the article.
+func (s *UpdateArticleService) updateSlug(ctx context.Context, currentArticle, article *models.Article) error {
+	articleID := currentArticle.ID.Hex()
+	if _, err := s.slugs.ReserveSlug(ctx, *currentArticle.Slug, articleID); err != nil {
+		return err
+	}
+	if article.Slug == nil || *article.Slug == *currentArticle.Slug {
+		return s.repository.UpdateArticle(ctx, *currentArticle.Slug, article)
+	}
+	return withUniqueSlug(article.Slug, func() error {
+		return reserveSlug(ctx, s.slugs, articleID, article, func() error {
+			return s.repository.UpdateArticle(ctx, *currentArticle.Slug, article)
+		})
+	})
+}
+
+func diffTags(previousTags, currentTags []string) (addedTags, removedTags []string) {
+	for _, tag := range currentTags {
+		if !slices.Contains(previousTags, tag) {
+			addedTags = append(addedTags, tag)
+		}
+	}
+	for _, tag := range previousTags {
+		if !slices.Contains(currentTags, tag) {
+			removedTags = append(removedTags, tag)
+		}
+	}
+	return addedTags, removedTags
 }
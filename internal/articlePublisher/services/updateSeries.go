@@ -0,0 +1,42 @@
+package services
+
+import (
+	"context"
+
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+)
+
+type seriesUpdater interface {
+	UpdateSeries(ctx context.Context, ID string, series *models.Series) (*models.Series, error)
+	seriesByArticleGetter
+}
+
+type UpdateSeriesService struct {
+	repository seriesUpdater
+}
+
+func NewUpdateSeriesService(repository seriesUpdater) *UpdateSeriesService {
+	return &UpdateSeriesService{
+		repository: repository,
+	}
+}
+
+// UpdateSeries updates a series. Series get a new slug when their title changes, and their articles are replaced
+// by the given ones when there are any. Returns the updated *models.Series.
+func (s *UpdateSeriesService) UpdateSeries(ctx context.Context, currentSeries, series *models.Series) (*models.Series, error) {
+	seriesID := currentSeries.ID.Hex()
+	if err := checkSeriesArticles(ctx, s.repository, seriesID, series.Articles); err != nil {
+		return nil, err
+	}
+	if series.Slug == nil || *series.Slug == *currentSeries.Slug {
+		series.Slug = nil
+		return s.repository.UpdateSeries(ctx, seriesID, series)
+	}
+	var updatedSeries *models.Series
+	err := withUniqueSlug(series.Slug, func() error {
+		var err error
+		updatedSeries, err = s.repository.UpdateSeries(ctx, seriesID, series)
+		return err
+	})
+	return updatedSeries, err
+}
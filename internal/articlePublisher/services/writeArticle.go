@@ -4,31 +4,81 @@ import (
 	"context"
 
 	"github.com/ravilock/goduit/internal/articlePublisher/models"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
 type articleWriter interface {
 	WriteArticle(ctx context.Context, article *models.Article) error
+	fanoutCompleter
 }
 
 type articlePublisher interface {
 	PublishArticle(ctx context.Context, article *models.Article) error
 }
 
+type revisionWriter interface {
+	WriteRevision(ctx context.Context, revision *models.Revision) error
+}
+
+type tagCounter interface {
+	UpdateTagCounts(ctx context.Context, tags []string, delta int64) error
+}
+
+type tagCanonicalizer interface {
+	tagCounter
+	tagResolver
+}
+
 type WriteArticleService struct {
 	repository articleWriter
+	slugs      slugReserver
+	revisions  revisionWriter
+	tags       tagCanonicalizer
 	queue      articlePublisher
 }
 
-func NewWriteArticleService(repository articleWriter, queue articlePublisher) *WriteArticleService {
+func NewWriteArticleService(repository articleWriter, slugs slugReserver, revisions revisionWriter, tags tagCanonicalizer, queue articlePublisher) *WriteArticleService {
 	return &WriteArticleService{
 		repository: repository,
+		slugs:      slugs,
+		revisions:  revisions,
+		tags:       tags,
 		queue:      queue,
 	}
 }
 
 func (s *WriteArticleService) WriteArticle(ctx context.Context, article *models.Article) error {
-	if err := s.repository.WriteArticle(ctx, article); err != nil {
+	articleID := primitive.NewObjectID()
+	article.ID = &articleID
+	resolvedTags, err := s.tags.ResolveTags(ctx, article.TagList)
+	if err != nil {
+		return err
+	}
+	article.TagList = resolvedTags
+	if err := renderBody(article); err != nil {
+		return err
+	}
+	summarizeBody(article)
+	if article.IsPublished() {
+		pendingFanout := true
+		article.PendingFanout = &pendingFanout
+	}
+	err = withUniqueSlug(article.Slug, func() error {
+		return reserveSlug(ctx, s.slugs, articleID.Hex(), article, func() error {
+			return s.repository.WriteArticle(ctx, article)
+		})
+	})
+	if err != nil {
+		return err
+	}
+	if err := s.revisions.WriteRevision(ctx, models.NewRevision(article, *article.Author)); err != nil {
+		return err
+	}
+	if !article.IsPublished() {
+		return nil
+	}
+	if err := s.tags.UpdateTagCounts(ctx, article.TagList, 1); err != nil {
 		return err
 	}
-	return s.queue.PublishArticle(ctx, article)
+	return fanoutArticle(ctx, s.repository, s.queue, article)
 }
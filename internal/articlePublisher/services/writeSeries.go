@@ -0,0 +1,59 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ravilock/goduit/internal/app"
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type seriesWriter interface {
+	WriteSeries(ctx context.Context, series *models.Series) error
+	seriesByArticleGetter
+}
+
+type seriesByArticleGetter interface {
+	GetSeriesByArticle(ctx context.Context, article string) (*models.Series, error)
+}
+
+type WriteSeriesService struct {
+	repository seriesWriter
+}
+
+func NewWriteSeriesService(repository seriesWriter) *WriteSeriesService {
+	return &WriteSeriesService{
+		repository: repository,
+	}
+}
+
+// WriteSeries registers a new series, suffixing its slug if it is already taken.
+// Articles can only be part of one series.
+func (s *WriteSeriesService) WriteSeries(ctx context.Context, series *models.Series) error {
+	seriesID := primitive.NewObjectID()
+	series.ID = &seriesID
+	if err := checkSeriesArticles(ctx, s.repository, seriesID.Hex(), series.Articles); err != nil {
+		return err
+	}
+	return withUniqueSlug(series.Slug, func() error {
+		return s.repository.WriteSeries(ctx, series)
+	})
+}
+
+// checkSeriesArticles fails with a conflict if any of the articles already belongs to a series other than seriesID.
+func checkSeriesArticles(ctx context.Context, repository seriesByArticleGetter, seriesID string, articles []string) error {
+	for _, article := range articles {
+		series, err := repository.GetSeriesByArticle(ctx, article)
+		if err != nil {
+			if appError := new(app.AppError); errors.As(err, &appError) && appError.ErrorCode == app.SeriesNotFoundErrorCode {
+				continue
+			}
+			return err
+		}
+		if series.ID.Hex() != seriesID {
+			return app.ConflictError("series")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+package syndication
+
+import (
+	"encoding/xml"
+	"time"
+)
+
+const atomNamespace = "http://www.w3.org/2005/Atom"
+
+type atomFeed struct {
+	XMLName   xml.Name    `xml:"feed"`
+	Namespace string      `xml:"xmlns,attr"`
+	ID        string      `xml:"id"`
+	Title     string      `xml:"title"`
+	Subtitle  string      `xml:"subtitle,omitempty"`
+	Updated   string      `xml:"updated"`
+	Generator string      `xml:"generator"`
+	Links     []atomLink  `xml:"link"`
+	Entries   []atomEntry `xml:"entry"`
+}
+
+type atomLink struct {
+	Rel  string `xml:"rel,attr"`
+	Type string `xml:"type,attr,omitempty"`
+	Href string `xml:"href,attr"`
+}
+
+type atomEntry struct {
+	ID         string         `xml:"id"`
+	Title      string         `xml:"title"`
+	Link       atomLink       `xml:"link"`
+	Published  string         `xml:"published"`
+	Updated    string         `xml:"updated"`
+	Author     *atomAuthor    `xml:"author,omitempty"`
+	Categories []atomCategory `xml:"category"`
+	Summary    string         `xml:"summary,omitempty"`
+	Content    *atomContent   `xml:"content,omitempty"`
+}
+
+type atomAuthor struct {
+	Name string `xml:"name"`
+}
+
+type atomCategory struct {
+	Term string `xml:"term,attr"`
+}
+
+type atomContent struct {
+	Type  string `xml:"type,attr"`
+	Value string `xml:",chardata"`
+}
+
+func newAtomFeed(feed *Feed) *atomFeed {
+	document := &atomFeed{
+		Namespace: atomNamespace,
+		ID:        feed.Self,
+		Title:     feed.Title,
+		Subtitle:  feed.Description,
+		Updated:   atomTime(feed.Updated),
+		Generator: Generator,
+		Links: []atomLink{
+			{Rel: "self", Type: ContentType(FormatAtom), Href: feed.Self},
+			{Rel: "alternate", Type: "text/html", Href: feed.Link},
+		},
+		Entries: make([]atomEntry, 0, len(feed.Entries)),
+	}
+	for _, entry := range feed.Entries {
+		atomEntry := atomEntry{
+			ID:         entry.ID,
+			Title:      entry.Title,
+			Link:       atomLink{Rel: "alternate", Type: "text/html", Href: entry.Link},
+			Published:  atomTime(entry.Published),
+			Updated:    atomTime(entry.Updated),
+			Categories: make([]atomCategory, 0, len(entry.Categories)),
+			Summary:    entry.Summary,
+		}
+		if entry.Author != "" {
+			atomEntry.Author = &atomAuthor{Name: entry.Author}
+		}
+		for _, category := range entry.Categories {
+			atomEntry.Categories = append(atomEntry.Categories, atomCategory{Term: category})
+		}
+		if entry.ContentHTML != "" {
+			atomEntry.Content = &atomContent{Type: "html", Value: entry.ContentHTML}
+		}
+		document.Entries = append(document.Entries, atomEntry)
+	}
+	return document
+}
+
+func atomTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
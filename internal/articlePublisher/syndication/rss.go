@@ -0,0 +1,83 @@
+package syndication
+
+import (
+	"encoding/xml"
+	"time"
+)
+
+const (
+	contentNamespace    = "http://purl.org/rss/1.0/modules/content/"
+	dublinCoreNamespace = "http://purl.org/dc/elements/1.1/"
+)
+
+type rssFeed struct {
+	XMLName          xml.Name   `xml:"rss"`
+	Version          string     `xml:"version,attr"`
+	AtomNamespace    string     `xml:"xmlns:atom,attr"`
+	ContentNamespace string     `xml:"xmlns:content,attr"`
+	DCNamespace      string     `xml:"xmlns:dc,attr"`
+	Channel          rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string    `xml:"title"`
+	Link          string    `xml:"link"`
+	Description   string    `xml:"description"`
+	Self          atomLink  `xml:"atom:link"`
+	LastBuildDate string    `xml:"lastBuildDate"`
+	Generator     string    `xml:"generator"`
+	Items         []rssItem `xml:"item"`
+}
+
+type rssItem struct {
+	Title       string   `xml:"title"`
+	Link        string   `xml:"link"`
+	GUID        rssGUID  `xml:"guid"`
+	PubDate     string   `xml:"pubDate"`
+	Creator     string   `xml:"dc:creator,omitempty"`
+	Categories  []string `xml:"category"`
+	Description string   `xml:"description,omitempty"`
+	Content     string   `xml:"content:encoded,omitempty"`
+}
+
+type rssGUID struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+// newRSSFeed builds an RSS 2.0 channel. RSS has no notion of entries being updated, so items carry their publication
+// date and the channel's last build date is when any of them last changed.
+func newRSSFeed(feed *Feed) *rssFeed {
+	document := &rssFeed{
+		Version:          "2.0",
+		AtomNamespace:    atomNamespace,
+		ContentNamespace: contentNamespace,
+		DCNamespace:      dublinCoreNamespace,
+		Channel: rssChannel{
+			Title:         feed.Title,
+			Link:          feed.Link,
+			Description:   feed.Description,
+			Self:          atomLink{Rel: "self", Type: ContentType(FormatRSS), Href: feed.Self},
+			LastBuildDate: rssTime(feed.Updated),
+			Generator:     Generator,
+			Items:         make([]rssItem, 0, len(feed.Entries)),
+		},
+	}
+	for _, entry := range feed.Entries {
+		document.Channel.Items = append(document.Channel.Items, rssItem{
+			Title:       entry.Title,
+			Link:        entry.Link,
+			GUID:        rssGUID{IsPermaLink: false, Value: entry.ID},
+			PubDate:     rssTime(entry.Published),
+			Creator:     entry.Author,
+			Categories:  entry.Categories,
+			Description: entry.Summary,
+			Content:     entry.ContentHTML,
+		})
+	}
+	return document
+}
+
+func rssTime(t time.Time) string {
+	return t.UTC().Format(time.RFC1123Z)
+}
@@ -0,0 +1,70 @@
+package syndication
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"time"
+)
+
+const (
+	FormatAtom = "atom"
+	FormatRSS  = "rss"
+)
+
+// Generator names the software feeds are produced by.
+const Generator = "goduit"
+
+// ContentType is the media type feeds of a format are served with.
+func ContentType(format string) string {
+	if format == FormatRSS {
+		return "application/rss+xml; charset=utf-8"
+	}
+	return "application/atom+xml; charset=utf-8"
+}
+
+// Feed is a list of articles, independent of the format it is written in.
+type Feed struct {
+	Title       string
+	Description string
+	// Link is the page the feed mirrors, while Self is where the feed itself is served from and also identifies it.
+	Link    string
+	Self    string
+	Updated time.Time
+	Entries []Entry
+}
+
+type Entry struct {
+	// ID stays the same for as long as the article exists, even if its link changes.
+	ID          string
+	Title       string
+	Link        string
+	Summary     string
+	ContentHTML string
+	Author      string
+	Categories  []string
+	Published   time.Time
+	Updated     time.Time
+}
+
+// Write encodes a feed in a format, either Atom or RSS 2.0.
+func Write(w io.Writer, format string, feed *Feed) error {
+	var document any
+	switch format {
+	case FormatAtom:
+		document = newAtomFeed(feed)
+	case FormatRSS:
+		document = newRSSFeed(feed)
+	default:
+		return fmt.Errorf("unsupported feed format %q", format)
+	}
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(document); err != nil {
+		return err
+	}
+	return encoder.Close()
+}
@@ -0,0 +1,102 @@
+package syndication
+
+import (
+	"bytes"
+	"encoding/xml"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWrite(t *testing.T) {
+	published := time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)
+	updated := time.Date(2024, time.March, 2, 8, 30, 0, 0, time.FixedZone("BRT", -3*60*60))
+	feed := &Feed{
+		Title:       "Articles by jake",
+		Description: "I work at statefarm",
+		Link:        "http://localhost:5173/profile/jake",
+		Self:        "http://localhost:3000/api/profiles/jake/feed.atom",
+		Updated:     updated,
+		Entries: []Entry{{
+			ID:          "urn:goduit:article:65e1f0c2a1b2c3d4e5f60718",
+			Title:       "How to train your dragon",
+			Link:        "http://localhost:5173/article/how-to-train-your-dragon",
+			Summary:     "Ever wonder how?",
+			ContentHTML: "<p>It takes a <em>Jacobian</em> & patience</p>",
+			Author:      "jake",
+			Categories:  []string{"dragons", "training"},
+			Published:   published,
+			Updated:     updated,
+		}},
+	}
+
+	t.Run("Should write Atom feeds", func(t *testing.T) {
+		// Arrange
+		output := new(bytes.Buffer)
+
+		// Act
+		err := Write(output, FormatAtom, feed)
+
+		// Assert
+		require.NoError(t, err)
+		document := new(atomFeed)
+		err = xml.Unmarshal(output.Bytes(), document)
+		require.NoError(t, err)
+		require.Equal(t, atomNamespace, document.XMLName.Space)
+		require.Equal(t, feed.Self, document.ID)
+		require.Equal(t, feed.Title, document.Title)
+		require.Equal(t, "2024-03-02T11:30:00Z", document.Updated)
+		require.Equal(t, []atomLink{
+			{Rel: "self", Type: "application/atom+xml; charset=utf-8", Href: feed.Self},
+			{Rel: "alternate", Type: "text/html", Href: feed.Link},
+		}, document.Links)
+		require.Len(t, document.Entries, 1)
+		entry := document.Entries[0]
+		require.Equal(t, feed.Entries[0].ID, entry.ID)
+		require.Equal(t, feed.Entries[0].Link, entry.Link.Href)
+		require.Equal(t, "2024-03-01T12:00:00Z", entry.Published)
+		require.Equal(t, "2024-03-02T11:30:00Z", entry.Updated)
+		require.Equal(t, "jake", entry.Author.Name)
+		require.Equal(t, []atomCategory{{Term: "dragons"}, {Term: "training"}}, entry.Categories)
+		require.Equal(t, "html", entry.Content.Type)
+		require.Equal(t, feed.Entries[0].ContentHTML, entry.Content.Value)
+	})
+
+	t.Run("Should write RSS feeds", func(t *testing.T) {
+		// Arrange
+		output := new(bytes.Buffer)
+
+		// Act
+		err := Write(output, FormatRSS, feed)
+
+		// Assert
+		require.NoError(t, err)
+		require.Contains(t, output.String(), `<rss version="2.0" xmlns:atom="http://www.w3.org/2005/Atom"`)
+		require.Contains(t, output.String(), `<atom:link rel="self" type="application/rss+xml; charset=utf-8" href="http://localhost:3000/api/profiles/jake/feed.atom"></atom:link>`)
+		require.Contains(t, output.String(), `<link>http://localhost:5173/profile/jake</link>`)
+		require.Contains(t, output.String(), `<dc:creator>jake</dc:creator>`)
+		require.Contains(t, output.String(), `<content:encoded>&lt;p&gt;It takes a &lt;em&gt;Jacobian&lt;/em&gt; &amp; patience&lt;/p&gt;</content:encoded>`)
+		document := new(rssFeed)
+		err = xml.Unmarshal(output.Bytes(), document)
+		require.NoError(t, err)
+		require.Equal(t, "2.0", document.Version)
+		require.Equal(t, feed.Title, document.Channel.Title)
+		require.Equal(t, "Sat, 02 Mar 2024 11:30:00 +0000", document.Channel.LastBuildDate)
+		require.Len(t, document.Channel.Items, 1)
+		item := document.Channel.Items[0]
+		require.Equal(t, feed.Entries[0].ID, item.GUID.Value)
+		require.False(t, item.GUID.IsPermaLink)
+		require.Equal(t, "Fri, 01 Mar 2024 12:00:00 +0000", item.PubDate)
+		require.Equal(t, []string{"dragons", "training"}, item.Categories)
+		require.Equal(t, feed.Entries[0].Summary, item.Description)
+	})
+
+	t.Run("Should not write unsupported formats", func(t *testing.T) {
+		// Act
+		err := Write(new(bytes.Buffer), "json", feed)
+
+		// Assert
+		require.ErrorContains(t, err, `unsupported feed format "json"`)
+	})
+}
@@ -23,6 +23,10 @@ type followersGetter interface {
 	GetFollowers(ctx context.Context, followed string) ([]*followerCentralModels.Follower, error)
 }
 
+type tagFollowersGetter interface {
+	GetTagFollowers(ctx context.Context, tags []string) ([]*followerCentralModels.TagFollower, error)
+}
+
 type feedAppender interface {
 	AppendArticleToUserFeeds(ctx context.Context, article *models.Article, author *profileManagerModels.User, userIDs []string) error
 }
@@ -32,16 +36,18 @@ type ArticleFeedHandler struct {
 	service         articleGetter
 	profileManager  profileGetter
 	followerCentral followersGetter
+	tagFollowers    tagFollowersGetter
 	feedAppender    feedAppender
 }
 
-func NewArticleFeedHandler(articleGetter articleGetter, profileGetter profileGetter, followersGetter followersGetter, feedAppender feedAppender, logger *slog.Logger) *ArticleFeedHandler {
+func NewArticleFeedHandler(articleGetter articleGetter, profileGetter profileGetter, followersGetter followersGetter, tagFollowersGetter tagFollowersGetter, feedAppender feedAppender, logger *slog.Logger) *ArticleFeedHandler {
 	logger = logger.With("emitter", "article-feed-worker")
 	return &ArticleFeedHandler{
 		logger:          logger,
 		service:         articleGetter,
 		profileManager:  profileGetter,
 		followerCentral: followersGetter,
+		tagFollowers:    tagFollowersGetter,
 		feedAppender:    feedAppender,
 	}
 }
@@ -67,6 +73,12 @@ func (w *ArticleFeedHandler) Handle(message app.Message) {
 	}
 	w.logger.Debug("Found Article", "article", article)
 
+	if !article.IsPublished() {
+		w.logger.Info("Article is not published", "articleID", articleID)
+		w.success(message)
+		return
+	}
+
 	author, err := w.profileManager.GetUserByID(ctx, *article.Author)
 	if err != nil {
 		if appError := new(app.AppError); errors.As(err, &appError) {
@@ -83,24 +95,45 @@ func (w *ArticleFeedHandler) Handle(message app.Message) {
 	}
 	w.logger.Debug("Found Author", "author", author)
 
-	followers, err := w.followerCentral.GetFollowers(ctx, author.ID.Hex())
+	followerIDs := []string{}
+	seenFollowers := map[string]bool{}
+	for _, authorID := range append([]string{author.ID.Hex()}, article.CoAuthors...) {
+		followers, err := w.followerCentral.GetFollowers(ctx, authorID)
+		if err != nil {
+			w.logger.Error("Failed to author's followers", "authorID", authorID, "error", err)
+			w.failure(message)
+			return
+		}
+		for _, follower := range followers {
+			if !seenFollowers[*follower.Follower] {
+				seenFollowers[*follower.Follower] = true
+				followerIDs = append(followerIDs, *follower.Follower)
+			}
+		}
+	}
+
+	tagFollowers, err := w.tagFollowers.GetTagFollowers(ctx, article.TagList)
 	if err != nil {
-		w.logger.Error("Failed to author's followers", "authorID", *article.Author, "error", err)
+		w.logger.Error("Failed to get tag followers", "tags", article.TagList, "error", err)
 		w.failure(message)
 		return
 	}
+	for _, tagFollower := range tagFollowers {
+		if article.HasAuthor(*tagFollower.Follower) {
+			continue
+		}
+		if !seenFollowers[*tagFollower.Follower] {
+			seenFollowers[*tagFollower.Follower] = true
+			followerIDs = append(followerIDs, *tagFollower.Follower)
+		}
+	}
 
-	if len(followers) == 0 {
+	if len(followerIDs) == 0 {
 		w.logger.Debug("No Followers Found", "author", author)
 		w.success(message)
 		return
 	}
-	w.logger.Debug("Found Followers", "followers", followers)
-
-	followerIDs := make([]string, 0, len(followers))
-	for _, follower := range followers {
-		followerIDs = append(followerIDs, *follower.Follower)
-	}
+	w.logger.Debug("Found Followers", "followers", followerIDs)
 
 	// TODO: Only do appending of article for active users (last 30 days)
 	if err := w.feedAppender.AppendArticleToUserFeeds(ctx, article, author, followerIDs); err != nil {
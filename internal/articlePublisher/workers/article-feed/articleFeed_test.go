@@ -38,8 +38,9 @@ func TestArticleFeedWorker(t *testing.T) {
 	articleGetterMock := newMockArticleGetter(t)
 	profileGetterMock := newMockProfileGetter(t)
 	followersGetterMock := newMockFollowersGetter(t)
+	tagFollowersGetterMock := newMockTagFollowersGetter(t)
 	feedAppenderMock := newMockFeedAppender(t)
-	worker := NewArticleFeedHandler(articleGetterMock, profileGetterMock, followersGetterMock, feedAppenderMock, slog.New(logHandler))
+	worker := NewArticleFeedHandler(articleGetterMock, profileGetterMock, followersGetterMock, tagFollowersGetterMock, feedAppenderMock, slog.New(logHandler))
 
 	t.Run("Should receive new article message and append it to followers feed", func(t *testing.T) {
 		// Arrange
@@ -58,6 +59,7 @@ func TestArticleFeedWorker(t *testing.T) {
 		articleGetterMock.EXPECT().GetArticleByID(mock.AnythingOfType("context.backgroundCtx"), expectedArticleID).Return(expectedArticle, nil).Once()
 		profileGetterMock.EXPECT().GetUserByID(mock.AnythingOfType("context.backgroundCtx"), expectedAuthorID.Hex()).Return(expectedAuthor, nil).Once()
 		followersGetterMock.EXPECT().GetFollowers(mock.AnythingOfType("context.backgroundCtx"), expectedAuthorID.Hex()).Return(expectedFollowers, nil).Once()
+		tagFollowersGetterMock.EXPECT().GetTagFollowers(mock.AnythingOfType("context.backgroundCtx"), expectedArticle.TagList).Return([]*followerCentralModels.TagFollower{}, nil).Once()
 		feedAppenderMock.EXPECT().AppendArticleToUserFeeds(mock.AnythingOfType("context.backgroundCtx"), expectedArticle, expectedAuthor, followerIDs).Return(nil).Once()
 		messageMock.EXPECT().Success().Return(nil).Once()
 
@@ -70,6 +72,98 @@ func TestArticleFeedWorker(t *testing.T) {
 		logSpy.Clean()
 	})
 
+	t.Run("Should append article to the followers of every co-author without duplicates", func(t *testing.T) {
+		// Arrange
+		expectedAuthor := assembleUserModel()
+		expectedAuthorID := *expectedAuthor.ID
+		expectedArticle := assembleArticleModel(expectedAuthorID)
+		coAuthorID := primitive.NewObjectID().Hex()
+		expectedArticle.CoAuthors = []string{coAuthorID}
+		expectedArticleID := expectedArticle.ID.Hex()
+		expectedMessageBody := []byte(expectedArticleID)
+		sharedFollower := assembleFollowerModel(expectedAuthorID.Hex())
+		authorFollowers := []*followerCentralModels.Follower{sharedFollower, assembleFollowerModel(expectedAuthorID.Hex())}
+		coAuthorFollowers := []*followerCentralModels.Follower{sharedFollower, assembleFollowerModel(coAuthorID)}
+		followerIDs := []string{*authorFollowers[0].Follower, *authorFollowers[1].Follower, *coAuthorFollowers[1].Follower}
+		messageMock := app.NewMockMessage(t)
+		messageMock.EXPECT().Data().Return(expectedMessageBody).Once()
+		articleGetterMock.EXPECT().GetArticleByID(mock.AnythingOfType("context.backgroundCtx"), expectedArticleID).Return(expectedArticle, nil).Once()
+		profileGetterMock.EXPECT().GetUserByID(mock.AnythingOfType("context.backgroundCtx"), expectedAuthorID.Hex()).Return(expectedAuthor, nil).Once()
+		followersGetterMock.EXPECT().GetFollowers(mock.AnythingOfType("context.backgroundCtx"), expectedAuthorID.Hex()).Return(authorFollowers, nil).Once()
+		followersGetterMock.EXPECT().GetFollowers(mock.AnythingOfType("context.backgroundCtx"), coAuthorID).Return(coAuthorFollowers, nil).Once()
+		tagFollowersGetterMock.EXPECT().GetTagFollowers(mock.AnythingOfType("context.backgroundCtx"), expectedArticle.TagList).Return([]*followerCentralModels.TagFollower{}, nil).Once()
+		feedAppenderMock.EXPECT().AppendArticleToUserFeeds(mock.AnythingOfType("context.backgroundCtx"), expectedArticle, expectedAuthor, followerIDs).Return(nil).Once()
+		messageMock.EXPECT().Success().Return(nil).Once()
+
+		// Act
+		worker.Handle(messageMock)
+
+		// Assert
+		require.Contains(t, logSpy.LastMessage, "Successfully appended article to user feeds")
+		require.Equal(t, 5, logSpy.NumberOfCalls)
+		logSpy.Clean()
+	})
+
+	t.Run("Should append article to the followers of its tags without duplicates", func(t *testing.T) {
+		// Arrange
+		expectedAuthor := assembleUserModel()
+		expectedAuthorID := *expectedAuthor.ID
+		expectedArticle := assembleArticleModel(expectedAuthorID)
+		expectedArticle.TagList = []string{"golang", "technology"}
+		expectedArticleID := expectedArticle.ID.Hex()
+		expectedMessageBody := []byte(expectedArticleID)
+		authorFollower := assembleFollowerModel(expectedAuthorID.Hex())
+		tagFollower := assembleTagFollowerModel("golang")
+		tagFollowers := []*followerCentralModels.TagFollower{
+			{Tag: &expectedArticle.TagList[0], Follower: authorFollower.Follower},
+			tagFollower,
+			{Tag: &expectedArticle.TagList[1], Follower: tagFollower.Follower},
+			{Tag: &expectedArticle.TagList[1], Follower: expectedArticle.Author},
+		}
+		followerIDs := []string{*authorFollower.Follower, *tagFollower.Follower}
+		messageMock := app.NewMockMessage(t)
+		messageMock.EXPECT().Data().Return(expectedMessageBody).Once()
+		articleGetterMock.EXPECT().GetArticleByID(mock.AnythingOfType("context.backgroundCtx"), expectedArticleID).Return(expectedArticle, nil).Once()
+		profileGetterMock.EXPECT().GetUserByID(mock.AnythingOfType("context.backgroundCtx"), expectedAuthorID.Hex()).Return(expectedAuthor, nil).Once()
+		followersGetterMock.EXPECT().GetFollowers(mock.AnythingOfType("context.backgroundCtx"), expectedAuthorID.Hex()).Return([]*followerCentralModels.Follower{authorFollower}, nil).Once()
+		tagFollowersGetterMock.EXPECT().GetTagFollowers(mock.AnythingOfType("context.backgroundCtx"), expectedArticle.TagList).Return(tagFollowers, nil).Once()
+		feedAppenderMock.EXPECT().AppendArticleToUserFeeds(mock.AnythingOfType("context.backgroundCtx"), expectedArticle, expectedAuthor, followerIDs).Return(nil).Once()
+		messageMock.EXPECT().Success().Return(nil).Once()
+
+		// Act
+		worker.Handle(messageMock)
+
+		// Assert
+		require.Contains(t, logSpy.LastMessage, "Successfully appended article to user feeds")
+		require.Equal(t, 5, logSpy.NumberOfCalls)
+		logSpy.Clean()
+	})
+
+	t.Run("Should fail saga (retry) if GetTagFollowers call fails unexpectedly", func(t *testing.T) {
+		// Arrange
+		expectedAuthor := assembleUserModel()
+		expectedAuthorID := *expectedAuthor.ID
+		expectedArticle := assembleArticleModel(expectedAuthorID)
+		expectedArticleID := expectedArticle.ID.Hex()
+		expectedMessageBody := []byte(expectedArticleID)
+		expectedError := errors.New("failed to get tag followers")
+		messageMock := app.NewMockMessage(t)
+		messageMock.EXPECT().Data().Return(expectedMessageBody).Once()
+		articleGetterMock.EXPECT().GetArticleByID(mock.AnythingOfType("context.backgroundCtx"), expectedArticleID).Return(expectedArticle, nil).Once()
+		profileGetterMock.EXPECT().GetUserByID(mock.AnythingOfType("context.backgroundCtx"), expectedAuthorID.Hex()).Return(expectedAuthor, nil).Once()
+		followersGetterMock.EXPECT().GetFollowers(mock.AnythingOfType("context.backgroundCtx"), expectedAuthorID.Hex()).Return([]*followerCentralModels.Follower{}, nil).Once()
+		tagFollowersGetterMock.EXPECT().GetTagFollowers(mock.AnythingOfType("context.backgroundCtx"), expectedArticle.TagList).Return(nil, expectedError).Once()
+		messageMock.EXPECT().Failure().Return(nil).Once()
+
+		// Act
+		worker.Handle(messageMock)
+
+		// Assert
+		require.Contains(t, logSpy.LastMessage, "Failed to get tag followers")
+		require.Equal(t, 4, logSpy.NumberOfCalls)
+		logSpy.Clean()
+	})
+
 	t.Run("Should finalize saga if articleID does not point to an expected article", func(t *testing.T) {
 		// Arrange
 		expectedAuthorID := primitive.NewObjectID()
@@ -91,6 +185,28 @@ func TestArticleFeedWorker(t *testing.T) {
 		logSpy.Clean()
 	})
 
+	t.Run("Should finalize saga if article is not published", func(t *testing.T) {
+		// Arrange
+		expectedAuthorID := primitive.NewObjectID()
+		expectedArticle := assembleArticleModel(expectedAuthorID)
+		draftStatus := models.ArticleStatusDraft
+		expectedArticle.Status = &draftStatus
+		expectedArticleID := expectedArticle.ID.Hex()
+		expectedMessageBody := []byte(expectedArticleID)
+		messageMock := app.NewMockMessage(t)
+		messageMock.EXPECT().Data().Return(expectedMessageBody).Once()
+		articleGetterMock.EXPECT().GetArticleByID(mock.AnythingOfType("context.backgroundCtx"), expectedArticleID).Return(expectedArticle, nil).Once()
+		messageMock.EXPECT().Success().Return(nil).Once()
+
+		// Act
+		worker.Handle(messageMock)
+
+		// Assert
+		require.Contains(t, logSpy.LastMessage, "Article is not published")
+		require.Equal(t, 3, logSpy.NumberOfCalls)
+		logSpy.Clean()
+	})
+
 	t.Run("Should fail saga (retry) if GetArticleByID call fails unexpectedly", func(t *testing.T) {
 		// Arrange
 		expectedAuthorID := primitive.NewObjectID()
@@ -169,6 +285,7 @@ func TestArticleFeedWorker(t *testing.T) {
 		articleGetterMock.EXPECT().GetArticleByID(mock.AnythingOfType("context.backgroundCtx"), expectedArticleID).Return(expectedArticle, nil).Once()
 		profileGetterMock.EXPECT().GetUserByID(mock.AnythingOfType("context.backgroundCtx"), expectedAuthorID.Hex()).Return(expectedAuthor, nil).Once()
 		followersGetterMock.EXPECT().GetFollowers(mock.AnythingOfType("context.backgroundCtx"), expectedAuthorID.Hex()).Return(expectedFollowers, nil).Once()
+		tagFollowersGetterMock.EXPECT().GetTagFollowers(mock.AnythingOfType("context.backgroundCtx"), expectedArticle.TagList).Return([]*followerCentralModels.TagFollower{}, nil).Once()
 		messageMock.EXPECT().Success().Return(nil).Once()
 
 		// Act
@@ -222,6 +339,7 @@ func TestArticleFeedWorker(t *testing.T) {
 		articleGetterMock.EXPECT().GetArticleByID(mock.AnythingOfType("context.backgroundCtx"), expectedArticleID).Return(expectedArticle, nil).Once()
 		profileGetterMock.EXPECT().GetUserByID(mock.AnythingOfType("context.backgroundCtx"), expectedAuthorID.Hex()).Return(expectedAuthor, nil).Once()
 		followersGetterMock.EXPECT().GetFollowers(mock.AnythingOfType("context.backgroundCtx"), expectedAuthorID.Hex()).Return(expectedFollowers, nil).Once()
+		tagFollowersGetterMock.EXPECT().GetTagFollowers(mock.AnythingOfType("context.backgroundCtx"), expectedArticle.TagList).Return([]*followerCentralModels.TagFollower{}, nil).Once()
 		feedAppenderMock.EXPECT().AppendArticleToUserFeeds(mock.AnythingOfType("context.backgroundCtx"), expectedArticle, expectedAuthor, followerIDs).Return(expectedError).Once()
 		messageMock.EXPECT().Failure().Return(nil).Once()
 
@@ -280,3 +398,13 @@ func assembleFollowerModel(followed string) *followerCentralModels.Follower {
 		Follower: &followerID,
 	}
 }
+
+func assembleTagFollowerModel(tag string) *followerCentralModels.TagFollower {
+	ID := primitive.NewObjectID()
+	followerID := uuid.NewString()
+	return &followerCentralModels.TagFollower{
+		ID:       &ID,
+		Tag:      &tag,
+		Follower: &followerID,
+	}
+}
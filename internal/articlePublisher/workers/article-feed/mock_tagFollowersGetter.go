@@ -0,0 +1,96 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package articlefeed
+
+import (
+	context "context"
+
+	models "github.com/ravilock/goduit/internal/followerCentral/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockTagFollowersGetter is an autogenerated mock type for the tagFollowersGetter type
+type mockTagFollowersGetter struct {
+	mock.Mock
+}
+
+type mockTagFollowersGetter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockTagFollowersGetter) EXPECT() *mockTagFollowersGetter_Expecter {
+	return &mockTagFollowersGetter_Expecter{mock: &_m.Mock}
+}
+
+// GetTagFollowers provides a mock function with given fields: ctx, tags
+func (_m *mockTagFollowersGetter) GetTagFollowers(ctx context.Context, tags []string) ([]*models.TagFollower, error) {
+	ret := _m.Called(ctx, tags)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTagFollowers")
+	}
+
+	var r0 []*models.TagFollower
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) ([]*models.TagFollower, error)); ok {
+		return rf(ctx, tags)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []*models.TagFollower); ok {
+		r0 = rf(ctx, tags)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.TagFollower)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, tags)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockTagFollowersGetter_GetTagFollowers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTagFollowers'
+type mockTagFollowersGetter_GetTagFollowers_Call struct {
+	*mock.Call
+}
+
+// GetTagFollowers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tags []string
+func (_e *mockTagFollowersGetter_Expecter) GetTagFollowers(ctx interface{}, tags interface{}) *mockTagFollowersGetter_GetTagFollowers_Call {
+	return &mockTagFollowersGetter_GetTagFollowers_Call{Call: _e.mock.On("GetTagFollowers", ctx, tags)}
+}
+
+func (_c *mockTagFollowersGetter_GetTagFollowers_Call) Run(run func(ctx context.Context, tags []string)) *mockTagFollowersGetter_GetTagFollowers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *mockTagFollowersGetter_GetTagFollowers_Call) Return(_a0 []*models.TagFollower, _a1 error) *mockTagFollowersGetter_GetTagFollowers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *mockTagFollowersGetter_GetTagFollowers_Call) RunAndReturn(run func(context.Context, []string) ([]*models.TagFollower, error)) *mockTagFollowersGetter_GetTagFollowers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockTagFollowersGetter creates a new instance of mockTagFollowersGetter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockTagFollowersGetter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockTagFollowersGetter {
+	mock := &mockTagFollowersGetter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,70 @@
+package articlepurger
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+)
+
+type expiredArticlesLister interface {
+	ListExpiredTrashedArticles(ctx context.Context, until time.Time, limit int64) ([]*models.Article, error)
+}
+
+type articlePurger interface {
+	PurgeArticle(ctx context.Context, ID string) error
+}
+
+type ArticlePurger struct {
+	logger     *slog.Logger
+	repository expiredArticlesLister
+	purger     articlePurger
+	retention  time.Duration
+	batchSize  int64
+}
+
+func NewArticlePurger(repository expiredArticlesLister, purger articlePurger, retention time.Duration, batchSize int64, logger *slog.Logger) *ArticlePurger {
+	logger = logger.With("emitter", "article-purger")
+	return &ArticlePurger{
+		logger:     logger,
+		repository: repository,
+		purger:     purger,
+		retention:  retention,
+		batchSize:  batchSize,
+	}
+}
+
+// Run purges expired trashed articles every interval until ctx is cancelled.
+func (p *ArticlePurger) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		p.PurgeExpiredArticles(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// PurgeExpiredArticles permanently deletes one batch of articles that have been in the trash for longer than the retention period.
+//
+// An article that fails to purge stays in the trash and is retried on the next run.
+func (p *ArticlePurger) PurgeExpiredArticles(ctx context.Context) {
+	until := time.Now().UTC().Add(-p.retention)
+	articles, err := p.repository.ListExpiredTrashedArticles(ctx, until, p.batchSize)
+	if err != nil {
+		p.logger.Error("Failed to list expired trashed articles", "error", err)
+		return
+	}
+	for _, article := range articles {
+		articleID := article.ID.Hex()
+		if err := p.purger.PurgeArticle(ctx, articleID); err != nil {
+			p.logger.Error("Failed to purge trashed article", "articleID", articleID, "error", err)
+			continue
+		}
+		p.logger.Info("Purged trashed article", "articleID", articleID)
+	}
+}
@@ -0,0 +1,104 @@
+package articlepurger
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+	mock "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type loggerSpy struct {
+	Messages []string
+}
+
+func (l *loggerSpy) Write(p []byte) (int, error) {
+	l.Messages = append(l.Messages, string(p))
+	return len(p), nil
+}
+
+func (l *loggerSpy) Contains(message string) bool {
+	for _, m := range l.Messages {
+		if strings.Contains(m, message) {
+			return true
+		}
+	}
+	return false
+}
+
+func (l *loggerSpy) Clean() {
+	l.Messages = nil
+}
+
+func TestArticlePurger(t *testing.T) {
+	logSpy := new(loggerSpy)
+	logHandler := slog.NewTextHandler(logSpy, &slog.HandlerOptions{Level: slog.LevelDebug})
+	expiredArticlesListerMock := newMockExpiredArticlesLister(t)
+	articlePurgerMock := newMockArticlePurger(t)
+	retention := 24 * time.Hour
+	batchSize := int64(10)
+	purger := NewArticlePurger(expiredArticlesListerMock, articlePurgerMock, retention, batchSize, slog.New(logHandler))
+	ctx := context.Background()
+
+	t.Run("Should purge expired trashed articles", func(t *testing.T) {
+		// Arrange
+		expiredArticles := []*models.Article{assembleTrashedArticle(), assembleTrashedArticle()}
+		expiredArticlesListerMock.EXPECT().ListExpiredTrashedArticles(ctx, mock.MatchedBy(func(until time.Time) bool {
+			return until.Before(time.Now().Add(-retention).Add(time.Second))
+		}), batchSize).Return(expiredArticles, nil).Once()
+		for _, article := range expiredArticles {
+			articlePurgerMock.EXPECT().PurgeArticle(ctx, article.ID.Hex()).Return(nil).Once()
+		}
+
+		// Act
+		purger.PurgeExpiredArticles(ctx)
+
+		// Assert
+		require.True(t, logSpy.Contains("Purged trashed article"))
+		logSpy.Clean()
+	})
+
+	t.Run("Should keep purging if one article fails", func(t *testing.T) {
+		// Arrange
+		failingArticle := assembleTrashedArticle()
+		expiredArticle := assembleTrashedArticle()
+		expiredArticlesListerMock.EXPECT().ListExpiredTrashedArticles(ctx, mock.AnythingOfType("time.Time"), batchSize).Return([]*models.Article{failingArticle, expiredArticle}, nil).Once()
+		articlePurgerMock.EXPECT().PurgeArticle(ctx, failingArticle.ID.Hex()).Return(errors.New("unexpected error")).Once()
+		articlePurgerMock.EXPECT().PurgeArticle(ctx, expiredArticle.ID.Hex()).Return(nil).Once()
+
+		// Act
+		purger.PurgeExpiredArticles(ctx)
+
+		// Assert
+		require.True(t, logSpy.Contains("Failed to purge trashed article"))
+		require.True(t, logSpy.Contains("Purged trashed article"))
+		logSpy.Clean()
+	})
+
+	t.Run("Should not purge anything if listing fails", func(t *testing.T) {
+		// Arrange
+		expiredArticlesListerMock.EXPECT().ListExpiredTrashedArticles(ctx, mock.AnythingOfType("time.Time"), batchSize).Return(nil, errors.New("unexpected error")).Once()
+
+		// Act
+		purger.PurgeExpiredArticles(ctx)
+
+		// Assert
+		require.True(t, logSpy.Contains("Failed to list expired trashed articles"))
+		logSpy.Clean()
+	})
+}
+
+func assembleTrashedArticle() *models.Article {
+	articleID := primitive.NewObjectID()
+	trashedAt := time.Now().UTC().Add(-48 * time.Hour)
+	return &models.Article{
+		ID:        &articleID,
+		TrashedAt: &trashedAt,
+	}
+}
@@ -0,0 +1,83 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package articlepurger
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockArticlePurger is an autogenerated mock type for the articlePurger type
+type mockArticlePurger struct {
+	mock.Mock
+}
+
+type mockArticlePurger_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockArticlePurger) EXPECT() *mockArticlePurger_Expecter {
+	return &mockArticlePurger_Expecter{mock: &_m.Mock}
+}
+
+// PurgeArticle provides a mock function with given fields: ctx, ID
+func (_m *mockArticlePurger) PurgeArticle(ctx context.Context, ID string) error {
+	ret := _m.Called(ctx, ID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeArticle")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, ID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// mockArticlePurger_PurgeArticle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeArticle'
+type mockArticlePurger_PurgeArticle_Call struct {
+	*mock.Call
+}
+
+// PurgeArticle is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ID string
+func (_e *mockArticlePurger_Expecter) PurgeArticle(ctx interface{}, ID interface{}) *mockArticlePurger_PurgeArticle_Call {
+	return &mockArticlePurger_PurgeArticle_Call{Call: _e.mock.On("PurgeArticle", ctx, ID)}
+}
+
+func (_c *mockArticlePurger_PurgeArticle_Call) Run(run func(ctx context.Context, ID string)) *mockArticlePurger_PurgeArticle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *mockArticlePurger_PurgeArticle_Call) Return(_a0 error) *mockArticlePurger_PurgeArticle_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *mockArticlePurger_PurgeArticle_Call) RunAndReturn(run func(context.Context, string) error) *mockArticlePurger_PurgeArticle_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockArticlePurger creates a new instance of mockArticlePurger. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockArticlePurger(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockArticlePurger {
+	mock := &mockArticlePurger{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,99 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package articlepurger
+
+import (
+	context "context"
+
+	models "github.com/ravilock/goduit/internal/articlePublisher/models"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// mockExpiredArticlesLister is an autogenerated mock type for the expiredArticlesLister type
+type mockExpiredArticlesLister struct {
+	mock.Mock
+}
+
+type mockExpiredArticlesLister_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockExpiredArticlesLister) EXPECT() *mockExpiredArticlesLister_Expecter {
+	return &mockExpiredArticlesLister_Expecter{mock: &_m.Mock}
+}
+
+// ListExpiredTrashedArticles provides a mock function with given fields: ctx, until, limit
+func (_m *mockExpiredArticlesLister) ListExpiredTrashedArticles(ctx context.Context, until time.Time, limit int64) ([]*models.Article, error) {
+	ret := _m.Called(ctx, until, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListExpiredTrashedArticles")
+	}
+
+	var r0 []*models.Article
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int64) ([]*models.Article, error)); ok {
+		return rf(ctx, until, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int64) []*models.Article); ok {
+		r0 = rf(ctx, until, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Article)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, int64) error); ok {
+		r1 = rf(ctx, until, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockExpiredArticlesLister_ListExpiredTrashedArticles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListExpiredTrashedArticles'
+type mockExpiredArticlesLister_ListExpiredTrashedArticles_Call struct {
+	*mock.Call
+}
+
+// ListExpiredTrashedArticles is a helper method to define mock.On call
+//   - ctx context.Context
+//   - until time.Time
+//   - limit int64
+func (_e *mockExpiredArticlesLister_Expecter) ListExpiredTrashedArticles(ctx interface{}, until interface{}, limit interface{}) *mockExpiredArticlesLister_ListExpiredTrashedArticles_Call {
+	return &mockExpiredArticlesLister_ListExpiredTrashedArticles_Call{Call: _e.mock.On("ListExpiredTrashedArticles", ctx, until, limit)}
+}
+
+func (_c *mockExpiredArticlesLister_ListExpiredTrashedArticles_Call) Run(run func(ctx context.Context, until time.Time, limit int64)) *mockExpiredArticlesLister_ListExpiredTrashedArticles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *mockExpiredArticlesLister_ListExpiredTrashedArticles_Call) Return(_a0 []*models.Article, _a1 error) *mockExpiredArticlesLister_ListExpiredTrashedArticles_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *mockExpiredArticlesLister_ListExpiredTrashedArticles_Call) RunAndReturn(run func(context.Context, time.Time, int64) ([]*models.Article, error)) *mockExpiredArticlesLister_ListExpiredTrashedArticles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockExpiredArticlesLister creates a new instance of mockExpiredArticlesLister. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockExpiredArticlesLister(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockExpiredArticlesLister {
+	mock := &mockExpiredArticlesLister{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
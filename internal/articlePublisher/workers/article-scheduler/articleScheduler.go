@@ -0,0 +1,103 @@
+package articlescheduler
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/ravilock/goduit/internal/app"
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+)
+
+type scheduledArticlesLister interface {
+	ListScheduledArticles(ctx context.Context, until time.Time, limit int64) ([]*models.Article, error)
+	ListPendingFanoutArticles(ctx context.Context, until time.Time, limit int64) ([]*models.Article, error)
+}
+
+type articlePublisher interface {
+	PublishArticle(ctx context.Context, ID string) (*models.Article, error)
+	FanoutArticle(ctx context.Context, article *models.Article) error
+}
+
+// fanoutGracePeriod is how long an article may stay pending fan-out before the scheduler retries it, giving whoever
+// published it time to enqueue it first.
+const fanoutGracePeriod = time.Minute
+
+type ArticleScheduler struct {
+	logger     *slog.Logger
+	repository scheduledArticlesLister
+	publisher  articlePublisher
+	batchSize  int64
+}
+
+func NewArticleScheduler(repository scheduledArticlesLister, publisher articlePublisher, batchSize int64, logger *slog.Logger) *ArticleScheduler {
+	logger = logger.With("emitter", "article-scheduler")
+	return &ArticleScheduler{
+		logger:     logger,
+		repository: repository,
+		publisher:  publisher,
+		batchSize:  batchSize,
+	}
+}
+
+// Run publishes due articles every interval until ctx is cancelled.
+func (s *ArticleScheduler) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		s.PublishDueArticles(ctx)
+		s.RetryPendingFanouts(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// PublishDueArticles publishes one batch of scheduled articles whose publishAt has passed.
+//
+// Several schedulers may race for the same article; publishing is conditional on the article
+// not being published yet, so only one of them publishes it and enqueues it for feed fan-out.
+func (s *ArticleScheduler) PublishDueArticles(ctx context.Context) {
+	articles, err := s.repository.ListScheduledArticles(ctx, time.Now().UTC(), s.batchSize)
+	if err != nil {
+		s.logger.Error("Failed to list scheduled articles", "error", err)
+		return
+	}
+	for _, article := range articles {
+		articleID := article.ID.Hex()
+		if _, err := s.publisher.PublishArticle(ctx, articleID); err != nil {
+			if appError := new(app.AppError); errors.As(err, &appError) {
+				switch appError.ErrorCode {
+				case app.ArticleAlreadyPublishedErrorCode:
+					s.logger.Debug("Article already published", "articleID", articleID)
+					continue
+				}
+			}
+			s.logger.Error("Failed to publish scheduled article", "articleID", articleID, "error", err)
+			continue
+		}
+		s.logger.Info("Published scheduled article", "articleID", articleID)
+	}
+}
+
+// RetryPendingFanouts enqueues one batch of published articles whose feed fan-out failed to be enqueued.
+//
+// Feeds skip articles they already have, so an article enqueued twice is still delivered only once.
+func (s *ArticleScheduler) RetryPendingFanouts(ctx context.Context) {
+	articles, err := s.repository.ListPendingFanoutArticles(ctx, time.Now().UTC().Add(-fanoutGracePeriod), s.batchSize)
+	if err != nil {
+		s.logger.Error("Failed to list articles pending fan-out", "error", err)
+		return
+	}
+	for _, article := range articles {
+		articleID := article.ID.Hex()
+		if err := s.publisher.FanoutArticle(ctx, article); err != nil {
+			s.logger.Error("Failed to enqueue article fan-out", "articleID", articleID, "error", err)
+			continue
+		}
+		s.logger.Info("Enqueued pending article fan-out", "articleID", articleID)
+	}
+}
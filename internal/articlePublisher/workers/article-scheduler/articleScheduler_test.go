@@ -0,0 +1,189 @@
+package articlescheduler
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ravilock/goduit/internal/app"
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+	mock "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type loggerSpy struct {
+	Messages []string
+}
+
+func (l *loggerSpy) Write(p []byte) (int, error) {
+	l.Messages = append(l.Messages, string(p))
+	return len(p), nil
+}
+
+func (l *loggerSpy) Contains(message string) bool {
+	for _, m := range l.Messages {
+		if strings.Contains(m, message) {
+			return true
+		}
+	}
+	return false
+}
+
+func (l *loggerSpy) Clean() {
+	l.Messages = nil
+}
+
+func TestArticleScheduler(t *testing.T) {
+	logSpy := new(loggerSpy)
+	logHandler := slog.NewTextHandler(logSpy, &slog.HandlerOptions{Level: slog.LevelDebug})
+	scheduledArticlesListerMock := newMockScheduledArticlesLister(t)
+	articlePublisherMock := newMockArticlePublisher(t)
+	batchSize := int64(10)
+	scheduler := NewArticleScheduler(scheduledArticlesListerMock, articlePublisherMock, batchSize, slog.New(logHandler))
+	ctx := context.Background()
+
+	t.Run("Should publish due articles", func(t *testing.T) {
+		// Arrange
+		dueArticles := []*models.Article{assembleScheduledArticle(), assembleScheduledArticle()}
+		scheduledArticlesListerMock.EXPECT().ListScheduledArticles(ctx, mock.AnythingOfType("time.Time"), batchSize).Return(dueArticles, nil).Once()
+		for _, article := range dueArticles {
+			articlePublisherMock.EXPECT().PublishArticle(ctx, article.ID.Hex()).Return(article, nil).Once()
+		}
+
+		// Act
+		scheduler.PublishDueArticles(ctx)
+
+		// Assert
+		require.True(t, logSpy.Contains("Published scheduled article"))
+		logSpy.Clean()
+	})
+
+	t.Run("Should skip articles already published by another scheduler", func(t *testing.T) {
+		// Arrange
+		publishedArticle := assembleScheduledArticle()
+		dueArticle := assembleScheduledArticle()
+		scheduledArticlesListerMock.EXPECT().ListScheduledArticles(ctx, mock.AnythingOfType("time.Time"), batchSize).Return([]*models.Article{publishedArticle, dueArticle}, nil).Once()
+		articlePublisherMock.EXPECT().PublishArticle(ctx, publishedArticle.ID.Hex()).Return(nil, app.ArticleAlreadyPublishedError(publishedArticle.ID.Hex())).Once()
+		articlePublisherMock.EXPECT().PublishArticle(ctx, dueArticle.ID.Hex()).Return(dueArticle, nil).Once()
+
+		// Act
+		scheduler.PublishDueArticles(ctx)
+
+		// Assert
+		require.True(t, logSpy.Contains("Article already published"))
+		require.True(t, logSpy.Contains("Published scheduled article"))
+		logSpy.Clean()
+	})
+
+	t.Run("Should keep publishing if one article fails", func(t *testing.T) {
+		// Arrange
+		failingArticle := assembleScheduledArticle()
+		dueArticle := assembleScheduledArticle()
+		scheduledArticlesListerMock.EXPECT().ListScheduledArticles(ctx, mock.AnythingOfType("time.Time"), batchSize).Return([]*models.Article{failingArticle, dueArticle}, nil).Once()
+		articlePublisherMock.EXPECT().PublishArticle(ctx, failingArticle.ID.Hex()).Return(nil, errors.New("unexpected error")).Once()
+		articlePublisherMock.EXPECT().PublishArticle(ctx, dueArticle.ID.Hex()).Return(dueArticle, nil).Once()
+
+		// Act
+		scheduler.PublishDueArticles(ctx)
+
+		// Assert
+		require.True(t, logSpy.Contains("Failed to publish scheduled article"))
+		require.True(t, logSpy.Contains("Published scheduled article"))
+		logSpy.Clean()
+	})
+
+	t.Run("Should not publish anything if listing fails", func(t *testing.T) {
+		// Arrange
+		scheduledArticlesListerMock.EXPECT().ListScheduledArticles(ctx, mock.AnythingOfType("time.Time"), batchSize).Return(nil, errors.New("unexpected error")).Once()
+
+		// Act
+		scheduler.PublishDueArticles(ctx)
+
+		// Assert
+		require.True(t, logSpy.Contains("Failed to list scheduled articles"))
+		logSpy.Clean()
+	})
+}
+
+func TestRetryPendingFanouts(t *testing.T) {
+	logSpy := new(loggerSpy)
+	logHandler := slog.NewTextHandler(logSpy, &slog.HandlerOptions{Level: slog.LevelDebug})
+	scheduledArticlesListerMock := newMockScheduledArticlesLister(t)
+	articlePublisherMock := newMockArticlePublisher(t)
+	batchSize := int64(10)
+	scheduler := NewArticleScheduler(scheduledArticlesListerMock, articlePublisherMock, batchSize, slog.New(logHandler))
+	ctx := context.Background()
+
+	t.Run("Should enqueue articles pending fan-out", func(t *testing.T) {
+		// Arrange
+		pendingArticles := []*models.Article{assemblePendingFanoutArticle(), assemblePendingFanoutArticle()}
+		scheduledArticlesListerMock.EXPECT().ListPendingFanoutArticles(ctx, mock.AnythingOfType("time.Time"), batchSize).Return(pendingArticles, nil).Once()
+		for _, article := range pendingArticles {
+			articlePublisherMock.EXPECT().FanoutArticle(ctx, article).Return(nil).Once()
+		}
+
+		// Act
+		scheduler.RetryPendingFanouts(ctx)
+
+		// Assert
+		require.True(t, logSpy.Contains("Enqueued pending article fan-out"))
+		logSpy.Clean()
+	})
+
+	t.Run("Should keep enqueueing if one article fails", func(t *testing.T) {
+		// Arrange
+		failingArticle := assemblePendingFanoutArticle()
+		pendingArticle := assemblePendingFanoutArticle()
+		scheduledArticlesListerMock.EXPECT().ListPendingFanoutArticles(ctx, mock.AnythingOfType("time.Time"), batchSize).Return([]*models.Article{failingArticle, pendingArticle}, nil).Once()
+		articlePublisherMock.EXPECT().FanoutArticle(ctx, failingArticle).Return(errors.New("unexpected error")).Once()
+		articlePublisherMock.EXPECT().FanoutArticle(ctx, pendingArticle).Return(nil).Once()
+
+		// Act
+		scheduler.RetryPendingFanouts(ctx)
+
+		// Assert
+		require.True(t, logSpy.Contains("Failed to enqueue article fan-out"))
+		require.True(t, logSpy.Contains("Enqueued pending article fan-out"))
+		logSpy.Clean()
+	})
+
+	t.Run("Should not enqueue anything if listing fails", func(t *testing.T) {
+		// Arrange
+		scheduledArticlesListerMock.EXPECT().ListPendingFanoutArticles(ctx, mock.AnythingOfType("time.Time"), batchSize).Return(nil, errors.New("unexpected error")).Once()
+
+		// Act
+		scheduler.RetryPendingFanouts(ctx)
+
+		// Assert
+		require.True(t, logSpy.Contains("Failed to list articles pending fan-out"))
+		logSpy.Clean()
+	})
+}
+
+func assemblePendingFanoutArticle() *models.Article {
+	articleID := primitive.NewObjectID()
+	status := models.ArticleStatusPublished
+	publishedAt := time.Now().UTC().Add(-time.Hour)
+	pendingFanout := true
+	return &models.Article{
+		ID:            &articleID,
+		Status:        &status,
+		PublishedAt:   &publishedAt,
+		PendingFanout: &pendingFanout,
+	}
+}
+
+func assembleScheduledArticle() *models.Article {
+	articleID := primitive.NewObjectID()
+	status := models.ArticleStatusScheduled
+	publishAt := time.Now().UTC().Add(-time.Minute)
+	return &models.Article{
+		ID:        &articleID,
+		Status:    &status,
+		PublishAt: &publishAt,
+	}
+}
@@ -0,0 +1,143 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package articlescheduler
+
+import (
+	context "context"
+
+	models "github.com/ravilock/goduit/internal/articlePublisher/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockArticlePublisher is an autogenerated mock type for the articlePublisher type
+type mockArticlePublisher struct {
+	mock.Mock
+}
+
+type mockArticlePublisher_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockArticlePublisher) EXPECT() *mockArticlePublisher_Expecter {
+	return &mockArticlePublisher_Expecter{mock: &_m.Mock}
+}
+
+// FanoutArticle provides a mock function with given fields: ctx, article
+func (_m *mockArticlePublisher) FanoutArticle(ctx context.Context, article *models.Article) error {
+	ret := _m.Called(ctx, article)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FanoutArticle")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Article) error); ok {
+		r0 = rf(ctx, article)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// mockArticlePublisher_FanoutArticle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FanoutArticle'
+type mockArticlePublisher_FanoutArticle_Call struct {
+	*mock.Call
+}
+
+// FanoutArticle is a helper method to define mock.On call
+//   - ctx context.Context
+//   - article *models.Article
+func (_e *mockArticlePublisher_Expecter) FanoutArticle(ctx interface{}, article interface{}) *mockArticlePublisher_FanoutArticle_Call {
+	return &mockArticlePublisher_FanoutArticle_Call{Call: _e.mock.On("FanoutArticle", ctx, article)}
+}
+
+func (_c *mockArticlePublisher_FanoutArticle_Call) Run(run func(ctx context.Context, article *models.Article)) *mockArticlePublisher_FanoutArticle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.Article))
+	})
+	return _c
+}
+
+func (_c *mockArticlePublisher_FanoutArticle_Call) Return(_a0 error) *mockArticlePublisher_FanoutArticle_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *mockArticlePublisher_FanoutArticle_Call) RunAndReturn(run func(context.Context, *models.Article) error) *mockArticlePublisher_FanoutArticle_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PublishArticle provides a mock function with given fields: ctx, ID
+func (_m *mockArticlePublisher) PublishArticle(ctx context.Context, ID string) (*models.Article, error) {
+	ret := _m.Called(ctx, ID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PublishArticle")
+	}
+
+	var r0 *models.Article
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.Article, error)); ok {
+		return rf(ctx, ID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *models.Article); ok {
+		r0 = rf(ctx, ID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Article)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, ID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockArticlePublisher_PublishArticle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PublishArticle'
+type mockArticlePublisher_PublishArticle_Call struct {
+	*mock.Call
+}
+
+// PublishArticle is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ID string
+func (_e *mockArticlePublisher_Expecter) PublishArticle(ctx interface{}, ID interface{}) *mockArticlePublisher_PublishArticle_Call {
+	return &mockArticlePublisher_PublishArticle_Call{Call: _e.mock.On("PublishArticle", ctx, ID)}
+}
+
+func (_c *mockArticlePublisher_PublishArticle_Call) Run(run func(ctx context.Context, ID string)) *mockArticlePublisher_PublishArticle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *mockArticlePublisher_PublishArticle_Call) Return(_a0 *models.Article, _a1 error) *mockArticlePublisher_PublishArticle_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *mockArticlePublisher_PublishArticle_Call) RunAndReturn(run func(context.Context, string) (*models.Article, error)) *mockArticlePublisher_PublishArticle_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockArticlePublisher creates a new instance of mockArticlePublisher. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockArticlePublisher(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockArticlePublisher {
+	mock := &mockArticlePublisher{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,159 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package articlescheduler
+
+import (
+	context "context"
+
+	models "github.com/ravilock/goduit/internal/articlePublisher/models"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// mockScheduledArticlesLister is an autogenerated mock type for the scheduledArticlesLister type
+type mockScheduledArticlesLister struct {
+	mock.Mock
+}
+
+type mockScheduledArticlesLister_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockScheduledArticlesLister) EXPECT() *mockScheduledArticlesLister_Expecter {
+	return &mockScheduledArticlesLister_Expecter{mock: &_m.Mock}
+}
+
+// ListPendingFanoutArticles provides a mock function with given fields: ctx, until, limit
+func (_m *mockScheduledArticlesLister) ListPendingFanoutArticles(ctx context.Context, until time.Time, limit int64) ([]*models.Article, error) {
+	ret := _m.Called(ctx, until, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPendingFanoutArticles")
+	}
+
+	var r0 []*models.Article
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int64) ([]*models.Article, error)); ok {
+		return rf(ctx, until, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int64) []*models.Article); ok {
+		r0 = rf(ctx, until, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Article)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, int64) error); ok {
+		r1 = rf(ctx, until, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockScheduledArticlesLister_ListPendingFanoutArticles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPendingFanoutArticles'
+type mockScheduledArticlesLister_ListPendingFanoutArticles_Call struct {
+	*mock.Call
+}
+
+// ListPendingFanoutArticles is a helper method to define mock.On call
+//   - ctx context.Context
+//   - until time.Time
+//   - limit int64
+func (_e *mockScheduledArticlesLister_Expecter) ListPendingFanoutArticles(ctx interface{}, until interface{}, limit interface{}) *mockScheduledArticlesLister_ListPendingFanoutArticles_Call {
+	return &mockScheduledArticlesLister_ListPendingFanoutArticles_Call{Call: _e.mock.On("ListPendingFanoutArticles", ctx, until, limit)}
+}
+
+func (_c *mockScheduledArticlesLister_ListPendingFanoutArticles_Call) Run(run func(ctx context.Context, until time.Time, limit int64)) *mockScheduledArticlesLister_ListPendingFanoutArticles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *mockScheduledArticlesLister_ListPendingFanoutArticles_Call) Return(_a0 []*models.Article, _a1 error) *mockScheduledArticlesLister_ListPendingFanoutArticles_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *mockScheduledArticlesLister_ListPendingFanoutArticles_Call) RunAndReturn(run func(context.Context, time.Time, int64) ([]*models.Article, error)) *mockScheduledArticlesLister_ListPendingFanoutArticles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListScheduledArticles provides a mock function with given fields: ctx, until, limit
+func (_m *mockScheduledArticlesLister) ListScheduledArticles(ctx context.Context, until time.Time, limit int64) ([]*models.Article, error) {
+	ret := _m.Called(ctx, until, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListScheduledArticles")
+	}
+
+	var r0 []*models.Article
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int64) ([]*models.Article, error)); ok {
+		return rf(ctx, until, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int64) []*models.Article); ok {
+		r0 = rf(ctx, until, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Article)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, int64) error); ok {
+		r1 = rf(ctx, until, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockScheduledArticlesLister_ListScheduledArticles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListScheduledArticles'
+type mockScheduledArticlesLister_ListScheduledArticles_Call struct {
+	*mock.Call
+}
+
+// ListScheduledArticles is a helper method to define mock.On call
+//   - ctx context.Context
+//   - until time.Time
+//   - limit int64
+func (_e *mockScheduledArticlesLister_Expecter) ListScheduledArticles(ctx interface{}, until interface{}, limit interface{}) *mockScheduledArticlesLister_ListScheduledArticles_Call {
+	return &mockScheduledArticlesLister_ListScheduledArticles_Call{Call: _e.mock.On("ListScheduledArticles", ctx, until, limit)}
+}
+
+func (_c *mockScheduledArticlesLister_ListScheduledArticles_Call) Run(run func(ctx context.Context, until time.Time, limit int64)) *mockScheduledArticlesLister_ListScheduledArticles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *mockScheduledArticlesLister_ListScheduledArticles_Call) Return(_a0 []*models.Article, _a1 error) *mockScheduledArticlesLister_ListScheduledArticles_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *mockScheduledArticlesLister_ListScheduledArticles_Call) RunAndReturn(run func(context.Context, time.Time, int64) ([]*models.Article, error)) *mockScheduledArticlesLister_ListScheduledArticles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockScheduledArticlesLister creates a new instance of mockScheduledArticlesLister. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockScheduledArticlesLister(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockScheduledArticlesLister {
+	mock := &mockScheduledArticlesLister{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
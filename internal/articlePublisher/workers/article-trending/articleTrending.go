@@ -0,0 +1,56 @@
+package articletrending
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+)
+
+type trendingScorer interface {
+	ScoreTrendingArticles(ctx context.Context, window models.TrendingWindow, now time.Time) error
+}
+
+type ArticleTrending struct {
+	logger  *slog.Logger
+	scorer  trendingScorer
+	windows []models.TrendingWindow
+}
+
+func NewArticleTrending(scorer trendingScorer, windows []models.TrendingWindow, logger *slog.Logger) *ArticleTrending {
+	logger = logger.With("emitter", "article-trending")
+	return &ArticleTrending{
+		logger:  logger,
+		scorer:  scorer,
+		windows: windows,
+	}
+}
+
+// Run recomputes trending articles every interval until ctx is cancelled.
+func (t *ArticleTrending) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		t.ScoreTrendingArticles(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ScoreTrendingArticles recomputes the trending articles of every window.
+//
+// A window that fails to be scored keeps its previous trending articles until the next run.
+func (t *ArticleTrending) ScoreTrendingArticles(ctx context.Context) {
+	now := time.Now().UTC()
+	for _, window := range t.windows {
+		if err := t.scorer.ScoreTrendingArticles(ctx, window, now); err != nil {
+			t.logger.Error("Failed to score trending articles", "window", window.Name, "error", err)
+			continue
+		}
+		t.logger.Info("Scored trending articles", "window", window.Name)
+	}
+}
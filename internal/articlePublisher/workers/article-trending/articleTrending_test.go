@@ -0,0 +1,83 @@
+package articletrending
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+	mock "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type loggerSpy struct {
+	Messages []string
+}
+
+func (l *loggerSpy) Write(p []byte) (int, error) {
+	l.Messages = append(l.Messages, string(p))
+	return len(p), nil
+}
+
+func (l *loggerSpy) Contains(message string) bool {
+	for _, m := range l.Messages {
+		if strings.Contains(m, message) {
+			return true
+		}
+	}
+	return false
+}
+
+func (l *loggerSpy) Clean() {
+	l.Messages = nil
+}
+
+func TestArticleTrending(t *testing.T) {
+	logSpy := new(loggerSpy)
+	logHandler := slog.NewTextHandler(logSpy, &slog.HandlerOptions{Level: slog.LevelDebug})
+	trendingScorerMock := newMockTrendingScorer(t)
+	trending := NewArticleTrending(trendingScorerMock, models.TrendingWindows, slog.New(logHandler))
+	ctx := context.Background()
+
+	t.Run("Should score every window at the same time", func(t *testing.T) {
+		// Arrange
+		var scoredAt []time.Time
+		for _, window := range models.TrendingWindows {
+			trendingScorerMock.EXPECT().ScoreTrendingArticles(ctx, window, mock.AnythingOfType("time.Time")).RunAndReturn(func(_ context.Context, _ models.TrendingWindow, now time.Time) error {
+				scoredAt = append(scoredAt, now)
+				return nil
+			}).Once()
+		}
+
+		// Act
+		trending.ScoreTrendingArticles(ctx)
+
+		// Assert
+		require.Len(t, scoredAt, len(models.TrendingWindows))
+		for _, now := range scoredAt {
+			require.Equal(t, scoredAt[0], now)
+		}
+		require.True(t, logSpy.Contains("Scored trending articles"))
+		logSpy.Clean()
+	})
+
+	t.Run("Should keep scoring if one window fails", func(t *testing.T) {
+		// Arrange
+		failingWindow := models.TrendingWindows[0]
+		trendingScorerMock.EXPECT().ScoreTrendingArticles(ctx, failingWindow, mock.AnythingOfType("time.Time")).Return(errors.New("unexpected error")).Once()
+		for _, window := range models.TrendingWindows[1:] {
+			trendingScorerMock.EXPECT().ScoreTrendingArticles(ctx, window, mock.AnythingOfType("time.Time")).Return(nil).Once()
+		}
+
+		// Act
+		trending.ScoreTrendingArticles(ctx)
+
+		// Assert
+		require.True(t, logSpy.Contains("Failed to score trending articles"))
+		require.True(t, logSpy.Contains("Scored trending articles"))
+		logSpy.Clean()
+	})
+}
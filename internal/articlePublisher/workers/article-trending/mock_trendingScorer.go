@@ -0,0 +1,87 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package articletrending
+
+import (
+	context "context"
+
+	models "github.com/ravilock/goduit/internal/articlePublisher/models"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// mockTrendingScorer is an autogenerated mock type for the trendingScorer type
+type mockTrendingScorer struct {
+	mock.Mock
+}
+
+type mockTrendingScorer_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockTrendingScorer) EXPECT() *mockTrendingScorer_Expecter {
+	return &mockTrendingScorer_Expecter{mock: &_m.Mock}
+}
+
+// ScoreTrendingArticles provides a mock function with given fields: ctx, window, now
+func (_m *mockTrendingScorer) ScoreTrendingArticles(ctx context.Context, window models.TrendingWindow, now time.Time) error {
+	ret := _m.Called(ctx, window, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ScoreTrendingArticles")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.TrendingWindow, time.Time) error); ok {
+		r0 = rf(ctx, window, now)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// mockTrendingScorer_ScoreTrendingArticles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ScoreTrendingArticles'
+type mockTrendingScorer_ScoreTrendingArticles_Call struct {
+	*mock.Call
+}
+
+// ScoreTrendingArticles is a helper method to define mock.On call
+//   - ctx context.Context
+//   - window models.TrendingWindow
+//   - now time.Time
+func (_e *mockTrendingScorer_Expecter) ScoreTrendingArticles(ctx interface{}, window interface{}, now interface{}) *mockTrendingScorer_ScoreTrendingArticles_Call {
+	return &mockTrendingScorer_ScoreTrendingArticles_Call{Call: _e.mock.On("ScoreTrendingArticles", ctx, window, now)}
+}
+
+func (_c *mockTrendingScorer_ScoreTrendingArticles_Call) Run(run func(ctx context.Context, window models.TrendingWindow, now time.Time)) *mockTrendingScorer_ScoreTrendingArticles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.TrendingWindow), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *mockTrendingScorer_ScoreTrendingArticles_Call) Return(_a0 error) *mockTrendingScorer_ScoreTrendingArticles_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *mockTrendingScorer_ScoreTrendingArticles_Call) RunAndReturn(run func(context.Context, models.TrendingWindow, time.Time) error) *mockTrendingScorer_ScoreTrendingArticles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockTrendingScorer creates a new instance of mockTrendingScorer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockTrendingScorer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockTrendingScorer {
+	mock := &mockTrendingScorer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
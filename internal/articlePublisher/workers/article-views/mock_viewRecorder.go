@@ -0,0 +1,84 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package articleviews
+
+import (
+	context "context"
+
+	models "github.com/ravilock/goduit/internal/articlePublisher/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockViewRecorder is an autogenerated mock type for the viewRecorder type
+type mockViewRecorder struct {
+	mock.Mock
+}
+
+type mockViewRecorder_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockViewRecorder) EXPECT() *mockViewRecorder_Expecter {
+	return &mockViewRecorder_Expecter{mock: &_m.Mock}
+}
+
+// RecordViews provides a mock function with given fields: ctx, views
+func (_m *mockViewRecorder) RecordViews(ctx context.Context, views []*models.ArticleViews) error {
+	ret := _m.Called(ctx, views)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordViews")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []*models.ArticleViews) error); ok {
+		r0 = rf(ctx, views)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// mockViewRecorder_RecordViews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordViews'
+type mockViewRecorder_RecordViews_Call struct {
+	*mock.Call
+}
+
+// RecordViews is a helper method to define mock.On call
+//   - ctx context.Context
+//   - views []*models.ArticleViews
+func (_e *mockViewRecorder_Expecter) RecordViews(ctx interface{}, views interface{}) *mockViewRecorder_RecordViews_Call {
+	return &mockViewRecorder_RecordViews_Call{Call: _e.mock.On("RecordViews", ctx, views)}
+}
+
+func (_c *mockViewRecorder_RecordViews_Call) Run(run func(ctx context.Context, views []*models.ArticleViews)) *mockViewRecorder_RecordViews_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]*models.ArticleViews))
+	})
+	return _c
+}
+
+func (_c *mockViewRecorder_RecordViews_Call) Return(_a0 error) *mockViewRecorder_RecordViews_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *mockViewRecorder_RecordViews_Call) RunAndReturn(run func(context.Context, []*models.ArticleViews) error) *mockViewRecorder_RecordViews_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockViewRecorder creates a new instance of mockViewRecorder. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockViewRecorder(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockViewRecorder {
+	mock := &mockViewRecorder{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
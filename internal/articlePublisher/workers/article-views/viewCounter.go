@@ -0,0 +1,140 @@
+package articleviews
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type viewRecorder interface {
+	RecordViews(ctx context.Context, views []*models.ArticleViews) error
+}
+
+type viewKey struct {
+	article string
+	viewer  string
+}
+
+type bucketKey struct {
+	article string
+	day     time.Time
+}
+
+// ViewCounter counts article views in memory and writes them to the database in batches.
+//
+// Views of the same article by the same viewer are only counted once per dedup window.
+type ViewCounter struct {
+	logger     *slog.Logger
+	repository viewRecorder
+	window     time.Duration
+	batchSize  int
+	now        func() time.Time
+	mu         sync.Mutex
+	seen       map[viewKey]time.Time
+	pending    map[bucketKey]int64
+	full       chan struct{}
+}
+
+func NewViewCounter(repository viewRecorder, window time.Duration, batchSize int, logger *slog.Logger) *ViewCounter {
+	logger = logger.With("emitter", "article-views")
+	return &ViewCounter{
+		logger:     logger,
+		repository: repository,
+		window:     window,
+		batchSize:  batchSize,
+		now:        time.Now,
+		seen:       map[viewKey]time.Time{},
+		pending:    map[bucketKey]int64{},
+		full:       make(chan struct{}, 1),
+	}
+}
+
+// CountView buffers a view of an article, unless the viewer already viewed it within the dedup window.
+// It never blocks on the database; buffered views are written by Flush.
+//
+// The article parameter represents the ID of the viewed article.
+//
+// The viewer parameter identifies who viewed it, such as a user ID or a client address.
+func (c *ViewCounter) CountView(article, viewer string) {
+	now := c.now().UTC()
+	key := viewKey{article, viewer}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if lastView, ok := c.seen[key]; ok && now.Sub(lastView) < c.window {
+		return
+	}
+	c.seen[key] = now
+	c.pending[bucketKey{article, models.Day(now)}]++
+	if len(c.pending) >= c.batchSize {
+		select {
+		case c.full <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// Run flushes buffered views every interval, or sooner when a batch fills up, until ctx is cancelled.
+// Views still buffered when ctx is cancelled are flushed before returning.
+func (c *ViewCounter) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			c.Flush(context.WithoutCancel(ctx))
+			return
+		case <-ticker.C:
+		case <-c.full:
+		}
+		c.Flush(ctx)
+	}
+}
+
+// Flush writes the buffered views to the database and forgets viewers whose dedup window is over.
+//
+// Views that fail to be written are put back in the buffer and retried on the next flush. When only some of the
+// writes fail, only those are retried, so the views that were written are not counted twice.
+func (c *ViewCounter) Flush(ctx context.Context) {
+	c.mu.Lock()
+	pending := c.pending
+	c.pending = map[bucketKey]int64{}
+	now := c.now().UTC()
+	for key, lastView := range c.seen {
+		if now.Sub(lastView) >= c.window {
+			delete(c.seen, key)
+		}
+	}
+	c.mu.Unlock()
+
+	if len(pending) == 0 {
+		return
+	}
+	keys := make([]bucketKey, 0, len(pending))
+	views := make([]*models.ArticleViews, 0, len(pending))
+	for key, count := range pending {
+		keys = append(keys, key)
+		views = append(views, &models.ArticleViews{Article: &key.article, Day: &key.day, Count: &count})
+	}
+	if err := c.repository.RecordViews(ctx, views); err != nil {
+		c.logger.Error("Failed to record article views", "buckets", len(views), "error", err)
+		failed := keys
+		if bulkWriteErr := new(mongo.BulkWriteException); errors.As(err, bulkWriteErr) && bulkWriteErr.WriteConcernError == nil {
+			failed = make([]bucketKey, 0, len(bulkWriteErr.WriteErrors))
+			for _, writeErr := range bulkWriteErr.WriteErrors {
+				failed = append(failed, keys[writeErr.Index])
+			}
+		}
+		c.mu.Lock()
+		for _, key := range failed {
+			c.pending[key] += pending[key]
+		}
+		c.mu.Unlock()
+		return
+	}
+	c.logger.Debug("Recorded article views", "buckets", len(views))
+}
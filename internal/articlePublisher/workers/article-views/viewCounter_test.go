@@ -0,0 +1,178 @@
+package articleviews
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ravilock/goduit/internal/articlePublisher/models"
+	mock "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type loggerSpy struct {
+	Messages []string
+}
+
+func (l *loggerSpy) Write(p []byte) (int, error) {
+	l.Messages = append(l.Messages, string(p))
+	return len(p), nil
+}
+
+func (l *loggerSpy) Contains(message string) bool {
+	for _, m := range l.Messages {
+		if strings.Contains(m, message) {
+			return true
+		}
+	}
+	return false
+}
+
+func TestViewCounter(t *testing.T) {
+	window := 30 * time.Minute
+	batchSize := 10
+	ctx := context.Background()
+
+	t.Run("Should count repeated views of the same viewer once per window", func(t *testing.T) {
+		// Arrange
+		logSpy := new(loggerSpy)
+		viewRecorderMock := newMockViewRecorder(t)
+		counter := NewViewCounter(viewRecorderMock, window, batchSize, slog.New(slog.NewTextHandler(logSpy, &slog.HandlerOptions{Level: slog.LevelDebug})))
+		now := time.Date(2026, time.October, 18, 12, 0, 0, 0, time.UTC)
+		counter.now = func() time.Time { return now }
+		article := primitive.NewObjectID().Hex()
+		counter.CountView(article, "first-viewer")
+		counter.CountView(article, "first-viewer")
+		counter.CountView(article, "second-viewer")
+		now = now.Add(window)
+		counter.CountView(article, "first-viewer")
+		viewRecorderMock.EXPECT().RecordViews(ctx, mock.MatchedBy(func(views []*models.ArticleViews) bool {
+			return len(views) == 1 && *views[0].Article == article && *views[0].Count == 3 && views[0].Day.Equal(models.Day(now))
+		})).Return(nil).Once()
+
+		// Act
+		counter.Flush(ctx)
+
+		// Assert
+		require.True(t, logSpy.Contains("Recorded article views"))
+	})
+
+	t.Run("Should bucket views by day", func(t *testing.T) {
+		// Arrange
+		viewRecorderMock := newMockViewRecorder(t)
+		counter := NewViewCounter(viewRecorderMock, window, batchSize, slog.New(slog.NewTextHandler(new(loggerSpy), nil)))
+		now := time.Date(2026, time.October, 18, 23, 50, 0, 0, time.UTC)
+		counter.now = func() time.Time { return now }
+		article := primitive.NewObjectID().Hex()
+		counter.CountView(article, "first-viewer")
+		now = now.Add(window)
+		counter.CountView(article, "first-viewer")
+		viewRecorderMock.EXPECT().RecordViews(ctx, mock.MatchedBy(func(views []*models.ArticleViews) bool {
+			return len(views) == 2 && *views[0].Count == 1 && *views[1].Count == 1 && !views[0].Day.Equal(*views[1].Day)
+		})).Return(nil).Once()
+
+		// Act
+		counter.Flush(ctx)
+
+		// Assert
+		require.Empty(t, counter.pending)
+	})
+
+	t.Run("Should keep views that failed to be recorded for the next flush", func(t *testing.T) {
+		// Arrange
+		logSpy := new(loggerSpy)
+		viewRecorderMock := newMockViewRecorder(t)
+		counter := NewViewCounter(viewRecorderMock, window, batchSize, slog.New(slog.NewTextHandler(logSpy, nil)))
+		article := primitive.NewObjectID().Hex()
+		counter.CountView(article, "first-viewer")
+		viewRecorderMock.EXPECT().RecordViews(ctx, mock.AnythingOfType("[]*models.ArticleViews")).Return(errors.New("unexpected error")).Once()
+		counter.Flush(ctx)
+		counter.CountView(article, "second-viewer")
+		viewRecorderMock.EXPECT().RecordViews(ctx, mock.MatchedBy(func(views []*models.ArticleViews) bool {
+			return len(views) == 1 && *views[0].Count == 2
+		})).Return(nil).Once()
+
+		// Act
+		counter.Flush(ctx)
+
+		// Assert
+		require.True(t, logSpy.Contains("Failed to record article views"))
+	})
+
+	t.Run("Should only keep the views of the writes that failed", func(t *testing.T) {
+		// Arrange
+		viewRecorderMock := newMockViewRecorder(t)
+		counter := NewViewCounter(viewRecorderMock, window, batchSize, slog.New(slog.NewTextHandler(new(loggerSpy), nil)))
+		recordedArticle := primitive.NewObjectID().Hex()
+		failedArticle := primitive.NewObjectID().Hex()
+		counter.CountView(recordedArticle, "first-viewer")
+		counter.CountView(failedArticle, "first-viewer")
+		viewRecorderMock.EXPECT().RecordViews(ctx, mock.AnythingOfType("[]*models.ArticleViews")).RunAndReturn(func(ctx context.Context, views []*models.ArticleViews) error {
+			for i, view := range views {
+				if *view.Article == failedArticle {
+					return mongo.BulkWriteException{WriteErrors: []mongo.BulkWriteError{{WriteError: mongo.WriteError{Index: i, Message: "unexpected error"}}}}
+				}
+			}
+			return nil
+		}).Once()
+
+		// Act
+		counter.Flush(ctx)
+
+		// Assert
+		require.Len(t, counter.pending, 1)
+		for key, count := range counter.pending {
+			require.Equal(t, failedArticle, key.article)
+			require.Equal(t, int64(1), count)
+		}
+	})
+
+	t.Run("Should not write anything without views", func(t *testing.T) {
+		// Arrange
+		viewRecorderMock := newMockViewRecorder(t)
+		counter := NewViewCounter(viewRecorderMock, window, batchSize, slog.New(slog.NewTextHandler(new(loggerSpy), nil)))
+
+		// Act
+		counter.Flush(ctx)
+
+		// Assert
+		viewRecorderMock.AssertNotCalled(t, "RecordViews")
+	})
+
+	t.Run("Should flush as soon as a batch fills up", func(t *testing.T) {
+		// Arrange
+		viewRecorderMock := newMockViewRecorder(t)
+		counter := NewViewCounter(viewRecorderMock, window, 2, slog.New(slog.NewTextHandler(new(loggerSpy), nil)))
+		runCtx, cancel := context.WithCancel(ctx)
+		recorded := make(chan struct{})
+		viewRecorderMock.EXPECT().RecordViews(runCtx, mock.MatchedBy(func(views []*models.ArticleViews) bool {
+			return len(views) == 2
+		})).RunAndReturn(func(context.Context, []*models.ArticleViews) error {
+			close(recorded)
+			return nil
+		}).Once()
+		done := make(chan struct{})
+		go func() {
+			counter.Run(runCtx, time.Hour)
+			close(done)
+		}()
+
+		// Act
+		counter.CountView(primitive.NewObjectID().Hex(), "first-viewer")
+		counter.CountView(primitive.NewObjectID().Hex(), "first-viewer")
+
+		// Assert
+		select {
+		case <-recorded:
+		case <-time.After(time.Second):
+			t.Fatal("views were not flushed")
+		}
+		cancel()
+		<-done
+	})
+}
@@ -0,0 +1,40 @@
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+// ErrNotFound is returned when a store has no blob under a key.
+var ErrNotFound = errors.New("blob not found")
+
+// ErrInvalidKey is returned for keys that are empty, hidden or would escape the store, such as ones containing a path.
+var ErrInvalidKey = errors.New("invalid blob key")
+
+// Blob is the content stored under a key. Callers must close its Content.
+type Blob struct {
+	Content     io.ReadCloser
+	ContentType string
+	Size        int64
+}
+
+// Store keeps blobs of bytes under flat keys.
+type Store interface {
+	Put(ctx context.Context, key, contentType string, content []byte) error
+	Get(ctx context.Context, key string) (*Blob, error)
+	Delete(ctx context.Context, key string) error
+}
+
+// validateKey only accepts plain file names, so keys map onto the same names in every store.
+func validateKey(key string) error {
+	if key == "" || key[0] == '.' {
+		return ErrInvalidKey
+	}
+	for _, char := range key {
+		if char == '/' || char == '\\' || char < ' ' {
+			return ErrInvalidKey
+		}
+	}
+	return nil
+}
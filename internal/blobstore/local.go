@@ -0,0 +1,77 @@
+package blobstore
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"mime"
+	"os"
+	"path/filepath"
+)
+
+type localStore struct {
+	dir string
+}
+
+// NewLocalStore keeps blobs as files in a directory, creating it when missing.
+// Content types are not stored, but derived back from the extension of keys.
+func NewLocalStore(dir string) (Store, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &localStore{dir: dir}, nil
+}
+
+func (s *localStore) Put(_ context.Context, key, _ string, content []byte) error {
+	if err := validateKey(key); err != nil {
+		return err
+	}
+	// Write to a temporary file first, so readers never see a partially written blob
+	file, err := os.CreateTemp(s.dir, ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+	if _, err := file.Write(content); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(file.Name(), 0o644); err != nil {
+		return err
+	}
+	return os.Rename(file.Name(), filepath.Join(s.dir, key))
+}
+
+func (s *localStore) Get(_ context.Context, key string) (*Blob, error) {
+	if err := validateKey(key); err != nil {
+		return nil, err
+	}
+	content, err := os.ReadFile(filepath.Join(s.dir, key))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return &Blob{
+		Content:     io.NopCloser(bytes.NewReader(content)),
+		ContentType: mime.TypeByExtension(filepath.Ext(key)),
+		Size:        int64(len(content)),
+	}, nil
+}
+
+func (s *localStore) Delete(_ context.Context, key string) error {
+	if err := validateKey(key); err != nil {
+		return err
+	}
+	err := os.Remove(filepath.Join(s.dir, key))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	return err
+}
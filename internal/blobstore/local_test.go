@@ -0,0 +1,55 @@
+package blobstore
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalStore(t *testing.T) {
+	store, err := NewLocalStore(t.TempDir())
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	t.Run("Should get a blob that was put", func(t *testing.T) {
+		// Arrange
+		content := []byte("not really a png")
+
+		// Act
+		err := store.Put(ctx, "image.png", "image/png", content)
+		require.NoError(t, err)
+		blob, err := store.Get(ctx, "image.png")
+
+		// Assert
+		require.NoError(t, err)
+		defer blob.Content.Close()
+		storedContent, err := io.ReadAll(blob.Content)
+		require.NoError(t, err)
+		require.Equal(t, content, storedContent)
+		require.Equal(t, "image/png", blob.ContentType)
+		require.Equal(t, int64(len(content)), blob.Size)
+	})
+
+	t.Run("Should not find deleted blobs", func(t *testing.T) {
+		// Arrange
+		err := store.Put(ctx, "deleted.gif", "image/gif", []byte("GIF89a"))
+		require.NoError(t, err)
+
+		// Act
+		err = store.Delete(ctx, "deleted.gif")
+
+		// Assert
+		require.NoError(t, err)
+		_, err = store.Get(ctx, "deleted.gif")
+		require.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("Should refuse keys that would escape the store", func(t *testing.T) {
+		for _, key := range []string{"", "../image.png", "nested/image.png", ".hidden"} {
+			_, err := store.Get(ctx, key)
+			require.ErrorIs(t, err, ErrInvalidKey, key)
+		}
+	})
+}
@@ -0,0 +1,149 @@
+package blobstore
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const defaultRegion = "us-east-1"
+
+type s3Store struct {
+	endpoint    *url.URL
+	bucket      string
+	credentials credentials
+	client      *http.Client
+}
+
+// NewS3Store keeps blobs in a bucket of S3, or of any service compatible with it such as MinIO, creating the bucket
+// when missing. The URL holds the endpoint, the credentials, the bucket as its path and the region as a query
+// parameter, defaulting to us-east-1. Objects are addressed path-style, as most compatible services expect.
+func NewS3Store(rawURL string) (Store, error) {
+	parsedURL, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	bucket := strings.Trim(parsedURL.Path, "/")
+	if bucket == "" || strings.Contains(bucket, "/") {
+		return nil, fmt.Errorf("s3 store URL should have the bucket as its path, got %q", parsedURL.Path)
+	}
+	if parsedURL.User == nil {
+		return nil, fmt.Errorf("s3 store URL should have credentials")
+	}
+	secretKey, _ := parsedURL.User.Password()
+	region := parsedURL.Query().Get("region")
+	if region == "" {
+		region = defaultRegion
+	}
+	store := &s3Store{
+		endpoint: &url.URL{Scheme: parsedURL.Scheme, Host: parsedURL.Host},
+		bucket:   bucket,
+		credentials: credentials{
+			accessKey: parsedURL.User.Username(),
+			secretKey: secretKey,
+			region:    region,
+		},
+		client: &http.Client{Timeout: 30 * time.Second},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := store.ensureBucket(ctx); err != nil {
+		return nil, err
+	}
+	return store, nil
+}
+
+func (s *s3Store) ensureBucket(ctx context.Context) error {
+	res, err := s.do(ctx, http.MethodHead, "", "", nil)
+	if err != nil {
+		return err
+	}
+	res.Body.Close()
+	switch res.StatusCode {
+	case http.StatusOK:
+		return nil
+	case http.StatusNotFound:
+		res, err := s.do(ctx, http.MethodPut, "", "", nil)
+		if err != nil {
+			return err
+		}
+		return checkResponse(res, http.MethodPut, s.bucket)
+	default:
+		return fmt.Errorf("s3 HEAD %s: %s", s.bucket, res.Status)
+	}
+}
+
+func (s *s3Store) Put(ctx context.Context, key, contentType string, content []byte) error {
+	if err := validateKey(key); err != nil {
+		return err
+	}
+	res, err := s.do(ctx, http.MethodPut, key, contentType, content)
+	if err != nil {
+		return err
+	}
+	return checkResponse(res, http.MethodPut, key)
+}
+
+func (s *s3Store) Get(ctx context.Context, key string) (*Blob, error) {
+	if err := validateKey(key); err != nil {
+		return nil, err
+	}
+	res, err := s.do(ctx, http.MethodGet, key, "", nil)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode == http.StatusNotFound {
+		res.Body.Close()
+		return nil, ErrNotFound
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, checkResponse(res, http.MethodGet, key)
+	}
+	return &Blob{
+		Content:     res.Body,
+		ContentType: res.Header.Get("Content-Type"),
+		Size:        res.ContentLength,
+	}, nil
+}
+
+func (s *s3Store) Delete(ctx context.Context, key string) error {
+	if err := validateKey(key); err != nil {
+		return err
+	}
+	res, err := s.do(ctx, http.MethodDelete, key, "", nil)
+	if err != nil {
+		return err
+	}
+	return checkResponse(res, http.MethodDelete, key)
+}
+
+// do sends a signed request for an object of the bucket, or for the bucket itself when key is empty.
+func (s *s3Store) do(ctx context.Context, method, key, contentType string, content []byte) (*http.Response, error) {
+	objectURL := s.endpoint.JoinPath(s.bucket, key)
+	req, err := http.NewRequestWithContext(ctx, method, objectURL.String(), bytes.NewReader(content))
+	if err != nil {
+		return nil, err
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	s.credentials.sign(req, hashHex(content), time.Now())
+	return s.client.Do(req)
+}
+
+// checkResponse closes a response, turning unsuccessful ones into errors that carry what the service answered.
+func checkResponse(res *http.Response, method, key string) error {
+	defer res.Body.Close()
+	if res.StatusCode >= 200 && res.StatusCode < 300 {
+		return nil
+	}
+	message, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+	return fmt.Errorf("s3 %s %s: %s: %s", method, key, res.Status, bytes.TrimSpace(message))
+}
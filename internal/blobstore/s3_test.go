@@ -0,0 +1,102 @@
+package blobstore
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestS3Store(t *testing.T) {
+	server := newFakeS3(t)
+	defer server.Close()
+	ctx := context.Background()
+
+	t.Run("Should create the bucket when missing", func(t *testing.T) {
+		// Act
+		_, err := NewS3Store(strings.Replace(server.URL, "http://", "http://key:secret@", 1) + "/media")
+
+		// Assert
+		require.NoError(t, err)
+		require.Contains(t, server.objects, "/media")
+	})
+
+	t.Run("Should get an object that was put", func(t *testing.T) {
+		// Arrange
+		store, err := NewS3Store(strings.Replace(server.URL, "http://", "http://key:secret@", 1) + "/media?region=eu-west-1")
+		require.NoError(t, err)
+		content := []byte("not really a jpeg")
+
+		// Act
+		err = store.Put(ctx, "image.jpg", "image/jpeg", content)
+		require.NoError(t, err)
+		blob, err := store.Get(ctx, "image.jpg")
+
+		// Assert
+		require.NoError(t, err)
+		defer blob.Content.Close()
+		storedContent, err := io.ReadAll(blob.Content)
+		require.NoError(t, err)
+		require.Equal(t, content, storedContent)
+		require.Equal(t, "image/jpeg", blob.ContentType)
+		require.Contains(t, server.authorizations["/media/image.jpg"], "/eu-west-1/s3/aws4_request")
+	})
+
+	t.Run("Should not find missing objects", func(t *testing.T) {
+		// Arrange
+		store, err := NewS3Store(strings.Replace(server.URL, "http://", "http://key:secret@", 1) + "/media")
+		require.NoError(t, err)
+
+		// Act
+		_, err = store.Get(ctx, "missing.png")
+
+		// Assert
+		require.ErrorIs(t, err, ErrNotFound)
+	})
+}
+
+type fakeS3 struct {
+	*httptest.Server
+	mu             sync.Mutex
+	objects        map[string][]byte
+	contentTypes   map[string]string
+	authorizations map[string]string
+}
+
+// newFakeS3 serves buckets and objects from memory, keyed by their path.
+func newFakeS3(t *testing.T) *fakeS3 {
+	t.Helper()
+	fake := &fakeS3{objects: map[string][]byte{}, contentTypes: map[string]string{}, authorizations: map[string]string{}}
+	fake.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fake.mu.Lock()
+		defer fake.mu.Unlock()
+		if !strings.HasPrefix(r.Header.Get("Authorization"), signingAlgorithm+" Credential=key/") {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		fake.authorizations[r.URL.Path] = r.Header.Get("Authorization")
+		switch r.Method {
+		case http.MethodPut:
+			content, _ := io.ReadAll(r.Body)
+			fake.objects[r.URL.Path] = content
+			fake.contentTypes[r.URL.Path] = r.Header.Get("Content-Type")
+		case http.MethodHead, http.MethodGet:
+			content, ok := fake.objects[r.URL.Path]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Header().Set("Content-Type", fake.contentTypes[r.URL.Path])
+			_, _ = w.Write(content)
+		case http.MethodDelete:
+			delete(fake.objects, r.URL.Path)
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	return fake
+}
@@ -0,0 +1,92 @@
+package etag
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"strings"
+)
+
+const (
+	HeaderETag        = "ETag"
+	HeaderIfMatch     = "If-Match"
+	HeaderIfNoneMatch = "If-None-Match"
+)
+
+const weakPrefix = "W/"
+
+const versionSeparator = "."
+
+// FromVersion formats the version counter of a resource as a strong entity tag.
+func FromVersion(version int64) string {
+	return strconv.Quote(strconv.FormatInt(version, 10))
+}
+
+// FromRepresentation derives a strong entity tag from the version of a resource and the exact body it is sent with,
+// so the tag changes whenever the body does, even if the version did not, while If-Match can still be checked
+// against the version with MatchesVersion.
+func FromRepresentation(version int64, body []byte) string {
+	digest := sha256.Sum256(body)
+	return strconv.Quote(strconv.FormatInt(version, 10) + versionSeparator + hex.EncodeToString(digest[:8]))
+}
+
+// FromDigest derives a weak entity tag from the parts a representation is built from, such as the IDs of listed resources.
+func FromDigest(parts ...string) string {
+	digest := sha256.Sum256([]byte(strings.Join(parts, "\x00")))
+	return weakPrefix + strconv.Quote(hex.EncodeToString(digest[:8]))
+}
+
+// Matches reports whether an If-None-Match header lists the entity tag or is a wildcard, using the weak comparison:
+// tags match regardless of being weak or strong.
+func Matches(header, tag string) bool {
+	return matches(header, tag, false)
+}
+
+// MatchesStrongly reports whether an If-Match header lists the entity tag or is a wildcard, using the strong comparison:
+// weak tags never match.
+func MatchesStrongly(header, tag string) bool {
+	return matches(header, tag, true)
+}
+
+// MatchesVersion reports whether an If-Match header lists a strong entity tag of the version, whether it was derived
+// with FromVersion or FromRepresentation, or is a wildcard.
+func MatchesVersion(header string, version int64) bool {
+	if strings.TrimSpace(header) == "*" {
+		return true
+	}
+	for _, listed := range strings.Split(header, ",") {
+		listed = strings.TrimSpace(listed)
+		if strings.HasPrefix(listed, weakPrefix) {
+			continue
+		}
+		opaqueListed, err := strconv.Unquote(listed)
+		if err != nil {
+			continue
+		}
+		listedVersion, _, _ := strings.Cut(opaqueListed, versionSeparator)
+		if listedVersion == strconv.FormatInt(version, 10) {
+			return true
+		}
+	}
+	return false
+}
+
+func matches(header, tag string, strong bool) bool {
+	if strings.TrimSpace(header) == "*" {
+		return true
+	}
+	opaqueTag, weakTag := strings.CutPrefix(tag, weakPrefix)
+	if strong && weakTag {
+		return false
+	}
+	for _, listed := range strings.Split(header, ",") {
+		opaqueListed, weakListed := strings.CutPrefix(strings.TrimSpace(listed), weakPrefix)
+		if strong && weakListed {
+			continue
+		}
+		if opaqueListed == opaqueTag {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,90 @@
+package etag
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFromVersion(t *testing.T) {
+	t.Run("Should quote the version", func(t *testing.T) {
+		require.Equal(t, `"0"`, FromVersion(0))
+		require.Equal(t, `"42"`, FromVersion(42))
+	})
+}
+
+func TestFromRepresentation(t *testing.T) {
+	t.Run("Should tag the version along with the body", func(t *testing.T) {
+		tag := FromRepresentation(3, []byte("body"))
+		require.Regexp(t, `^"3\.[0-9a-f]{16}"$`, tag)
+		require.Equal(t, tag, FromRepresentation(3, []byte("body")))
+	})
+	t.Run("Should derive different tags from different bodies of the same version", func(t *testing.T) {
+		require.NotEqual(t, FromRepresentation(3, []byte("body")), FromRepresentation(3, []byte("other body")))
+	})
+}
+
+func TestFromDigest(t *testing.T) {
+	t.Run("Should derive the same weak tag from the same parts", func(t *testing.T) {
+		tag := FromDigest("first", "second")
+		require.Regexp(t, `^W/"[0-9a-f]{16}"$`, tag)
+		require.Equal(t, tag, FromDigest("first", "second"))
+	})
+	t.Run("Should derive different tags from different parts", func(t *testing.T) {
+		require.NotEqual(t, FromDigest("first", "second"), FromDigest("firstsecond"))
+		require.NotEqual(t, FromDigest("first", "second"), FromDigest("second", "first"))
+	})
+}
+
+func TestMatches(t *testing.T) {
+	t.Run("Should match a listed tag", func(t *testing.T) {
+		require.True(t, Matches(`"1"`, `"1"`))
+		require.True(t, Matches(`"0", "1" , "2"`, `"1"`))
+	})
+	t.Run("Should match a wildcard", func(t *testing.T) {
+		require.True(t, Matches(" * ", `"1"`))
+	})
+	t.Run("Should compare weak tags weakly", func(t *testing.T) {
+		require.True(t, Matches(`W/"1"`, `"1"`))
+		require.True(t, Matches(`"1"`, `W/"1"`))
+	})
+	t.Run("Should not match other tags", func(t *testing.T) {
+		require.False(t, Matches(`"2"`, `"1"`))
+		require.False(t, Matches(`1`, `"1"`))
+		require.False(t, Matches("", `"1"`))
+	})
+}
+
+func TestMatchesStrongly(t *testing.T) {
+	t.Run("Should match a listed tag", func(t *testing.T) {
+		require.True(t, MatchesStrongly(`"0", "1"`, `"1"`))
+	})
+	t.Run("Should match a wildcard", func(t *testing.T) {
+		require.True(t, MatchesStrongly("*", `"1"`))
+	})
+	t.Run("Should never match weak tags", func(t *testing.T) {
+		require.False(t, MatchesStrongly(`W/"1"`, `"1"`))
+		require.False(t, MatchesStrongly(`"1"`, `W/"1"`))
+	})
+	t.Run("Should not match other tags", func(t *testing.T) {
+		require.False(t, MatchesStrongly(`"2"`, `"1"`))
+	})
+}
+
+func TestMatchesVersion(t *testing.T) {
+	t.Run("Should match tags of the version", func(t *testing.T) {
+		require.True(t, MatchesVersion(FromVersion(3), 3))
+		require.True(t, MatchesVersion(`"1", `+FromRepresentation(3, []byte("body")), 3))
+	})
+	t.Run("Should match a wildcard", func(t *testing.T) {
+		require.True(t, MatchesVersion("*", 3))
+	})
+	t.Run("Should never match weak tags", func(t *testing.T) {
+		require.False(t, MatchesVersion(`W/"3"`, 3))
+	})
+	t.Run("Should not match tags of other versions", func(t *testing.T) {
+		require.False(t, MatchesVersion(FromRepresentation(2, []byte("body")), 3))
+		require.False(t, MatchesVersion(`"33"`, 3))
+		require.False(t, MatchesVersion(`3`, 3))
+	})
+}
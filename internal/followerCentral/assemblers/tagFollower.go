@@ -0,0 +1,18 @@
+package assemblers
+
+import "github.com/ravilock/goduit/internal/followerCentral/responses"
+
+func TagResponse(tag string, following bool) *responses.TagResponse {
+	return &responses.TagResponse{
+		Tag: responses.Tag{
+			Name:      tag,
+			Following: following,
+		},
+	}
+}
+
+func FollowedTagsResponse(tags []string) *responses.FollowedTagsResponse {
+	response := &responses.FollowedTagsResponse{Tags: make([]string, 0, len(tags))}
+	response.Tags = append(response.Tags, tags...)
+	return response
+}
@@ -0,0 +1,60 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ravilock/goduit/api"
+	"github.com/ravilock/goduit/internal/app"
+	"github.com/ravilock/goduit/internal/followerCentral/assemblers"
+	"github.com/ravilock/goduit/internal/followerCentral/requests"
+	"github.com/ravilock/goduit/internal/identity"
+)
+
+type tagFollower interface {
+	FollowTag(ctx context.Context, tag, follower string) (string, error)
+}
+
+type FollowTagHandler struct {
+	service tagFollower
+}
+
+func NewFollowTagHandler(service tagFollower) *FollowTagHandler {
+	return &FollowTagHandler{
+		service: service,
+	}
+}
+
+func (h *FollowTagHandler) FollowTag(c echo.Context) error {
+	request := new(requests.TagFollowerRequest)
+	identity := new(identity.IdentityHeaders)
+	binder := &echo.DefaultBinder{}
+	if err := binder.BindPathParams(c, request); err != nil {
+		return api.CouldNotUnmarshalBodyError
+	}
+	if err := binder.BindHeaders(c, identity); err != nil {
+		return err
+	}
+
+	if err := request.Validate(); err != nil {
+		return err
+	}
+
+	ctx := c.Request().Context()
+
+	tag, err := h.service.FollowTag(ctx, request.Name(), identity.Subject)
+	if err != nil {
+		if appError := new(app.AppError); errors.As(err, &appError) {
+			switch appError.ErrorCode {
+			case app.ConflictErrorCode:
+				return api.ConfictError
+			}
+		}
+		return err
+	}
+
+	response := assemblers.TagResponse(tag, true)
+	return c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,91 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ravilock/goduit/api"
+	"github.com/ravilock/goduit/api/validators"
+	"github.com/ravilock/goduit/internal/app"
+	followerCentralResponses "github.com/ravilock/goduit/internal/followerCentral/responses"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestFollowTag(t *testing.T) {
+	err := validators.InitValidator()
+	require.NoError(t, err)
+	tagFollowerMock := newMockTagFollower(t)
+	handler := FollowTagHandler{tagFollowerMock}
+	e := echo.New()
+
+	t.Run("Should follow a tag", func(t *testing.T) {
+		// Arrange
+		followerID := primitive.NewObjectID()
+		tag := "Technology"
+		req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/api/tags/%s/followers", tag), nil)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("tag")
+		c.SetParamValues(tag)
+		req.Header.Set("Goduit-Subject", followerID.Hex())
+		ctx := c.Request().Context()
+		tagFollowerMock.EXPECT().FollowTag(ctx, "technology", followerID.Hex()).Return("technology", nil).Once()
+
+		// Act
+		err := handler.FollowTag(c)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Code)
+		tagResponse := new(followerCentralResponses.TagResponse)
+		err = json.Unmarshal(rec.Body.Bytes(), tagResponse)
+		require.NoError(t, err)
+		require.Equal(t, "technology", tagResponse.Tag.Name)
+		require.True(t, tagResponse.Tag.Following)
+	})
+
+	t.Run("Should return HTTP 400 if tag is too short", func(t *testing.T) {
+		// Arrange
+		tag := "go"
+		req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/api/tags/%s/followers", tag), nil)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("tag")
+		c.SetParamValues(tag)
+		req.Header.Set("Goduit-Subject", primitive.NewObjectID().Hex())
+
+		// Act
+		err := handler.FollowTag(c)
+
+		// Assert
+		require.ErrorContains(t, err, api.InvalidFieldLimit("Tag", "min", "3").Error())
+	})
+
+	t.Run("Should return HTTP 409 if user already follows tag", func(t *testing.T) {
+		// Arrange
+		followerID := primitive.NewObjectID()
+		tag := "technology"
+		req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/api/tags/%s/followers", tag), nil)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("tag")
+		c.SetParamValues(tag)
+		req.Header.Set("Goduit-Subject", followerID.Hex())
+		ctx := c.Request().Context()
+		tagFollowerMock.EXPECT().FollowTag(ctx, tag, followerID.Hex()).Return("", app.ConflictError("tagFollowers")).Once()
+
+		// Act
+		err := handler.FollowTag(c)
+
+		// Assert
+		require.ErrorContains(t, err, api.ConfictError.Error())
+	})
+}
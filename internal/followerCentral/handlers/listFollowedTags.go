@@ -0,0 +1,42 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ravilock/goduit/internal/followerCentral/assemblers"
+	"github.com/ravilock/goduit/internal/identity"
+)
+
+type followedTagsLister interface {
+	ListFollowedTags(ctx context.Context, follower string) ([]string, error)
+}
+
+type ListFollowedTagsHandler struct {
+	service followedTagsLister
+}
+
+func NewListFollowedTagsHandler(service followedTagsLister) *ListFollowedTagsHandler {
+	return &ListFollowedTagsHandler{
+		service: service,
+	}
+}
+
+func (h *ListFollowedTagsHandler) ListFollowedTags(c echo.Context) error {
+	identity := new(identity.IdentityHeaders)
+	binder := &echo.DefaultBinder{}
+	if err := binder.BindHeaders(c, identity); err != nil {
+		return err
+	}
+
+	ctx := c.Request().Context()
+
+	tags, err := h.service.ListFollowedTags(ctx, identity.Subject)
+	if err != nil {
+		return err
+	}
+
+	response := assemblers.FollowedTagsResponse(tags)
+	return c.JSON(http.StatusOK, response)
+}
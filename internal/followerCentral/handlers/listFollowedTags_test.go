@@ -0,0 +1,63 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	followerCentralResponses "github.com/ravilock/goduit/internal/followerCentral/responses"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestListFollowedTags(t *testing.T) {
+	followedTagsListerMock := newMockFollowedTagsLister(t)
+	handler := ListFollowedTagsHandler{followedTagsListerMock}
+	e := echo.New()
+
+	t.Run("Should list followed tags", func(t *testing.T) {
+		// Arrange
+		followerID := primitive.NewObjectID()
+		tags := []string{"golang", "technology"}
+		req := httptest.NewRequest(http.MethodGet, "/api/tags/followed", nil)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		req.Header.Set("Goduit-Subject", followerID.Hex())
+		ctx := c.Request().Context()
+		followedTagsListerMock.EXPECT().ListFollowedTags(ctx, followerID.Hex()).Return(tags, nil).Once()
+
+		// Act
+		err := handler.ListFollowedTags(c)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Code)
+		followedTagsResponse := new(followerCentralResponses.FollowedTagsResponse)
+		err = json.Unmarshal(rec.Body.Bytes(), followedTagsResponse)
+		require.NoError(t, err)
+		require.Equal(t, tags, followedTagsResponse.Tags)
+	})
+
+	t.Run("Should return an empty list if no tag is followed", func(t *testing.T) {
+		// Arrange
+		followerID := primitive.NewObjectID()
+		req := httptest.NewRequest(http.MethodGet, "/api/tags/followed", nil)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		req.Header.Set("Goduit-Subject", followerID.Hex())
+		ctx := c.Request().Context()
+		followedTagsListerMock.EXPECT().ListFollowedTags(ctx, followerID.Hex()).Return([]string{}, nil).Once()
+
+		// Act
+		err := handler.ListFollowedTags(c)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.JSONEq(t, `{"tags":[]}`, rec.Body.String())
+	})
+}
@@ -0,0 +1,94 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package handlers
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockTagFollower is an autogenerated mock type for the tagFollower type
+type mockTagFollower struct {
+	mock.Mock
+}
+
+type mockTagFollower_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockTagFollower) EXPECT() *mockTagFollower_Expecter {
+	return &mockTagFollower_Expecter{mock: &_m.Mock}
+}
+
+// FollowTag provides a mock function with given fields: ctx, tag, follower
+func (_m *mockTagFollower) FollowTag(ctx context.Context, tag string, follower string) (string, error) {
+	ret := _m.Called(ctx, tag, follower)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FollowTag")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (string, error)); ok {
+		return rf(ctx, tag, follower)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) string); ok {
+		r0 = rf(ctx, tag, follower)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, tag, follower)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockTagFollower_FollowTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FollowTag'
+type mockTagFollower_FollowTag_Call struct {
+	*mock.Call
+}
+
+// FollowTag is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tag string
+//   - follower string
+func (_e *mockTagFollower_Expecter) FollowTag(ctx interface{}, tag interface{}, follower interface{}) *mockTagFollower_FollowTag_Call {
+	return &mockTagFollower_FollowTag_Call{Call: _e.mock.On("FollowTag", ctx, tag, follower)}
+}
+
+func (_c *mockTagFollower_FollowTag_Call) Run(run func(ctx context.Context, tag string, follower string)) *mockTagFollower_FollowTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *mockTagFollower_FollowTag_Call) Return(_a0 string, _a1 error) *mockTagFollower_FollowTag_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *mockTagFollower_FollowTag_Call) RunAndReturn(run func(context.Context, string, string) (string, error)) *mockTagFollower_FollowTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockTagFollower creates a new instance of mockTagFollower. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockTagFollower(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockTagFollower {
+	mock := &mockTagFollower{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
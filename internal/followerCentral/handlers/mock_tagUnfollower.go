@@ -0,0 +1,94 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package handlers
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockTagUnfollower is an autogenerated mock type for the tagUnfollower type
+type mockTagUnfollower struct {
+	mock.Mock
+}
+
+type mockTagUnfollower_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockTagUnfollower) EXPECT() *mockTagUnfollower_Expecter {
+	return &mockTagUnfollower_Expecter{mock: &_m.Mock}
+}
+
+// UnfollowTag provides a mock function with given fields: ctx, tag, follower
+func (_m *mockTagUnfollower) UnfollowTag(ctx context.Context, tag string, follower string) (string, error) {
+	ret := _m.Called(ctx, tag, follower)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnfollowTag")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (string, error)); ok {
+		return rf(ctx, tag, follower)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) string); ok {
+		r0 = rf(ctx, tag, follower)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, tag, follower)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockTagUnfollower_UnfollowTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnfollowTag'
+type mockTagUnfollower_UnfollowTag_Call struct {
+	*mock.Call
+}
+
+// UnfollowTag is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tag string
+//   - follower string
+func (_e *mockTagUnfollower_Expecter) UnfollowTag(ctx interface{}, tag interface{}, follower interface{}) *mockTagUnfollower_UnfollowTag_Call {
+	return &mockTagUnfollower_UnfollowTag_Call{Call: _e.mock.On("UnfollowTag", ctx, tag, follower)}
+}
+
+func (_c *mockTagUnfollower_UnfollowTag_Call) Run(run func(ctx context.Context, tag string, follower string)) *mockTagUnfollower_UnfollowTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *mockTagUnfollower_UnfollowTag_Call) Return(_a0 string, _a1 error) *mockTagUnfollower_UnfollowTag_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *mockTagUnfollower_UnfollowTag_Call) RunAndReturn(run func(context.Context, string, string) (string, error)) *mockTagUnfollower_UnfollowTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockTagUnfollower creates a new instance of mockTagUnfollower. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockTagUnfollower(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockTagUnfollower {
+	mock := &mockTagUnfollower{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,52 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ravilock/goduit/api"
+	"github.com/ravilock/goduit/internal/followerCentral/assemblers"
+	"github.com/ravilock/goduit/internal/followerCentral/requests"
+	"github.com/ravilock/goduit/internal/identity"
+)
+
+type tagUnfollower interface {
+	UnfollowTag(ctx context.Context, tag, follower string) (string, error)
+}
+
+type UnfollowTagHandler struct {
+	service tagUnfollower
+}
+
+func NewUnfollowTagHandler(service tagUnfollower) *UnfollowTagHandler {
+	return &UnfollowTagHandler{
+		service: service,
+	}
+}
+
+func (h *UnfollowTagHandler) UnfollowTag(c echo.Context) error {
+	request := new(requests.TagFollowerRequest)
+	identity := new(identity.IdentityHeaders)
+	binder := &echo.DefaultBinder{}
+	if err := binder.BindPathParams(c, request); err != nil {
+		return api.CouldNotUnmarshalBodyError
+	}
+	if err := binder.BindHeaders(c, identity); err != nil {
+		return err
+	}
+
+	if err := request.Validate(); err != nil {
+		return err
+	}
+
+	ctx := c.Request().Context()
+
+	tag, err := h.service.UnfollowTag(ctx, request.Name(), identity.Subject)
+	if err != nil {
+		return err
+	}
+
+	response := assemblers.TagResponse(tag, false)
+	return c.JSON(http.StatusOK, response)
+}
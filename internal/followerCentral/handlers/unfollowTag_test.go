@@ -0,0 +1,73 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ravilock/goduit/api/validators"
+	followerCentralResponses "github.com/ravilock/goduit/internal/followerCentral/responses"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestUnfollowTag(t *testing.T) {
+	err := validators.InitValidator()
+	require.NoError(t, err)
+	tagUnfollowerMock := newMockTagUnfollower(t)
+	handler := UnfollowTagHandler{tagUnfollowerMock}
+	e := echo.New()
+
+	t.Run("Should unfollow a tag", func(t *testing.T) {
+		// Arrange
+		followerID := primitive.NewObjectID()
+		tag := "Technology"
+		req := httptest.NewRequest(http.MethodDelete, fmt.Sprintf("/api/tags/%s/followers", tag), nil)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("tag")
+		c.SetParamValues(tag)
+		req.Header.Set("Goduit-Subject", followerID.Hex())
+		ctx := c.Request().Context()
+		tagUnfollowerMock.EXPECT().UnfollowTag(ctx, "technology", followerID.Hex()).Return("technology", nil).Once()
+
+		// Act
+		err := handler.UnfollowTag(c)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Code)
+		tagResponse := new(followerCentralResponses.TagResponse)
+		err = json.Unmarshal(rec.Body.Bytes(), tagResponse)
+		require.NoError(t, err)
+		require.Equal(t, "technology", tagResponse.Tag.Name)
+		require.False(t, tagResponse.Tag.Following)
+	})
+
+	t.Run("Should return error if unfollowing fails", func(t *testing.T) {
+		// Arrange
+		followerID := primitive.NewObjectID()
+		tag := "technology"
+		req := httptest.NewRequest(http.MethodDelete, fmt.Sprintf("/api/tags/%s/followers", tag), nil)
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("tag")
+		c.SetParamValues(tag)
+		req.Header.Set("Goduit-Subject", followerID.Hex())
+		ctx := c.Request().Context()
+		unexpectedError := errors.New("unexpected error")
+		tagUnfollowerMock.EXPECT().UnfollowTag(ctx, tag, followerID.Hex()).Return("", unexpectedError).Once()
+
+		// Act
+		err := handler.UnfollowTag(c)
+
+		// Assert
+		require.ErrorIs(t, err, unexpectedError)
+	})
+}
@@ -0,0 +1,12 @@
+package models
+
+import "go.mongodb.org/mongo-driver/bson/primitive"
+
+// TagFollower represents a user following a tag, receiving in their feed the articles published with it.
+//   - "Tag" represents the name of the followed tag
+//   - "Follower" represents the ID of the user that is following
+type TagFollower struct {
+	ID       *primitive.ObjectID `bson:"_id,omitempty"`
+	Tag      *string             `bson:"tag"`
+	Follower *string             `bson:"follower"`
+}
@@ -0,0 +1,127 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/ravilock/goduit/internal/app"
+	"github.com/ravilock/goduit/internal/followerCentral/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type TagFollowerRepository struct {
+	DBClient *mongo.Client
+}
+
+func NewTagFollowerRepository(client *mongo.Client) *TagFollowerRepository {
+	return &TagFollowerRepository{client}
+}
+
+// FollowTag establishes a follow relationship between a user and a tag.
+//
+// The tag parameter represents the name of the tag to be followed.
+//
+// The follower parameter represents the ID of the user that is following.
+func (r *TagFollowerRepository) FollowTag(ctx context.Context, tag, follower string) error {
+	followRelationship := models.TagFollower{Tag: &tag, Follower: &follower}
+	collection := r.DBClient.Database("conduit").Collection("tagFollowers")
+	if _, err := collection.InsertOne(ctx, followRelationship); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return app.ConflictError("tagFollowers")
+		}
+		return err
+	}
+	return nil
+}
+
+// UnfollowTag de-establishes a follow relationship between a user and a tag.
+//
+// The tag parameter represents the name of the followed tag.
+//
+// The follower parameter represents the ID of the user that is following.
+func (r *TagFollowerRepository) UnfollowTag(ctx context.Context, tag, follower string) error {
+	filter := bson.D{
+		{Key: "tag", Value: tag},
+		{Key: "follower", Value: follower},
+	}
+	collection := r.DBClient.Database("conduit").Collection("tagFollowers")
+	if _, err := collection.DeleteOne(ctx, filter); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ListFollowedTags queries for all tags that a given user follows, in alphabetical order. Returns []*models.TagFollower.
+//
+// The follower parameter represents the ID of the user that is following.
+func (r *TagFollowerRepository) ListFollowedTags(ctx context.Context, follower string) ([]*models.TagFollower, error) {
+	tagFollowers := []*models.TagFollower{}
+	filter := bson.D{
+		{Key: "follower", Value: follower},
+	}
+	opt := options.Find().SetSort(bson.D{{Key: "tag", Value: 1}})
+	collection := r.DBClient.Database("conduit").Collection("tagFollowers")
+	cursor, err := collection.Find(ctx, filter, opt)
+	if err != nil {
+		return nil, err
+	}
+	if err := cursor.All(ctx, &tagFollowers); err != nil {
+		return nil, err
+	}
+	return tagFollowers, nil
+}
+
+// GetTagFollowers queries for all followers of any of the given tags. Returns []*models.TagFollower.
+// A user following more than one of the tags is returned once per followed tag.
+//
+// The tags parameter represents the names of the followed tags.
+func (r *TagFollowerRepository) GetTagFollowers(ctx context.Context, tags []string) ([]*models.TagFollower, error) {
+	tagFollowers := []*models.TagFollower{}
+	if len(tags) == 0 {
+		return tagFollowers, nil
+	}
+	filter := bson.D{
+		{Key: "tag", Value: bson.D{{Key: "$in", Value: tags}}},
+	}
+	collection := r.DBClient.Database("conduit").Collection("tagFollowers")
+	cursor, err := collection.Find(ctx, filter, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err := cursor.All(ctx, &tagFollowers); err != nil {
+		return nil, err
+	}
+	return tagFollowers, nil
+}
+
+// ReplaceFollowedTag moves the followers of a tag to another one. Users already following both keep a single follow.
+//
+// The from parameter represents the name of the tag losing its followers.
+//
+// The to parameter represents the name of the tag gaining them.
+func (r *TagFollowerRepository) ReplaceFollowedTag(ctx context.Context, from, to string) error {
+	tagFollowers, err := r.GetTagFollowers(ctx, []string{from})
+	if err != nil {
+		return err
+	}
+	collection := r.DBClient.Database("conduit").Collection("tagFollowers")
+	if len(tagFollowers) > 0 {
+		writes := make([]mongo.WriteModel, 0, len(tagFollowers))
+		for _, tagFollower := range tagFollowers {
+			filter := bson.D{
+				{Key: "tag", Value: to},
+				{Key: "follower", Value: *tagFollower.Follower},
+			}
+			writes = append(writes, mongo.NewUpdateOneModel().
+				SetFilter(filter).
+				SetUpdate(bson.D{{Key: "$setOnInsert", Value: filter}}).
+				SetUpsert(true))
+		}
+		if _, err := collection.BulkWrite(ctx, writes, options.BulkWrite().SetOrdered(false)); err != nil {
+			return err
+		}
+	}
+	_, err = collection.DeleteMany(ctx, bson.D{{Key: "tag", Value: from}})
+	return err
+}
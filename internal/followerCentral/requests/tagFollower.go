@@ -0,0 +1,35 @@
+package requests
+
+import (
+	"errors"
+	"unicode/utf8"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/ravilock/goduit/api"
+	"github.com/ravilock/goduit/api/validators"
+	"github.com/ravilock/goduit/internal/slugger"
+)
+
+type TagFollowerRequest struct {
+	Tag string `param:"tag" validate:"required,notblank,min=3,max=30"`
+}
+
+func (r *TagFollowerRequest) Validate() error {
+	if err := validators.Validate.Struct(r); err != nil {
+		if validationErrors := new(validator.ValidationErrors); errors.As(err, validationErrors) {
+			for _, validationError := range *validationErrors {
+				return validators.ToHTTP(validationError)
+			}
+		}
+		return err
+	}
+	if length := utf8.RuneCountInString(r.Name()); length < 3 || length > 30 {
+		return api.InvalidFieldError("Tag", r.Tag)
+	}
+	return nil
+}
+
+// Name returns the tag name folded into its canonical form, the way it is stored in articles' tag lists.
+func (r *TagFollowerRequest) Name() string {
+	return slugger.Tag(r.Tag)
+}
@@ -0,0 +1,57 @@
+package requests
+
+import (
+	"testing"
+
+	"github.com/ravilock/goduit/api"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTagFollower(t *testing.T) {
+	t.Run("Valid request should not return errors", func(t *testing.T) {
+		request := generateTagFollowerRequest()
+		err := request.Validate()
+		require.NoError(t, err)
+	})
+	t.Run("Tag is required", func(t *testing.T) {
+		request := generateTagFollowerRequest()
+		request.Tag = ""
+		err := request.Validate()
+		require.ErrorContains(t, err, api.RequiredFieldError("Tag").Error())
+	})
+	t.Run("Tag should not be blank", func(t *testing.T) {
+		request := generateTagFollowerRequest()
+		request.Tag = "   "
+		err := request.Validate()
+		require.ErrorContains(t, err, api.RequiredFieldError("Tag").Error())
+	})
+	t.Run("Tag should contain at least 3 chars", func(t *testing.T) {
+		request := generateTagFollowerRequest()
+		request.Tag = "go"
+		err := request.Validate()
+		require.ErrorContains(t, err, api.InvalidFieldLimit("Tag", "min", "3").Error())
+	})
+	t.Run("Tag should contain at most 30 chars", func(t *testing.T) {
+		request := generateTagFollowerRequest()
+		request.Tag = randomString(31)
+		err := request.Validate()
+		require.ErrorContains(t, err, api.InvalidFieldLimit("Tag", "max", "30").Error())
+	})
+	t.Run("Tag should contain at least 3 chars once canonical", func(t *testing.T) {
+		request := generateTagFollowerRequest()
+		request.Tag = "!!go!!"
+		err := request.Validate()
+		require.ErrorContains(t, err, api.InvalidFieldError("Tag", "!!go!!").Error())
+	})
+	t.Run("Tag name should be folded into its canonical form", func(t *testing.T) {
+		request := generateTagFollowerRequest()
+		request.Tag = "Go Lang"
+		require.Equal(t, "go-lang", request.Name())
+	})
+}
+
+func generateTagFollowerRequest() *TagFollowerRequest {
+	return &TagFollowerRequest{
+		Tag: "technology",
+	}
+}
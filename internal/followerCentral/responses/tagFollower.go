@@ -0,0 +1,14 @@
+package responses
+
+type TagResponse struct {
+	Tag Tag `json:"tag"`
+}
+
+type Tag struct {
+	Name      string `json:"name"`
+	Following bool   `json:"following"`
+}
+
+type FollowedTagsResponse struct {
+	Tags []string `json:"tags"`
+}
@@ -0,0 +1,40 @@
+package services
+
+import "context"
+
+type tagFollower interface {
+	FollowTag(ctx context.Context, tag, follower string) error
+}
+
+type tagResolver interface {
+	ResolveTags(ctx context.Context, tags []string) ([]string, error)
+}
+
+type FollowTagService struct {
+	repository tagFollower
+	tags       tagResolver
+}
+
+func NewFollowTagService(repository tagFollower, tags tagResolver) *FollowTagService {
+	return &FollowTagService{
+		repository: repository,
+		tags:       tags,
+	}
+}
+
+// FollowTag establishes a follow relationship between a user and a tag. Aliases are followed through their canonical tag.
+// Returns the name of the followed tag.
+//
+// The tag parameter represents the canonical name of the tag to be followed.
+//
+// The follower parameter represents the ID of the user that is following.
+func (s *FollowTagService) FollowTag(ctx context.Context, tag, follower string) (string, error) {
+	resolvedTags, err := s.tags.ResolveTags(ctx, []string{tag})
+	if err != nil {
+		return "", err
+	}
+	if err := s.repository.FollowTag(ctx, resolvedTags[0], follower); err != nil {
+		return "", err
+	}
+	return resolvedTags[0], nil
+}
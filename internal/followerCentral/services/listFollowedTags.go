@@ -0,0 +1,36 @@
+package services
+
+import (
+	"context"
+
+	"github.com/ravilock/goduit/internal/followerCentral/models"
+)
+
+type followedTagsLister interface {
+	ListFollowedTags(ctx context.Context, follower string) ([]*models.TagFollower, error)
+}
+
+type ListFollowedTagsService struct {
+	repository followedTagsLister
+}
+
+func NewListFollowedTagsService(repository followedTagsLister) *ListFollowedTagsService {
+	return &ListFollowedTagsService{
+		repository: repository,
+	}
+}
+
+// ListFollowedTags lists the names of the tags a user follows, in alphabetical order.
+//
+// The follower parameter represents the ID of the user that is following.
+func (s *ListFollowedTagsService) ListFollowedTags(ctx context.Context, follower string) ([]string, error) {
+	tagFollowers, err := s.repository.ListFollowedTags(ctx, follower)
+	if err != nil {
+		return nil, err
+	}
+	tags := make([]string, 0, len(tagFollowers))
+	for _, tagFollower := range tagFollowers {
+		tags = append(tags, *tagFollower.Tag)
+	}
+	return tags, nil
+}
@@ -0,0 +1,96 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package services
+
+import (
+	context "context"
+
+	models "github.com/ravilock/goduit/internal/followerCentral/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockFollowedTagsLister is an autogenerated mock type for the followedTagsLister type
+type mockFollowedTagsLister struct {
+	mock.Mock
+}
+
+type mockFollowedTagsLister_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockFollowedTagsLister) EXPECT() *mockFollowedTagsLister_Expecter {
+	return &mockFollowedTagsLister_Expecter{mock: &_m.Mock}
+}
+
+// ListFollowedTags provides a mock function with given fields: ctx, follower
+func (_m *mockFollowedTagsLister) ListFollowedTags(ctx context.Context, follower string) ([]*models.TagFollower, error) {
+	ret := _m.Called(ctx, follower)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListFollowedTags")
+	}
+
+	var r0 []*models.TagFollower
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*models.TagFollower, error)); ok {
+		return rf(ctx, follower)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*models.TagFollower); ok {
+		r0 = rf(ctx, follower)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.TagFollower)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, follower)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockFollowedTagsLister_ListFollowedTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListFollowedTags'
+type mockFollowedTagsLister_ListFollowedTags_Call struct {
+	*mock.Call
+}
+
+// ListFollowedTags is a helper method to define mock.On call
+//   - ctx context.Context
+//   - follower string
+func (_e *mockFollowedTagsLister_Expecter) ListFollowedTags(ctx interface{}, follower interface{}) *mockFollowedTagsLister_ListFollowedTags_Call {
+	return &mockFollowedTagsLister_ListFollowedTags_Call{Call: _e.mock.On("ListFollowedTags", ctx, follower)}
+}
+
+func (_c *mockFollowedTagsLister_ListFollowedTags_Call) Run(run func(ctx context.Context, follower string)) *mockFollowedTagsLister_ListFollowedTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *mockFollowedTagsLister_ListFollowedTags_Call) Return(_a0 []*models.TagFollower, _a1 error) *mockFollowedTagsLister_ListFollowedTags_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *mockFollowedTagsLister_ListFollowedTags_Call) RunAndReturn(run func(context.Context, string) ([]*models.TagFollower, error)) *mockFollowedTagsLister_ListFollowedTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockFollowedTagsLister creates a new instance of mockFollowedTagsLister. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockFollowedTagsLister(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockFollowedTagsLister {
+	mock := &mockFollowedTagsLister{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,36 @@
+package services
+
+import "context"
+
+type tagUnfollower interface {
+	UnfollowTag(ctx context.Context, tag, follower string) error
+}
+
+type UnfollowTagService struct {
+	repository tagUnfollower
+	tags       tagResolver
+}
+
+func NewUnfollowTagService(repository tagUnfollower, tags tagResolver) *UnfollowTagService {
+	return &UnfollowTagService{
+		repository: repository,
+		tags:       tags,
+	}
+}
+
+// UnfollowTag de-establishes a follow relationship between a user and a tag. Aliases are unfollowed through their
+// canonical tag. Returns the name of the unfollowed tag.
+//
+// The tag parameter represents the canonical name of the followed tag.
+//
+// The follower parameter represents the ID of the user that is following.
+func (s *UnfollowTagService) UnfollowTag(ctx context.Context, tag, follower string) (string, error) {
+	resolvedTags, err := s.tags.ResolveTags(ctx, []string{tag})
+	if err != nil {
+		return "", err
+	}
+	if err := s.repository.UnfollowTag(ctx, resolvedTags[0], follower); err != nil {
+		return "", err
+	}
+	return resolvedTags[0], nil
+}
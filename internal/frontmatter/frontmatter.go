@@ -0,0 +1,65 @@
+package frontmatter
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Delimiter opens and closes the YAML front matter of a document.
+const Delimiter = "---"
+
+// ErrMissingFrontMatter is returned when a document does not start with a front matter block.
+var ErrMissingFrontMatter = errors.New("document does not start with front matter")
+
+// Marshal writes a document made of the YAML front matter of meta followed by body.
+func Marshal(meta any, body string) ([]byte, error) {
+	header, err := yaml.Marshal(meta)
+	if err != nil {
+		return nil, err
+	}
+	document := new(bytes.Buffer)
+	document.WriteString(Delimiter + "\n")
+	document.Write(header)
+	document.WriteString(Delimiter + "\n\n")
+	document.WriteString(body)
+	if len(body) > 0 && body[len(body)-1] != '\n' {
+		document.WriteByte('\n')
+	}
+	return document.Bytes(), nil
+}
+
+// Unmarshal decodes the YAML front matter of a document into meta and returns the body that follows it.
+// Blank lines between the front matter and the body are dropped, as are the trailing line breaks of the body.
+func Unmarshal(document []byte, meta any) (string, error) {
+	document = bytes.TrimPrefix(document, []byte("\ufeff"))
+	document = bytes.ReplaceAll(document, []byte("\r\n"), []byte("\n"))
+	rest, ok := bytes.CutPrefix(document, []byte(Delimiter+"\n"))
+	if !ok {
+		return "", ErrMissingFrontMatter
+	}
+	header, rest, found := cutClosingDelimiter(rest)
+	if !found {
+		return "", fmt.Errorf("front matter is not closed by %q", Delimiter)
+	}
+	if err := yaml.Unmarshal(header, meta); err != nil {
+		return "", fmt.Errorf("could not parse front matter: %w", err)
+	}
+	body := bytes.TrimLeft(rest, "\n")
+	body = bytes.TrimRight(body, "\n")
+	return string(body), nil
+}
+
+// cutClosingDelimiter splits a document right after its opening delimiter into the front matter and what follows the closing delimiter.
+func cutClosingDelimiter(document []byte) (header, rest []byte, found bool) {
+	for offset := 0; offset < len(document); {
+		line, next, _ := bytes.Cut(document[offset:], []byte("\n"))
+		if string(line) == Delimiter {
+			return document[:offset], next, true
+		}
+		offset += len(line) + 1
+	}
+	return nil, nil, false
+}
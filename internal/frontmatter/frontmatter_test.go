@@ -0,0 +1,71 @@
+package frontmatter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testMeta struct {
+	Title     string     `yaml:"title"`
+	Tags      []string   `yaml:"tags"`
+	CreatedAt *time.Time `yaml:"createdAt,omitempty"`
+}
+
+func TestMarshal(t *testing.T) {
+	t.Run("Should write the front matter before the body", func(t *testing.T) {
+		document, err := Marshal(testMeta{Title: "Hello, World", Tags: []string{"golang"}}, "# Hello\n\nWorld")
+		require.NoError(t, err)
+		require.Equal(t, "---\ntitle: Hello, World\ntags:\n    - golang\n---\n\n# Hello\n\nWorld\n", string(document))
+	})
+}
+
+func TestUnmarshal(t *testing.T) {
+	t.Run("Should read back a marshalled document", func(t *testing.T) {
+		createdAt := time.Date(2024, time.March, 1, 10, 30, 0, 0, time.UTC)
+		document, err := Marshal(testMeta{Title: "Hello", Tags: []string{"golang", "testing"}, CreatedAt: &createdAt}, "Body\n\n---\n\nMore body")
+		require.NoError(t, err)
+
+		meta := new(testMeta)
+		body, err := Unmarshal(document, meta)
+		require.NoError(t, err)
+		require.Equal(t, "Hello", meta.Title)
+		require.Equal(t, []string{"golang", "testing"}, meta.Tags)
+		require.True(t, createdAt.Equal(*meta.CreatedAt))
+		require.Equal(t, "Body\n\n---\n\nMore body", body)
+	})
+	t.Run("Should accept Windows line breaks and a byte order mark", func(t *testing.T) {
+		meta := new(testMeta)
+		body, err := Unmarshal([]byte("\ufeff---\r\ntitle: Hello\r\n---\r\nBody\r\n"), meta)
+		require.NoError(t, err)
+		require.Equal(t, "Hello", meta.Title)
+		require.Equal(t, "Body", body)
+	})
+	t.Run("Should accept an empty front matter", func(t *testing.T) {
+		meta := new(testMeta)
+		body, err := Unmarshal([]byte("---\n---\nBody"), meta)
+		require.NoError(t, err)
+		require.Empty(t, meta.Title)
+		require.Equal(t, "Body", body)
+	})
+	t.Run("Should accept a document without body", func(t *testing.T) {
+		meta := new(testMeta)
+		body, err := Unmarshal([]byte("---\ntitle: Hello\n---"), meta)
+		require.NoError(t, err)
+		require.Equal(t, "Hello", meta.Title)
+		require.Empty(t, body)
+	})
+	t.Run("Should fail if the document has no front matter", func(t *testing.T) {
+		_, err := Unmarshal([]byte("# Hello"), new(testMeta))
+		require.ErrorIs(t, err, ErrMissingFrontMatter)
+	})
+	t.Run("Should fail if the front matter is not closed", func(t *testing.T) {
+		_, err := Unmarshal([]byte("---\ntitle: Hello\n# Hello"), new(testMeta))
+		require.ErrorContains(t, err, "not closed")
+	})
+	t.Run("Should fail if the front matter is not valid YAML", func(t *testing.T) {
+		_, err := Unmarshal([]byte("---\ntitle: [Hello\n---\nBody"), new(testMeta))
+		require.ErrorContains(t, err, "could not parse front matter")
+	})
+}
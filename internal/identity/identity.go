@@ -35,7 +35,12 @@ type IdentityHeaders struct {
 func CreateAuthMiddleware(requiredAuthentication bool) echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
-			authHeader := c.Request().Header.Get("Authorization")
+			// The identity headers are only trusted when set from a verified token, never as sent by the client
+			headers := c.Request().Header
+			headers.Del("Goduit-Subject")
+			headers.Del("Goduit-Client-Username")
+			headers.Del("Goduit-Client-Email")
+			authHeader := headers.Get("Authorization")
 			cookie, err := c.Cookie(cookie.CookieKey)
 			if err != nil && !errors.Is(err, http.ErrNoCookie) {
 				if requiredAuthentication {
@@ -63,7 +68,6 @@ func CreateAuthMiddleware(requiredAuthentication bool) echo.MiddlewareFunc {
 			if err != nil {
 				return api.FailedAuthentication
 			}
-			headers := c.Request().Header
 			headers.Set("Goduit-Subject", identity.Subject)
 			headers.Set("Goduit-Client-Username", identity.Username)
 			headers.Set("Goduit-Client-Email", identity.UserEmail)
@@ -72,6 +76,25 @@ func CreateAuthMiddleware(requiredAuthentication bool) echo.MiddlewareFunc {
 	}
 }
 
+// CreateAdminMiddleware only lets through users whose username is among admins. It relies on the identity headers, so it
+// must run after an authentication middleware that requires authentication.
+func CreateAdminMiddleware(admins []string) echo.MiddlewareFunc {
+	allowedUsernames := make(map[string]bool, len(admins))
+	for _, admin := range admins {
+		if admin = strings.TrimSpace(admin); admin != "" {
+			allowedUsernames[admin] = true
+		}
+	}
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if !allowedUsernames[c.Request().Header.Get("Goduit-Client-Username")] {
+				return api.Forbidden
+			}
+			return next(c)
+		}
+	}
+}
+
 func GenerateToken(userEmail, username, userID string) (string, error) {
 	now := time.Now().UTC().Truncate(time.Millisecond)
 	token := jwt.NewWithClaims(jwt.SigningMethodRS256, &Identity{
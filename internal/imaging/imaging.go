@@ -0,0 +1,192 @@
+package imaging
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/draw"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"net/http"
+)
+
+const (
+	MIMEImageJPEG = "image/jpeg"
+	MIMEImagePNG  = "image/png"
+	MIMEImageGIF  = "image/gif"
+)
+
+const jpegQuality = 90
+
+// ErrUnsupportedFormat is returned for content that is not an image in one of the supported formats.
+var ErrUnsupportedFormat = errors.New("unsupported image format")
+
+// Extensions maps the supported content types to the file extension images of that type are stored with.
+var Extensions = map[string]string{
+	MIMEImageJPEG: ".jpg",
+	MIMEImagePNG:  ".png",
+	MIMEImageGIF:  ".gif",
+}
+
+// Sniff detects the content type of content from its first bytes, regardless of what its uploader claims it to be.
+func Sniff(content []byte) string {
+	return http.DetectContentType(content)
+}
+
+// Image is an uploaded image, re-encoded without any of the metadata it came with.
+type Image struct {
+	ContentType string
+	Content     []byte
+	Width       int
+	Height      int
+	// frame is the image as displayed, with its orientation applied, and the first frame of animations
+	frame image.Image
+}
+
+// Process sniffs, decodes and re-encodes an image, which drops EXIF and any other metadata embedded in it.
+// JPEG images are turned upright first, since the orientation they are displayed with is part of the dropped EXIF.
+// Images wider or taller than maxDimension, or with more than maxPixels pixels, are refused before being decoded,
+// so they cannot exhaust memory.
+func Process(content []byte, maxDimension, maxPixels int) (*Image, error) {
+	contentType := Sniff(content)
+	if _, ok := Extensions[contentType]; !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedFormat, contentType)
+	}
+	config, _, err := image.DecodeConfig(bytes.NewReader(content))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnsupportedFormat, err)
+	}
+	if config.Width > maxDimension || config.Height > maxDimension {
+		return nil, fmt.Errorf("%w: %dx%d is larger than %dx%d", ErrUnsupportedFormat, config.Width, config.Height, maxDimension, maxDimension)
+	}
+	// Decoding takes up to 4 bytes per pixel, and as much again to turn JPEG images upright
+	if int64(config.Width)*int64(config.Height) > int64(maxPixels) {
+		return nil, fmt.Errorf("%w: %dx%d has more than %d pixels", ErrUnsupportedFormat, config.Width, config.Height, maxPixels)
+	}
+
+	var output bytes.Buffer
+	var frame image.Image
+	switch contentType {
+	case MIMEImageJPEG:
+		decoded, err := jpeg.Decode(bytes.NewReader(content))
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrUnsupportedFormat, err)
+		}
+		frame = orient(toRGBA(decoded), jpegOrientation(content))
+		err = jpeg.Encode(&output, frame, &jpeg.Options{Quality: jpegQuality})
+		if err != nil {
+			return nil, err
+		}
+	case MIMEImagePNG:
+		decoded, err := png.Decode(bytes.NewReader(content))
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrUnsupportedFormat, err)
+		}
+		frame = decoded
+		if err := png.Encode(&output, frame); err != nil {
+			return nil, err
+		}
+	case MIMEImageGIF:
+		// Every frame is kept, so animations keep playing, while comments and application extensions are dropped
+		decoded, err := gif.DecodeAll(bytes.NewReader(content))
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrUnsupportedFormat, err)
+		}
+		frame = decoded.Image[0]
+		if err := gif.EncodeAll(&output, decoded); err != nil {
+			return nil, err
+		}
+	}
+	// Only orientation changes the dimensions an image is displayed with
+	width, height := config.Width, config.Height
+	if bounds := frame.Bounds(); contentType == MIMEImageJPEG {
+		width, height = bounds.Dx(), bounds.Dy()
+	}
+	return &Image{
+		ContentType: contentType,
+		Content:     output.Bytes(),
+		Width:       width,
+		Height:      height,
+		frame:       frame,
+	}, nil
+}
+
+// Thumbnail scales an image down to fit within a square of size pixels, keeping its aspect ratio. Images that already
+// fit are not scaled up. Thumbnails of JPEG images are JPEG images too, while the others become PNG images, keeping
+// their transparency.
+func (i *Image) Thumbnail(size int) (*Image, error) {
+	width, height := fit(i.Width, i.Height, size)
+	thumbnail := scaleDown(toRGBA(i.frame), width, height)
+	var output bytes.Buffer
+	contentType := MIMEImagePNG
+	if i.ContentType == MIMEImageJPEG {
+		contentType = MIMEImageJPEG
+		if err := jpeg.Encode(&output, thumbnail, &jpeg.Options{Quality: jpegQuality}); err != nil {
+			return nil, err
+		}
+	} else if err := png.Encode(&output, thumbnail); err != nil {
+		return nil, err
+	}
+	return &Image{
+		ContentType: contentType,
+		Content:     output.Bytes(),
+		Width:       width,
+		Height:      height,
+		frame:       thumbnail,
+	}, nil
+}
+
+// fit scales width and height down to fit within a square of size pixels, never going below a pixel.
+func fit(width, height, size int) (int, int) {
+	if width <= size && height <= size {
+		return width, height
+	}
+	if width >= height {
+		return size, max(1, height*size/width)
+	}
+	return max(1, width*size/height), size
+}
+
+func toRGBA(src image.Image) *image.RGBA {
+	if rgba, ok := src.(*image.RGBA); ok && rgba.Rect.Min == (image.Point{}) {
+		return rgba
+	}
+	bounds := src.Bounds()
+	rgba := image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(rgba, rgba.Rect, src, bounds.Min, draw.Src)
+	return rgba
+}
+
+// scaleDown resizes src to width by height pixels, each one the average of the source pixels it covers.
+// Averaging premultiplied colours keeps transparent pixels from bleeding their colour into their neighbours.
+func scaleDown(src *image.RGBA, width, height int) *image.RGBA {
+	srcWidth, srcHeight := src.Rect.Dx(), src.Rect.Dy()
+	if width == srcWidth && height == srcHeight {
+		return src
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		y0, y1 := y*srcHeight/height, max((y+1)*srcHeight/height, y*srcHeight/height+1)
+		for x := 0; x < width; x++ {
+			x0, x1 := x*srcWidth/width, max((x+1)*srcWidth/width, x*srcWidth/width+1)
+			var sum [4]int
+			for sy := y0; sy < y1; sy++ {
+				offset := src.PixOffset(x0, sy)
+				for sx := x0; sx < x1; sx++ {
+					for channel := range sum {
+						sum[channel] += int(src.Pix[offset+channel])
+					}
+					offset += 4
+				}
+			}
+			count := (y1 - y0) * (x1 - x0)
+			offset := dst.PixOffset(x, y)
+			for channel := range sum {
+				dst.Pix[offset+channel] = uint8(sum[channel] / count)
+			}
+		}
+	}
+	return dst
+}
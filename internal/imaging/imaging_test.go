@@ -0,0 +1,177 @@
+package imaging
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestProcess(t *testing.T) {
+	t.Run("Should strip EXIF from JPEG images, turning them upright", func(t *testing.T) {
+		// Arrange
+		content := withEXIFOrientation(t, mustEncodeJPEG(t, 40, 20), 6)
+		require.Equal(t, 6, jpegOrientation(content))
+
+		// Act
+		processed, err := Process(content, 100, 10000)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, MIMEImageJPEG, processed.ContentType)
+		require.NotContains(t, string(processed.Content), "Exif")
+		require.Equal(t, 20, processed.Width)
+		require.Equal(t, 40, processed.Height)
+		config, err := jpeg.DecodeConfig(bytes.NewReader(processed.Content))
+		require.NoError(t, err)
+		require.Equal(t, 20, config.Width)
+		require.Equal(t, 40, config.Height)
+	})
+
+	t.Run("Should sniff the format from the content", func(t *testing.T) {
+		// Arrange
+		content := mustEncodePNG(t, 30, 10)
+
+		// Act
+		processed, err := Process(content, 100, 10000)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, MIMEImagePNG, processed.ContentType)
+		require.Equal(t, 30, processed.Width)
+		require.Equal(t, 10, processed.Height)
+	})
+
+	t.Run("Should refuse content that is not a supported image", func(t *testing.T) {
+		_, err := Process([]byte("<svg xmlns=\"http://www.w3.org/2000/svg\"></svg>"), 100, 10000)
+		require.ErrorIs(t, err, ErrUnsupportedFormat)
+	})
+
+	t.Run("Should refuse images larger than the maximum dimension", func(t *testing.T) {
+		_, err := Process(mustEncodePNG(t, 101, 10), 100, 10000)
+		require.ErrorIs(t, err, ErrUnsupportedFormat)
+	})
+
+	t.Run("Should refuse images with more pixels than the maximum", func(t *testing.T) {
+		_, err := Process(mustEncodePNG(t, 100, 100), 100, 9999)
+		require.ErrorIs(t, err, ErrUnsupportedFormat)
+		require.ErrorContains(t, err, "pixels")
+	})
+}
+
+func TestThumbnail(t *testing.T) {
+	t.Run("Should scale images down keeping their aspect ratio", func(t *testing.T) {
+		// Arrange
+		processed, err := Process(mustEncodePNG(t, 400, 200), 1000, 1000000)
+		require.NoError(t, err)
+
+		// Act
+		thumbnail, err := processed.Thumbnail(100)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, MIMEImagePNG, thumbnail.ContentType)
+		require.Equal(t, 100, thumbnail.Width)
+		require.Equal(t, 50, thumbnail.Height)
+		config, err := png.DecodeConfig(bytes.NewReader(thumbnail.Content))
+		require.NoError(t, err)
+		require.Equal(t, 100, config.Width)
+		require.Equal(t, 50, config.Height)
+	})
+
+	t.Run("Should not scale small images up", func(t *testing.T) {
+		// Arrange
+		processed, err := Process(mustEncodeJPEG(t, 40, 20), 1000, 1000000)
+		require.NoError(t, err)
+
+		// Act
+		thumbnail, err := processed.Thumbnail(100)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, MIMEImageJPEG, thumbnail.ContentType)
+		require.Equal(t, 40, thumbnail.Width)
+		require.Equal(t, 20, thumbnail.Height)
+	})
+}
+
+func TestOrient(t *testing.T) {
+	// Arrange
+	red := color.RGBA{R: 255, A: 255}
+	blue := color.RGBA{B: 255, A: 255}
+	src := image.NewRGBA(image.Rect(0, 0, 2, 1))
+	src.Set(0, 0, red)
+	src.Set(1, 0, blue)
+
+	t.Run("Should rotate clockwise images with orientation 6", func(t *testing.T) {
+		// Act
+		dst := orient(src, 6)
+
+		// Assert
+		require.Equal(t, image.Rect(0, 0, 1, 2), dst.Rect)
+		require.Equal(t, red, dst.RGBAAt(0, 0))
+		require.Equal(t, blue, dst.RGBAAt(0, 1))
+	})
+
+	t.Run("Should rotate counterclockwise images with orientation 8", func(t *testing.T) {
+		// Act
+		dst := orient(src, 8)
+
+		// Assert
+		require.Equal(t, image.Rect(0, 0, 1, 2), dst.Rect)
+		require.Equal(t, blue, dst.RGBAAt(0, 0))
+		require.Equal(t, red, dst.RGBAAt(0, 1))
+	})
+
+	t.Run("Should mirror images with orientation 2", func(t *testing.T) {
+		// Act
+		dst := orient(src, 2)
+
+		// Assert
+		require.Equal(t, blue, dst.RGBAAt(0, 0))
+		require.Equal(t, red, dst.RGBAAt(1, 0))
+	})
+}
+
+func mustEncodeJPEG(t *testing.T, width, height int) []byte {
+	t.Helper()
+	var output bytes.Buffer
+	err := jpeg.Encode(&output, image.NewRGBA(image.Rect(0, 0, width, height)), nil)
+	require.NoError(t, err)
+	return output.Bytes()
+}
+
+func mustEncodePNG(t *testing.T, width, height int) []byte {
+	t.Helper()
+	var output bytes.Buffer
+	err := png.Encode(&output, image.NewRGBA(image.Rect(0, 0, width, height)))
+	require.NoError(t, err)
+	return output.Bytes()
+}
+
+// withEXIFOrientation inserts an APP1 segment with a big endian EXIF orientation right after the start of a JPEG image.
+func withEXIFOrientation(t *testing.T, content []byte, orientation uint16) []byte {
+	t.Helper()
+	var tiff bytes.Buffer
+	tiff.WriteString("MM")
+	_ = binary.Write(&tiff, binary.BigEndian, uint16(42))
+	_ = binary.Write(&tiff, binary.BigEndian, uint32(8))
+	_ = binary.Write(&tiff, binary.BigEndian, uint16(1))
+	_ = binary.Write(&tiff, binary.BigEndian, []uint16{tagOrientation, typeShort})
+	_ = binary.Write(&tiff, binary.BigEndian, uint32(1))
+	_ = binary.Write(&tiff, binary.BigEndian, []uint16{orientation, 0})
+	_ = binary.Write(&tiff, binary.BigEndian, uint32(0))
+	segment := append(append([]byte{}, exifHeader...), tiff.Bytes()...)
+	var output bytes.Buffer
+	output.Write(content[:2])
+	output.Write([]byte{0xFF, markerAPP1})
+	_ = binary.Write(&output, binary.BigEndian, uint16(len(segment)+2))
+	output.Write(segment)
+	output.Write(content[2:])
+	return output.Bytes()
+}
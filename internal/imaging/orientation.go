@@ -0,0 +1,124 @@
+package imaging
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+)
+
+const (
+	markerSOI       = 0xD8
+	markerSOS       = 0xDA
+	markerAPP1      = 0xE1
+	tagOrientation  = 0x0112
+	typeShort       = 3
+	ifdEntryLength  = 12
+	orientationNone = 1
+)
+
+var exifHeader = []byte("Exif\x00\x00")
+
+// jpegOrientation reads the EXIF orientation of a JPEG image, from 1 to 8 as the EXIF specification numbers them.
+// Images without EXIF, or with EXIF that cannot be read, are taken as already upright.
+func jpegOrientation(content []byte) int {
+	if len(content) < 4 || content[0] != 0xFF || content[1] != markerSOI {
+		return orientationNone
+	}
+	for offset := 2; offset+4 <= len(content); {
+		if content[offset] != 0xFF {
+			return orientationNone
+		}
+		marker := content[offset+1]
+		if marker == 0xFF {
+			offset++
+			continue
+		}
+		if marker == markerSOS {
+			return orientationNone
+		}
+		length := int(binary.BigEndian.Uint16(content[offset+2:]))
+		end := offset + 2 + length
+		if length < 2 || end > len(content) {
+			return orientationNone
+		}
+		segment := content[offset+4 : end]
+		if marker == markerAPP1 && bytes.HasPrefix(segment, exifHeader) {
+			return exifOrientation(segment[len(exifHeader):])
+		}
+		offset = end
+	}
+	return orientationNone
+}
+
+// exifOrientation looks the orientation tag up in the first image file directory of a TIFF structure.
+func exifOrientation(tiff []byte) int {
+	if len(tiff) < 8 {
+		return orientationNone
+	}
+	var order binary.ByteOrder
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return orientationNone
+	}
+	ifd := int(order.Uint32(tiff[4:]))
+	if ifd < 8 || ifd+2 > len(tiff) {
+		return orientationNone
+	}
+	entries := int(order.Uint16(tiff[ifd:]))
+	for i := 0; i < entries; i++ {
+		entry := ifd + 2 + i*ifdEntryLength
+		if entry+ifdEntryLength > len(tiff) {
+			return orientationNone
+		}
+		if order.Uint16(tiff[entry:]) != tagOrientation || order.Uint16(tiff[entry+2:]) != typeShort {
+			continue
+		}
+		orientation := int(order.Uint16(tiff[entry+8:]))
+		if orientation < 1 || orientation > 8 {
+			return orientationNone
+		}
+		return orientation
+	}
+	return orientationNone
+}
+
+// orient turns an image upright according to its EXIF orientation, mirroring and rotating it as needed.
+func orient(src *image.RGBA, orientation int) *image.RGBA {
+	if orientation <= orientationNone || orientation > 8 {
+		return src
+	}
+	width, height := src.Rect.Dx(), src.Rect.Dy()
+	// Orientations 5 to 8 are rotated by a quarter turn, so their width and height swap
+	dstWidth, dstHeight := width, height
+	if orientation >= 5 {
+		dstWidth, dstHeight = height, width
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, dstWidth, dstHeight))
+	for y := 0; y < dstHeight; y++ {
+		for x := 0; x < dstWidth; x++ {
+			var sx, sy int
+			switch orientation {
+			case 2:
+				sx, sy = width-1-x, y
+			case 3:
+				sx, sy = width-1-x, height-1-y
+			case 4:
+				sx, sy = x, height-1-y
+			case 5:
+				sx, sy = y, x
+			case 6:
+				sx, sy = y, height-1-x
+			case 7:
+				sx, sy = width-1-y, height-1-x
+			case 8:
+				sx, sy = width-1-y, x
+			}
+			copy(dst.Pix[dst.PixOffset(x, y):dst.PixOffset(x, y)+4], src.Pix[src.PixOffset(sx, sy):src.PixOffset(sx, sy)+4])
+		}
+	}
+	return dst
+}
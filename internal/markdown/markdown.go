@@ -0,0 +1,197 @@
+package markdown
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"strings"
+
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/ravilock/goduit/internal/slugger"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+var converter = goldmark.New(
+	goldmark.WithExtensions(extension.GFM),
+	goldmark.WithParserOptions(
+		parser.WithAutoHeadingID(),
+		parser.WithASTTransformers(util.Prioritized(headingAnchors{}, 1000)),
+	),
+)
+
+var policy = newPolicy()
+
+func newPolicy() *bluemonday.Policy {
+	policy := bluemonday.UGCPolicy()
+	policy.RequireNoFollowOnLinks(false)
+	policy.RequireNoFollowOnFullyQualifiedLinks(true)
+	policy.AllowAttrs("id").Matching(bluemonday.SpaceSeparatedTokens).OnElements("h1", "h2", "h3", "h4", "h5", "h6")
+	policy.AllowAttrs("class").Matching(bluemonday.SpaceSeparatedTokens).OnElements("a", "nav")
+	policy.AllowElements("nav")
+	return policy
+}
+
+// tocEntry is an entry of the table of contents of a rendered document.
+type tocEntry struct {
+	Level int
+	ID    string
+	Text  string
+}
+
+// Render converts a Markdown document to sanitised HTML.
+//
+// Every heading gets an id derived from its text and a leading anchor link to itself, and documents with headings
+// are prefixed by a table of contents linking to them. Raw HTML in the source is dropped, and whatever is left
+// is run through a user generated content policy, so the result is safe to embed as is.
+func Render(source string) (string, error) {
+	src := []byte(source)
+	document := converter.Parser().Parse(text.NewReader(src), parser.WithContext(parser.NewContext(parser.WithIDs(newHeadingIDs()))))
+	var body bytes.Buffer
+	if err := converter.Renderer().Render(&body, src, document); err != nil {
+		return "", fmt.Errorf("could not render markdown: %w", err)
+	}
+	var output bytes.Buffer
+	output.WriteString(tableOfContents(listHeadings(document, src)))
+	output.Write(body.Bytes())
+	return policy.Sanitize(output.String()), nil
+}
+
+// listHeadings lists the headings of a parsed document in order of appearance.
+func listHeadings(document ast.Node, source []byte) []tocEntry {
+	var headings []tocEntry
+	_ = ast.Walk(document, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		heading, ok := node.(*ast.Heading)
+		if !entering || !ok {
+			return ast.WalkContinue, nil
+		}
+		id, _ := heading.AttributeString("id")
+		idBytes, _ := id.([]byte)
+		headings = append(headings, tocEntry{Level: heading.Level, ID: string(idBytes), Text: plainText(heading, source)})
+		return ast.WalkSkipChildren, nil
+	})
+	return headings
+}
+
+// tableOfContents renders headings as nested lists of links, one level of nesting per heading level.
+// Returns an empty string when there are no headings.
+func tableOfContents(headings []tocEntry) string {
+	if len(headings) == 0 {
+		return ""
+	}
+	var toc strings.Builder
+	toc.WriteString(`<nav class="toc">`)
+	levels := []int{}
+	for _, heading := range headings {
+		for len(levels) > 0 && levels[len(levels)-1] > heading.Level {
+			toc.WriteString("</li></ul>")
+			levels = levels[:len(levels)-1]
+		}
+		if len(levels) == 0 || levels[len(levels)-1] < heading.Level {
+			toc.WriteString("<ul>")
+			levels = append(levels, heading.Level)
+		} else {
+			toc.WriteString("</li>")
+		}
+		fmt.Fprintf(&toc, `<li><a href="#%s">%s</a>`, html.EscapeString(heading.ID), html.EscapeString(heading.Text))
+	}
+	for range levels {
+		toc.WriteString("</li></ul>")
+	}
+	toc.WriteString("</nav>")
+	return toc.String()
+}
+
+// PlainText extracts the text of a Markdown document, without markup, raw HTML or link targets.
+// Whitespace is collapsed, so blocks end up separated by a single space.
+func PlainText(source string) string {
+	src := []byte(source)
+	document := converter.Parser().Parse(text.NewReader(src))
+	return plainText(document, src)
+}
+
+func plainText(node ast.Node, source []byte) string {
+	var text strings.Builder
+	_ = ast.Walk(node, func(child ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+		if child.Type() == ast.TypeBlock {
+			text.WriteByte(' ')
+		}
+		switch child := child.(type) {
+		case *ast.Text:
+			text.Write(child.Segment.Value(source))
+			if child.SoftLineBreak() || child.HardLineBreak() {
+				text.WriteByte(' ')
+			}
+		case *ast.String:
+			text.Write(child.Value)
+		case *ast.AutoLink:
+			text.Write(child.Label(source))
+		case *ast.CodeSpan:
+			for grandchild := child.FirstChild(); grandchild != nil; grandchild = grandchild.NextSibling() {
+				if segment, ok := grandchild.(*ast.Text); ok {
+					text.Write(segment.Segment.Value(source))
+				}
+			}
+			return ast.WalkSkipChildren, nil
+		case *ast.CodeBlock, *ast.FencedCodeBlock:
+			lines := child.Lines()
+			for i := 0; i < lines.Len(); i++ {
+				segment := lines.At(i)
+				text.Write(segment.Value(source))
+			}
+		case *ast.HTMLBlock, *ast.RawHTML:
+			return ast.WalkSkipChildren, nil
+		}
+		return ast.WalkContinue, nil
+	})
+	return strings.Join(strings.Fields(text.String()), " ")
+}
+
+// headingAnchors prepends every heading with a link to its own id.
+type headingAnchors struct{}
+
+func (headingAnchors) Transform(document *ast.Document, _ text.Reader, _ parser.Context) {
+	_ = ast.Walk(document, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		heading, ok := node.(*ast.Heading)
+		if !entering || !ok {
+			return ast.WalkContinue, nil
+		}
+		if id, ok := heading.AttributeString("id"); ok {
+			anchor := ast.NewLink()
+			anchor.Destination = append([]byte("#"), id.([]byte)...)
+			anchor.SetAttributeString("class", []byte("anchor"))
+			heading.InsertBefore(heading, heading.FirstChild(), anchor)
+		}
+		return ast.WalkSkipChildren, nil
+	})
+}
+
+// headingIDs derives heading ids the same way article slugs are made, numbering repeated headings.
+type headingIDs struct {
+	used map[string]bool
+}
+
+func newHeadingIDs() *headingIDs {
+	return &headingIDs{used: map[string]bool{}}
+}
+
+func (h *headingIDs) Generate(value []byte, _ ast.NodeKind) []byte {
+	base := slugger.Make(string(value))
+	id := base
+	for i := 1; h.used[id]; i++ {
+		id = fmt.Sprintf("%s-%d", base, i)
+	}
+	h.used[id] = true
+	return []byte(id)
+}
+
+func (h *headingIDs) Put(value []byte) {
+	h.used[string(value)] = true
+}
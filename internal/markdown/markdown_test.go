@@ -0,0 +1,77 @@
+package markdown
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRender(t *testing.T) {
+	t.Run("Should render markdown to HTML", func(t *testing.T) {
+		html, err := Render("Some *emphasis* and **strong** text")
+		require.NoError(t, err)
+		require.Equal(t, "<p>Some <em>emphasis</em> and <strong>strong</strong> text</p>\n", html)
+	})
+	t.Run("Should render GitHub flavoured tables", func(t *testing.T) {
+		html, err := Render("| a | b |\n|---|---|\n| 1 | 2 |")
+		require.NoError(t, err)
+		require.Contains(t, html, "<table>")
+		require.Contains(t, html, "<td>1</td>")
+	})
+	t.Run("Should add anchors to headings", func(t *testing.T) {
+		html, err := Render("# Crème Brûlée")
+		require.NoError(t, err)
+		require.Contains(t, html, `<h1 id="creme-brulee"><a href="#creme-brulee" class="anchor"></a>Crème Brûlée</h1>`)
+	})
+	t.Run("Should number repeated headings", func(t *testing.T) {
+		html, err := Render("## Notes\n\n## Notes")
+		require.NoError(t, err)
+		require.Contains(t, html, `<h2 id="notes">`)
+		require.Contains(t, html, `<h2 id="notes-1">`)
+	})
+	t.Run("Should prefix the document with a table of contents", func(t *testing.T) {
+		html, err := Render("# Intro\n\n## Setup `go`\n\n## Usage\n\n# Outro")
+		require.NoError(t, err)
+		require.Contains(t, html, `<nav class="toc"><ul>`+
+			`<li><a href="#intro">Intro</a><ul><li><a href="#setup-go">Setup go</a></li><li><a href="#usage">Usage</a></li></ul></li>`+
+			`<li><a href="#outro">Outro</a></li>`+
+			`</ul></nav><h1 id="intro">`)
+	})
+	t.Run("Should not add a table of contents without headings", func(t *testing.T) {
+		html, err := Render("Just a paragraph")
+		require.NoError(t, err)
+		require.NotContains(t, html, "<nav")
+	})
+	t.Run("Should strip scripts and event handlers", func(t *testing.T) {
+		html, err := Render("Hello <script>alert(1)</script> <img src=x onerror=alert(1)>\n\n<div onclick=\"alert(1)\">click</div>")
+		require.NoError(t, err)
+		require.NotContains(t, html, "<script")
+		require.NotContains(t, html, "onerror")
+		require.NotContains(t, html, "onclick")
+	})
+	t.Run("Should strip dangerous link targets", func(t *testing.T) {
+		html, err := Render("[click](javascript:alert(1))")
+		require.NoError(t, err)
+		require.NotContains(t, html, "javascript:")
+	})
+	t.Run("Should mark external links as nofollow", func(t *testing.T) {
+		html, err := Render("[goduit](https://example.com)")
+		require.NoError(t, err)
+		require.Contains(t, html, `<a href="https://example.com" rel="nofollow">goduit</a>`)
+	})
+}
+
+func TestPlainText(t *testing.T) {
+	t.Run("Should strip markup", func(t *testing.T) {
+		require.Equal(t, "Title Some emphasis and a link.", PlainText("# Title\n\nSome *emphasis* and [a link](https://example.com)."))
+	})
+	t.Run("Should separate blocks and collapse whitespace", func(t *testing.T) {
+		require.Equal(t, "First paragraph Second one item one item two", PlainText("First   paragraph\n\nSecond\none\n\n- item one\n- item two"))
+	})
+	t.Run("Should keep code", func(t *testing.T) {
+		require.Equal(t, "Run go test ./... fmt.Println(1)", PlainText("Run `go test ./...`\n\n```go\nfmt.Println(1)\n```"))
+	})
+	t.Run("Should drop raw HTML", func(t *testing.T) {
+		require.Equal(t, "Hello world", PlainText("Hello <b>world</b>\n\n<div>\nhidden\n</div>"))
+	})
+}
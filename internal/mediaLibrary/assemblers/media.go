@@ -0,0 +1,26 @@
+package assemblers
+
+import (
+	"strings"
+
+	"github.com/ravilock/goduit/internal/mediaLibrary/models"
+	"github.com/ravilock/goduit/internal/mediaLibrary/responses"
+)
+
+// MediaPath is where media are served from, relative to the public URL of the API.
+const MediaPath = "/api/media/"
+
+// MediaResponse builds the absolute URLs of an upload from the public URL of the API, so they can be used as profile
+// images and embedded in article bodies as they are.
+func MediaResponse(media *models.Media, publicURL string) *responses.MediaResponse {
+	baseURL := strings.TrimSuffix(publicURL, "/") + MediaPath
+	response := new(responses.MediaResponse)
+	response.Media.URL = baseURL + *media.Name
+	response.Media.ThumbnailURL = baseURL + *media.ThumbnailName
+	response.Media.ContentType = *media.ContentType
+	response.Media.Size = *media.Size
+	response.Media.Width = *media.Width
+	response.Media.Height = *media.Height
+	response.Media.CreatedAt = media.CreatedAt
+	return response
+}
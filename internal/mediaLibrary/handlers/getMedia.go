@@ -0,0 +1,65 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ravilock/goduit/api"
+	"github.com/ravilock/goduit/internal/app"
+	"github.com/ravilock/goduit/internal/blobstore"
+	"github.com/ravilock/goduit/internal/mediaLibrary/requests"
+)
+
+// mediaCacheControl lets clients and proxies cache media for good, since an upload never changes once stored.
+const mediaCacheControl = "public, max-age=31536000, immutable"
+
+type mediaGetter interface {
+	GetMedia(ctx context.Context, name string) (*blobstore.Blob, error)
+}
+
+type GetMediaHandler struct {
+	service mediaGetter
+}
+
+func NewGetMediaHandler(service mediaGetter) *GetMediaHandler {
+	return &GetMediaHandler{
+		service: service,
+	}
+}
+
+func (h *GetMediaHandler) GetMedia(c echo.Context) error {
+	request := new(requests.MediaNameRequest)
+	binder := &echo.DefaultBinder{}
+	if err := binder.BindPathParams(c, request); err != nil {
+		return err
+	}
+
+	if err := request.Validate(); err != nil {
+		return err
+	}
+
+	ctx := c.Request().Context()
+
+	blob, err := h.service.GetMedia(ctx, request.Name)
+	if err != nil {
+		if appError := new(app.AppError); errors.As(err, &appError) {
+			switch appError.ErrorCode {
+			case app.MediaNotFoundErrorCode:
+				return api.MediaNotFound(request.Name)
+			}
+		}
+		return err
+	}
+	defer blob.Content.Close()
+
+	header := c.Response().Header()
+	header.Set(echo.HeaderCacheControl, mediaCacheControl)
+	header.Set(echo.HeaderXContentTypeOptions, "nosniff")
+	if blob.Size > 0 {
+		header.Set(echo.HeaderContentLength, strconv.FormatInt(blob.Size, 10))
+	}
+	return c.Stream(http.StatusOK, blob.ContentType, blob.Content)
+}
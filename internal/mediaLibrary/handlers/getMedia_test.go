@@ -0,0 +1,89 @@
+package handlers
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ravilock/goduit/api"
+	"github.com/ravilock/goduit/api/validators"
+	"github.com/ravilock/goduit/internal/app"
+	"github.com/ravilock/goduit/internal/blobstore"
+	"github.com/ravilock/goduit/internal/imaging"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetMedia(t *testing.T) {
+	err := validators.InitValidator()
+	require.NoError(t, err)
+	mediaGetterMock := newMockMediaGetter(t)
+	handler := NewGetMediaHandler(mediaGetterMock)
+	e := echo.New()
+
+	t.Run("Should stream media", func(t *testing.T) {
+		// Arrange
+		req := httptest.NewRequest(http.MethodGet, "/api/media/image.png", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("name")
+		c.SetParamValues("image.png")
+		ctx := c.Request().Context()
+		blob := &blobstore.Blob{
+			Content:     io.NopCloser(strings.NewReader("image content")),
+			ContentType: imaging.MIMEImagePNG,
+			Size:        int64(len("image content")),
+		}
+		mediaGetterMock.EXPECT().GetMedia(ctx, "image.png").Return(blob, nil).Once()
+
+		// Act
+		err := handler.GetMedia(c)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Equal(t, "image content", rec.Body.String())
+		require.Equal(t, imaging.MIMEImagePNG, rec.Header().Get(echo.HeaderContentType))
+		require.Equal(t, "13", rec.Header().Get(echo.HeaderContentLength))
+		require.Equal(t, mediaCacheControl, rec.Header().Get(echo.HeaderCacheControl))
+		require.Equal(t, "nosniff", rec.Header().Get(echo.HeaderXContentTypeOptions))
+	})
+
+	t.Run("Should return HTTP 404 if media is not found", func(t *testing.T) {
+		// Arrange
+		req := httptest.NewRequest(http.MethodGet, "/api/media/missing.png", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("name")
+		c.SetParamValues("missing.png")
+		ctx := c.Request().Context()
+		mediaGetterMock.EXPECT().GetMedia(ctx, "missing.png").Return(nil, app.MediaNotFoundError("missing.png", blobstore.ErrNotFound)).Once()
+
+		// Act
+		err := handler.GetMedia(c)
+
+		// Assert
+		require.ErrorContains(t, err, api.MediaNotFound("missing.png").Error())
+	})
+
+	t.Run("Should return unexpected errors", func(t *testing.T) {
+		// Arrange
+		req := httptest.NewRequest(http.MethodGet, "/api/media/image.png", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("name")
+		c.SetParamValues("image.png")
+		ctx := c.Request().Context()
+		unexpectedError := errors.New("unexpected error")
+		mediaGetterMock.EXPECT().GetMedia(ctx, "image.png").Return(nil, unexpectedError).Once()
+
+		// Act
+		err := handler.GetMedia(c)
+
+		// Assert
+		require.ErrorIs(t, err, unexpectedError)
+	})
+}
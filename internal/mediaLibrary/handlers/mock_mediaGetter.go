@@ -0,0 +1,97 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package handlers
+
+import (
+	context "context"
+
+	blobstore "github.com/ravilock/goduit/internal/blobstore"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockMediaGetter is an autogenerated mock type for the mediaGetter type
+type mockMediaGetter struct {
+	mock.Mock
+}
+
+type mockMediaGetter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockMediaGetter) EXPECT() *mockMediaGetter_Expecter {
+	return &mockMediaGetter_Expecter{mock: &_m.Mock}
+}
+
+// GetMedia provides a mock function with given fields: ctx, name
+func (_m *mockMediaGetter) GetMedia(ctx context.Context, name string) (*blobstore.Blob, error) {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMedia")
+	}
+
+	var r0 *blobstore.Blob
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*blobstore.Blob, error)); ok {
+		return rf(ctx, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *blobstore.Blob); ok {
+		r0 = rf(ctx, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*blobstore.Blob)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockMediaGetter_GetMedia_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMedia'
+type mockMediaGetter_GetMedia_Call struct {
+	*mock.Call
+}
+
+// GetMedia is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+func (_e *mockMediaGetter_Expecter) GetMedia(ctx interface{}, name interface{}) *mockMediaGetter_GetMedia_Call {
+	return &mockMediaGetter_GetMedia_Call{Call: _e.mock.On("GetMedia", ctx, name)}
+}
+
+func (_c *mockMediaGetter_GetMedia_Call) Run(run func(ctx context.Context, name string)) *mockMediaGetter_GetMedia_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *mockMediaGetter_GetMedia_Call) Return(_a0 *blobstore.Blob, _a1 error) *mockMediaGetter_GetMedia_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *mockMediaGetter_GetMedia_Call) RunAndReturn(run func(context.Context, string) (*blobstore.Blob, error)) *mockMediaGetter_GetMedia_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockMediaGetter creates a new instance of mockMediaGetter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockMediaGetter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockMediaGetter {
+	mock := &mockMediaGetter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,97 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package handlers
+
+import (
+	context "context"
+
+	models "github.com/ravilock/goduit/internal/mediaLibrary/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockMediaUploader is an autogenerated mock type for the mediaUploader type
+type mockMediaUploader struct {
+	mock.Mock
+}
+
+type mockMediaUploader_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockMediaUploader) EXPECT() *mockMediaUploader_Expecter {
+	return &mockMediaUploader_Expecter{mock: &_m.Mock}
+}
+
+// UploadMedia provides a mock function with given fields: ctx, owner, content
+func (_m *mockMediaUploader) UploadMedia(ctx context.Context, owner string, content []byte) (*models.Media, error) {
+	ret := _m.Called(ctx, owner, content)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UploadMedia")
+	}
+
+	var r0 *models.Media
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []byte) (*models.Media, error)); ok {
+		return rf(ctx, owner, content)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []byte) *models.Media); ok {
+		r0 = rf(ctx, owner, content)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Media)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, []byte) error); ok {
+		r1 = rf(ctx, owner, content)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockMediaUploader_UploadMedia_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UploadMedia'
+type mockMediaUploader_UploadMedia_Call struct {
+	*mock.Call
+}
+
+// UploadMedia is a helper method to define mock.On call
+//   - ctx context.Context
+//   - owner string
+//   - content []byte
+func (_e *mockMediaUploader_Expecter) UploadMedia(ctx interface{}, owner interface{}, content interface{}) *mockMediaUploader_UploadMedia_Call {
+	return &mockMediaUploader_UploadMedia_Call{Call: _e.mock.On("UploadMedia", ctx, owner, content)}
+}
+
+func (_c *mockMediaUploader_UploadMedia_Call) Run(run func(ctx context.Context, owner string, content []byte)) *mockMediaUploader_UploadMedia_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]byte))
+	})
+	return _c
+}
+
+func (_c *mockMediaUploader_UploadMedia_Call) Return(_a0 *models.Media, _a1 error) *mockMediaUploader_UploadMedia_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *mockMediaUploader_UploadMedia_Call) RunAndReturn(run func(context.Context, string, []byte) (*models.Media, error)) *mockMediaUploader_UploadMedia_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockMediaUploader creates a new instance of mockMediaUploader. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockMediaUploader(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockMediaUploader {
+	mock := &mockMediaUploader{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,88 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ravilock/goduit/api"
+	"github.com/ravilock/goduit/internal/app"
+	"github.com/ravilock/goduit/internal/identity"
+	"github.com/ravilock/goduit/internal/mediaLibrary/assemblers"
+	"github.com/ravilock/goduit/internal/mediaLibrary/models"
+)
+
+// UploadFormField is the multipart form field uploads are sent in.
+const UploadFormField = "file"
+
+// multipartOverhead leaves room for the boundaries and headers of the multipart form around the uploaded file.
+const multipartOverhead = 64 << 10
+
+type mediaUploader interface {
+	UploadMedia(ctx context.Context, owner string, content []byte) (*models.Media, error)
+}
+
+type UploadMediaHandler struct {
+	service   mediaUploader
+	maxSize   int64
+	publicURL string
+}
+
+func NewUploadMediaHandler(service mediaUploader, maxSize int64, publicURL string) *UploadMediaHandler {
+	return &UploadMediaHandler{
+		service:   service,
+		maxSize:   maxSize,
+		publicURL: publicURL,
+	}
+}
+
+// UploadMedia uploads the image sent in the file field of a multipart form.
+func (h *UploadMediaHandler) UploadMedia(c echo.Context) error {
+	identity := new(identity.IdentityHeaders)
+	binder := &echo.DefaultBinder{}
+	if err := binder.BindHeaders(c, identity); err != nil {
+		return err
+	}
+
+	c.Request().Body = http.MaxBytesReader(c.Response(), c.Request().Body, h.maxSize+multipartOverhead)
+	fileHeader, err := c.FormFile(UploadFormField)
+	if err != nil {
+		if maxBytesError := new(http.MaxBytesError); errors.As(err, &maxBytesError) {
+			return api.MediaTooLarge(h.maxSize)
+		}
+		return api.RequiredFieldError(UploadFormField)
+	}
+	if fileHeader.Size > h.maxSize {
+		return api.MediaTooLarge(h.maxSize)
+	}
+	file, err := fileHeader.Open()
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	content, err := io.ReadAll(io.LimitReader(file, h.maxSize+1))
+	if err != nil {
+		return err
+	}
+	if int64(len(content)) > h.maxSize {
+		return api.MediaTooLarge(h.maxSize)
+	}
+
+	ctx := c.Request().Context()
+
+	media, err := h.service.UploadMedia(ctx, identity.Subject, content)
+	if err != nil {
+		if appError := new(app.AppError); errors.As(err, &appError) {
+			switch appError.ErrorCode {
+			case app.UnsupportedMediaErrorCode:
+				return api.UnsupportedMedia(appError.OriginalError)
+			}
+		}
+		return err
+	}
+
+	response := assemblers.MediaResponse(media, h.publicURL)
+	return c.JSON(http.StatusCreated, response)
+}
@@ -0,0 +1,158 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ravilock/goduit/api"
+	"github.com/ravilock/goduit/internal/app"
+	"github.com/ravilock/goduit/internal/imaging"
+	"github.com/ravilock/goduit/internal/mediaLibrary/models"
+	"github.com/ravilock/goduit/internal/mediaLibrary/responses"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestUploadMedia(t *testing.T) {
+	mediaUploaderMock := newMockMediaUploader(t)
+	handler := NewUploadMediaHandler(mediaUploaderMock, 16, "http://localhost:3000/")
+	e := echo.New()
+
+	t.Run("Should upload media", func(t *testing.T) {
+		// Arrange
+		ownerID := primitive.NewObjectID()
+		content := []byte("image content")
+		req := newUploadRequest(t, UploadFormField, content)
+		req.Header.Set("Goduit-Subject", ownerID.Hex())
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		ctx := c.Request().Context()
+		media := assembleMediaModel(ownerID)
+		mediaUploaderMock.EXPECT().UploadMedia(ctx, ownerID.Hex(), content).Return(media, nil).Once()
+
+		// Act
+		err := handler.UploadMedia(c)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, http.StatusCreated, rec.Code)
+		mediaResponse := new(responses.MediaResponse)
+		err = json.Unmarshal(rec.Body.Bytes(), mediaResponse)
+		require.NoError(t, err)
+		require.Equal(t, "http://localhost:3000/api/media/"+*media.Name, mediaResponse.Media.URL)
+		require.Equal(t, "http://localhost:3000/api/media/"+*media.ThumbnailName, mediaResponse.Media.ThumbnailURL)
+		require.Equal(t, *media.ContentType, mediaResponse.Media.ContentType)
+		require.Equal(t, *media.Size, mediaResponse.Media.Size)
+		require.Equal(t, *media.Width, mediaResponse.Media.Width)
+		require.Equal(t, *media.Height, mediaResponse.Media.Height)
+	})
+
+	t.Run("Should return HTTP 400 if no file is sent", func(t *testing.T) {
+		// Arrange
+		req := newUploadRequest(t, "image", []byte("image content"))
+		req.Header.Set("Goduit-Subject", primitive.NewObjectID().Hex())
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		// Act
+		err := handler.UploadMedia(c)
+
+		// Assert
+		require.ErrorContains(t, err, api.RequiredFieldError(UploadFormField).Error())
+	})
+
+	t.Run("Should return HTTP 413 if file is too large", func(t *testing.T) {
+		// Arrange
+		req := newUploadRequest(t, UploadFormField, []byte("an image larger than 16 bytes"))
+		req.Header.Set("Goduit-Subject", primitive.NewObjectID().Hex())
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		// Act
+		err := handler.UploadMedia(c)
+
+		// Assert
+		require.ErrorContains(t, err, api.MediaTooLarge(16).Error())
+	})
+
+	t.Run("Should return HTTP 415 if file is not a supported image", func(t *testing.T) {
+		// Arrange
+		ownerID := primitive.NewObjectID()
+		content := []byte("not an image")
+		req := newUploadRequest(t, UploadFormField, content)
+		req.Header.Set("Goduit-Subject", ownerID.Hex())
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		ctx := c.Request().Context()
+		mediaUploaderMock.EXPECT().UploadMedia(ctx, ownerID.Hex(), content).Return(nil, app.UnsupportedMediaError(imaging.ErrUnsupportedFormat)).Once()
+
+		// Act
+		err := handler.UploadMedia(c)
+
+		// Assert
+		require.ErrorContains(t, err, api.UnsupportedMedia(imaging.ErrUnsupportedFormat).Error())
+	})
+
+	t.Run("Should return unexpected errors", func(t *testing.T) {
+		// Arrange
+		ownerID := primitive.NewObjectID()
+		content := []byte("image content")
+		req := newUploadRequest(t, UploadFormField, content)
+		req.Header.Set("Goduit-Subject", ownerID.Hex())
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		ctx := c.Request().Context()
+		unexpectedError := errors.New("unexpected error")
+		mediaUploaderMock.EXPECT().UploadMedia(ctx, ownerID.Hex(), content).Return(nil, unexpectedError).Once()
+
+		// Act
+		err := handler.UploadMedia(c)
+
+		// Assert
+		require.ErrorIs(t, err, unexpectedError)
+	})
+}
+
+func newUploadRequest(t *testing.T, field string, content []byte) *http.Request {
+	t.Helper()
+	body := new(bytes.Buffer)
+	writer := multipart.NewWriter(body)
+	part, err := writer.CreateFormFile(field, "image.png")
+	require.NoError(t, err)
+	_, err = part.Write(content)
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+	req := httptest.NewRequest(http.MethodPost, "/api/media", body)
+	req.Header.Set(echo.HeaderContentType, writer.FormDataContentType())
+	return req
+}
+
+func assembleMediaModel(ownerID primitive.ObjectID) *models.Media {
+	id := primitive.NewObjectID()
+	name := id.Hex() + ".png"
+	thumbnailName := id.Hex() + "-thumbnail.png"
+	contentType := imaging.MIMEImagePNG
+	size := int64(1024)
+	width := 640
+	height := 480
+	createdAt := time.Now().UTC().Truncate(time.Millisecond)
+	owner := ownerID.Hex()
+	return &models.Media{
+		ID:            &id,
+		Owner:         &owner,
+		Name:          &name,
+		ThumbnailName: &thumbnailName,
+		ContentType:   &contentType,
+		Size:          &size,
+		Width:         &width,
+		Height:        &height,
+		CreatedAt:     &createdAt,
+	}
+}
@@ -0,0 +1,20 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Media is an uploaded image, stored under Name along with a thumbnail stored under ThumbnailName.
+type Media struct {
+	ID            *primitive.ObjectID `bson:"_id,omitempty"`
+	Owner         *string             `bson:"owner,omitempty"`
+	Name          *string             `bson:"name,omitempty"`
+	ThumbnailName *string             `bson:"thumbnailName,omitempty"`
+	ContentType   *string             `bson:"contentType,omitempty"`
+	Size          *int64              `bson:"size,omitempty"`
+	Width         *int                `bson:"width,omitempty"`
+	Height        *int                `bson:"height,omitempty"`
+	CreatedAt     *time.Time          `bson:"createdAt,omitempty"`
+}
@@ -0,0 +1,25 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/ravilock/goduit/internal/mediaLibrary/models"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type MediaRepository struct {
+	DBClient *mongo.Client
+}
+
+func NewMediaRepository(client *mongo.Client) *MediaRepository {
+	return &MediaRepository{client}
+}
+
+func (r *MediaRepository) WriteMedia(ctx context.Context, media *models.Media) error {
+	now := time.Now().UTC().Truncate(time.Millisecond)
+	media.CreatedAt = &now
+	collection := r.DBClient.Database("conduit").Collection("media")
+	_, err := collection.InsertOne(ctx, media)
+	return err
+}
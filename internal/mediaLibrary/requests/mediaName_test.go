@@ -0,0 +1,26 @@
+package requests
+
+import (
+	"testing"
+
+	"github.com/ravilock/goduit/api"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMediaName(t *testing.T) {
+	t.Run("Valid request should not return errors", func(t *testing.T) {
+		request := &MediaNameRequest{Name: "65f0c0ffee0000000000cafe.jpg"}
+		err := request.Validate()
+		require.NoError(t, err)
+	})
+	t.Run("Name is required", func(t *testing.T) {
+		request := &MediaNameRequest{Name: ""}
+		err := request.Validate()
+		require.ErrorContains(t, err, api.RequiredFieldError("Name").Error())
+	})
+	t.Run("Name should not be blank", func(t *testing.T) {
+		request := &MediaNameRequest{Name: " "}
+		err := request.Validate()
+		require.ErrorContains(t, err, api.RequiredFieldError("Name").Error())
+	})
+}
@@ -0,0 +1,17 @@
+package responses
+
+import "time"
+
+type MediaResponse struct {
+	Media Media `json:"media"`
+}
+
+type Media struct {
+	URL          string     `json:"url"`
+	ThumbnailURL string     `json:"thumbnailUrl"`
+	ContentType  string     `json:"contentType"`
+	Size         int64      `json:"size"`
+	Width        int        `json:"width"`
+	Height       int        `json:"height"`
+	CreatedAt    *time.Time `json:"createdAt"`
+}
@@ -0,0 +1,34 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ravilock/goduit/internal/app"
+	"github.com/ravilock/goduit/internal/blobstore"
+)
+
+type blobGetter interface {
+	Get(ctx context.Context, key string) (*blobstore.Blob, error)
+}
+
+type GetMediaService struct {
+	store blobGetter
+}
+
+func NewGetMediaService(store blobGetter) *GetMediaService {
+	return &GetMediaService{
+		store: store,
+	}
+}
+
+func (s *GetMediaService) GetMedia(ctx context.Context, name string) (*blobstore.Blob, error) {
+	blob, err := s.store.Get(ctx, name)
+	if err != nil {
+		if errors.Is(err, blobstore.ErrNotFound) || errors.Is(err, blobstore.ErrInvalidKey) {
+			return nil, app.MediaNotFoundError(name, err)
+		}
+		return nil, err
+	}
+	return blob, nil
+}
@@ -0,0 +1,97 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package services
+
+import (
+	context "context"
+
+	blobstore "github.com/ravilock/goduit/internal/blobstore"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockBlobGetter is an autogenerated mock type for the blobGetter type
+type mockBlobGetter struct {
+	mock.Mock
+}
+
+type mockBlobGetter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockBlobGetter) EXPECT() *mockBlobGetter_Expecter {
+	return &mockBlobGetter_Expecter{mock: &_m.Mock}
+}
+
+// Get provides a mock function with given fields: ctx, key
+func (_m *mockBlobGetter) Get(ctx context.Context, key string) (*blobstore.Blob, error) {
+	ret := _m.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *blobstore.Blob
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*blobstore.Blob, error)); ok {
+		return rf(ctx, key)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *blobstore.Blob); ok {
+		r0 = rf(ctx, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*blobstore.Blob)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockBlobGetter_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type mockBlobGetter_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+func (_e *mockBlobGetter_Expecter) Get(ctx interface{}, key interface{}) *mockBlobGetter_Get_Call {
+	return &mockBlobGetter_Get_Call{Call: _e.mock.On("Get", ctx, key)}
+}
+
+func (_c *mockBlobGetter_Get_Call) Run(run func(ctx context.Context, key string)) *mockBlobGetter_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *mockBlobGetter_Get_Call) Return(_a0 *blobstore.Blob, _a1 error) *mockBlobGetter_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *mockBlobGetter_Get_Call) RunAndReturn(run func(context.Context, string) (*blobstore.Blob, error)) *mockBlobGetter_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockBlobGetter creates a new instance of mockBlobGetter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockBlobGetter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockBlobGetter {
+	mock := &mockBlobGetter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
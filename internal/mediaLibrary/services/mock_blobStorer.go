@@ -0,0 +1,132 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package services
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockBlobStorer is an autogenerated mock type for the blobStorer type
+type mockBlobStorer struct {
+	mock.Mock
+}
+
+type mockBlobStorer_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockBlobStorer) EXPECT() *mockBlobStorer_Expecter {
+	return &mockBlobStorer_Expecter{mock: &_m.Mock}
+}
+
+// Delete provides a mock function with given fields: ctx, key
+func (_m *mockBlobStorer) Delete(ctx context.Context, key string) error {
+	ret := _m.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, key)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// mockBlobStorer_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type mockBlobStorer_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+func (_e *mockBlobStorer_Expecter) Delete(ctx interface{}, key interface{}) *mockBlobStorer_Delete_Call {
+	return &mockBlobStorer_Delete_Call{Call: _e.mock.On("Delete", ctx, key)}
+}
+
+func (_c *mockBlobStorer_Delete_Call) Run(run func(ctx context.Context, key string)) *mockBlobStorer_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *mockBlobStorer_Delete_Call) Return(_a0 error) *mockBlobStorer_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *mockBlobStorer_Delete_Call) RunAndReturn(run func(context.Context, string) error) *mockBlobStorer_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Put provides a mock function with given fields: ctx, key, contentType, content
+func (_m *mockBlobStorer) Put(ctx context.Context, key string, contentType string, content []byte) error {
+	ret := _m.Called(ctx, key, contentType, content)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Put")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, []byte) error); ok {
+		r0 = rf(ctx, key, contentType, content)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// mockBlobStorer_Put_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Put'
+type mockBlobStorer_Put_Call struct {
+	*mock.Call
+}
+
+// Put is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+//   - contentType string
+//   - content []byte
+func (_e *mockBlobStorer_Expecter) Put(ctx interface{}, key interface{}, contentType interface{}, content interface{}) *mockBlobStorer_Put_Call {
+	return &mockBlobStorer_Put_Call{Call: _e.mock.On("Put", ctx, key, contentType, content)}
+}
+
+func (_c *mockBlobStorer_Put_Call) Run(run func(ctx context.Context, key string, contentType string, content []byte)) *mockBlobStorer_Put_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].([]byte))
+	})
+	return _c
+}
+
+func (_c *mockBlobStorer_Put_Call) Return(_a0 error) *mockBlobStorer_Put_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *mockBlobStorer_Put_Call) RunAndReturn(run func(context.Context, string, string, []byte) error) *mockBlobStorer_Put_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockBlobStorer creates a new instance of mockBlobStorer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockBlobStorer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockBlobStorer {
+	mock := &mockBlobStorer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
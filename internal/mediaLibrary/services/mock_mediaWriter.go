@@ -0,0 +1,84 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package services
+
+import (
+	context "context"
+
+	models "github.com/ravilock/goduit/internal/mediaLibrary/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockMediaWriter is an autogenerated mock type for the mediaWriter type
+type mockMediaWriter struct {
+	mock.Mock
+}
+
+type mockMediaWriter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockMediaWriter) EXPECT() *mockMediaWriter_Expecter {
+	return &mockMediaWriter_Expecter{mock: &_m.Mock}
+}
+
+// WriteMedia provides a mock function with given fields: ctx, media
+func (_m *mockMediaWriter) WriteMedia(ctx context.Context, media *models.Media) error {
+	ret := _m.Called(ctx, media)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WriteMedia")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Media) error); ok {
+		r0 = rf(ctx, media)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// mockMediaWriter_WriteMedia_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WriteMedia'
+type mockMediaWriter_WriteMedia_Call struct {
+	*mock.Call
+}
+
+// WriteMedia is a helper method to define mock.On call
+//   - ctx context.Context
+//   - media *models.Media
+func (_e *mockMediaWriter_Expecter) WriteMedia(ctx interface{}, media interface{}) *mockMediaWriter_WriteMedia_Call {
+	return &mockMediaWriter_WriteMedia_Call{Call: _e.mock.On("WriteMedia", ctx, media)}
+}
+
+func (_c *mockMediaWriter_WriteMedia_Call) Run(run func(ctx context.Context, media *models.Media)) *mockMediaWriter_WriteMedia_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.Media))
+	})
+	return _c
+}
+
+func (_c *mockMediaWriter_WriteMedia_Call) Return(_a0 error) *mockMediaWriter_WriteMedia_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *mockMediaWriter_WriteMedia_Call) RunAndReturn(run func(context.Context, *models.Media) error) *mockMediaWriter_WriteMedia_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockMediaWriter creates a new instance of mockMediaWriter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockMediaWriter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockMediaWriter {
+	mock := &mockMediaWriter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,90 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ravilock/goduit/internal/app"
+	"github.com/ravilock/goduit/internal/imaging"
+	"github.com/ravilock/goduit/internal/mediaLibrary/models"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type mediaWriter interface {
+	WriteMedia(ctx context.Context, media *models.Media) error
+}
+
+type blobStorer interface {
+	Put(ctx context.Context, key, contentType string, content []byte) error
+	Delete(ctx context.Context, key string) error
+}
+
+type UploadMediaService struct {
+	repository    mediaWriter
+	store         blobStorer
+	maxDimension  int
+	maxPixels     int
+	thumbnailSize int
+}
+
+func NewUploadMediaService(repository mediaWriter, store blobStorer, maxDimension, maxPixels, thumbnailSize int) *UploadMediaService {
+	return &UploadMediaService{
+		repository:    repository,
+		store:         store,
+		maxDimension:  maxDimension,
+		maxPixels:     maxPixels,
+		thumbnailSize: thumbnailSize,
+	}
+}
+
+// UploadMedia stores an image along with a thumbnail of it, once stripped of its metadata.
+// Content that does not sniff as a supported image fails with an UnsupportedMediaError.
+func (s *UploadMediaService) UploadMedia(ctx context.Context, owner string, content []byte) (*models.Media, error) {
+	image, err := imaging.Process(content, s.maxDimension, s.maxPixels)
+	if err != nil {
+		if errors.Is(err, imaging.ErrUnsupportedFormat) {
+			return nil, app.UnsupportedMediaError(err)
+		}
+		return nil, err
+	}
+	thumbnail, err := image.Thumbnail(s.thumbnailSize)
+	if err != nil {
+		return nil, err
+	}
+
+	ID := primitive.NewObjectID()
+	name := ID.Hex() + imaging.Extensions[image.ContentType]
+	thumbnailName := ID.Hex() + "-thumbnail" + imaging.Extensions[thumbnail.ContentType]
+	size := int64(len(image.Content))
+	media := &models.Media{
+		ID:            &ID,
+		Owner:         &owner,
+		Name:          &name,
+		ThumbnailName: &thumbnailName,
+		ContentType:   &image.ContentType,
+		Size:          &size,
+		Width:         &image.Width,
+		Height:        &image.Height,
+	}
+
+	if err := s.store.Put(ctx, name, image.ContentType, image.Content); err != nil {
+		return nil, err
+	}
+	if err := s.store.Put(ctx, thumbnailName, thumbnail.ContentType, thumbnail.Content); err != nil {
+		s.discard(name)
+		return nil, err
+	}
+	if err := s.repository.WriteMedia(ctx, media); err != nil {
+		s.discard(name, thumbnailName)
+		return nil, err
+	}
+	return media, nil
+}
+
+// discard deletes blobs of an upload that failed, regardless of the request being cancelled.
+// Blobs that cannot be deleted are left behind, they are only unreferenced.
+func (s *UploadMediaService) discard(names ...string) {
+	for _, name := range names {
+		_ = s.store.Delete(context.Background(), name)
+	}
+}
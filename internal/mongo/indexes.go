@@ -48,6 +48,28 @@ func ensureIndexes(client *mongo.Client) error {
 		return err
 	}
 
+	tagFollowersCollection := client.Database("conduit").Collection("tagFollowers")
+	_, err = tagFollowersCollection.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "tag", Value: 1},
+			{Key: "follower", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = tagFollowersCollection.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "follower", Value: 1},
+			{Key: "tag", Value: 1},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
 	articlesCollection := client.Database("conduit").Collection("articles")
 	_, err = articlesCollection.Indexes().CreateOne(context.Background(), mongo.IndexModel{
 		Keys: bson.D{{Key: "author", Value: 1}},
@@ -71,6 +93,65 @@ func ensureIndexes(client *mongo.Client) error {
 		return err
 	}
 
+	_, err = articlesCollection.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "title", Value: "text"},
+			{Key: "description", Value: "text"},
+			{Key: "body", Value: "text"},
+		},
+		Options: options.Index().SetName("articles_text").SetWeights(bson.D{
+			{Key: "title", Value: 10},
+			{Key: "description", Value: 5},
+			{Key: "body", Value: 1},
+		}),
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = articlesCollection.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "status", Value: 1},
+			{Key: "publishAt", Value: 1},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = articlesCollection.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys:    bson.D{{Key: "trashedAt", Value: 1}},
+		Options: options.Index().SetSparse(true),
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = articlesCollection.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{{Key: "coAuthors", Value: 1}},
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = articlesCollection.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "publishedAt", Value: -1},
+			{Key: "_id", Value: -1},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = articlesCollection.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys:    bson.D{{Key: "pendingFanout", Value: 1}},
+		Options: options.Index().SetSparse(true),
+	})
+	if err != nil {
+		return err
+	}
+
 	commentsCollection := client.Database("conduit").Collection("comments")
 	_, err = commentsCollection.Indexes().CreateOne(context.Background(), mongo.IndexModel{
 		Keys: bson.D{{Key: "article", Value: 1}},
@@ -78,5 +159,194 @@ func ensureIndexes(client *mongo.Client) error {
 	if err != nil {
 		return err
 	}
+
+	_, err = commentsCollection.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{{Key: "createdAt", Value: 1}},
+	})
+	if err != nil {
+		return err
+	}
+
+	feedsCollection := client.Database("conduit").Collection("feeds")
+	_, err = feedsCollection.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{{Key: "articles.articleID", Value: 1}},
+	})
+	if err != nil {
+		return err
+	}
+
+	tagsCollection := client.Database("conduit").Collection("tags")
+	_, err = tagsCollection.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{{Key: "count", Value: -1}},
+	})
+	if err != nil {
+		return err
+	}
+
+	tagAliasesCollection := client.Database("conduit").Collection("tagAliases")
+	_, err = tagAliasesCollection.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{{Key: "tag", Value: 1}},
+	})
+	if err != nil {
+		return err
+	}
+
+	revisionsCollection := client.Database("conduit").Collection("revisions")
+	_, err = revisionsCollection.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "article", Value: 1},
+			{Key: "number", Value: -1},
+		},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return err
+	}
+
+	slugsCollection := client.Database("conduit").Collection("slugs")
+	_, err = slugsCollection.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{{Key: "article", Value: 1}},
+	})
+	if err != nil {
+		return err
+	}
+
+	favoritesCollection := client.Database("conduit").Collection("favorites")
+	_, err = favoritesCollection.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "article", Value: 1},
+			{Key: "user", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = favoritesCollection.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{{Key: "user", Value: 1}},
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = favoritesCollection.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{{Key: "createdAt", Value: 1}},
+	})
+	if err != nil {
+		return err
+	}
+
+	coAuthorInvitationsCollection := client.Database("conduit").Collection("coAuthorInvitations")
+	_, err = coAuthorInvitationsCollection.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "article", Value: 1},
+			{Key: "invitee", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = coAuthorInvitationsCollection.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "invitee", Value: 1},
+			{Key: "createdAt", Value: -1},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	seriesCollection := client.Database("conduit").Collection("series")
+	_, err = seriesCollection.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys:    bson.D{{Key: "slug", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = seriesCollection.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{{Key: "articles", Value: 1}},
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = seriesCollection.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "owner", Value: 1},
+			{Key: "createdAt", Value: -1},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	viewsCollection := client.Database("conduit").Collection("views")
+	_, err = viewsCollection.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "article", Value: 1},
+			{Key: "day", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = viewsCollection.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{{Key: "day", Value: 1}},
+	})
+	if err != nil {
+		return err
+	}
+
+	trendingCollection := client.Database("conduit").Collection("trending")
+	_, err = trendingCollection.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "window", Value: 1},
+			{Key: "article", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = trendingCollection.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "window", Value: 1},
+			{Key: "tagList", Value: 1},
+			{Key: "score", Value: -1},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = trendingCollection.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "window", Value: 1},
+			{Key: "overall", Value: 1},
+			{Key: "score", Value: -1},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	mediaCollection := client.Database("conduit").Collection("media")
+	_, err = mediaCollection.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "owner", Value: 1},
+			{Key: "createdAt", Value: -1},
+		},
+	})
+	if err != nil {
+		return err
+	}
 	return nil
 }
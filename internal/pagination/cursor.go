@@ -0,0 +1,70 @@
+package pagination
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var errInvalidCursor = errors.New("invalid cursor")
+
+// EncodeCursor builds an opaque pagination cursor that points to the document identified by ID.
+// Returns an empty string if ID is not a valid ObjectID.
+func EncodeCursor(ID string) string {
+	objectID, err := primitive.ObjectIDFromHex(ID)
+	if err != nil {
+		return ""
+	}
+	return base64.RawURLEncoding.EncodeToString(objectID[:])
+}
+
+// DecodeCursor extracts the document ID from a pagination cursor built by EncodeCursor.
+// An empty cursor decodes to an empty ID.
+func DecodeCursor(cursor string) (string, error) {
+	if cursor == "" {
+		return "", nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return "", errors.Join(errInvalidCursor, err)
+	}
+	var objectID primitive.ObjectID
+	if len(raw) != len(objectID) {
+		return "", errInvalidCursor
+	}
+	copy(objectID[:], raw)
+	return objectID.Hex(), nil
+}
+
+// EncodePositionCursor builds an opaque pagination cursor that points to a position in an ordered sequence.
+// Returns an empty string if position is not positive.
+func EncodePositionCursor(position int64) string {
+	if position <= 0 {
+		return ""
+	}
+	raw := make([]byte, 8)
+	binary.BigEndian.PutUint64(raw, uint64(position))
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// DecodePositionCursor extracts the position from a pagination cursor built by EncodePositionCursor.
+// An empty cursor decodes to position zero.
+func DecodePositionCursor(cursor string) (int64, error) {
+	if cursor == "" {
+		return 0, nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, errors.Join(errInvalidCursor, err)
+	}
+	if len(raw) != 8 {
+		return 0, errInvalidCursor
+	}
+	position := int64(binary.BigEndian.Uint64(raw))
+	if position <= 0 {
+		return 0, errInvalidCursor
+	}
+	return position, nil
+}
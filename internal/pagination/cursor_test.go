@@ -0,0 +1,60 @@
+package pagination
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestCursor(t *testing.T) {
+	t.Run("Should decode an encoded cursor back to the same ID", func(t *testing.T) {
+		ID := primitive.NewObjectID().Hex()
+		cursor := EncodeCursor(ID)
+		require.NotEmpty(t, cursor)
+		decodedID, err := DecodeCursor(cursor)
+		require.NoError(t, err)
+		require.Equal(t, ID, decodedID)
+	})
+	t.Run("Empty cursor should decode to empty ID", func(t *testing.T) {
+		decodedID, err := DecodeCursor("")
+		require.NoError(t, err)
+		require.Empty(t, decodedID)
+	})
+	t.Run("Invalid ID should encode to empty cursor", func(t *testing.T) {
+		require.Empty(t, EncodeCursor("not-an-object-id"))
+	})
+	t.Run("Should not decode malformed cursors", func(t *testing.T) {
+		_, err := DecodeCursor("not a cursor")
+		require.ErrorIs(t, err, errInvalidCursor)
+		_, err = DecodeCursor("dG9vLXNob3J0")
+		require.ErrorIs(t, err, errInvalidCursor)
+	})
+}
+
+func TestPositionCursor(t *testing.T) {
+	t.Run("Should decode an encoded cursor back to the same position", func(t *testing.T) {
+		cursor := EncodePositionCursor(42)
+		require.NotEmpty(t, cursor)
+		position, err := DecodePositionCursor(cursor)
+		require.NoError(t, err)
+		require.Equal(t, int64(42), position)
+	})
+	t.Run("Empty cursor should decode to position zero", func(t *testing.T) {
+		position, err := DecodePositionCursor("")
+		require.NoError(t, err)
+		require.Zero(t, position)
+	})
+	t.Run("Non positive positions should encode to empty cursor", func(t *testing.T) {
+		require.Empty(t, EncodePositionCursor(0))
+		require.Empty(t, EncodePositionCursor(-1))
+	})
+	t.Run("Should not decode malformed cursors", func(t *testing.T) {
+		_, err := DecodePositionCursor("not a cursor")
+		require.ErrorIs(t, err, errInvalidCursor)
+		_, err = DecodePositionCursor(EncodeCursor(primitive.NewObjectID().Hex()))
+		require.ErrorIs(t, err, errInvalidCursor)
+		_, err = DecodePositionCursor("AAAAAAAAAAA")
+		require.ErrorIs(t, err, errInvalidCursor)
+	})
+}
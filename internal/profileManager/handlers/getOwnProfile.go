@@ -5,6 +5,7 @@ import (
 	"net/http"
 
 	"github.com/labstack/echo/v4"
+	"github.com/ravilock/goduit/internal/etag"
 	"github.com/ravilock/goduit/internal/identity"
 	"github.com/ravilock/goduit/internal/profileManager/assemblers"
 	"github.com/ravilock/goduit/internal/profileManager/models"
@@ -37,6 +38,12 @@ func (h *GetOwnProfileHandler) GetOwnProfile(c echo.Context) error {
 		return err
 	}
 
+	tag := etag.FromVersion(user.CurrentVersion())
+	c.Response().Header().Set(etag.HeaderETag, tag)
+	if etag.Matches(c.Request().Header.Get(etag.HeaderIfNoneMatch), tag) {
+		return c.NoContent(http.StatusNotModified)
+	}
+
 	response := assemblers.UserResponse(user)
 
 	return c.JSON(http.StatusOK, response)
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/labstack/echo/v4"
+	"github.com/ravilock/goduit/internal/etag"
 	"github.com/ravilock/goduit/internal/profileManager/models"
 	profileManagerResponses "github.com/ravilock/goduit/internal/profileManager/responses"
 	"github.com/stretchr/testify/require"
@@ -56,6 +57,37 @@ func TestGetOwnProfile(t *testing.T) {
 		err = json.Unmarshal(rec.Body.Bytes(), getOwnProfileResponse)
 		require.NoError(t, err)
 		checkGetOwnProfileResponse(t, expectedUserModel, getOwnProfileResponse)
+		require.Equal(t, `"0"`, rec.Header().Get(etag.HeaderETag))
+	})
+
+	t.Run("Should return HTTP 304 if the profile did not change since it was fetched", func(t *testing.T) {
+		// Arrange
+		expectedSubject := primitive.NewObjectID()
+		clientUsername := "test-username"
+		clientEmail := "test.email@test.test"
+		version := int64(4)
+		expectedUserModel := &models.User{
+			ID:       &expectedSubject,
+			Username: &clientUsername,
+			Email:    &clientEmail,
+			Version:  &version,
+		}
+		req := httptest.NewRequest(http.MethodGet, "/user", nil)
+		req.Header.Set(etag.HeaderIfNoneMatch, `"4"`)
+		req.Header.Set("Goduit-Subject", expectedSubject.Hex())
+		req.Header.Set("Goduit-Client-Username", clientUsername)
+		req.Header.Set("Goduit-Client-Email", clientEmail)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		profileGetterMock.EXPECT().GetProfileByID(c.Request().Context(), expectedSubject.Hex()).Return(expectedUserModel, nil).Once()
+
+		// Act
+		err := handler.GetOwnProfile(c)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, http.StatusNotModified, rec.Code)
+		require.Empty(t, rec.Body.Bytes())
 	})
 }
 
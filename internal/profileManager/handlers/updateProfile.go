@@ -9,6 +9,7 @@ import (
 	"github.com/labstack/echo/v4"
 	"github.com/ravilock/goduit/api"
 	"github.com/ravilock/goduit/internal/app"
+	"github.com/ravilock/goduit/internal/etag"
 	"github.com/ravilock/goduit/internal/identity"
 	"github.com/ravilock/goduit/internal/profileManager/assemblers"
 	"github.com/ravilock/goduit/internal/profileManager/models"
@@ -21,12 +22,14 @@ type profileUpdater interface {
 
 type UpdateProfileHandler struct {
 	service       profileUpdater
+	profileGetter profileGetter
 	cookieService CookieCreator
 }
 
-func NewUpdateProfileHandler(service profileUpdater, cookieService CookieCreator) *UpdateProfileHandler {
+func NewUpdateProfileHandler(service profileUpdater, profileGetter profileGetter, cookieService CookieCreator) *UpdateProfileHandler {
 	return &UpdateProfileHandler{
 		service:       service,
+		profileGetter: profileGetter,
 		cookieService: cookieService,
 	}
 }
@@ -47,8 +50,24 @@ func (h *UpdateProfileHandler) UpdateProfile(c echo.Context) error {
 	}
 
 	model := request.Model()
+	ctx := c.Request().Context()
 
-	token, err := h.service.UpdateProfile(c.Request().Context(), identityHeaders.ClientEmail, identityHeaders.ClientUsername, request.User.Password, model)
+	if ifMatch := c.Request().Header.Get(etag.HeaderIfMatch); ifMatch != "" {
+		user, err := h.profileGetter.GetProfileByID(ctx, identityHeaders.Subject)
+		if err != nil {
+			if appError := new(app.AppError); errors.As(err, &appError) && appError.ErrorCode == app.UserNotFoundErrorCode {
+				return api.UserNotFound(fmt.Sprintf("%s+%s", identityHeaders.ClientEmail, identityHeaders.ClientUsername))
+			}
+			return err
+		}
+		currentVersion := user.CurrentVersion()
+		if !etag.MatchesStrongly(ifMatch, etag.FromVersion(currentVersion)) {
+			return api.PreconditionFailed(identityHeaders.ClientUsername)
+		}
+		model.Version = &currentVersion
+	}
+
+	token, err := h.service.UpdateProfile(ctx, identityHeaders.ClientEmail, identityHeaders.ClientUsername, request.User.Password, model)
 	if err != nil {
 		if appError := new(app.AppError); errors.As(err, &appError) {
 			switch appError.ErrorCode {
@@ -56,12 +75,15 @@ func (h *UpdateProfileHandler) UpdateProfile(c echo.Context) error {
 				return api.ConfictError
 			case app.UserNotFoundErrorCode:
 				return api.UserNotFound(fmt.Sprintf("%s+%s", identityHeaders.ClientEmail, identityHeaders.ClientUsername))
+			case app.VersionMismatchErrorCode:
+				return api.PreconditionFailed(identityHeaders.ClientUsername)
 			}
 		}
 		return err
 	}
 
 	response := assemblers.UserResponse(model, token)
+	c.Response().Header().Set(etag.HeaderETag, etag.FromVersion(model.CurrentVersion()))
 	if token != "" {
 		cookie := h.cookieService.Create(token)
 		c.SetCookie(cookie)
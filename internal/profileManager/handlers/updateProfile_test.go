@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -14,6 +15,8 @@ import (
 	"github.com/ravilock/goduit/api/validators"
 	"github.com/ravilock/goduit/internal/app"
 	"github.com/ravilock/goduit/internal/cookie"
+	"github.com/ravilock/goduit/internal/etag"
+	"github.com/ravilock/goduit/internal/profileManager/models"
 	profileManagerRequests "github.com/ravilock/goduit/internal/profileManager/requests"
 	profileManagerResponses "github.com/ravilock/goduit/internal/profileManager/responses"
 	"github.com/stretchr/testify/require"
@@ -25,8 +28,9 @@ func TestUpdateProfile(t *testing.T) {
 	require.NoError(t, err)
 	cookieManager := cookie.NewCookieManager()
 	profileUpdaterMock := newMockProfileUpdater(t)
+	profileGetterMock := newMockProfileGetter(t)
 	cookieCreatorMock := NewMockCookieCreator(t)
-	handler := UpdateProfileHandler{service: profileUpdaterMock, cookieService: cookieCreatorMock}
+	handler := UpdateProfileHandler{service: profileUpdaterMock, profileGetter: profileGetterMock, cookieService: cookieCreatorMock}
 	e := echo.New()
 	imageServer := mockValidImageURL(t)
 	defer imageServer.Close()
@@ -87,6 +91,96 @@ func TestUpdateProfile(t *testing.T) {
 		// Assert
 		require.ErrorIs(t, err, api.ConfictError)
 	})
+
+	t.Run("Should only update the profile at the version the client fetched", func(t *testing.T) {
+		// Arrange
+		expectedSubject := primitive.NewObjectID()
+		clientUsername := "test-username"
+		clientEmail := "test.email@test.test"
+		currentVersion := int64(5)
+		currentUser := &models.User{ID: &expectedSubject, Username: &clientUsername, Email: &clientEmail, Version: &currentVersion}
+		updateProfileRequest := generateUpdateProfileBody(imageServer.URL)
+		requestBody, err := json.Marshal(updateProfileRequest)
+		require.NoError(t, err)
+		req := httptest.NewRequest(http.MethodPut, "/user", bytes.NewBuffer(requestBody))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set(etag.HeaderIfMatch, `"5"`)
+		req.Header.Set("Goduit-Subject", expectedSubject.Hex())
+		req.Header.Set("Goduit-Client-Username", clientUsername)
+		req.Header.Set("Goduit-Client-Email", clientEmail)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		ctx := c.Request().Context()
+		expectedModel := updateProfileRequest.Model()
+		expectedModel.Version = &currentVersion
+		expectedToken := "token"
+		profileGetterMock.EXPECT().GetProfileByID(ctx, expectedSubject.Hex()).Return(currentUser, nil).Once()
+		profileUpdaterMock.EXPECT().UpdateProfile(ctx, clientEmail, clientUsername, updateProfileRequest.User.Password, expectedModel).RunAndReturn(func(ctx context.Context, subjectEmail, clientUsername, password string, model *models.User) (string, error) {
+			nextVersion := currentVersion + 1
+			model.Version = &nextVersion
+			return expectedToken, nil
+		}).Once()
+		cookieCreatorMock.EXPECT().Create(expectedToken).Return(cookieManager.Create(expectedToken))
+
+		// Act
+		err = handler.UpdateProfile(c)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Equal(t, `"6"`, rec.Header().Get(etag.HeaderETag))
+	})
+
+	t.Run("Should return HTTP 412 if the profile changed since the client fetched it", func(t *testing.T) {
+		// Arrange
+		expectedSubject := primitive.NewObjectID()
+		clientUsername := "test-username"
+		clientEmail := "test.email@test.test"
+		currentVersion := int64(5)
+		currentUser := &models.User{ID: &expectedSubject, Username: &clientUsername, Email: &clientEmail, Version: &currentVersion}
+		updateProfileRequest := generateUpdateProfileBody(imageServer.URL)
+		requestBody, err := json.Marshal(updateProfileRequest)
+		require.NoError(t, err)
+		req := httptest.NewRequest(http.MethodPut, "/user", bytes.NewBuffer(requestBody))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set(etag.HeaderIfMatch, `"4"`)
+		req.Header.Set("Goduit-Subject", expectedSubject.Hex())
+		req.Header.Set("Goduit-Client-Username", clientUsername)
+		req.Header.Set("Goduit-Client-Email", clientEmail)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		profileGetterMock.EXPECT().GetProfileByID(c.Request().Context(), expectedSubject.Hex()).Return(currentUser, nil).Once()
+
+		// Act
+		err = handler.UpdateProfile(c)
+
+		// Assert
+		require.ErrorContains(t, err, api.PreconditionFailed(clientUsername).Error())
+	})
+
+	t.Run("Should return HTTP 412 if the profile changed while it was being updated", func(t *testing.T) {
+		// Arrange
+		expectedSubject := primitive.NewObjectID().Hex()
+		clientUsername := "test-username"
+		clientEmail := "test.email@test.test"
+		updateProfileRequest := generateUpdateProfileBody(imageServer.URL)
+		requestBody, err := json.Marshal(updateProfileRequest)
+		require.NoError(t, err)
+		req := httptest.NewRequest(http.MethodPut, "/user", bytes.NewBuffer(requestBody))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set("Goduit-Subject", expectedSubject)
+		req.Header.Set("Goduit-Client-Username", clientUsername)
+		req.Header.Set("Goduit-Client-Email", clientEmail)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		profileUpdaterMock.EXPECT().UpdateProfile(c.Request().Context(), clientEmail, clientUsername, updateProfileRequest.User.Password, updateProfileRequest.Model()).Return("", app.VersionMismatchError(clientUsername, 0)).Once()
+
+		// Act
+		err = handler.UpdateProfile(c)
+
+		// Assert
+		require.ErrorContains(t, err, api.PreconditionFailed(clientUsername).Error())
+	})
 }
 
 func generateUpdateProfileBody(imageURL string) *profileManagerRequests.UpdateProfileRequest {
@@ -16,4 +16,13 @@ type User struct {
 	CreatedAt    *time.Time          `bson:"createdAt,omitempty"`
 	UpdatedAt    *time.Time          `bson:"updatedAt,omitempty"`
 	LastSession  *time.Time          `bson:"lastSession,omitempty"`
+	Version      *int64              `bson:"version,omitempty"`
+}
+
+// CurrentVersion is the number of times the user changed. Users that did not change since versions were counted are at version 0.
+func (u *User) CurrentVersion() int64 {
+	if u.Version == nil {
+		return 0
+	}
+	return *u.Version
 }
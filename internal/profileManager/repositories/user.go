@@ -94,6 +94,36 @@ func (r *UserRepository) GetUserByID(ctx context.Context, ID string) (*models.Us
 	return user, nil
 }
 
+// CountUsers counts every registered user.
+func (r *UserRepository) CountUsers(ctx context.Context) (int64, error) {
+	collection := r.DBClient.Database("conduit").Collection("users")
+	return collection.CountDocuments(ctx, bson.D{})
+}
+
+// ListSitemapUsers lists users oldest first, loading only their usernames and dates.
+func (r *UserRepository) ListSitemapUsers(ctx context.Context, limit, offset int64) ([]*models.User, error) {
+	projection := bson.D{
+		{Key: "username", Value: 1},
+		{Key: "createdAt", Value: 1},
+		{Key: "updatedAt", Value: 1},
+	}
+	opt := options.Find().SetLimit(limit).SetSkip(offset).SetSort(bson.D{{Key: "_id", Value: 1}}).SetProjection(projection)
+	collection := r.DBClient.Database("conduit").Collection("users")
+	results := []*models.User{}
+	cursor, err := collection.Find(ctx, bson.D{}, opt)
+	if err != nil {
+		return results, err
+	}
+	if err = cursor.All(ctx, &results); err != nil {
+		return results, err
+	}
+	return results, nil
+}
+
+// UpdateProfile sets the fields of user on the user with the email and username, counting a new version of it.
+//
+// When user has a version, the update only happens if the stored user is still at that version;
+// otherwise it fails with a VersionMismatchError.
 func (r *UserRepository) UpdateProfile(ctx context.Context, subjectEmail, clientUsername string, user *models.User) error {
 	now := time.Now().UTC().Truncate(time.Millisecond)
 	user.UpdatedAt = &now
@@ -101,7 +131,17 @@ func (r *UserRepository) UpdateProfile(ctx context.Context, subjectEmail, client
 		{Key: "username", Value: clientUsername},
 		{Key: "email", Value: subjectEmail},
 	}
-	update := bson.D{{Key: "$set", Value: user}}
+	expectedVersion := user.Version
+	if expectedVersion != nil {
+		filter = append(filter, versionFilter(*expectedVersion))
+	}
+	// The version is only ever incremented, never set along with the other fields
+	fields := *user
+	fields.Version = nil
+	update := bson.D{
+		{Key: "$set", Value: &fields},
+		{Key: "$inc", Value: bson.D{{Key: "version", Value: 1}}},
+	}
 	collection := r.DBClient.Database("conduit").Collection("users")
 	returnDocumentOption := options.After
 	err := collection.FindOneAndUpdate(ctx, filter, update, &options.FindOneAndUpdateOptions{ReturnDocument: &returnDocumentOption}).Decode(user)
@@ -110,9 +150,26 @@ func (r *UserRepository) UpdateProfile(ctx context.Context, subjectEmail, client
 			return app.ConflictError("users")
 		}
 		if errors.Is(err, mongo.ErrNoDocuments) {
+			if expectedVersion != nil {
+				count, countErr := collection.CountDocuments(ctx, filter[:len(filter)-1])
+				if countErr != nil {
+					return countErr
+				}
+				if count > 0 {
+					return app.VersionMismatchError(clientUsername, *expectedVersion)
+				}
+			}
 			return app.UserNotFoundError(fmt.Sprintf("%s+%s", subjectEmail, clientUsername), err)
 		}
 		return err
 	}
 	return nil
 }
+
+// versionFilter matches users at a version, users that never changed since versions were counted being at version 0.
+func versionFilter(version int64) bson.E {
+	if version == 0 {
+		return bson.E{Key: "version", Value: bson.D{{Key: "$in", Value: bson.A{0, nil}}}}
+	}
+	return bson.E{Key: "version", Value: version}
+}
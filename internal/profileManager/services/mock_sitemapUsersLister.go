@@ -0,0 +1,153 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package services
+
+import (
+	context "context"
+
+	models "github.com/ravilock/goduit/internal/profileManager/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockSitemapUsersLister is an autogenerated mock type for the sitemapUsersLister type
+type mockSitemapUsersLister struct {
+	mock.Mock
+}
+
+type mockSitemapUsersLister_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockSitemapUsersLister) EXPECT() *mockSitemapUsersLister_Expecter {
+	return &mockSitemapUsersLister_Expecter{mock: &_m.Mock}
+}
+
+// CountUsers provides a mock function with given fields: ctx
+func (_m *mockSitemapUsersLister) CountUsers(ctx context.Context) (int64, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountUsers")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int64, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int64); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockSitemapUsersLister_CountUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountUsers'
+type mockSitemapUsersLister_CountUsers_Call struct {
+	*mock.Call
+}
+
+// CountUsers is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *mockSitemapUsersLister_Expecter) CountUsers(ctx interface{}) *mockSitemapUsersLister_CountUsers_Call {
+	return &mockSitemapUsersLister_CountUsers_Call{Call: _e.mock.On("CountUsers", ctx)}
+}
+
+func (_c *mockSitemapUsersLister_CountUsers_Call) Run(run func(ctx context.Context)) *mockSitemapUsersLister_CountUsers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *mockSitemapUsersLister_CountUsers_Call) Return(_a0 int64, _a1 error) *mockSitemapUsersLister_CountUsers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *mockSitemapUsersLister_CountUsers_Call) RunAndReturn(run func(context.Context) (int64, error)) *mockSitemapUsersLister_CountUsers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSitemapUsers provides a mock function with given fields: ctx, limit, offset
+func (_m *mockSitemapUsersLister) ListSitemapUsers(ctx context.Context, limit int64, offset int64) ([]*models.User, error) {
+	ret := _m.Called(ctx, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSitemapUsers")
+	}
+
+	var r0 []*models.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) ([]*models.User, error)); ok {
+		return rf(ctx, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) []*models.User); ok {
+		r0 = rf(ctx, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = rf(ctx, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockSitemapUsersLister_ListSitemapUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSitemapUsers'
+type mockSitemapUsersLister_ListSitemapUsers_Call struct {
+	*mock.Call
+}
+
+// ListSitemapUsers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int64
+//   - offset int64
+func (_e *mockSitemapUsersLister_Expecter) ListSitemapUsers(ctx interface{}, limit interface{}, offset interface{}) *mockSitemapUsersLister_ListSitemapUsers_Call {
+	return &mockSitemapUsersLister_ListSitemapUsers_Call{Call: _e.mock.On("ListSitemapUsers", ctx, limit, offset)}
+}
+
+func (_c *mockSitemapUsersLister_ListSitemapUsers_Call) Run(run func(ctx context.Context, limit int64, offset int64)) *mockSitemapUsersLister_ListSitemapUsers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *mockSitemapUsersLister_ListSitemapUsers_Call) Return(_a0 []*models.User, _a1 error) *mockSitemapUsersLister_ListSitemapUsers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *mockSitemapUsersLister_ListSitemapUsers_Call) RunAndReturn(run func(context.Context, int64, int64) ([]*models.User, error)) *mockSitemapUsersLister_ListSitemapUsers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newMockSitemapUsersLister creates a new instance of mockSitemapUsersLister. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockSitemapUsersLister(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockSitemapUsersLister {
+	mock := &mockSitemapUsersLister{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,31 @@
+package services
+
+import (
+	"context"
+
+	"github.com/ravilock/goduit/internal/profileManager/models"
+)
+
+type sitemapUsersLister interface {
+	CountUsers(ctx context.Context) (int64, error)
+	ListSitemapUsers(ctx context.Context, limit, offset int64) ([]*models.User, error)
+}
+
+type SitemapProfilesService struct {
+	repository sitemapUsersLister
+}
+
+func NewSitemapProfilesService(repository sitemapUsersLister) *SitemapProfilesService {
+	return &SitemapProfilesService{
+		repository: repository,
+	}
+}
+
+func (s *SitemapProfilesService) CountSitemapProfiles(ctx context.Context) (int64, error) {
+	return s.repository.CountUsers(ctx)
+}
+
+// ListSitemapProfiles lists profiles oldest first, only carrying their usernames and dates.
+func (s *SitemapProfilesService) ListSitemapProfiles(ctx context.Context, limit, offset int64) ([]*models.User, error) {
+	return s.repository.ListSitemapUsers(ctx, limit, offset)
+}
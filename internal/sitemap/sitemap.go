@@ -0,0 +1,72 @@
+package sitemap
+
+import (
+	"encoding/xml"
+	"io"
+	"time"
+)
+
+// MaxURLs is the most URLs the sitemap protocol allows in a single sitemap, and the most sitemaps in a sitemap index.
+const MaxURLs = 50000
+
+// ContentType is the media type sitemaps and sitemap indexes are served with.
+const ContentType = "application/xml; charset=utf-8"
+
+const namespace = "http://www.sitemaps.org/schemas/sitemap/0.9"
+
+// URL is a page listed in a sitemap, or a sitemap listed in a sitemap index. LastModified is left out when it is zero.
+type URL struct {
+	Location     string
+	LastModified time.Time
+}
+
+type urlSet struct {
+	XMLName   xml.Name   `xml:"urlset"`
+	Namespace string     `xml:"xmlns,attr"`
+	URLs      []location `xml:"url"`
+}
+
+type sitemapIndex struct {
+	XMLName   xml.Name   `xml:"sitemapindex"`
+	Namespace string     `xml:"xmlns,attr"`
+	Sitemaps  []location `xml:"sitemap"`
+}
+
+type location struct {
+	Location     string `xml:"loc"`
+	LastModified string `xml:"lastmod,omitempty"`
+}
+
+// WriteURLSet writes a sitemap listing pages.
+func WriteURLSet(w io.Writer, urls []URL) error {
+	return write(w, &urlSet{Namespace: namespace, URLs: locations(urls)})
+}
+
+// WriteIndex writes a sitemap index listing sitemaps, which is how sites with more than MaxURLs pages are mapped.
+func WriteIndex(w io.Writer, sitemaps []URL) error {
+	return write(w, &sitemapIndex{Namespace: namespace, Sitemaps: locations(sitemaps)})
+}
+
+func locations(urls []URL) []location {
+	locations := make([]location, 0, len(urls))
+	for _, url := range urls {
+		location := location{Location: url.Location}
+		if !url.LastModified.IsZero() {
+			location.LastModified = url.LastModified.UTC().Format(time.RFC3339)
+		}
+		locations = append(locations, location)
+	}
+	return locations
+}
+
+func write(w io.Writer, document any) error {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(document); err != nil {
+		return err
+	}
+	return encoder.Close()
+}
@@ -0,0 +1,62 @@
+package sitemap
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteURLSet(t *testing.T) {
+	t.Run("Should list pages with their last modification", func(t *testing.T) {
+		// Arrange
+		output := new(bytes.Buffer)
+		urls := []URL{
+			{Location: "http://localhost:5173/article/how-to-train-your-dragon", LastModified: time.Date(2024, time.March, 2, 8, 30, 0, 0, time.FixedZone("BRT", -3*60*60))},
+			{Location: "http://localhost:5173/profile/jake?tab=favorites&page=1"},
+		}
+
+		// Act
+		err := WriteURLSet(output, urls)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, `<?xml version="1.0" encoding="UTF-8"?>
+<urlset xmlns="http://www.sitemaps.org/schemas/sitemap/0.9">
+  <url>
+    <loc>http://localhost:5173/article/how-to-train-your-dragon</loc>
+    <lastmod>2024-03-02T11:30:00Z</lastmod>
+  </url>
+  <url>
+    <loc>http://localhost:5173/profile/jake?tab=favorites&amp;page=1</loc>
+  </url>
+</urlset>`, output.String())
+	})
+}
+
+func TestWriteIndex(t *testing.T) {
+	t.Run("Should list sitemaps", func(t *testing.T) {
+		// Arrange
+		output := new(bytes.Buffer)
+		sitemaps := []URL{
+			{Location: "http://localhost:3000/api/sitemap.xml?page=1"},
+			{Location: "http://localhost:3000/api/sitemap.xml?page=2"},
+		}
+
+		// Act
+		err := WriteIndex(output, sitemaps)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, `<?xml version="1.0" encoding="UTF-8"?>
+<sitemapindex xmlns="http://www.sitemaps.org/schemas/sitemap/0.9">
+  <sitemap>
+    <loc>http://localhost:3000/api/sitemap.xml?page=1</loc>
+  </sitemap>
+  <sitemap>
+    <loc>http://localhost:3000/api/sitemap.xml?page=2</loc>
+  </sitemap>
+</sitemapindex>`, output.String())
+	})
+}
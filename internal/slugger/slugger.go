@@ -0,0 +1,121 @@
+package slugger
+
+import (
+	"crypto/rand"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	// MaxLength bounds the length of generated slugs, unique suffixes included.
+	MaxLength = 96
+	// MinLength is the shortest slug accepted by the article routes.
+	MinLength = 5
+
+	fallback       = "article"
+	suffixLength   = 6
+	suffixAlphabet = "0123456789abcdefghijklmnopqrstuvwxyz"
+)
+
+// transliterations covers letters that do not decompose into a latin base letter plus diacritics.
+var transliterations = map[rune]string{
+	'ß': "ss", 'æ': "ae", 'œ': "oe", 'ø': "o", 'đ': "d", 'ð': "d", 'þ': "th", 'ł': "l", 'ı': "i", 'ħ': "h",
+	'&': " and ", '@': " at ", '\'': "", '’': "",
+	// Cyrillic
+	'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "e", 'ж': "zh", 'з': "z", 'и': "i",
+	'й': "y", 'к': "k", 'л': "l", 'м': "m", 'н': "n", 'о': "o", 'п': "p", 'р': "r", 'с': "s", 'т': "t",
+	'у': "u", 'ф': "f", 'х': "kh", 'ц': "ts", 'ч': "ch", 'ш': "sh", 'щ': "shch", 'ъ': "", 'ы': "y", 'ь': "",
+	'э': "e", 'ю': "yu", 'я': "ya", 'є': "ye", 'і': "i", 'ї': "yi", 'ґ': "g",
+	// Greek
+	'α': "a", 'β': "v", 'γ': "g", 'δ': "d", 'ε': "e", 'ζ': "z", 'η': "i", 'θ': "th", 'ι': "i", 'κ': "k",
+	'λ': "l", 'μ': "m", 'ν': "n", 'ξ': "x", 'ο': "o", 'π': "p", 'ρ': "r", 'σ': "s", 'ς': "s", 'τ': "t",
+	'υ': "y", 'φ': "f", 'χ': "ch", 'ψ': "ps", 'ω': "o",
+}
+
+// Make builds a URL safe slug out of a title: diacritics are removed, known scripts are transliterated to latin letters
+// and every other run of characters becomes a single hyphen. Titles with nothing left to keep fall back to "article".
+func Make(title string) string {
+	slug := truncate(fold(title, isSlugRune), MaxLength-suffixLength-1)
+	if slug == "" {
+		return fallback
+	}
+	return slug
+}
+
+// Tag folds a tag into its canonical form, so variants such as "Go Lang", "go_lang" and "go.lang" become "go-lang".
+// It follows the same rules as Make, except that letters of any script are kept, as are "+" and "#" so tags such as
+// "c++" and "c#" stay apart. Tags with nothing left to keep become empty.
+func Tag(tag string) string {
+	return fold(tag, isTagRune)
+}
+
+// fold lower cases s, removes diacritics, transliterates known letters and turns every run of characters rejected by
+// keep into a single hyphen, never leading or trailing.
+func fold(s string, keep func(rune) bool) string {
+	var builder strings.Builder
+	pendingHyphen := false
+	for _, r := range norm.NFKD.String(strings.ToLower(s)) {
+		if unicode.Is(unicode.Mn, r) {
+			continue
+		}
+		replacement, ok := transliterations[r]
+		if !ok {
+			replacement = string(r)
+		}
+		for _, c := range replacement {
+			if keep(c) {
+				if pendingHyphen && builder.Len() > 0 {
+					builder.WriteByte('-')
+				}
+				pendingHyphen = false
+				builder.WriteRune(c)
+				continue
+			}
+			pendingHyphen = true
+		}
+	}
+	return builder.String()
+}
+
+func isSlugRune(r rune) bool {
+	return (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9')
+}
+
+func isTagRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '+' || r == '#'
+}
+
+// WithSuffix appends a short random suffix to a slug, keeping the result within MaxLength.
+func WithSuffix(slug string) string {
+	return truncate(slug, MaxLength-suffixLength-1) + "-" + randomSuffix()
+}
+
+// Matches reports whether slug is base itself or base followed by a suffix added by WithSuffix.
+func Matches(slug, base string) bool {
+	if slug == base {
+		return true
+	}
+	suffix, ok := strings.CutPrefix(slug, base+"-")
+	if !ok || len(suffix) != suffixLength {
+		return false
+	}
+	return strings.Trim(suffix, suffixAlphabet) == ""
+}
+
+// truncate cuts slug to at most length bytes, preferring to cut on a word boundary.
+func truncate(slug string, length int) string {
+	if len(slug) <= length {
+		return slug
+	}
+	slug = slug[:length]
+	if i := strings.LastIndexByte(slug, '-'); i > length/2 {
+		slug = slug[:i]
+	}
+	return strings.TrimSuffix(slug, "-")
+}
+
+func randomSuffix() string {
+	return strings.ToLower(rand.Text()[:suffixLength])
+}
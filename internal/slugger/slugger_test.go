@@ -0,0 +1,87 @@
+package slugger
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMake(t *testing.T) {
+	t.Run("Should lower case and hyphenate words", func(t *testing.T) {
+		require.Equal(t, "how-to-train-your-dragon", Make("How to Train Your Dragon"))
+	})
+	t.Run("Should strip punctuation", func(t *testing.T) {
+		require.Equal(t, "whats-new-in-go-1-22", Make("What's new in Go 1.22?!"))
+		require.Equal(t, "hello-world", Make("  --Hello,   World!--  "))
+	})
+	t.Run("Should remove diacritics", func(t *testing.T) {
+		require.Equal(t, "creme-brulee-a-la-francaise", Make("Crème Brûlée à la Française"))
+	})
+	t.Run("Should transliterate letters without a latin decomposition", func(t *testing.T) {
+		require.Equal(t, "strasse-and-smorrebrod", Make("Straße & Smørrebrød"))
+		require.Equal(t, "privet-mir", Make("Привет, мир"))
+		require.Equal(t, "kalimera-kosme", Make("Καλημέρα κόσμε"))
+	})
+	t.Run("Should fall back when nothing can be kept", func(t *testing.T) {
+		require.Equal(t, "article", Make("日本語のタイトル"))
+		require.Equal(t, "article", Make("!!!!!"))
+	})
+	t.Run("Should limit the slug length on a word boundary", func(t *testing.T) {
+		slug := Make(strings.Repeat("word ", 100))
+		require.LessOrEqual(t, len(slug), MaxLength-suffixLength-1)
+		require.False(t, strings.HasSuffix(slug, "-"))
+		require.True(t, strings.HasSuffix(slug, "word"))
+	})
+}
+
+func TestTag(t *testing.T) {
+	t.Run("Should fold whitespace and punctuation into hyphens", func(t *testing.T) {
+		require.Equal(t, "go-lang", Tag("Go Lang"))
+		require.Equal(t, "go-lang", Tag("go_lang"))
+		require.Equal(t, "go-lang", Tag("  --Go.Lang!--  "))
+		require.Equal(t, "golang", Tag("GoLang"))
+	})
+	t.Run("Should remove diacritics", func(t *testing.T) {
+		require.Equal(t, "cafe", Tag("Café"))
+	})
+	t.Run("Should keep plus and hash signs", func(t *testing.T) {
+		require.Equal(t, "c++", Tag("C++"))
+		require.Equal(t, "c#", Tag("C#"))
+	})
+	t.Run("Should keep letters of other scripts", func(t *testing.T) {
+		require.Equal(t, "日本語", Tag("日本語"))
+	})
+	t.Run("Should become empty when nothing can be kept", func(t *testing.T) {
+		require.Equal(t, "", Tag("!!!!!"))
+	})
+}
+
+func TestWithSuffix(t *testing.T) {
+	t.Run("Should append a short unique suffix", func(t *testing.T) {
+		first := WithSuffix("my-title")
+		second := WithSuffix("my-title")
+		require.NotEqual(t, first, second)
+		require.True(t, Matches(first, "my-title"))
+		require.Len(t, first, len("my-title")+suffixLength+1)
+	})
+	t.Run("Should keep suffixed slugs within the max length", func(t *testing.T) {
+		slug := WithSuffix(strings.Repeat("a", 200))
+		require.LessOrEqual(t, len(slug), MaxLength)
+	})
+}
+
+func TestMatches(t *testing.T) {
+	require.True(t, Matches("my-title", "my-title"))
+	require.True(t, Matches("my-title-a2b3c4", "my-title"))
+	require.False(t, Matches("my-title-again", "my-title"))
+	require.False(t, Matches("my-title-A2B3C4", "my-title"))
+	require.False(t, Matches("other-title", "my-title"))
+}
+
+func BenchmarkMake(b *testing.B) {
+	title := strings.Repeat("Crème Brûlée ", 20)
+	for i := 0; i < b.N; i++ {
+		Make(title)
+	}
+}
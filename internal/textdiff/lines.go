@@ -0,0 +1,138 @@
+package textdiff
+
+import "strings"
+
+type Operation string
+
+const (
+	Equal  Operation = "equal"
+	Insert Operation = "insert"
+	Delete Operation = "delete"
+)
+
+// maxTableSize bounds the memory used to compare two texts; past it, the texts are reported as fully replaced.
+const maxTableSize = 4_000_000
+
+// Change represents consecutive lines that were kept, inserted or deleted.
+type Change struct {
+	Operation Operation
+	Text      string
+}
+
+// Lines computes the changes that turn a into b, line by line, based on their longest common subsequence.
+func Lines(a, b string) []Change {
+	if a == b {
+		if a == "" {
+			return []Change{}
+		}
+		return []Change{{Operation: Equal, Text: a}}
+	}
+	aLines, bLines := splitLines(a), splitLines(b)
+
+	prefix := 0
+	for prefix < len(aLines) && prefix < len(bLines) && aLines[prefix] == bLines[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(aLines)-prefix && suffix < len(bLines)-prefix && aLines[len(aLines)-1-suffix] == bLines[len(bLines)-1-suffix] {
+		suffix++
+	}
+
+	changes := newChangeBuilder()
+	for _, line := range aLines[:prefix] {
+		changes.add(Equal, line)
+	}
+	diffMiddle(changes, aLines[prefix:len(aLines)-suffix], bLines[prefix:len(bLines)-suffix])
+	for _, line := range aLines[len(aLines)-suffix:] {
+		changes.add(Equal, line)
+	}
+	return changes.build()
+}
+
+func diffMiddle(changes *changeBuilder, aLines, bLines []string) {
+	n, m := len(aLines), len(bLines)
+	if n*m > maxTableSize {
+		for _, line := range aLines {
+			changes.add(Delete, line)
+		}
+		for _, line := range bLines {
+			changes.add(Insert, line)
+		}
+		return
+	}
+
+	// lcs[i][j] holds the length of the longest common subsequence of aLines[i:] and bLines[j:]
+	lcs := make([][]int, n+1)
+	for i := range lcs {
+		lcs[i] = make([]int, m+1)
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			if aLines[i] == bLines[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	i, j := 0, 0
+	for i < n && j < m {
+		switch {
+		case aLines[i] == bLines[j]:
+			changes.add(Equal, aLines[i])
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			changes.add(Delete, aLines[i])
+			i++
+		default:
+			changes.add(Insert, bLines[j])
+			j++
+		}
+	}
+	for ; i < n; i++ {
+		changes.add(Delete, aLines[i])
+	}
+	for ; j < m; j++ {
+		changes.add(Insert, bLines[j])
+	}
+}
+
+func splitLines(text string) []string {
+	if text == "" {
+		return []string{}
+	}
+	return strings.Split(text, "\n")
+}
+
+type changeBuilder struct {
+	changes []Change
+	lines   []string
+}
+
+func newChangeBuilder() *changeBuilder {
+	return &changeBuilder{changes: []Change{}}
+}
+
+func (b *changeBuilder) add(operation Operation, line string) {
+	if len(b.changes) > 0 && b.changes[len(b.changes)-1].Operation == operation {
+		b.lines = append(b.lines, line)
+		return
+	}
+	b.flush()
+	b.changes = append(b.changes, Change{Operation: operation})
+	b.lines = []string{line}
+}
+
+func (b *changeBuilder) flush() {
+	if len(b.changes) == 0 {
+		return
+	}
+	b.changes[len(b.changes)-1].Text = strings.Join(b.lines, "\n")
+}
+
+func (b *changeBuilder) build() []Change {
+	b.flush()
+	return b.changes
+}
@@ -0,0 +1,62 @@
+package textdiff
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLines(t *testing.T) {
+	t.Run("Equal texts should have a single equal change", func(t *testing.T) {
+		changes := Lines("first\nsecond", "first\nsecond")
+		require.Equal(t, []Change{{Operation: Equal, Text: "first\nsecond"}}, changes)
+	})
+	t.Run("Empty texts should have no changes", func(t *testing.T) {
+		require.Empty(t, Lines("", ""))
+	})
+	t.Run("Should detect inserted lines", func(t *testing.T) {
+		changes := Lines("first\nthird", "first\nsecond\nthird")
+		require.Equal(t, []Change{
+			{Operation: Equal, Text: "first"},
+			{Operation: Insert, Text: "second"},
+			{Operation: Equal, Text: "third"},
+		}, changes)
+	})
+	t.Run("Should detect deleted lines", func(t *testing.T) {
+		changes := Lines("first\nsecond\nthird", "first\nthird")
+		require.Equal(t, []Change{
+			{Operation: Equal, Text: "first"},
+			{Operation: Delete, Text: "second"},
+			{Operation: Equal, Text: "third"},
+		}, changes)
+	})
+	t.Run("Should detect replaced lines", func(t *testing.T) {
+		changes := Lines("first\nsecond\nthird\nfourth", "first\n2nd\n3rd\nfourth")
+		require.Equal(t, []Change{
+			{Operation: Equal, Text: "first"},
+			{Operation: Delete, Text: "second\nthird"},
+			{Operation: Insert, Text: "2nd\n3rd"},
+			{Operation: Equal, Text: "fourth"},
+		}, changes)
+	})
+	t.Run("Should keep common lines between changes", func(t *testing.T) {
+		changes := Lines("a\nb\nc\nd\ne", "a\nc\nd\nx\ne")
+		require.Equal(t, []Change{
+			{Operation: Equal, Text: "a"},
+			{Operation: Delete, Text: "b"},
+			{Operation: Equal, Text: "c\nd"},
+			{Operation: Insert, Text: "x"},
+			{Operation: Equal, Text: "e"},
+		}, changes)
+	})
+	t.Run("Should replace everything when texts are too large to compare", func(t *testing.T) {
+		a := strings.Repeat("a\n", 2100) + "a"
+		b := strings.Repeat("b\n", 2100) + "b"
+		changes := Lines(a, b)
+		require.Equal(t, []Change{
+			{Operation: Delete, Text: a},
+			{Operation: Insert, Text: b},
+		}, changes)
+	})
+}
@@ -0,0 +1,49 @@
+package textstats
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	// WordsPerMinute is the reading speed reading times are estimated with.
+	WordsPerMinute = 200
+	// ExcerptLength bounds the length of excerpts, in characters, the trailing ellipsis included.
+	ExcerptLength = 200
+)
+
+// CountWords counts the whitespace separated words of a text.
+func CountWords(text string) int {
+	return len(strings.Fields(text))
+}
+
+// ReadingTime estimates how many minutes it takes to read a number of words, rounding up.
+// Any text that has words takes at least a minute.
+func ReadingTime(words int) int {
+	return (words + WordsPerMinute - 1) / WordsPerMinute
+}
+
+// Excerpt shortens a plain text to at most maxLength characters, cutting it on a word boundary and marking the cut with an ellipsis.
+// Texts that already fit are returned as is, with whitespace collapsed.
+func Excerpt(text string, maxLength int) string {
+	text = strings.Join(strings.Fields(text), " ")
+	if utf8.RuneCountInString(text) <= maxLength {
+		return text
+	}
+	runes := []rune(text)
+	// Leave room for the ellipsis, and keep the last word if it ends right at the limit
+	limit := maxLength - 1
+	cut := limit
+	if !unicode.IsSpace(runes[limit]) {
+		for cut > 0 && !unicode.IsSpace(runes[cut]) {
+			cut--
+		}
+		if cut == 0 {
+			cut = limit
+		}
+	}
+	return strings.TrimRightFunc(string(runes[:cut]), func(r rune) bool {
+		return unicode.IsSpace(r) || unicode.IsPunct(r)
+	}) + "…"
+}
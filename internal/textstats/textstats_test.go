@@ -0,0 +1,43 @@
+package textstats
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCountWords(t *testing.T) {
+	require.Equal(t, 0, CountWords(""))
+	require.Equal(t, 0, CountWords("   \n\t"))
+	require.Equal(t, 5, CountWords("How to  train\nyour dragon"))
+}
+
+func TestReadingTime(t *testing.T) {
+	require.Equal(t, 0, ReadingTime(0))
+	require.Equal(t, 1, ReadingTime(1))
+	require.Equal(t, 1, ReadingTime(WordsPerMinute))
+	require.Equal(t, 2, ReadingTime(WordsPerMinute+1))
+}
+
+func TestExcerpt(t *testing.T) {
+	t.Run("Should keep short texts whole", func(t *testing.T) {
+		require.Equal(t, "A short text", Excerpt("A  short\ntext", 20))
+	})
+	t.Run("Should cut long texts on a word boundary", func(t *testing.T) {
+		require.Equal(t, "The quick brown…", Excerpt("The quick brown fox jumps over the lazy dog", 18))
+		require.Equal(t, "The quick brown fox…", Excerpt("The quick brown fox jumps over the lazy dog", 20))
+	})
+	t.Run("Should not end on punctuation before the ellipsis", func(t *testing.T) {
+		require.Equal(t, "Hello world…", Excerpt("Hello world, this is long", 16))
+	})
+	t.Run("Should cut words that do not fit", func(t *testing.T) {
+		require.Equal(t, "Supercalifragilisti…", Excerpt("Supercalifragilisticexpialidocious", 20))
+	})
+	t.Run("Should count characters rather than bytes", func(t *testing.T) {
+		excerpt := Excerpt(strings.Repeat("çé ", 100), ExcerptLength)
+		require.LessOrEqual(t, utf8.RuneCountInString(excerpt), ExcerptLength)
+		require.True(t, strings.HasSuffix(excerpt, "çé…"))
+	})
+}